@@ -1,18 +1,53 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"google.golang.org/grpc"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/graphql"
+	grpcserver "github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/server"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/handler"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/router"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/metrics"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/instrumented"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/smtpmailer"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/webhooksender"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
 	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	commentapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/comment"
+	expandapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/expand"
 	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
+	notificationapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/notification"
 	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	orderstreamapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/orderstream"
+	outboxapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/outbox"
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
 	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
+	schedulerapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/scheduler"
 	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
+	webhookapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/webhook"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/config"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
 	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/uuid"
 )
 
@@ -20,58 +55,483 @@ func main() {
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatal("Failed to load configuration:", err)
+		fatal("Failed to load configuration", err)
+	}
+
+	// Structured logging; the records of a request carry its request, user
+	// and laboratory IDs
+	logger, err := logging.New(logging.Config{Level: cfg.Logging.Level, Format: cfg.Logging.Format}, os.Stderr)
+	if err != nil {
+		fatal("Invalid logging configuration", err)
+	}
+	slog.SetDefault(logger)
+
+	// The server runs until SIGTERM or an interrupt, then shuts down gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// OpenTelemetry tracing of the requests, service methods and repository
+	// calls
+	var tracerProvider interface{ Shutdown(context.Context) error }
+	if cfg.Tracing.Enabled {
+		tp, err := tracing.NewProvider(context.Background(), tracing.Config{
+			ServiceName: cfg.Tracing.ServiceName,
+			Exporter:    cfg.Tracing.Exporter,
+			Endpoint:    cfg.Tracing.Endpoint,
+			Insecure:    cfg.Tracing.Insecure,
+			SampleRatio: cfg.Tracing.SampleRatio,
+		}, os.Stdout)
+		if err != nil {
+			fatal("Invalid tracing configuration", err)
+		}
+		tracing.Setup(tp)
+		tracerProvider = tp
 	}
 
 	// Initialize dependencies
 	idGen := uuid.NewGenerator()
 
+	// Readiness checks of /readyz, registered with their dependencies below
+	readiness := health.NewRegistry(cfg.Health.Timeout)
+
+	// Background workers, run until the server shuts down
+	background, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	var workers sync.WaitGroup
+	runInBackground := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(background)
+		}()
+	}
+
 	// Repositories
-	labRepo := memory.NewLaboratoryRepository()
-	clientRepo := memory.NewClientRepository()
-	orderRepo := memory.NewOrderRepository()
-	prosthesisRepo := memory.NewProsthesisRepository()
-	techRepo := memory.NewTechnicianRepository()
+	labStore := memory.NewLaboratoryRepository()
+	clientStore := memory.NewClientRepository()
+	orderStore := memory.NewOrderRepository()
+	prosthesisStore := memory.NewProsthesisRepository()
+	techStore := memory.NewTechnicianRepository()
+	attachmentStorage := memory.NewAttachmentStorage()
+	auditRepo := memory.NewAuditRepository()
+	webhookSubscriptionRepo := memory.NewWebhookSubscriptionRepository()
+	webhookDeliveryRepo := memory.NewWebhookDeliveryRepository()
+	commentStore := memory.NewCommentRepository()
+	notificationSettingsRepo := memory.NewNotificationSettingsRepository()
+	jobRunRepo := memory.NewJobRunRepository()
+	readiness.Register("repositories", pingStores(map[string]outbound.Pinger{
+		"laboratories": labStore,
+		"clients":      clientStore,
+		"orders":       orderStore,
+		"prostheses":   prosthesisStore,
+		"technicians":  techStore,
+		"comments":     commentStore,
+	}))
+
+	// The repositories of the services are timed by the Prometheus metrics,
+	// which compute the order KPIs from the order store and events, and traced
+	var (
+		labRepo        outbound.LaboratoryRepository = labStore
+		clientRepo     outbound.ClientRepository     = clientStore
+		orderRepo      outbound.OrderRepository      = orderStore
+		prosthesisRepo outbound.ProsthesisRepository = prosthesisStore
+		techRepo       outbound.TechnicianRepository = techStore
+		commentRepo    outbound.CommentRepository    = commentStore
+		appMetrics     *metrics.Metrics
+		observers      instrumented.Observers
+	)
+	if cfg.Tracing.Enabled {
+		observers = append(observers, instrumented.NewTracer())
+	}
+	if cfg.Metrics.Enabled {
+		appMetrics = metrics.New(orderStore)
+		observers = append(observers, appMetrics)
+	} else {
+		slog.Warn("Metrics disabled")
+	}
+	if len(observers) > 0 {
+		labRepo = instrumented.NewLaboratoryRepository(labStore, observers)
+		clientRepo = instrumented.NewClientRepository(clientStore, observers)
+		orderRepo = instrumented.NewOrderRepository(orderStore, observers)
+		prosthesisRepo = instrumented.NewProsthesisRepository(prosthesisStore, observers)
+		techRepo = instrumented.NewTechnicianRepository(techStore, observers)
+		commentRepo = instrumented.NewCommentRepository(commentStore, observers)
+	}
+
+	// Event bus, delivering domain events to the subscribed features
+	bus := newEventBus(cfg.Events)
+	if appMetrics != nil {
+		bus.SubscribeAll("metrics", appMetrics.Handle)
+	}
+
+	// Domain events are stored in the outbox within the transaction of their
+	// change and relayed to the bus, or published to it when the transaction
+	// commits when the outbox is disabled
+	transactor := memory.NewTransactor()
+	var events outbound.EventPublisher = memory.NewCommitPublisher(bus)
+	if cfg.Outbox.Enabled {
+		outboxStore := memory.NewOutboxStore()
+		events = outboxapp.NewPublisher(outboxStore, idGen)
+		relay := outboxapp.NewRelay(outboxStore, bus, toRelayConfig(cfg.Outbox))
+		runInBackground(relay.Run)
+		readiness.Register("outbox_relay", relay.Check)
+	} else {
+		slog.Warn("Outbox disabled, events are lost if delivery is interrupted")
+	}
+
+	// Outgoing webhooks: order events are queued as deliveries to the
+	// subscribed URLs and sent in the background
+	if cfg.Webhooks.Enabled {
+		dispatcher := webhookapp.NewDispatcher(webhookSubscriptionRepo, webhookDeliveryRepo, idGen)
+		bus.SubscribeAll("webhooks", dispatcher.Handle)
+		sender := webhooksender.NewHTTPSender(webhooksender.Config{
+			Timeout:              cfg.Webhooks.Timeout,
+			AllowPrivateNetworks: cfg.Webhooks.AllowPrivateNetworks,
+		})
+		worker := webhookapp.NewWorker(webhookDeliveryRepo, webhookSubscriptionRepo, sender, toWorkerConfig(cfg.Webhooks))
+		runInBackground(worker.Run)
+		readiness.Register("webhook_worker", worker.Check)
+	} else {
+		slog.Warn("Outgoing webhooks disabled")
+	}
+
+	// Live order stream, fed by the order events of the bus
+	orderStream := orderstreamapp.NewBroker(labRepo, orderstreamapp.Config{Replay: cfg.Stream.Replay, Buffer: cfg.Stream.Buffer})
+	bus.SubscribeAll("order_stream", orderStream.Handle)
+
+	// Comment WebSockets, fed by the comment events of the bus
+	commentHubs := commentapp.NewHubs(commentapp.HubConfig{Buffer: cfg.Comments.Buffer, TypingInterval: cfg.Comments.TypingInterval})
+	bus.SubscribeAll("comments", commentHubs.Handle)
+
+	// Email notifications to clients and technicians: queued on order events
+	// and sent in the background
+	if cfg.Notifications.Enabled {
+		templates, err := notificationapp.LoadTemplates()
+		if err != nil {
+			fatal("Failed to load notification templates", err)
+		}
+		mailer := smtpmailer.NewMailer(smtpmailer.Config{
+			Host:     cfg.Notifications.SMTP.Host,
+			Port:     cfg.Notifications.SMTP.Port,
+			Username: cfg.Notifications.SMTP.Username,
+			Password: cfg.Notifications.SMTP.Password,
+			Timeout:  cfg.Notifications.SMTP.Timeout,
+		})
+		emailQueue := memory.NewEmailQueue()
+		notifier := notificationapp.NewNotifier(labRepo, clientRepo, techRepo, notificationSettingsRepo, emailQueue, templates, notificationapp.SenderConfig{
+			From:           cfg.Notifications.From,
+			AllowedDomains: cfg.Notifications.SenderDomains,
+		})
+		bus.SubscribeAll("notifications", notifier.Handle)
+		worker := notificationapp.NewWorker(emailQueue, mailer, toEmailWorkerConfig(cfg.Notifications))
+		runInBackground(worker.Run)
+		readiness.Register("email_worker", worker.Check)
+	} else {
+		slog.Warn("Email notifications disabled")
+	}
 
 	// Services
-	labService := labapp.NewService(labRepo, idGen)
-	clientService := clientapp.NewService(clientRepo, labRepo, idGen)
-	orderService := orderapp.NewService(orderRepo, clientRepo, idGen)
-	prosthesisService := prosthesisapp.NewService(prosthesisRepo, labRepo, idGen)
-	techService := techapp.NewService(techRepo, labRepo, idGen)
+	auditService := auditapp.NewService(auditRepo, idGen)
+	labService := labapp.NewService(labRepo, idGen, auditService, events, transactor)
+	clientService := clientapp.NewService(clientRepo, labRepo, idGen, auditService, events, transactor)
+	orderService := orderapp.NewService(orderRepo, clientRepo, techRepo, prosthesisRepo, attachmentStorage, idGen, auditService, events, transactor)
+	prosthesisService := prosthesisapp.NewService(prosthesisRepo, labRepo, idGen, auditService, events, transactor)
+	techService := techapp.NewService(techRepo, labRepo, orderRepo, idGen, auditService, events, transactor)
+	portalService := portalapp.NewService(clientRepo, orderService)
+	expandService := expandapp.NewService(labRepo, clientRepo, techRepo, prosthesisRepo)
+	commentService := commentapp.NewService(commentRepo, orderRepo, clientRepo, idGen, auditService, events, transactor)
+	webhookService := webhookapp.NewService(webhookSubscriptionRepo, webhookDeliveryRepo, labRepo, idGen, auditService)
+	notificationService := notificationapp.NewService(notificationSettingsRepo, labRepo, auditService, cfg.Notifications.SenderDomains)
+
+	// Background jobs, run on their schedules until the process is stopped
+	scheduler := schedulerapp.NewScheduler(jobRunRepo, memory.NewJobLocker(), idGen, schedulerInstance(cfg.Scheduler))
+	err = scheduler.Register(schedulerapp.Job{
+		Name:     schedulerapp.PurgeDeletedJob,
+		Schedule: cfg.Scheduler.PurgeDeleted.Schedule,
+		Timeout:  cfg.Scheduler.PurgeDeleted.Timeout,
+		Task: schedulerapp.PurgeDeleted(map[string]outbound.DeletedPurger{
+			"orders":      orderStore,
+			"clients":     clientStore,
+			"prostheses":  prosthesisStore,
+			"technicians": techStore,
+			"comments":    commentStore,
+		}, cfg.Scheduler.PurgeDeleted.Retention),
+	})
+	if err != nil {
+		fatal("Failed to register the background jobs", err)
+	}
+	if cfg.Scheduler.Enabled {
+		runInBackground(scheduler.Run)
+		readiness.Register("scheduler", scheduler.Check)
+	} else {
+		slog.Warn("Background jobs disabled")
+	}
 
 	// Handlers
 	labHandler := handler.NewLaboratoryHandler(labService)
-	clientHandler := handler.NewClientHandler(clientService)
-	orderHandler := handler.NewOrderHandler(orderService)
+	clientHandler := handler.NewClientHandler(clientService, expandService)
+	orderHandler := handler.NewOrderHandler(orderService, expandService)
 	prosthesisHandler := handler.NewProsthesisHandler(prosthesisService)
 	techHandler := handler.NewTechnicianHandler(techService)
+	portalHandler := handler.NewPortalHandler(portalService)
+	auditHandler := handler.NewAuditHandler(auditService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	orderStreamHandler := handler.NewOrderStreamHandler(orderStream, cfg.Stream.Heartbeat)
+	commentHandler := handler.NewCommentHandler(commentService, commentHubs, cfg.Comments.AllowedOrigins)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	jobHandler := handler.NewJobHandler(scheduler)
+	healthHandler := handler.NewHealthHandler(readiness)
+	graphqlHandler := handler.NewGraphQLHandler(graphql.New(graphql.Services{
+		Laboratories: labService,
+		Clients:      clientService,
+		Orders:       orderService,
+		Prostheses:   prosthesisService,
+		Technicians:  techService,
+	}))
 
 	// Initialize Clerk middleware (optional - only if configured)
 	var clerkMiddleware *auth.ClerkMiddleware
 	if cfg.Clerk.SecretKey != "" {
-		log.Println("Clerk Secret Key configured, authentication enabled")
+		slog.Info("Clerk Secret Key configured, authentication enabled")
 		clerkMiddleware = auth.NewClerkMiddleware(auth.ClerkConfig{
 			SecretKey: cfg.Clerk.SecretKey,
 		})
+		readiness.Register("jwks", health.Cached(clerkMiddleware.CheckJWKS, cfg.Health.JWKSInterval))
+	} else {
+		slog.Warn("Clerk Secret Key not configured, authentication disabled")
+	}
+
+	// Initialize rate limiter (optional - enabled by default)
+	var rateLimiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		// Staff identities aren't bound to laboratories, so only identities are
+		// limited: charging the laboratory_id of the request would let anyone
+		// drain the bucket of another laboratory
+		rateLimiter = ratelimit.NewLimiter(ratelimit.NewMemoryStore(), toRateLimitRules(cfg.RateLimit), nil)
+	} else {
+		slog.Warn("Rate limiting disabled")
+	}
+
+	// Initialize Idempotency-Key handling (optional - enabled by default)
+	var idempotency *handler.Idempotency
+	if cfg.Idempotency.Enabled {
+		idempotency = handler.NewIdempotency(memory.NewIdempotencyStore(), cfg.Idempotency.Window)
 	} else {
-		log.Println("Warning: Clerk Secret Key not configured, authentication disabled")
+		slog.Warn("Idempotency-Key handling disabled")
 	}
 
 	// Create router
 	r := router.New(router.Config{
-		LaboratoryHandler: labHandler,
-		ClientHandler:     clientHandler,
-		OrderHandler:      orderHandler,
-		ProsthesisHandler: prosthesisHandler,
-		TechnicianHandler: techHandler,
-		ClerkMiddleware:   clerkMiddleware,
+		LaboratoryHandler:   labHandler,
+		ClientHandler:       clientHandler,
+		OrderHandler:        orderHandler,
+		OrderStream:         orderStreamHandler,
+		CommentHandler:      commentHandler,
+		NotificationHandler: notificationHandler,
+		ProsthesisHandler:   prosthesisHandler,
+		TechnicianHandler:   techHandler,
+		PortalHandler:       portalHandler,
+		AuditHandler:        auditHandler,
+		GraphQLHandler:      graphqlHandler,
+		WebhookHandler:      webhookHandler,
+		JobHandler:          jobHandler,
+		HealthHandler:       healthHandler,
+		Metrics:             appMetrics,
+		Tracing:             cfg.Tracing.Enabled,
+		ClerkMiddleware:     clerkMiddleware,
+		RateLimiter:         rateLimiter,
+		Localizer:           handler.NewLocalizer(labService),
+		Idempotency:         idempotency,
+		AdminUserIDs:        cfg.Admin.UserIDs,
+		StaffOnly:           handler.RequireStaff(portalService),
 	})
+	if len(cfg.Admin.UserIDs) == 0 {
+		slog.Warn("Admin users not configured, admin endpoints disabled")
+	}
+
+	// Start gRPC server (optional - enabled by default)
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		grpcAddr := cfg.Server.Host + ":" + cfg.GRPC.Port
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			fatal("Failed to listen for gRPC", err)
+		}
+		grpcServer = grpcserver.New(grpcserver.Config{
+			Orders:          orderService,
+			Clients:         clientService,
+			Prostheses:      prosthesisService,
+			Technicians:     techService,
+			ClerkMiddleware: clerkMiddleware,
+			RateLimiter:     rateLimiter,
+			Portal:          portalService,
+		})
+		slog.Info("Starting gRPC server", "addr", grpcAddr)
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				fatal("Failed to start gRPC server", err)
+			}
+		}()
+	}
 
 	// Start server
-	addr := cfg.Server.Host + ":" + cfg.Server.Port
-	log.Printf("Starting server on %s", addr)
-	if err := r.Run(addr); err != nil {
-		log.Fatal("Failed to start server:", err)
+	srv := &http.Server{
+		Addr:              cfg.Server.Host + ":" + cfg.Server.Port,
+		Handler:           r,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+	// Order streams and comment connections never end on their own; their
+	// clients resume elsewhere
+	srv.RegisterOnShutdown(orderStream.Close)
+	srv.RegisterOnShutdown(commentHubs.Close)
+	slog.Info("Starting server", "addr", srv.Addr)
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- srv.ListenAndServe() }()
+
+	select {
+	case err := <-serveErr:
+		fatal("Failed to start server", err)
+	case <-ctx.Done():
+	}
+	stop() // A second signal kills the process
+	slog.Info("Shutting down", "drain_delay", cfg.Server.DrainDelay.String(), "timeout", cfg.Server.ShutdownTimeout.String())
+
+	// Load balancers stop sending requests once their probe sees /readyz
+	// fail, so the server keeps serving for the drain delay; the requests in
+	// flight then get until the shutdown timeout to finish
+	readiness.Drain()
+	time.Sleep(cfg.Server.DrainDelay)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		slog.Error("Failed to finish the requests in flight", "error", err)
+	}
+	if grpcServer != nil {
+		stopGRPC(shutdownCtx, grpcServer)
+	}
+
+	// The workers finish their current batch and the scheduler its running
+	// jobs, then the events still queued are delivered
+	stopBackground()
+	workers.Wait()
+	bus.Close()
+
+	if tracerProvider != nil {
+		if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Failed to export the remaining spans", "error", err)
+		}
+	}
+	slog.Info("Server stopped")
+}
+
+// stopGRPC stops the gRPC server once its calls in flight finish, or cancels
+// them when ctx is done first
+func stopGRPC(ctx context.Context, s *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Error("Failed to finish the gRPC calls in flight", "error", ctx.Err())
+		s.Stop()
+	}
+}
+
+// pingStores returns a readiness check pinging every store
+func pingStores(stores map[string]outbound.Pinger) health.Check {
+	return func(ctx context.Context) error {
+		var errs []error
+		for name, store := range stores {
+			if err := store.Ping(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// fatal logs an error the server can't start with and exits
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// newEventBus creates the event bus selected by the configuration
+func newEventBus(cfg config.EventsConfig) eventbus.Bus {
+	if !cfg.Async {
+		return eventbus.NewSyncBus()
+	}
+	return eventbus.NewAsyncBus(cfg.Buffer, cfg.Workers)
+}
+
+// schedulerInstance returns the name of this instance in job locks and run
+// history, the host name and process ID unless configured
+func schedulerInstance(cfg config.SchedulerConfig) string {
+	if cfg.Instance != "" {
+		return cfg.Instance
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// toRelayConfig converts the outbox configuration to relay settings
+func toRelayConfig(cfg config.OutboxConfig) outboxapp.RelayConfig {
+	relay := outboxapp.DefaultRelayConfig()
+	relay.PollInterval = cfg.PollInterval
+	relay.BatchSize = cfg.BatchSize
+	relay.Retry = outbox.RetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     outbox.Backoff{Initial: cfg.InitialBackoff, Max: cfg.MaxBackoff},
+	}
+	relay.Retention = cfg.Retention
+	return relay
+}
+
+// toWorkerConfig converts the webhooks configuration to worker settings
+func toWorkerConfig(cfg config.WebhooksConfig) webhookapp.WorkerConfig {
+	worker := webhookapp.DefaultWorkerConfig()
+	worker.PollInterval = cfg.PollInterval
+	worker.Retry = webhook.RetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     outbox.Backoff{Initial: cfg.InitialBackoff, Max: cfg.MaxBackoff},
+	}
+	return worker
+}
+
+// toEmailWorkerConfig converts the notifications configuration to email worker settings
+func toEmailWorkerConfig(cfg config.NotificationsConfig) notificationapp.WorkerConfig {
+	worker := notificationapp.DefaultWorkerConfig()
+	worker.PollInterval = cfg.PollInterval
+	worker.Retry = outbox.RetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     outbox.Backoff{Initial: cfg.InitialBackoff, Max: cfg.MaxBackoff},
+	}
+	return worker
+}
+
+// toRateLimitRules converts the rate limit configuration to limiter rules
+func toRateLimitRules(cfg config.RateLimitConfig) ratelimit.Rules {
+	rules := ratelimit.Rules{
+		Default: ratelimit.Rule{
+			RequestsPerMinute: cfg.Default.RequestsPerMinute,
+			Burst:             cfg.Default.Burst,
+		},
+		Groups: make(map[string]ratelimit.Rule, len(cfg.Groups)),
+	}
+	for name, rule := range cfg.Groups {
+		rules.Groups[name] = ratelimit.Rule{
+			RequestsPerMinute: rule.RequestsPerMinute,
+			Burst:             rule.Burst,
+		}
 	}
+	return rules
 }
@@ -0,0 +1,78 @@
+// Package graphql serves a GraphQL schema mirroring the domain. Resolvers call
+// the application services, scoped to one laboratory per request, and resolve
+// related resources through per-request dataloaders.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/graph-gophers/graphql-go"
+
+	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
+	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// maxDepth limits the nesting of queries
+const maxDepth = 10
+
+// Services holds the application services used by the resolvers
+type Services struct {
+	Laboratories *labapp.Service
+	Clients      *clientapp.Service
+	Orders       *orderapp.Service
+	Prostheses   *prosthesisapp.Service
+	Technicians  *techapp.Service
+}
+
+// API executes GraphQL requests
+type API struct {
+	schema   *graphql.Schema
+	services Services
+}
+
+// New creates a new GraphQL API over the given services
+func New(services Services) *API {
+	return &API{
+		schema: graphql.MustParseSchema(schema, &resolver{services: services},
+			graphql.MaxDepth(maxDepth),
+			// Resolve a whole page at once so its related resources load in one batch
+			graphql.MaxParallelism(listing.MaxLimit),
+		),
+		services: services,
+	}
+}
+
+// Schema returns the schema in the GraphQL schema language
+func (a *API) Schema() string {
+	return schema
+}
+
+// Exec executes a query or mutation for a laboratory
+func (a *API) Exec(ctx context.Context, laboratoryID, query, operationName string, variables map[string]any) *graphql.Response {
+	ctx = context.WithValue(ctx, scopeKey{}, &scope{
+		laboratoryID: laboratoryID,
+		loaders:      newLoaders(a.services, laboratoryID),
+	})
+	return a.schema.Exec(ctx, query, operationName, variables)
+}
+
+// scope holds the state of a request
+type scope struct {
+	laboratoryID string
+	loaders      *loaders
+}
+
+type scopeKey struct{}
+
+// scopeFrom returns the scope stored by Exec
+func scopeFrom(ctx context.Context) *scope {
+	return ctx.Value(scopeKey{}).(*scope)
+}
@@ -0,0 +1,310 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go/types"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
+	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/uuid"
+)
+
+// countingClientRepository counts batched client lookups
+type countingClientRepository struct {
+	*memory.ClientRepository
+	mu      sync.Mutex
+	lookups int
+}
+
+func (r *countingClientRepository) GetByIDs(ctx context.Context, ids []string) ([]*client.Client, error) {
+	r.mu.Lock()
+	r.lookups++
+	r.mu.Unlock()
+	return r.ClientRepository.GetByIDs(ctx, ids)
+}
+
+// setupAPI creates an API over memory repositories holding two laboratories
+func setupAPI(t *testing.T) (*API, *countingClientRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	labRepo := memory.NewLaboratoryRepository()
+	clientRepo := &countingClientRepository{ClientRepository: memory.NewClientRepository()}
+	orderRepo := memory.NewOrderRepository()
+	techRepo := memory.NewTechnicianRepository()
+	catalog := memory.NewProsthesisRepository()
+
+	_ = labRepo.Create(ctx, &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"})
+	_ = labRepo.Create(ctx, &laboratory.Laboratory{ID: "lab-456", Name: "Other Lab"})
+	_ = clientRepo.Create(ctx, &client.Client{ID: "client-1", LaboratoryID: "lab-123", Name: "Clinic One"})
+	_ = clientRepo.Create(ctx, &client.Client{ID: "client-2", LaboratoryID: "lab-123", Name: "Clinic Two"})
+	_ = clientRepo.Create(ctx, &client.Client{ID: "client-3", LaboratoryID: "lab-456", Name: "Other Clinic"})
+	_ = techRepo.Create(ctx, &technician.Technician{ID: "tech-1", LaboratoryID: "lab-123", Name: "John Doe", Role: technician.RoleTechnician})
+	_ = catalog.Create(ctx, &prosthesis.Prosthesis{ID: "crown-1", LaboratoryID: "lab-123", Type: prosthesis.ProsthesisTypeCrown, Material: "zirconia"})
+
+	item := order.ProsthesisItem{Type: "crown", Material: "zirconia", Quantity: 1}
+	catalogItem := item
+	catalogItem.CatalogItemID = "crown-1"
+	_ = orderRepo.Create(ctx, &order.Order{ID: "order-1", ClientID: "client-1", LaboratoryID: "lab-123", TechnicianID: "tech-1",
+		Status: order.StatusReceived, Prosthesis: []order.ProsthesisItem{catalogItem}})
+	_ = orderRepo.Create(ctx, &order.Order{ID: "order-2", ClientID: "client-2", LaboratoryID: "lab-123",
+		Status: order.StatusReceived, Prosthesis: []order.ProsthesisItem{item}})
+	_ = orderRepo.Create(ctx, &order.Order{ID: "order-3", ClientID: "client-1", LaboratoryID: "lab-123",
+		Status: order.StatusReceived, Prosthesis: []order.ProsthesisItem{item}})
+	_ = orderRepo.Create(ctx, &order.Order{ID: "order-4", ClientID: "client-3", LaboratoryID: "lab-456",
+		Status: order.StatusReceived, Prosthesis: []order.ProsthesisItem{item}})
+
+	idGen := uuid.NewGenerator()
+	auditor := auditapp.NopRecorder{}
+	api := New(Services{
+		Laboratories: labapp.NewService(labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Clients:      clientapp.NewService(clientRepo, labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Orders:       orderapp.NewService(orderRepo, clientRepo, techRepo, catalog, memory.NewAttachmentStorage(), idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Prostheses:   prosthesisapp.NewService(catalog, labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Technicians:  techapp.NewService(techRepo, labRepo, orderRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+	})
+	return api, clientRepo
+}
+
+// exec runs a query for lab-123 and decodes its data
+func exec(t *testing.T, api *API, query string, variables map[string]any, data any) []error {
+	t.Helper()
+	resp := api.Exec(context.Background(), "lab-123", query, "", variables)
+
+	var errs []error
+	for _, qe := range resp.Errors {
+		errs = append(errs, qe)
+	}
+	if data != nil && resp.Data != nil {
+		if err := json.Unmarshal(resp.Data, data); err != nil {
+			t.Fatalf("Failed to unmarshal data: %v", err)
+		}
+	}
+	return errs
+}
+
+func TestAPI_Orders_NestedResources(t *testing.T) {
+	api, clientRepo := setupAPI(t)
+
+	var data struct {
+		Orders struct {
+			Nodes []struct {
+				ID         string
+				Status     string
+				Client     *struct{ Name string }
+				Laboratory struct {
+					ID string
+				}
+				Technician *struct{ Name string }
+				Prosthesis []struct {
+					CatalogItem *struct{ ID, Type string }
+				}
+			}
+			Total int
+		}
+	}
+	errs := exec(t, api, `{
+		orders {
+			nodes {
+				id status
+				client { name }
+				laboratory { id }
+				technician { name }
+				prosthesis { catalogItem { id type } }
+			}
+			total
+		}
+	}`, nil, &data)
+	if len(errs) > 0 {
+		t.Fatalf("Exec() errors = %v", errs)
+	}
+
+	orders := data.Orders.Nodes
+	if len(orders) != 3 || data.Orders.Total != 3 {
+		t.Fatalf("Exec() orders = %d (total %d), want the 3 orders of lab-123", len(orders), data.Orders.Total)
+	}
+	clients := map[string]string{}
+	for _, o := range orders {
+		if o.Client == nil {
+			t.Fatalf("Exec() order %s has no client", o.ID)
+		}
+		clients[o.ID] = o.Client.Name
+		if o.Laboratory.ID != "lab-123" {
+			t.Errorf("Exec() order %s laboratory = %s, want lab-123", o.ID, o.Laboratory.ID)
+		}
+		if o.Status != "RECEIVED" {
+			t.Errorf("Exec() order %s status = %s, want RECEIVED", o.ID, o.Status)
+		}
+	}
+	want := map[string]string{"order-1": "Clinic One", "order-2": "Clinic Two", "order-3": "Clinic One"}
+	if !reflect.DeepEqual(clients, want) {
+		t.Errorf("Exec() clients = %v, want %v", clients, want)
+	}
+	if clientRepo.lookups != 1 {
+		t.Errorf("Exec() client lookups = %d, want 1", clientRepo.lookups)
+	}
+
+	first := orders[0]
+	if first.ID != "order-1" {
+		t.Fatalf("Exec() first order = %s, want order-1", first.ID)
+	}
+	if first.Technician == nil || first.Technician.Name != "John Doe" {
+		t.Errorf("Exec() technician = %+v, want John Doe", first.Technician)
+	}
+	if item := first.Prosthesis[0].CatalogItem; item == nil || item.ID != "crown-1" || item.Type != "CROWN" {
+		t.Errorf("Exec() catalog item = %+v, want crown-1", item)
+	}
+	if orders[1].Technician != nil {
+		t.Errorf("Exec() unassigned order technician = %+v, want nil", orders[1].Technician)
+	}
+}
+
+func TestAPI_LaboratoryScope(t *testing.T) {
+	api, _ := setupAPI(t)
+
+	var data struct {
+		Order  *struct{ ID string }
+		Client *struct{ ID string }
+		Orders struct {
+			Total int
+		}
+	}
+	errs := exec(t, api, `{
+		order(id: "order-4") { id }
+		client(id: "client-3") { id }
+		orders(filter: {clientId: "client-3"}) { total }
+	}`, nil, &data)
+	if len(errs) > 0 {
+		t.Fatalf("Exec() errors = %v", errs)
+	}
+
+	if data.Order != nil || data.Client != nil || data.Orders.Total != 0 {
+		t.Errorf("Exec() data = %+v, want nothing of another laboratory", data)
+	}
+}
+
+func TestAPI_OrderMutations(t *testing.T) {
+	api, _ := setupAPI(t)
+
+	var created struct {
+		CreateOrder struct {
+			ID         string
+			Status     string
+			Client     struct{ Name string }
+			Prosthesis []struct {
+				Quantity    int
+				CatalogItem *struct{ ID string }
+			}
+		}
+	}
+	errs := exec(t, api, `mutation($input: CreateOrderInput!) {
+		createOrder(input: $input) {
+			id status
+			client { name }
+			prosthesis { quantity catalogItem { id } }
+		}
+	}`, map[string]any{"input": map[string]any{
+		"clientId": "client-2",
+		"prosthesis": []any{
+			map[string]any{"type": "crown", "material": "zirconia", "quantity": 2, "catalogItemId": "crown-1"},
+		},
+	}}, &created)
+	if len(errs) > 0 {
+		t.Fatalf("createOrder errors = %v", errs)
+	}
+
+	o := created.CreateOrder
+	if o.ID == "" || o.Status != "RECEIVED" || o.Client.Name != "Clinic Two" {
+		t.Errorf("createOrder = %+v, want a received order of Clinic Two", o)
+	}
+	if len(o.Prosthesis) != 1 || o.Prosthesis[0].Quantity != 2 || o.Prosthesis[0].CatalogItem == nil {
+		t.Errorf("createOrder prosthesis = %+v, want 2 crowns from the catalog", o.Prosthesis)
+	}
+
+	var updated struct {
+		UpdateOrderStatus struct {
+			Status  string
+			History []struct {
+				From *string
+				To   string
+			}
+		}
+	}
+	errs = exec(t, api, `mutation($id: ID!) {
+		updateOrderStatus(id: $id, status: IN_PRODUCTION) { status history { from to } }
+	}`, map[string]any{"id": o.ID}, &updated)
+	if len(errs) > 0 {
+		t.Fatalf("updateOrderStatus errors = %v", errs)
+	}
+	if updated.UpdateOrderStatus.Status != "IN_PRODUCTION" || len(updated.UpdateOrderStatus.History) != 2 {
+		t.Errorf("updateOrderStatus = %+v, want IN_PRODUCTION with 2 history entries", updated.UpdateOrderStatus)
+	}
+	if h := updated.UpdateOrderStatus.History[0]; h.From != nil || h.To != "RECEIVED" {
+		t.Errorf("updateOrderStatus history[0] = %+v, want the initial status", h)
+	}
+
+	errs = exec(t, api, `mutation { updateOrderStatus(id: "order-2", status: DELIVERED) { status } }`, nil, nil)
+	if len(errs) != 1 || !errors.Is(errs[0], domainerrors.ErrInvalidStatusTransition) {
+		t.Errorf("updateOrderStatus errors = %v, want %v", errs, domainerrors.ErrInvalidStatusTransition)
+	}
+
+	errs = exec(t, api, `mutation { updateOrderStatus(id: "order-4", status: IN_PRODUCTION) { status } }`, nil, nil)
+	if len(errs) != 1 || !errors.Is(errs[0], domainerrors.ErrNotFound) {
+		t.Errorf("updateOrderStatus errors = %v, want %v", errs, domainerrors.ErrNotFound)
+	}
+}
+
+func TestSchema_EnumsMatchDomain(t *testing.T) {
+	api, _ := setupAPI(t)
+
+	tests := []struct {
+		enum   string
+		values []string
+	}{
+		{"OrderStatus", domainValues(order.AllStatuses())},
+		{"ProsthesisType", domainValues(prosthesis.AllProsthesisTypes())},
+		{"TechnicianRole", domainValues(technician.AllRoles())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.enum, func(t *testing.T) {
+			enum, ok := api.schema.ASTSchema().Types[tt.enum].(*types.EnumTypeDefinition)
+			if !ok {
+				t.Fatalf("schema has no enum %s", tt.enum)
+			}
+			var got []string
+			for _, v := range enum.EnumValuesDefinition {
+				got = append(got, fromEnum(v.EnumValue))
+			}
+			if !reflect.DeepEqual(got, tt.values) {
+				t.Errorf("enum %s = %v, want %v", tt.enum, got, tt.values)
+			}
+		})
+	}
+}
+
+func domainValues[T ~string](values []T) []string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = string(v)
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader/v7"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+)
+
+// loaders batch and cache the lookups of related resources within a request.
+// IDs outside the laboratory load as nil.
+type loaders struct {
+	laboratory  *dataloader.Loader[string, *laboratory.Laboratory]
+	clients     *dataloader.Loader[string, *client.Client]
+	technicians *dataloader.Loader[string, *technician.Technician]
+	catalog     *dataloader.Loader[string, *prosthesis.Prosthesis]
+}
+
+// newLoaders creates the loaders of a request scoped to a laboratory
+func newLoaders(services Services, laboratoryID string) *loaders {
+	return &loaders{
+		laboratory: dataloader.NewBatchedLoader(func(ctx context.Context, ids []string) []*dataloader.Result[*laboratory.Laboratory] {
+			// Only the laboratory of the request is ever loaded
+			lab, err := services.Laboratories.GetLaboratory(ctx, laboratoryID)
+			if err == errors.ErrNotFound {
+				err = nil
+			}
+			results := make([]*dataloader.Result[*laboratory.Laboratory], len(ids))
+			for i, id := range ids {
+				results[i] = &dataloader.Result[*laboratory.Laboratory]{Error: err}
+				if lab != nil && id == laboratoryID {
+					results[i].Data = lab
+				}
+			}
+			return results
+		}),
+		clients: dataloader.NewBatchedLoader(batch(laboratoryID, services.Clients.GetClients, func(c *client.Client) string {
+			return c.ID
+		})),
+		technicians: dataloader.NewBatchedLoader(batch(laboratoryID, services.Technicians.GetTechnicians, func(t *technician.Technician) string {
+			return t.ID
+		})),
+		catalog: dataloader.NewBatchedLoader(batch(laboratoryID, services.Prostheses.GetProstheses, func(p *prosthesis.Prosthesis) string {
+			return p.ID
+		})),
+	}
+}
+
+// batch adapts a laboratory-scoped service lookup to a dataloader batch
+// function, which must return one result per key in key order
+func batch[T any](laboratoryID string, get func(ctx context.Context, ids []string, laboratoryID string) ([]T, error), id func(T) string) dataloader.BatchFunc[string, T] {
+	return func(ctx context.Context, ids []string) []*dataloader.Result[T] {
+		results := make([]*dataloader.Result[T], len(ids))
+
+		found, err := get(ctx, ids, laboratoryID)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[T]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[string]T, len(found))
+		for _, v := range found {
+			byID[id(v)] = v
+		}
+		for i, key := range ids {
+			results[i] = &dataloader.Result[T]{Data: byID[key]}
+		}
+		return results
+	}
+}
+
+// load loads a related resource, resolving an empty ID to nil
+func load[T any](ctx context.Context, loader *dataloader.Loader[string, *T], id string) (*T, error) {
+	if id == "" {
+		return nil, nil
+	}
+	return loader.Load(ctx, id)()
+}
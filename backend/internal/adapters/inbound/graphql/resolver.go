@@ -0,0 +1,250 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// resolver resolves the Query and Mutation fields
+type resolver struct {
+	services Services
+}
+
+// pageArgs are the pagination arguments of list fields
+type pageArgs struct {
+	First *int32
+	After *string
+}
+
+// query converts the pagination arguments to a list query
+func (a pageArgs) query() (listing.Query, error) {
+	var q listing.Query
+	if a.First != nil {
+		if *a.First < 1 {
+			return q, errors.Validation(errors.PositiveInteger("first"))
+		}
+		q.Limit = int(*a.First)
+	}
+	if a.After != nil {
+		q.Cursor = *a.After
+	}
+	return q, nil
+}
+
+// orNil resolves a not found error to a null field
+func orNil[T any](v *T, err error) (*T, error) {
+	if err == errors.ErrNotFound {
+		return nil, nil
+	}
+	return v, err
+}
+
+func (r *resolver) Laboratory(ctx context.Context) (*laboratoryResolver, error) {
+	lab, err := r.services.Laboratories.GetLaboratory(ctx, scopeFrom(ctx).laboratoryID)
+	if err != nil {
+		return nil, err
+	}
+	return &laboratoryResolver{lab}, nil
+}
+
+func (r *resolver) Client(ctx context.Context, args struct{ ID graphql.ID }) (*clientResolver, error) {
+	c, err := orNil(r.services.Clients.GetClient(ctx, string(args.ID), scopeFrom(ctx).laboratoryID))
+	if c == nil {
+		return nil, err
+	}
+	return &clientResolver{c}, nil
+}
+
+func (r *resolver) Clients(ctx context.Context, args pageArgs) (*connection[*clientResolver], error) {
+	q, err := args.query()
+	if err != nil {
+		return nil, err
+	}
+	page, err := r.services.Clients.ListClients(ctx, scopeFrom(ctx).laboratoryID, q)
+	if err != nil {
+		return nil, err
+	}
+	return newConnection(page, newClientResolver), nil
+}
+
+func (r *resolver) Order(ctx context.Context, args struct{ ID graphql.ID }) (*orderResolver, error) {
+	o, err := orNil(r.services.Orders.GetOrder(ctx, string(args.ID), scopeFrom(ctx).laboratoryID))
+	if o == nil {
+		return nil, err
+	}
+	return &orderResolver{o}, nil
+}
+
+// orderFilter are the arguments of the OrderFilter input
+type orderFilter struct {
+	Status         *[]string
+	ClientID       *graphql.ID
+	TechnicianID   *graphql.ID
+	CreatedFrom    *graphql.Time
+	CreatedTo      *graphql.Time
+	UpdatedFrom    *graphql.Time
+	UpdatedTo      *graphql.Time
+	ProsthesisType *string
+	Material       *string
+	Q              *string
+}
+
+// criteria converts the filter to order search criteria
+func (f *orderFilter) criteria() order.SearchCriteria {
+	var c order.SearchCriteria
+	if f == nil {
+		return c
+	}
+	if f.Status != nil {
+		for _, s := range *f.Status {
+			c.Statuses = append(c.Statuses, order.Status(fromEnum(s)))
+		}
+	}
+	if f.ClientID != nil {
+		c.ClientID = string(*f.ClientID)
+	}
+	if f.TechnicianID != nil {
+		c.TechnicianID = string(*f.TechnicianID)
+	}
+	c.CreatedFrom, c.CreatedTo = timeOf(f.CreatedFrom), timeOf(f.CreatedTo)
+	c.UpdatedFrom, c.UpdatedTo = timeOf(f.UpdatedFrom), timeOf(f.UpdatedTo)
+	c.ProsthesisType, c.Material, c.Text = value(f.ProsthesisType), value(f.Material), value(f.Q)
+	return c
+}
+
+func (r *resolver) Orders(ctx context.Context, args struct {
+	Filter *orderFilter
+	First  *int32
+	After  *string
+}) (*connection[*orderResolver], error) {
+	q, err := pageArgs{First: args.First, After: args.After}.query()
+	if err != nil {
+		return nil, err
+	}
+	page, err := r.services.Orders.ListOrders(ctx, scopeFrom(ctx).laboratoryID, args.Filter.criteria(), q)
+	if err != nil {
+		return nil, err
+	}
+	return newConnection(page, newOrderResolver), nil
+}
+
+func (r *resolver) Prosthesis(ctx context.Context, args struct{ ID graphql.ID }) (*prosthesisResolver, error) {
+	p, err := orNil(r.services.Prostheses.GetProsthesis(ctx, string(args.ID), scopeFrom(ctx).laboratoryID))
+	if p == nil {
+		return nil, err
+	}
+	return &prosthesisResolver{p}, nil
+}
+
+func (r *resolver) Prostheses(ctx context.Context, args pageArgs) (*connection[*prosthesisResolver], error) {
+	q, err := args.query()
+	if err != nil {
+		return nil, err
+	}
+	page, err := r.services.Prostheses.ListProstheses(ctx, scopeFrom(ctx).laboratoryID, q)
+	if err != nil {
+		return nil, err
+	}
+	return newConnection(page, newProsthesisResolver), nil
+}
+
+func (r *resolver) Technician(ctx context.Context, args struct{ ID graphql.ID }) (*technicianResolver, error) {
+	t, err := orNil(r.services.Technicians.GetTechnician(ctx, string(args.ID), scopeFrom(ctx).laboratoryID))
+	if t == nil {
+		return nil, err
+	}
+	return &technicianResolver{t}, nil
+}
+
+func (r *resolver) Technicians(ctx context.Context, args pageArgs) (*connection[*technicianResolver], error) {
+	q, err := args.query()
+	if err != nil {
+		return nil, err
+	}
+	page, err := r.services.Technicians.ListTechnicians(ctx, scopeFrom(ctx).laboratoryID, q)
+	if err != nil {
+		return nil, err
+	}
+	return newConnection(page, newTechnicianResolver), nil
+}
+
+// prosthesisItemInput are the arguments of the ProsthesisItemInput input
+type prosthesisItemInput struct {
+	Type          string
+	Material      string
+	Shade         *string
+	Quantity      int32
+	Notes         *string
+	CatalogItemID *graphql.ID
+}
+
+func (r *resolver) CreateOrder(ctx context.Context, args struct {
+	Input struct {
+		ClientID   graphql.ID
+		Prosthesis []prosthesisItemInput
+	}
+}) (*orderResolver, error) {
+	items := make([]order.ProsthesisItem, len(args.Input.Prosthesis))
+	for i, item := range args.Input.Prosthesis {
+		items[i] = order.ProsthesisItem{
+			Type:     item.Type,
+			Material: item.Material,
+			Shade:    value(item.Shade),
+			Quantity: int(item.Quantity),
+			Notes:    value(item.Notes),
+		}
+		if item.CatalogItemID != nil {
+			items[i].CatalogItemID = string(*item.CatalogItemID)
+		}
+	}
+
+	o, err := r.services.Orders.CreateOrder(ctx, orderapp.CreateInput{
+		ClientID:     string(args.Input.ClientID),
+		LaboratoryID: scopeFrom(ctx).laboratoryID,
+		Prosthesis:   items,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &orderResolver{o}, nil
+}
+
+func (r *resolver) UpdateOrderStatus(ctx context.Context, args struct {
+	ID     graphql.ID
+	Status string
+}) (*orderResolver, error) {
+	o, err := r.services.Orders.UpdateOrderStatus(ctx, orderapp.UpdateStatusInput{
+		ID:           string(args.ID),
+		LaboratoryID: scopeFrom(ctx).laboratoryID,
+		Status:       order.Status(fromEnum(args.Status)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &orderResolver{o}, nil
+}
+
+// toEnum converts a domain value such as in_production to its GraphQL enum value, IN_PRODUCTION
+func toEnum(s string) string {
+	return strings.ToUpper(s)
+}
+
+// fromEnum converts a GraphQL enum value to its domain value
+func fromEnum(s string) string {
+	return strings.ToLower(s)
+}
+
+// value returns the string an optional argument points to, or an empty string
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,232 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+)
+
+// connection resolves a page of a list field
+type connection[R any] struct {
+	nodes      []R
+	nextCursor string
+	total      int
+}
+
+// newConnection wraps every item of a page in its resolver
+func newConnection[T, R any](page listing.Page[T], wrap func(T) R) *connection[R] {
+	nodes := make([]R, len(page.Items))
+	for i, item := range page.Items {
+		nodes[i] = wrap(item)
+	}
+	return &connection[R]{nodes: nodes, nextCursor: page.NextCursor, total: page.Total}
+}
+
+func (c *connection[R]) Nodes() []R         { return c.nodes }
+func (c *connection[R]) NextCursor() string { return c.nextCursor }
+func (c *connection[R]) Total() int32       { return int32(c.total) }
+
+// address resolves the Address type of laboratories and clients
+type address struct {
+	street, city, state, postalCode, country string
+}
+
+func (a *address) Street() string     { return a.street }
+func (a *address) City() string       { return a.city }
+func (a *address) State() string      { return a.state }
+func (a *address) PostalCode() string { return a.postalCode }
+func (a *address) Country() string    { return a.country }
+
+// laboratoryResolver resolves the Laboratory type
+type laboratoryResolver struct {
+	l *laboratory.Laboratory
+}
+
+func (r *laboratoryResolver) ID() graphql.ID          { return graphql.ID(r.l.ID) }
+func (r *laboratoryResolver) Name() string            { return r.l.Name }
+func (r *laboratoryResolver) Email() string           { return r.l.Email }
+func (r *laboratoryResolver) Phone() string           { return r.l.Phone }
+func (r *laboratoryResolver) Language() string        { return string(r.l.Language) }
+func (r *laboratoryResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.l.CreatedAt} }
+func (r *laboratoryResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.l.UpdatedAt} }
+
+func (r *laboratoryResolver) Address() *address {
+	a := r.l.Address
+	return &address{a.Street, a.City, a.State, a.PostalCode, a.Country}
+}
+
+// loadLaboratory resolves the laboratory of the request
+func loadLaboratory(ctx context.Context) (*laboratoryResolver, error) {
+	s := scopeFrom(ctx)
+	lab, err := load(ctx, s.loaders.laboratory, s.laboratoryID)
+	if lab == nil {
+		return nil, err
+	}
+	return &laboratoryResolver{lab}, nil
+}
+
+// clientResolver resolves the Client type
+type clientResolver struct {
+	c *client.Client
+}
+
+func newClientResolver(c *client.Client) *clientResolver { return &clientResolver{c} }
+
+func (r *clientResolver) ID() graphql.ID          { return graphql.ID(r.c.ID) }
+func (r *clientResolver) Name() string            { return r.c.Name }
+func (r *clientResolver) Email() string           { return r.c.Email }
+func (r *clientResolver) Phone() string           { return r.c.Phone }
+func (r *clientResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.c.CreatedAt} }
+func (r *clientResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.c.UpdatedAt} }
+
+func (r *clientResolver) Address() *address {
+	a := r.c.Address
+	return &address{a.Street, a.City, a.State, a.PostalCode, a.Country}
+}
+
+func (r *clientResolver) Laboratory(ctx context.Context) (*laboratoryResolver, error) {
+	return loadLaboratory(ctx)
+}
+
+// orderResolver resolves the Order type
+type orderResolver struct {
+	o *order.Order
+}
+
+func newOrderResolver(o *order.Order) *orderResolver { return &orderResolver{o} }
+
+func (r *orderResolver) ID() graphql.ID          { return graphql.ID(r.o.ID) }
+func (r *orderResolver) Status() string          { return toEnum(string(r.o.Status)) }
+func (r *orderResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.o.CreatedAt} }
+func (r *orderResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.o.UpdatedAt} }
+
+func (r *orderResolver) Client(ctx context.Context) (*clientResolver, error) {
+	c, err := load(ctx, scopeFrom(ctx).loaders.clients, r.o.ClientID)
+	if c == nil {
+		return nil, err
+	}
+	return &clientResolver{c}, nil
+}
+
+func (r *orderResolver) Laboratory(ctx context.Context) (*laboratoryResolver, error) {
+	return loadLaboratory(ctx)
+}
+
+func (r *orderResolver) Technician(ctx context.Context) (*technicianResolver, error) {
+	t, err := load(ctx, scopeFrom(ctx).loaders.technicians, r.o.TechnicianID)
+	if t == nil {
+		return nil, err
+	}
+	return &technicianResolver{t}, nil
+}
+
+func (r *orderResolver) Prosthesis() []*prosthesisItemResolver {
+	items := make([]*prosthesisItemResolver, len(r.o.Prosthesis))
+	for i := range r.o.Prosthesis {
+		items[i] = &prosthesisItemResolver{r.o.Prosthesis[i]}
+	}
+	return items
+}
+
+func (r *orderResolver) History() []*statusChangeResolver {
+	history := make([]*statusChangeResolver, len(r.o.History))
+	for i := range r.o.History {
+		history[i] = &statusChangeResolver{r.o.History[i]}
+	}
+	return history
+}
+
+// prosthesisItemResolver resolves the ProsthesisItem type
+type prosthesisItemResolver struct {
+	item order.ProsthesisItem
+}
+
+func (r *prosthesisItemResolver) Type() string     { return r.item.Type }
+func (r *prosthesisItemResolver) Material() string { return r.item.Material }
+func (r *prosthesisItemResolver) Shade() string    { return r.item.Shade }
+func (r *prosthesisItemResolver) Quantity() int32  { return int32(r.item.Quantity) }
+func (r *prosthesisItemResolver) Notes() string    { return r.item.Notes }
+
+func (r *prosthesisItemResolver) CatalogItem(ctx context.Context) (*prosthesisResolver, error) {
+	p, err := load(ctx, scopeFrom(ctx).loaders.catalog, r.item.CatalogItemID)
+	if p == nil {
+		return nil, err
+	}
+	return &prosthesisResolver{p}, nil
+}
+
+// statusChangeResolver resolves the StatusChange type
+type statusChangeResolver struct {
+	change order.StatusChange
+}
+
+func (r *statusChangeResolver) To() string { return toEnum(string(r.change.To)) }
+func (r *statusChangeResolver) ChangedAt() graphql.Time {
+	return graphql.Time{Time: r.change.ChangedAt}
+}
+
+func (r *statusChangeResolver) From() *string {
+	if r.change.From == "" {
+		return nil
+	}
+	from := toEnum(string(r.change.From))
+	return &from
+}
+
+// prosthesisResolver resolves the Prosthesis type
+type prosthesisResolver struct {
+	p *prosthesis.Prosthesis
+}
+
+func newProsthesisResolver(p *prosthesis.Prosthesis) *prosthesisResolver {
+	return &prosthesisResolver{p}
+}
+
+func (r *prosthesisResolver) ID() graphql.ID          { return graphql.ID(r.p.ID) }
+func (r *prosthesisResolver) Type() string            { return toEnum(string(r.p.Type)) }
+func (r *prosthesisResolver) Material() string        { return r.p.Material }
+func (r *prosthesisResolver) Shade() string           { return r.p.Shade }
+func (r *prosthesisResolver) Specifications() string  { return r.p.Specifications }
+func (r *prosthesisResolver) Notes() string           { return r.p.Notes }
+func (r *prosthesisResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.p.CreatedAt} }
+func (r *prosthesisResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.p.UpdatedAt} }
+
+// technicianResolver resolves the Technician type
+type technicianResolver struct {
+	t *technician.Technician
+}
+
+func newTechnicianResolver(t *technician.Technician) *technicianResolver {
+	return &technicianResolver{t}
+}
+
+func (r *technicianResolver) ID() graphql.ID          { return graphql.ID(r.t.ID) }
+func (r *technicianResolver) Name() string            { return r.t.Name }
+func (r *technicianResolver) Email() string           { return r.t.Email }
+func (r *technicianResolver) Phone() string           { return r.t.Phone }
+func (r *technicianResolver) Role() string            { return toEnum(string(r.t.Role)) }
+func (r *technicianResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.t.CreatedAt} }
+func (r *technicianResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.t.UpdatedAt} }
+
+func (r *technicianResolver) Specializations() []string {
+	if r.t.Specializations == nil {
+		return []string{}
+	}
+	return r.t.Specializations
+}
+
+// timeOf returns the time an optional argument points to, or nil
+func timeOf(t *graphql.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
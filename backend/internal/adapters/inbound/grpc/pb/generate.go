@@ -0,0 +1,5 @@
+// Package pb holds the Go code generated from the protobuf definitions in
+// api/proto. Regenerate it with go generate after changing them.
+package pb
+
+//go:generate protoc -I ../../../../../api/proto --go_out=../../../../.. --go_opt=module=github.com/JonatasP2A/dental-prosthesis/backend --go-grpc_out=../../../../.. --go-grpc_opt=module=github.com/JonatasP2A/dental-prosthesis/backend dental/v1/common.proto dental/v1/clients.proto dental/v1/orders.proto dental/v1/prostheses.proto dental/v1/technicians.proto
@@ -0,0 +1,917 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.21.12
+// source: dental/v1/prostheses.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProsthesisType int32
+
+const (
+	ProsthesisType_PROSTHESIS_TYPE_UNSPECIFIED      ProsthesisType = 0
+	ProsthesisType_PROSTHESIS_TYPE_CROWN            ProsthesisType = 1
+	ProsthesisType_PROSTHESIS_TYPE_BRIDGE           ProsthesisType = 2
+	ProsthesisType_PROSTHESIS_TYPE_COMPLETE_DENTURE ProsthesisType = 3
+	ProsthesisType_PROSTHESIS_TYPE_PARTIAL_DENTURE  ProsthesisType = 4
+	ProsthesisType_PROSTHESIS_TYPE_IMPLANT          ProsthesisType = 5
+	ProsthesisType_PROSTHESIS_TYPE_VENEER           ProsthesisType = 6
+	ProsthesisType_PROSTHESIS_TYPE_INLAY            ProsthesisType = 7
+	ProsthesisType_PROSTHESIS_TYPE_ONLAY            ProsthesisType = 8
+)
+
+// Enum value maps for ProsthesisType.
+var (
+	ProsthesisType_name = map[int32]string{
+		0: "PROSTHESIS_TYPE_UNSPECIFIED",
+		1: "PROSTHESIS_TYPE_CROWN",
+		2: "PROSTHESIS_TYPE_BRIDGE",
+		3: "PROSTHESIS_TYPE_COMPLETE_DENTURE",
+		4: "PROSTHESIS_TYPE_PARTIAL_DENTURE",
+		5: "PROSTHESIS_TYPE_IMPLANT",
+		6: "PROSTHESIS_TYPE_VENEER",
+		7: "PROSTHESIS_TYPE_INLAY",
+		8: "PROSTHESIS_TYPE_ONLAY",
+	}
+	ProsthesisType_value = map[string]int32{
+		"PROSTHESIS_TYPE_UNSPECIFIED":      0,
+		"PROSTHESIS_TYPE_CROWN":            1,
+		"PROSTHESIS_TYPE_BRIDGE":           2,
+		"PROSTHESIS_TYPE_COMPLETE_DENTURE": 3,
+		"PROSTHESIS_TYPE_PARTIAL_DENTURE":  4,
+		"PROSTHESIS_TYPE_IMPLANT":          5,
+		"PROSTHESIS_TYPE_VENEER":           6,
+		"PROSTHESIS_TYPE_INLAY":            7,
+		"PROSTHESIS_TYPE_ONLAY":            8,
+	}
+)
+
+func (x ProsthesisType) Enum() *ProsthesisType {
+	p := new(ProsthesisType)
+	*p = x
+	return p
+}
+
+func (x ProsthesisType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProsthesisType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dental_v1_prostheses_proto_enumTypes[0].Descriptor()
+}
+
+func (ProsthesisType) Type() protoreflect.EnumType {
+	return &file_dental_v1_prostheses_proto_enumTypes[0]
+}
+
+func (x ProsthesisType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProsthesisType.Descriptor instead.
+func (ProsthesisType) EnumDescriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{0}
+}
+
+type Prosthesis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LaboratoryId   string                 `protobuf:"bytes,2,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Type           ProsthesisType         `protobuf:"varint,3,opt,name=type,proto3,enum=dental.v1.ProsthesisType" json:"type,omitempty"`
+	Material       string                 `protobuf:"bytes,4,opt,name=material,proto3" json:"material,omitempty"`
+	Shade          string                 `protobuf:"bytes,5,opt,name=shade,proto3" json:"shade,omitempty"`
+	Specifications string                 `protobuf:"bytes,6,opt,name=specifications,proto3" json:"specifications,omitempty"`
+	Notes          string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Prosthesis) Reset() {
+	*x = Prosthesis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_prostheses_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Prosthesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prosthesis) ProtoMessage() {}
+
+func (x *Prosthesis) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_prostheses_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prosthesis.ProtoReflect.Descriptor instead.
+func (*Prosthesis) Descriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Prosthesis) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Prosthesis) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *Prosthesis) GetType() ProsthesisType {
+	if x != nil {
+		return x.Type
+	}
+	return ProsthesisType_PROSTHESIS_TYPE_UNSPECIFIED
+}
+
+func (x *Prosthesis) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+func (x *Prosthesis) GetShade() string {
+	if x != nil {
+		return x.Shade
+	}
+	return ""
+}
+
+func (x *Prosthesis) GetSpecifications() string {
+	if x != nil {
+		return x.Specifications
+	}
+	return ""
+}
+
+func (x *Prosthesis) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Prosthesis) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Prosthesis) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateProsthesisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId   string         `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Type           ProsthesisType `protobuf:"varint,2,opt,name=type,proto3,enum=dental.v1.ProsthesisType" json:"type,omitempty"`
+	Material       string         `protobuf:"bytes,3,opt,name=material,proto3" json:"material,omitempty"`
+	Shade          string         `protobuf:"bytes,4,opt,name=shade,proto3" json:"shade,omitempty"`
+	Specifications string         `protobuf:"bytes,5,opt,name=specifications,proto3" json:"specifications,omitempty"`
+	Notes          string         `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *CreateProsthesisRequest) Reset() {
+	*x = CreateProsthesisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_prostheses_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProsthesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProsthesisRequest) ProtoMessage() {}
+
+func (x *CreateProsthesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_prostheses_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProsthesisRequest.ProtoReflect.Descriptor instead.
+func (*CreateProsthesisRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateProsthesisRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *CreateProsthesisRequest) GetType() ProsthesisType {
+	if x != nil {
+		return x.Type
+	}
+	return ProsthesisType_PROSTHESIS_TYPE_UNSPECIFIED
+}
+
+func (x *CreateProsthesisRequest) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+func (x *CreateProsthesisRequest) GetShade() string {
+	if x != nil {
+		return x.Shade
+	}
+	return ""
+}
+
+func (x *CreateProsthesisRequest) GetSpecifications() string {
+	if x != nil {
+		return x.Specifications
+	}
+	return ""
+}
+
+func (x *CreateProsthesisRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type GetProsthesisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId string `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetProsthesisRequest) Reset() {
+	*x = GetProsthesisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_prostheses_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProsthesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProsthesisRequest) ProtoMessage() {}
+
+func (x *GetProsthesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_prostheses_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProsthesisRequest.ProtoReflect.Descriptor instead.
+func (*GetProsthesisRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProsthesisRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *GetProsthesisRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListProsthesesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId string `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	// Filters: type, material, shade
+	Page *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListProsthesesRequest) Reset() {
+	*x = ListProsthesesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_prostheses_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProsthesesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProsthesesRequest) ProtoMessage() {}
+
+func (x *ListProsthesesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_prostheses_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProsthesesRequest.ProtoReflect.Descriptor instead.
+func (*ListProsthesesRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListProsthesesRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *ListProsthesesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListProsthesesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Prosthesis `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Total      int32  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListProsthesesResponse) Reset() {
+	*x = ListProsthesesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_prostheses_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProsthesesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProsthesesResponse) ProtoMessage() {}
+
+func (x *ListProsthesesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_prostheses_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProsthesesResponse.ProtoReflect.Descriptor instead.
+func (*ListProsthesesResponse) Descriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListProsthesesResponse) GetItems() []*Prosthesis {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListProsthesesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListProsthesesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateProsthesisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId   string         `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Id             string         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type           ProsthesisType `protobuf:"varint,3,opt,name=type,proto3,enum=dental.v1.ProsthesisType" json:"type,omitempty"`
+	Material       string         `protobuf:"bytes,4,opt,name=material,proto3" json:"material,omitempty"`
+	Shade          string         `protobuf:"bytes,5,opt,name=shade,proto3" json:"shade,omitempty"`
+	Specifications string         `protobuf:"bytes,6,opt,name=specifications,proto3" json:"specifications,omitempty"`
+	Notes          string         `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *UpdateProsthesisRequest) Reset() {
+	*x = UpdateProsthesisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_prostheses_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProsthesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProsthesisRequest) ProtoMessage() {}
+
+func (x *UpdateProsthesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_prostheses_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProsthesisRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProsthesisRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateProsthesisRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *UpdateProsthesisRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProsthesisRequest) GetType() ProsthesisType {
+	if x != nil {
+		return x.Type
+	}
+	return ProsthesisType_PROSTHESIS_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateProsthesisRequest) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+func (x *UpdateProsthesisRequest) GetShade() string {
+	if x != nil {
+		return x.Shade
+	}
+	return ""
+}
+
+func (x *UpdateProsthesisRequest) GetSpecifications() string {
+	if x != nil {
+		return x.Specifications
+	}
+	return ""
+}
+
+func (x *UpdateProsthesisRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type DeleteProsthesisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId string `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteProsthesisRequest) Reset() {
+	*x = DeleteProsthesisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_prostheses_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProsthesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProsthesisRequest) ProtoMessage() {}
+
+func (x *DeleteProsthesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_prostheses_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProsthesisRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProsthesisRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_prostheses_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteProsthesisRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *DeleteProsthesisRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_dental_v1_prostheses_proto protoreflect.FileDescriptor
+
+var file_dental_v1_prostheses_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x73,
+	0x74, 0x68, 0x65, 0x73, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x64, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x02,
+	0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x73,
+	0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x68, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61,
+	0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x68, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x74,
+	0x68, 0x65, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x7c, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xed, 0x01, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x64, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0xa2, 0x02, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
+	0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x53, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x53, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x52, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52,
+	0x4f, 0x53, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x52,
+	0x49, 0x44, 0x47, 0x45, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x4f, 0x53, 0x54, 0x48,
+	0x45, 0x53, 0x49, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x44, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f,
+	0x50, 0x52, 0x4f, 0x53, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x10,
+	0x04, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x53, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x41, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x1a,
+	0x0a, 0x16, 0x50, 0x52, 0x4f, 0x53, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x56, 0x45, 0x4e, 0x45, 0x45, 0x52, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52,
+	0x4f, 0x53, 0x54, 0x48, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
+	0x4c, 0x41, 0x59, 0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x53, 0x54, 0x48, 0x45,
+	0x53, 0x49, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x41, 0x59, 0x10, 0x08,
+	0x32, 0xa1, 0x03, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x74,
+	0x68, 0x65, 0x73, 0x69, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x73,
+	0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x12, 0x55,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x65, 0x73,
+	0x12, 0x20, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
+	0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x74, 0x68,
+	0x65, 0x73, 0x69, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x74,
+	0x68, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x4a, 0x6f, 0x6e, 0x61, 0x74, 0x61, 0x73, 0x50, 0x32, 0x41, 0x2f, 0x64, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2d, 0x70, 0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dental_v1_prostheses_proto_rawDescOnce sync.Once
+	file_dental_v1_prostheses_proto_rawDescData = file_dental_v1_prostheses_proto_rawDesc
+)
+
+func file_dental_v1_prostheses_proto_rawDescGZIP() []byte {
+	file_dental_v1_prostheses_proto_rawDescOnce.Do(func() {
+		file_dental_v1_prostheses_proto_rawDescData = protoimpl.X.CompressGZIP(file_dental_v1_prostheses_proto_rawDescData)
+	})
+	return file_dental_v1_prostheses_proto_rawDescData
+}
+
+var file_dental_v1_prostheses_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dental_v1_prostheses_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_dental_v1_prostheses_proto_goTypes = []any{
+	(ProsthesisType)(0),             // 0: dental.v1.ProsthesisType
+	(*Prosthesis)(nil),              // 1: dental.v1.Prosthesis
+	(*CreateProsthesisRequest)(nil), // 2: dental.v1.CreateProsthesisRequest
+	(*GetProsthesisRequest)(nil),    // 3: dental.v1.GetProsthesisRequest
+	(*ListProsthesesRequest)(nil),   // 4: dental.v1.ListProsthesesRequest
+	(*ListProsthesesResponse)(nil),  // 5: dental.v1.ListProsthesesResponse
+	(*UpdateProsthesisRequest)(nil), // 6: dental.v1.UpdateProsthesisRequest
+	(*DeleteProsthesisRequest)(nil), // 7: dental.v1.DeleteProsthesisRequest
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
+	(*PageRequest)(nil),             // 9: dental.v1.PageRequest
+	(*emptypb.Empty)(nil),           // 10: google.protobuf.Empty
+}
+var file_dental_v1_prostheses_proto_depIdxs = []int32{
+	0,  // 0: dental.v1.Prosthesis.type:type_name -> dental.v1.ProsthesisType
+	8,  // 1: dental.v1.Prosthesis.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: dental.v1.Prosthesis.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: dental.v1.CreateProsthesisRequest.type:type_name -> dental.v1.ProsthesisType
+	9,  // 4: dental.v1.ListProsthesesRequest.page:type_name -> dental.v1.PageRequest
+	1,  // 5: dental.v1.ListProsthesesResponse.items:type_name -> dental.v1.Prosthesis
+	0,  // 6: dental.v1.UpdateProsthesisRequest.type:type_name -> dental.v1.ProsthesisType
+	2,  // 7: dental.v1.ProsthesisService.CreateProsthesis:input_type -> dental.v1.CreateProsthesisRequest
+	3,  // 8: dental.v1.ProsthesisService.GetProsthesis:input_type -> dental.v1.GetProsthesisRequest
+	4,  // 9: dental.v1.ProsthesisService.ListProstheses:input_type -> dental.v1.ListProsthesesRequest
+	6,  // 10: dental.v1.ProsthesisService.UpdateProsthesis:input_type -> dental.v1.UpdateProsthesisRequest
+	7,  // 11: dental.v1.ProsthesisService.DeleteProsthesis:input_type -> dental.v1.DeleteProsthesisRequest
+	1,  // 12: dental.v1.ProsthesisService.CreateProsthesis:output_type -> dental.v1.Prosthesis
+	1,  // 13: dental.v1.ProsthesisService.GetProsthesis:output_type -> dental.v1.Prosthesis
+	5,  // 14: dental.v1.ProsthesisService.ListProstheses:output_type -> dental.v1.ListProsthesesResponse
+	1,  // 15: dental.v1.ProsthesisService.UpdateProsthesis:output_type -> dental.v1.Prosthesis
+	10, // 16: dental.v1.ProsthesisService.DeleteProsthesis:output_type -> google.protobuf.Empty
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_dental_v1_prostheses_proto_init() }
+func file_dental_v1_prostheses_proto_init() {
+	if File_dental_v1_prostheses_proto != nil {
+		return
+	}
+	file_dental_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_dental_v1_prostheses_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Prosthesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_prostheses_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateProsthesisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_prostheses_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetProsthesisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_prostheses_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListProsthesesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_prostheses_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ListProsthesesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_prostheses_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateProsthesisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_prostheses_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteProsthesisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dental_v1_prostheses_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dental_v1_prostheses_proto_goTypes,
+		DependencyIndexes: file_dental_v1_prostheses_proto_depIdxs,
+		EnumInfos:         file_dental_v1_prostheses_proto_enumTypes,
+		MessageInfos:      file_dental_v1_prostheses_proto_msgTypes,
+	}.Build()
+	File_dental_v1_prostheses_proto = out.File
+	file_dental_v1_prostheses_proto_rawDesc = nil
+	file_dental_v1_prostheses_proto_goTypes = nil
+	file_dental_v1_prostheses_proto_depIdxs = nil
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v3.21.12
+// source: dental/v1/prostheses.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	ProsthesisService_CreateProsthesis_FullMethodName = "/dental.v1.ProsthesisService/CreateProsthesis"
+	ProsthesisService_GetProsthesis_FullMethodName    = "/dental.v1.ProsthesisService/GetProsthesis"
+	ProsthesisService_ListProstheses_FullMethodName   = "/dental.v1.ProsthesisService/ListProstheses"
+	ProsthesisService_UpdateProsthesis_FullMethodName = "/dental.v1.ProsthesisService/UpdateProsthesis"
+	ProsthesisService_DeleteProsthesis_FullMethodName = "/dental.v1.ProsthesisService/DeleteProsthesis"
+)
+
+// ProsthesisServiceClient is the client API for ProsthesisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProsthesisService manages the prosthesis catalog of a laboratory
+type ProsthesisServiceClient interface {
+	CreateProsthesis(ctx context.Context, in *CreateProsthesisRequest, opts ...grpc.CallOption) (*Prosthesis, error)
+	GetProsthesis(ctx context.Context, in *GetProsthesisRequest, opts ...grpc.CallOption) (*Prosthesis, error)
+	ListProstheses(ctx context.Context, in *ListProsthesesRequest, opts ...grpc.CallOption) (*ListProsthesesResponse, error)
+	UpdateProsthesis(ctx context.Context, in *UpdateProsthesisRequest, opts ...grpc.CallOption) (*Prosthesis, error)
+	DeleteProsthesis(ctx context.Context, in *DeleteProsthesisRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type prosthesisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProsthesisServiceClient(cc grpc.ClientConnInterface) ProsthesisServiceClient {
+	return &prosthesisServiceClient{cc}
+}
+
+func (c *prosthesisServiceClient) CreateProsthesis(ctx context.Context, in *CreateProsthesisRequest, opts ...grpc.CallOption) (*Prosthesis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Prosthesis)
+	err := c.cc.Invoke(ctx, ProsthesisService_CreateProsthesis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prosthesisServiceClient) GetProsthesis(ctx context.Context, in *GetProsthesisRequest, opts ...grpc.CallOption) (*Prosthesis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Prosthesis)
+	err := c.cc.Invoke(ctx, ProsthesisService_GetProsthesis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prosthesisServiceClient) ListProstheses(ctx context.Context, in *ListProsthesesRequest, opts ...grpc.CallOption) (*ListProsthesesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProsthesesResponse)
+	err := c.cc.Invoke(ctx, ProsthesisService_ListProstheses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prosthesisServiceClient) UpdateProsthesis(ctx context.Context, in *UpdateProsthesisRequest, opts ...grpc.CallOption) (*Prosthesis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Prosthesis)
+	err := c.cc.Invoke(ctx, ProsthesisService_UpdateProsthesis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prosthesisServiceClient) DeleteProsthesis(ctx context.Context, in *DeleteProsthesisRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProsthesisService_DeleteProsthesis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProsthesisServiceServer is the server API for ProsthesisService service.
+// All implementations must embed UnimplementedProsthesisServiceServer
+// for forward compatibility
+//
+// ProsthesisService manages the prosthesis catalog of a laboratory
+type ProsthesisServiceServer interface {
+	CreateProsthesis(context.Context, *CreateProsthesisRequest) (*Prosthesis, error)
+	GetProsthesis(context.Context, *GetProsthesisRequest) (*Prosthesis, error)
+	ListProstheses(context.Context, *ListProsthesesRequest) (*ListProsthesesResponse, error)
+	UpdateProsthesis(context.Context, *UpdateProsthesisRequest) (*Prosthesis, error)
+	DeleteProsthesis(context.Context, *DeleteProsthesisRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedProsthesisServiceServer()
+}
+
+// UnimplementedProsthesisServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProsthesisServiceServer struct {
+}
+
+func (UnimplementedProsthesisServiceServer) CreateProsthesis(context.Context, *CreateProsthesisRequest) (*Prosthesis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProsthesis not implemented")
+}
+func (UnimplementedProsthesisServiceServer) GetProsthesis(context.Context, *GetProsthesisRequest) (*Prosthesis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProsthesis not implemented")
+}
+func (UnimplementedProsthesisServiceServer) ListProstheses(context.Context, *ListProsthesesRequest) (*ListProsthesesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProstheses not implemented")
+}
+func (UnimplementedProsthesisServiceServer) UpdateProsthesis(context.Context, *UpdateProsthesisRequest) (*Prosthesis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProsthesis not implemented")
+}
+func (UnimplementedProsthesisServiceServer) DeleteProsthesis(context.Context, *DeleteProsthesisRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProsthesis not implemented")
+}
+func (UnimplementedProsthesisServiceServer) mustEmbedUnimplementedProsthesisServiceServer() {}
+
+// UnsafeProsthesisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProsthesisServiceServer will
+// result in compilation errors.
+type UnsafeProsthesisServiceServer interface {
+	mustEmbedUnimplementedProsthesisServiceServer()
+}
+
+func RegisterProsthesisServiceServer(s grpc.ServiceRegistrar, srv ProsthesisServiceServer) {
+	s.RegisterService(&ProsthesisService_ServiceDesc, srv)
+}
+
+func _ProsthesisService_CreateProsthesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProsthesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProsthesisServiceServer).CreateProsthesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProsthesisService_CreateProsthesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProsthesisServiceServer).CreateProsthesis(ctx, req.(*CreateProsthesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProsthesisService_GetProsthesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProsthesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProsthesisServiceServer).GetProsthesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProsthesisService_GetProsthesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProsthesisServiceServer).GetProsthesis(ctx, req.(*GetProsthesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProsthesisService_ListProstheses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProsthesesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProsthesisServiceServer).ListProstheses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProsthesisService_ListProstheses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProsthesisServiceServer).ListProstheses(ctx, req.(*ListProsthesesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProsthesisService_UpdateProsthesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProsthesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProsthesisServiceServer).UpdateProsthesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProsthesisService_UpdateProsthesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProsthesisServiceServer).UpdateProsthesis(ctx, req.(*UpdateProsthesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProsthesisService_DeleteProsthesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProsthesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProsthesisServiceServer).DeleteProsthesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProsthesisService_DeleteProsthesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProsthesisServiceServer).DeleteProsthesis(ctx, req.(*DeleteProsthesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProsthesisService_ServiceDesc is the grpc.ServiceDesc for ProsthesisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProsthesisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dental.v1.ProsthesisService",
+	HandlerType: (*ProsthesisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProsthesis",
+			Handler:    _ProsthesisService_CreateProsthesis_Handler,
+		},
+		{
+			MethodName: "GetProsthesis",
+			Handler:    _ProsthesisService_GetProsthesis_Handler,
+		},
+		{
+			MethodName: "ListProstheses",
+			Handler:    _ProsthesisService_ListProstheses_Handler,
+		},
+		{
+			MethodName: "UpdateProsthesis",
+			Handler:    _ProsthesisService_UpdateProsthesis_Handler,
+		},
+		{
+			MethodName: "DeleteProsthesis",
+			Handler:    _ProsthesisService_DeleteProsthesis_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dental/v1/prostheses.proto",
+}
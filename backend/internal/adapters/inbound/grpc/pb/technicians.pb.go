@@ -0,0 +1,892 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.21.12
+// source: dental/v1/technicians.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TechnicianRole int32
+
+const (
+	TechnicianRole_TECHNICIAN_ROLE_UNSPECIFIED       TechnicianRole = 0
+	TechnicianRole_TECHNICIAN_ROLE_SENIOR_TECHNICIAN TechnicianRole = 1
+	TechnicianRole_TECHNICIAN_ROLE_TECHNICIAN        TechnicianRole = 2
+	TechnicianRole_TECHNICIAN_ROLE_APPRENTICE        TechnicianRole = 3
+)
+
+// Enum value maps for TechnicianRole.
+var (
+	TechnicianRole_name = map[int32]string{
+		0: "TECHNICIAN_ROLE_UNSPECIFIED",
+		1: "TECHNICIAN_ROLE_SENIOR_TECHNICIAN",
+		2: "TECHNICIAN_ROLE_TECHNICIAN",
+		3: "TECHNICIAN_ROLE_APPRENTICE",
+	}
+	TechnicianRole_value = map[string]int32{
+		"TECHNICIAN_ROLE_UNSPECIFIED":       0,
+		"TECHNICIAN_ROLE_SENIOR_TECHNICIAN": 1,
+		"TECHNICIAN_ROLE_TECHNICIAN":        2,
+		"TECHNICIAN_ROLE_APPRENTICE":        3,
+	}
+)
+
+func (x TechnicianRole) Enum() *TechnicianRole {
+	p := new(TechnicianRole)
+	*p = x
+	return p
+}
+
+func (x TechnicianRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TechnicianRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_dental_v1_technicians_proto_enumTypes[0].Descriptor()
+}
+
+func (TechnicianRole) Type() protoreflect.EnumType {
+	return &file_dental_v1_technicians_proto_enumTypes[0]
+}
+
+func (x TechnicianRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TechnicianRole.Descriptor instead.
+func (TechnicianRole) EnumDescriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{0}
+}
+
+type Technician struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LaboratoryId    string                 `protobuf:"bytes,2,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone           string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Role            TechnicianRole         `protobuf:"varint,6,opt,name=role,proto3,enum=dental.v1.TechnicianRole" json:"role,omitempty"`
+	Specializations []string               `protobuf:"bytes,7,rep,name=specializations,proto3" json:"specializations,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Technician) Reset() {
+	*x = Technician{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_technicians_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Technician) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Technician) ProtoMessage() {}
+
+func (x *Technician) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_technicians_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Technician.ProtoReflect.Descriptor instead.
+func (*Technician) Descriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Technician) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Technician) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *Technician) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Technician) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Technician) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Technician) GetRole() TechnicianRole {
+	if x != nil {
+		return x.Role
+	}
+	return TechnicianRole_TECHNICIAN_ROLE_UNSPECIFIED
+}
+
+func (x *Technician) GetSpecializations() []string {
+	if x != nil {
+		return x.Specializations
+	}
+	return nil
+}
+
+func (x *Technician) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Technician) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTechnicianRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId    string         `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Name            string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string         `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone           string         `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Role            TechnicianRole `protobuf:"varint,5,opt,name=role,proto3,enum=dental.v1.TechnicianRole" json:"role,omitempty"`
+	Specializations []string       `protobuf:"bytes,6,rep,name=specializations,proto3" json:"specializations,omitempty"`
+}
+
+func (x *CreateTechnicianRequest) Reset() {
+	*x = CreateTechnicianRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_technicians_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTechnicianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTechnicianRequest) ProtoMessage() {}
+
+func (x *CreateTechnicianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_technicians_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTechnicianRequest.ProtoReflect.Descriptor instead.
+func (*CreateTechnicianRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTechnicianRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *CreateTechnicianRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTechnicianRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateTechnicianRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateTechnicianRequest) GetRole() TechnicianRole {
+	if x != nil {
+		return x.Role
+	}
+	return TechnicianRole_TECHNICIAN_ROLE_UNSPECIFIED
+}
+
+func (x *CreateTechnicianRequest) GetSpecializations() []string {
+	if x != nil {
+		return x.Specializations
+	}
+	return nil
+}
+
+type GetTechnicianRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId string `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTechnicianRequest) Reset() {
+	*x = GetTechnicianRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_technicians_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTechnicianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTechnicianRequest) ProtoMessage() {}
+
+func (x *GetTechnicianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_technicians_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTechnicianRequest.ProtoReflect.Descriptor instead.
+func (*GetTechnicianRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTechnicianRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *GetTechnicianRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTechniciansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId string `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	// Filters: role
+	Page *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListTechniciansRequest) Reset() {
+	*x = ListTechniciansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_technicians_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTechniciansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTechniciansRequest) ProtoMessage() {}
+
+func (x *ListTechniciansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_technicians_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTechniciansRequest.ProtoReflect.Descriptor instead.
+func (*ListTechniciansRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTechniciansRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *ListTechniciansRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListTechniciansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Technician `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Total      int32  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListTechniciansResponse) Reset() {
+	*x = ListTechniciansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_technicians_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTechniciansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTechniciansResponse) ProtoMessage() {}
+
+func (x *ListTechniciansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_technicians_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTechniciansResponse.ProtoReflect.Descriptor instead.
+func (*ListTechniciansResponse) Descriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTechniciansResponse) GetItems() []*Technician {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTechniciansResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListTechniciansResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateTechnicianRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId    string         `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Id              string         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string         `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone           string         `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Role            TechnicianRole `protobuf:"varint,6,opt,name=role,proto3,enum=dental.v1.TechnicianRole" json:"role,omitempty"`
+	Specializations []string       `protobuf:"bytes,7,rep,name=specializations,proto3" json:"specializations,omitempty"`
+}
+
+func (x *UpdateTechnicianRequest) Reset() {
+	*x = UpdateTechnicianRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_technicians_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTechnicianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTechnicianRequest) ProtoMessage() {}
+
+func (x *UpdateTechnicianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_technicians_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTechnicianRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTechnicianRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTechnicianRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *UpdateTechnicianRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTechnicianRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTechnicianRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateTechnicianRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateTechnicianRequest) GetRole() TechnicianRole {
+	if x != nil {
+		return x.Role
+	}
+	return TechnicianRole_TECHNICIAN_ROLE_UNSPECIFIED
+}
+
+func (x *UpdateTechnicianRequest) GetSpecializations() []string {
+	if x != nil {
+		return x.Specializations
+	}
+	return nil
+}
+
+type DeleteTechnicianRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LaboratoryId string `protobuf:"bytes,1,opt,name=laboratory_id,json=laboratoryId,proto3" json:"laboratory_id,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteTechnicianRequest) Reset() {
+	*x = DeleteTechnicianRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dental_v1_technicians_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTechnicianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTechnicianRequest) ProtoMessage() {}
+
+func (x *DeleteTechnicianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dental_v1_technicians_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTechnicianRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTechnicianRequest) Descriptor() ([]byte, []int) {
+	return file_dental_v1_technicians_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTechnicianRequest) GetLaboratoryId() string {
+	if x != nil {
+		return x.LaboratoryId
+	}
+	return ""
+}
+
+func (x *DeleteTechnicianRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_dental_v1_technicians_proto protoreflect.FileDescriptor
+
+var file_dental_v1_technicians_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x63, 0x68,
+	0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x64,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0,
+	0x02, 0x0a, 0x0a, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xd7, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68,
+	0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x22, 0x7d, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x63, 0x68, 0x6e,
+	0x69, 0x63, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69,
+	0x63, 0x69, 0x61, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0xe7, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4e, 0x0a, 0x17,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x98, 0x01, 0x0a,
+	0x0e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x1f, 0x0a, 0x1b, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x49, 0x43, 0x49, 0x41, 0x4e, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x25, 0x0a, 0x21, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x49, 0x43, 0x49, 0x41, 0x4e, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x49, 0x4f, 0x52, 0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e,
+	0x49, 0x43, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x45, 0x43, 0x48, 0x4e,
+	0x49, 0x43, 0x49, 0x41, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e,
+	0x49, 0x43, 0x49, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x45, 0x43, 0x48, 0x4e,
+	0x49, 0x43, 0x49, 0x41, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x45,
+	0x4e, 0x54, 0x49, 0x43, 0x45, 0x10, 0x03, 0x32, 0xa4, 0x03, 0x0a, 0x11, 0x54, 0x65, 0x63, 0x68,
+	0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61,
+	0x6e, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x12, 0x47, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x12, 0x1f, 0x2e,
+	0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e,
+	0x69, 0x63, 0x69, 0x61, 0x6e, 0x12, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63,
+	0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x63, 0x68,
+	0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63,
+	0x69, 0x61, 0x6e, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x12, 0x4e,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69,
+	0x61, 0x6e, 0x12, 0x22, 0x2e, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x56,
+	0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a, 0x6f, 0x6e,
+	0x61, 0x74, 0x61, 0x73, 0x50, 0x32, 0x41, 0x2f, 0x64, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2d, 0x70,
+	0x72, 0x6f, 0x73, 0x74, 0x68, 0x65, 0x73, 0x69, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x73, 0x2f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dental_v1_technicians_proto_rawDescOnce sync.Once
+	file_dental_v1_technicians_proto_rawDescData = file_dental_v1_technicians_proto_rawDesc
+)
+
+func file_dental_v1_technicians_proto_rawDescGZIP() []byte {
+	file_dental_v1_technicians_proto_rawDescOnce.Do(func() {
+		file_dental_v1_technicians_proto_rawDescData = protoimpl.X.CompressGZIP(file_dental_v1_technicians_proto_rawDescData)
+	})
+	return file_dental_v1_technicians_proto_rawDescData
+}
+
+var file_dental_v1_technicians_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dental_v1_technicians_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_dental_v1_technicians_proto_goTypes = []any{
+	(TechnicianRole)(0),             // 0: dental.v1.TechnicianRole
+	(*Technician)(nil),              // 1: dental.v1.Technician
+	(*CreateTechnicianRequest)(nil), // 2: dental.v1.CreateTechnicianRequest
+	(*GetTechnicianRequest)(nil),    // 3: dental.v1.GetTechnicianRequest
+	(*ListTechniciansRequest)(nil),  // 4: dental.v1.ListTechniciansRequest
+	(*ListTechniciansResponse)(nil), // 5: dental.v1.ListTechniciansResponse
+	(*UpdateTechnicianRequest)(nil), // 6: dental.v1.UpdateTechnicianRequest
+	(*DeleteTechnicianRequest)(nil), // 7: dental.v1.DeleteTechnicianRequest
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
+	(*PageRequest)(nil),             // 9: dental.v1.PageRequest
+	(*emptypb.Empty)(nil),           // 10: google.protobuf.Empty
+}
+var file_dental_v1_technicians_proto_depIdxs = []int32{
+	0,  // 0: dental.v1.Technician.role:type_name -> dental.v1.TechnicianRole
+	8,  // 1: dental.v1.Technician.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: dental.v1.Technician.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: dental.v1.CreateTechnicianRequest.role:type_name -> dental.v1.TechnicianRole
+	9,  // 4: dental.v1.ListTechniciansRequest.page:type_name -> dental.v1.PageRequest
+	1,  // 5: dental.v1.ListTechniciansResponse.items:type_name -> dental.v1.Technician
+	0,  // 6: dental.v1.UpdateTechnicianRequest.role:type_name -> dental.v1.TechnicianRole
+	2,  // 7: dental.v1.TechnicianService.CreateTechnician:input_type -> dental.v1.CreateTechnicianRequest
+	3,  // 8: dental.v1.TechnicianService.GetTechnician:input_type -> dental.v1.GetTechnicianRequest
+	4,  // 9: dental.v1.TechnicianService.ListTechnicians:input_type -> dental.v1.ListTechniciansRequest
+	6,  // 10: dental.v1.TechnicianService.UpdateTechnician:input_type -> dental.v1.UpdateTechnicianRequest
+	7,  // 11: dental.v1.TechnicianService.DeleteTechnician:input_type -> dental.v1.DeleteTechnicianRequest
+	1,  // 12: dental.v1.TechnicianService.CreateTechnician:output_type -> dental.v1.Technician
+	1,  // 13: dental.v1.TechnicianService.GetTechnician:output_type -> dental.v1.Technician
+	5,  // 14: dental.v1.TechnicianService.ListTechnicians:output_type -> dental.v1.ListTechniciansResponse
+	1,  // 15: dental.v1.TechnicianService.UpdateTechnician:output_type -> dental.v1.Technician
+	10, // 16: dental.v1.TechnicianService.DeleteTechnician:output_type -> google.protobuf.Empty
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_dental_v1_technicians_proto_init() }
+func file_dental_v1_technicians_proto_init() {
+	if File_dental_v1_technicians_proto != nil {
+		return
+	}
+	file_dental_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_dental_v1_technicians_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Technician); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_technicians_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTechnicianRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_technicians_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTechnicianRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_technicians_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTechniciansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_technicians_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTechniciansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_technicians_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTechnicianRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dental_v1_technicians_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteTechnicianRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dental_v1_technicians_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dental_v1_technicians_proto_goTypes,
+		DependencyIndexes: file_dental_v1_technicians_proto_depIdxs,
+		EnumInfos:         file_dental_v1_technicians_proto_enumTypes,
+		MessageInfos:      file_dental_v1_technicians_proto_msgTypes,
+	}.Build()
+	File_dental_v1_technicians_proto = out.File
+	file_dental_v1_technicians_proto_rawDesc = nil
+	file_dental_v1_technicians_proto_goTypes = nil
+	file_dental_v1_technicians_proto_depIdxs = nil
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v3.21.12
+// source: dental/v1/technicians.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	TechnicianService_CreateTechnician_FullMethodName = "/dental.v1.TechnicianService/CreateTechnician"
+	TechnicianService_GetTechnician_FullMethodName    = "/dental.v1.TechnicianService/GetTechnician"
+	TechnicianService_ListTechnicians_FullMethodName  = "/dental.v1.TechnicianService/ListTechnicians"
+	TechnicianService_UpdateTechnician_FullMethodName = "/dental.v1.TechnicianService/UpdateTechnician"
+	TechnicianService_DeleteTechnician_FullMethodName = "/dental.v1.TechnicianService/DeleteTechnician"
+)
+
+// TechnicianServiceClient is the client API for TechnicianService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TechnicianService manages the technicians of a laboratory
+type TechnicianServiceClient interface {
+	CreateTechnician(ctx context.Context, in *CreateTechnicianRequest, opts ...grpc.CallOption) (*Technician, error)
+	GetTechnician(ctx context.Context, in *GetTechnicianRequest, opts ...grpc.CallOption) (*Technician, error)
+	ListTechnicians(ctx context.Context, in *ListTechniciansRequest, opts ...grpc.CallOption) (*ListTechniciansResponse, error)
+	UpdateTechnician(ctx context.Context, in *UpdateTechnicianRequest, opts ...grpc.CallOption) (*Technician, error)
+	DeleteTechnician(ctx context.Context, in *DeleteTechnicianRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type technicianServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTechnicianServiceClient(cc grpc.ClientConnInterface) TechnicianServiceClient {
+	return &technicianServiceClient{cc}
+}
+
+func (c *technicianServiceClient) CreateTechnician(ctx context.Context, in *CreateTechnicianRequest, opts ...grpc.CallOption) (*Technician, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Technician)
+	err := c.cc.Invoke(ctx, TechnicianService_CreateTechnician_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *technicianServiceClient) GetTechnician(ctx context.Context, in *GetTechnicianRequest, opts ...grpc.CallOption) (*Technician, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Technician)
+	err := c.cc.Invoke(ctx, TechnicianService_GetTechnician_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *technicianServiceClient) ListTechnicians(ctx context.Context, in *ListTechniciansRequest, opts ...grpc.CallOption) (*ListTechniciansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTechniciansResponse)
+	err := c.cc.Invoke(ctx, TechnicianService_ListTechnicians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *technicianServiceClient) UpdateTechnician(ctx context.Context, in *UpdateTechnicianRequest, opts ...grpc.CallOption) (*Technician, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Technician)
+	err := c.cc.Invoke(ctx, TechnicianService_UpdateTechnician_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *technicianServiceClient) DeleteTechnician(ctx context.Context, in *DeleteTechnicianRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TechnicianService_DeleteTechnician_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TechnicianServiceServer is the server API for TechnicianService service.
+// All implementations must embed UnimplementedTechnicianServiceServer
+// for forward compatibility
+//
+// TechnicianService manages the technicians of a laboratory
+type TechnicianServiceServer interface {
+	CreateTechnician(context.Context, *CreateTechnicianRequest) (*Technician, error)
+	GetTechnician(context.Context, *GetTechnicianRequest) (*Technician, error)
+	ListTechnicians(context.Context, *ListTechniciansRequest) (*ListTechniciansResponse, error)
+	UpdateTechnician(context.Context, *UpdateTechnicianRequest) (*Technician, error)
+	DeleteTechnician(context.Context, *DeleteTechnicianRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTechnicianServiceServer()
+}
+
+// UnimplementedTechnicianServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTechnicianServiceServer struct {
+}
+
+func (UnimplementedTechnicianServiceServer) CreateTechnician(context.Context, *CreateTechnicianRequest) (*Technician, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTechnician not implemented")
+}
+func (UnimplementedTechnicianServiceServer) GetTechnician(context.Context, *GetTechnicianRequest) (*Technician, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTechnician not implemented")
+}
+func (UnimplementedTechnicianServiceServer) ListTechnicians(context.Context, *ListTechniciansRequest) (*ListTechniciansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTechnicians not implemented")
+}
+func (UnimplementedTechnicianServiceServer) UpdateTechnician(context.Context, *UpdateTechnicianRequest) (*Technician, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTechnician not implemented")
+}
+func (UnimplementedTechnicianServiceServer) DeleteTechnician(context.Context, *DeleteTechnicianRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTechnician not implemented")
+}
+func (UnimplementedTechnicianServiceServer) mustEmbedUnimplementedTechnicianServiceServer() {}
+
+// UnsafeTechnicianServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TechnicianServiceServer will
+// result in compilation errors.
+type UnsafeTechnicianServiceServer interface {
+	mustEmbedUnimplementedTechnicianServiceServer()
+}
+
+func RegisterTechnicianServiceServer(s grpc.ServiceRegistrar, srv TechnicianServiceServer) {
+	s.RegisterService(&TechnicianService_ServiceDesc, srv)
+}
+
+func _TechnicianService_CreateTechnician_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTechnicianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TechnicianServiceServer).CreateTechnician(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TechnicianService_CreateTechnician_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TechnicianServiceServer).CreateTechnician(ctx, req.(*CreateTechnicianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TechnicianService_GetTechnician_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTechnicianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TechnicianServiceServer).GetTechnician(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TechnicianService_GetTechnician_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TechnicianServiceServer).GetTechnician(ctx, req.(*GetTechnicianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TechnicianService_ListTechnicians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTechniciansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TechnicianServiceServer).ListTechnicians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TechnicianService_ListTechnicians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TechnicianServiceServer).ListTechnicians(ctx, req.(*ListTechniciansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TechnicianService_UpdateTechnician_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTechnicianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TechnicianServiceServer).UpdateTechnician(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TechnicianService_UpdateTechnician_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TechnicianServiceServer).UpdateTechnician(ctx, req.(*UpdateTechnicianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TechnicianService_DeleteTechnician_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTechnicianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TechnicianServiceServer).DeleteTechnician(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TechnicianService_DeleteTechnician_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TechnicianServiceServer).DeleteTechnician(ctx, req.(*DeleteTechnicianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TechnicianService_ServiceDesc is the grpc.ServiceDesc for TechnicianService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TechnicianService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dental.v1.TechnicianService",
+	HandlerType: (*TechnicianServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTechnician",
+			Handler:    _TechnicianService_CreateTechnician_Handler,
+		},
+		{
+			MethodName: "GetTechnician",
+			Handler:    _TechnicianService_GetTechnician_Handler,
+		},
+		{
+			MethodName: "ListTechnicians",
+			Handler:    _TechnicianService_ListTechnicians_Handler,
+		},
+		{
+			MethodName: "UpdateTechnician",
+			Handler:    _TechnicianService_UpdateTechnician_Handler,
+		},
+		{
+			MethodName: "DeleteTechnician",
+			Handler:    _TechnicianService_DeleteTechnician_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dental/v1/technicians.proto",
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/pb"
+	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+)
+
+// clientServer implements pb.ClientServiceServer
+type clientServer struct {
+	pb.UnimplementedClientServiceServer
+	service *clientapp.Service
+}
+
+// CreateClient creates a client of the laboratory
+func (s *clientServer) CreateClient(ctx context.Context, req *pb.CreateClientRequest) (*pb.Client, error) {
+	c, err := s.service.CreateClient(ctx, clientapp.CreateInput{
+		LaboratoryID: req.GetLaboratoryId(),
+		Name:         req.GetName(),
+		Email:        req.GetEmail(),
+		Phone:        req.GetPhone(),
+		Address:      toClientAddress(req.GetAddress()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toClient(c), nil
+}
+
+// GetClient retrieves a client of the laboratory
+func (s *clientServer) GetClient(ctx context.Context, req *pb.GetClientRequest) (*pb.Client, error) {
+	c, err := s.service.GetClient(ctx, req.GetId(), req.GetLaboratoryId())
+	if err != nil {
+		return nil, err
+	}
+	return toClient(c), nil
+}
+
+// ListClients lists the clients of the laboratory
+func (s *clientServer) ListClients(ctx context.Context, req *pb.ListClientsRequest) (*pb.ListClientsResponse, error) {
+	page, err := s.service.ListClients(ctx, req.GetLaboratoryId(), listQuery(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListClientsResponse{NextCursor: page.NextCursor, Total: int32(page.Total)}
+	for _, c := range page.Items {
+		resp.Items = append(resp.Items, toClient(c))
+	}
+	return resp, nil
+}
+
+// UpdateClient replaces the details of a client
+func (s *clientServer) UpdateClient(ctx context.Context, req *pb.UpdateClientRequest) (*pb.Client, error) {
+	c, err := s.service.UpdateClient(ctx, clientapp.UpdateInput{
+		ID:           req.GetId(),
+		LaboratoryID: req.GetLaboratoryId(),
+		Name:         req.GetName(),
+		Email:        req.GetEmail(),
+		Phone:        req.GetPhone(),
+		Address:      toClientAddress(req.GetAddress()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toClient(c), nil
+}
+
+// DeleteClient soft deletes a client of the laboratory
+func (s *clientServer) DeleteClient(ctx context.Context, req *pb.DeleteClientRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteClient(ctx, req.GetId(), req.GetLaboratoryId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// toClient converts a domain client to its message
+func toClient(c *client.Client) *pb.Client {
+	return &pb.Client{
+		Id:           c.ID,
+		LaboratoryId: c.LaboratoryID,
+		Name:         c.Name,
+		Email:        c.Email,
+		Phone:        c.Phone,
+		Address: &pb.Address{
+			Street:     c.Address.Street,
+			City:       c.Address.City,
+			State:      c.Address.State,
+			PostalCode: c.Address.PostalCode,
+			Country:    c.Address.Country,
+		},
+		CreatedAt: timestamp(c.CreatedAt),
+		UpdatedAt: timestamp(c.UpdatedAt),
+	}
+}
+
+// toClientAddress converts an address message to a client address
+func toClientAddress(a *pb.Address) client.Address {
+	return client.Address{
+		Street:     a.GetStreet(),
+		City:       a.GetCity(),
+		State:      a.GetState(),
+		PostalCode: a.GetPostalCode(),
+		Country:    a.GetCountry(),
+	}
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/pb"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+)
+
+// listQuery converts a page request to a list query. Sort and filter fields
+// are validated by the services.
+func listQuery(p *pb.PageRequest) listing.Query {
+	if p == nil {
+		return listing.Query{}
+	}
+	q := listing.Query{
+		Limit:     int(p.GetLimit()),
+		Cursor:    p.GetCursor(),
+		SortBy:    p.GetSort(),
+		Direction: listing.Direction(p.GetOrder()),
+	}
+	if len(p.GetFilters()) > 0 {
+		q.Filters = p.GetFilters()
+	}
+	return q
+}
+
+// timestamp converts a time to a protobuf timestamp
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(t)
+}
+
+// timeOf converts an optional protobuf timestamp to a time
+func timeOf(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// enumNumber converts a domain value such as in_production to the number of
+// its protobuf enum value, e.g. ORDER_STATUS_IN_PRODUCTION. Unknown values
+// map to the unspecified value.
+func enumNumber(values map[string]int32, prefix, v string) int32 {
+	return values[prefix+strings.ToUpper(v)]
+}
+
+// domainValue converts the number of a protobuf enum value to its domain value.
+// The unspecified value maps to an empty string, and unknown numbers to their
+// decimal form, so that the domain rejects them.
+func domainValue(names map[int32]string, prefix string, n int32) string {
+	if n == 0 {
+		return ""
+	}
+	name, ok := names[n]
+	if !ok {
+		return strconv.Itoa(int(n))
+	}
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+)
+
+// errorDomain is the domain of the ErrorInfo details of every status
+const errorDomain = "dental-prosthesis"
+
+// statusKind describes how a class of errors is reported. The reason is the
+// code of the matching problem type of the REST API, whose translated detail
+// becomes the status message.
+type statusKind struct {
+	code   codes.Code
+	reason string
+}
+
+var (
+	statusValidation        = statusKind{codes.InvalidArgument, "validation_failed"}
+	statusInvalidInput      = statusKind{codes.InvalidArgument, "invalid_input"}
+	statusInvalidTransition = statusKind{codes.FailedPrecondition, "invalid_status_transition"}
+	statusUnauthorized      = statusKind{codes.Unauthenticated, "unauthorized"}
+	statusForbidden         = statusKind{codes.PermissionDenied, "forbidden"}
+	statusNotFound          = statusKind{codes.NotFound, "not_found"}
+	statusDuplicateEmail    = statusKind{codes.AlreadyExists, "duplicate_email"}
+	statusPortalUserLinked  = statusKind{codes.AlreadyExists, "portal_user_already_linked"}
+	statusRateLimited       = statusKind{codes.ResourceExhausted, "rate_limited"}
+	statusInternal          = statusKind{codes.Internal, "internal_error"}
+)
+
+// toStatus converts an error to a gRPC status in the given language, with an
+// ErrorInfo detail and, for validation errors, a BadRequest detail naming
+// every invalid field
+func toStatus(err error, lang i18n.Language) *status.Status {
+	kind := statusKindOf(err)
+	st := status.New(kind.code, i18n.Translate(lang, "problem."+kind.reason+".detail", nil))
+
+	if withDetails, err := st.WithDetails(&errdetails.ErrorInfo{Reason: kind.reason, Domain: errorDomain}); err == nil {
+		st = withDetails
+	}
+
+	var validationErrors domainerrors.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		badRequest := &errdetails.BadRequest{}
+		for _, ve := range validationErrors {
+			message := ve.Message
+			if ve.Key != "" {
+				message = i18n.Translate(lang, ve.Key, ve.Params)
+			}
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       ve.Field,
+				Description: message,
+			})
+		}
+		if withDetails, err := st.WithDetails(badRequest); err == nil {
+			st = withDetails
+		}
+	}
+
+	return st
+}
+
+// statusKindOf classifies an error
+func statusKindOf(err error) statusKind {
+	var validationErrors domainerrors.ValidationErrors
+	switch {
+	case errors.As(err, &validationErrors):
+		return statusValidation
+	case errors.Is(err, domainerrors.ErrInvalidStatusTransition):
+		return statusInvalidTransition
+	case errors.Is(err, domainerrors.ErrInvalidInput):
+		return statusInvalidInput
+	case errors.Is(err, domainerrors.ErrUnauthorized), errors.Is(err, auth.ErrUnauthorized):
+		return statusUnauthorized
+	case errors.Is(err, domainerrors.ErrForbidden):
+		return statusForbidden
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return statusNotFound
+	case errors.Is(err, domainerrors.ErrDuplicateEmail):
+		return statusDuplicateEmail
+	case errors.Is(err, domainerrors.ErrPortalUserAlreadyLinked):
+		return statusPortalUserLinked
+	case errors.Is(err, ratelimit.ErrRateLimited):
+		return statusRateLimited
+	default:
+		return statusInternal
+	}
+}
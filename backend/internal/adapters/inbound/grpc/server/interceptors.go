@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/uuid"
+)
+
+// maxRequestIDLength bounds client-supplied request IDs, as for HTTP requests
+const maxRequestIDLength = 128
+
+// rateLimitGroups maps the gRPC services to the rate limit groups of the
+// matching REST routes, so that both APIs share the same buckets
+var rateLimitGroups = map[string]string{
+	"dental.v1.OrderService":      "orders",
+	"dental.v1.ClientService":     "clients",
+	"dental.v1.ProsthesisService": "prostheses",
+	"dental.v1.TechnicianService": "technicians",
+}
+
+// recoverPanics turns a panicking call into an Internal status instead of
+// crashing the server
+func recoverPanics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				slog.ErrorContext(ctx, "call panicked", "method", info.FullMethod, "panic", p, "stack", string(debug.Stack()))
+				lang, _ := i18n.FromContext(ctx)
+				resp, err = nil, toStatus(domainerrors.ErrInternal, lang).Err()
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// rateLimit takes a token per call from the buckets of the REST routes of
+// its service and rejects it when they are empty, telling the caller when to
+// retry in the retry-after header (seconds)
+func rateLimit(l *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		service, _, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		var laboratoryID string
+		if r, ok := req.(laboratoryScoped); ok {
+			laboratoryID = r.GetLaboratoryId()
+		}
+
+		result := l.Take(ctx, rateLimitGroups[service], auth.GetUserID(ctx), peerIP(ctx), laboratoryID)
+		if result != nil && !result.Allowed {
+			_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(result.RetryAfterSeconds())))
+			return nil, ratelimit.ErrRateLimited
+		}
+		return handler(ctx, req)
+	}
+}
+
+// requestContext stores the request ID of the x-request-id metadata,
+// generating one when absent, and the language negotiated from the
+// accept-language metadata in the context, and sends the request ID back in
+// the response header
+func requestContext() grpc.UnaryServerInterceptor {
+	gen := uuid.NewGenerator()
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		id := firstValue(ctx, requestid.Header)
+		if id == "" || len(id) > maxRequestIDLength {
+			id = gen.Generate()
+		}
+		ctx = requestid.WithRequestID(ctx, id)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.Header, id))
+
+		lang, ok := i18n.Negotiate(firstValue(ctx, "accept-language"))
+		if !ok {
+			lang = i18n.Default
+		}
+		ctx = i18n.WithLanguage(ctx, lang)
+
+		return handler(ctx, req)
+	}
+}
+
+// authenticate verifies the bearer token of the authorization metadata and
+// stores the user ID in the context
+func authenticate(m *auth.ClerkMiddleware) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		scheme, token, ok := strings.Cut(firstValue(ctx, "authorization"), " ")
+		if !ok || !strings.EqualFold(scheme, "bearer") {
+			return nil, unauthenticated(ctx, info, "missing bearer token")
+		}
+
+		claims, err := m.VerifyToken(ctx, token)
+		if err != nil {
+			return nil, unauthenticated(ctx, info, fmt.Sprintf("invalid token: %v", err))
+		}
+
+		return handler(auth.WithUserID(ctx, claims.Sub), req)
+	}
+}
+
+// unauthenticated returns the status of a call that isn't authenticated. The
+// reason is only logged, for tokens not to be probed through the responses.
+func unauthenticated(ctx context.Context, info *grpc.UnaryServerInfo, reason string) error {
+	slog.DebugContext(ctx, "call not authenticated", "method", info.FullMethod, "reason", reason)
+	return status.Error(codes.Unauthenticated, "unauthorized")
+}
+
+// requireStaff refuses the identities bound to a client through the portal,
+// as every service is for laboratory staff
+func requireStaff(portal *portalapp.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		isPortalUser, err := portal.IsPortalUser(ctx, auth.GetUserID(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if isPortalUser {
+			return nil, domainerrors.ErrForbidden
+		}
+		return handler(ctx, req)
+	}
+}
+
+// laboratoryScoped is implemented by the requests scoped to a laboratory
+type laboratoryScoped interface {
+	GetLaboratoryId() string
+}
+
+// logRequests stores the laboratory of the request in the context and logs
+// every call once handled, with its status code
+func logRequests() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if r, ok := req.(laboratoryScoped); ok && r.GetLaboratoryId() != "" {
+			ctx = logging.WithLaboratoryID(ctx, r.GetLaboratoryId())
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		level := slog.LevelInfo
+		switch code {
+		case codes.OK:
+		case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+			level = slog.LevelError
+		default:
+			level = slog.LevelWarn
+		}
+		slog.Log(ctx, level, "call served",
+			"method", info.FullMethod,
+			"code", code.String(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		)
+		return resp, err
+	}
+}
+
+// statusErrors converts the errors of the application services to gRPC
+// statuses in the language of the request
+func statusErrors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		lang, _ := i18n.FromContext(ctx)
+		st := toStatus(err, lang)
+		if st.Code() == codes.Internal {
+			slog.ErrorContext(ctx, "request failed", "method", info.FullMethod, "error", err)
+		}
+		return nil, st.Err()
+	}
+}
+
+// peerIP returns the IP address of the caller
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
+
+// firstValue returns the first value of an incoming metadata key
+func firstValue(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/pb"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// orderStatusPrefix prefixes the values of the OrderStatus enum
+const orderStatusPrefix = "ORDER_STATUS_"
+
+// orderServer implements pb.OrderServiceServer
+type orderServer struct {
+	pb.UnimplementedOrderServiceServer
+	service *orderapp.Service
+}
+
+// CreateOrder creates an order for a client of the laboratory
+func (s *orderServer) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error) {
+	o, err := s.service.CreateOrder(ctx, orderapp.CreateInput{
+		ClientID:     req.GetClientId(),
+		LaboratoryID: req.GetLaboratoryId(),
+		Prosthesis:   toProsthesisItems(req.GetProsthesis()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toOrder(o), nil
+}
+
+// GetOrder retrieves an order of the laboratory
+func (s *orderServer) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.Order, error) {
+	o, err := s.service.GetOrder(ctx, req.GetId(), req.GetLaboratoryId())
+	if err != nil {
+		return nil, err
+	}
+	return toOrder(o), nil
+}
+
+// ListOrders searches the orders of the laboratory
+func (s *orderServer) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	page, err := s.service.ListOrders(ctx, req.GetLaboratoryId(), toSearchCriteria(req.GetFilter()), listQuery(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListOrdersResponse{NextCursor: page.NextCursor, Total: int32(page.Total)}
+	for _, o := range page.Items {
+		resp.Items = append(resp.Items, toOrder(o))
+	}
+	return resp, nil
+}
+
+// UpdateOrder replaces the items of an order and changes its technician
+func (s *orderServer) UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest) (*pb.Order, error) {
+	o, err := s.service.UpdateOrder(ctx, orderapp.UpdateInput{
+		ID:           req.GetId(),
+		LaboratoryID: req.GetLaboratoryId(),
+		Prosthesis:   toProsthesisItems(req.GetProsthesis()),
+		TechnicianID: req.TechnicianId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toOrder(o), nil
+}
+
+// UpdateOrderStatus moves an order along its workflow
+func (s *orderServer) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.Order, error) {
+	o, err := s.service.UpdateOrderStatus(ctx, orderapp.UpdateStatusInput{
+		ID:           req.GetId(),
+		LaboratoryID: req.GetLaboratoryId(),
+		Status:       toStatusValue(req.GetStatus()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toOrder(o), nil
+}
+
+// DeleteOrder soft deletes an order of the laboratory
+func (s *orderServer) DeleteOrder(ctx context.Context, req *pb.DeleteOrderRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteOrder(ctx, req.GetId(), req.GetLaboratoryId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// toOrder converts a domain order to its message
+func toOrder(o *order.Order) *pb.Order {
+	msg := &pb.Order{
+		Id:           o.ID,
+		ClientId:     o.ClientID,
+		LaboratoryId: o.LaboratoryID,
+		TechnicianId: o.TechnicianID,
+		Status:       toOrderStatus(o.Status),
+		CreatedAt:    timestamp(o.CreatedAt),
+		UpdatedAt:    timestamp(o.UpdatedAt),
+	}
+	for _, item := range o.Prosthesis {
+		msg.Prosthesis = append(msg.Prosthesis, &pb.ProsthesisItem{
+			Type:          item.Type,
+			Material:      item.Material,
+			Shade:         item.Shade,
+			Quantity:      int32(item.Quantity),
+			Notes:         item.Notes,
+			CatalogItemId: item.CatalogItemID,
+		})
+	}
+	for _, change := range o.History {
+		msg.History = append(msg.History, &pb.StatusChange{
+			From:      toOrderStatus(change.From),
+			To:        toOrderStatus(change.To),
+			ChangedAt: timestamp(change.ChangedAt),
+		})
+	}
+	return msg
+}
+
+// toProsthesisItems converts item messages to domain items
+func toProsthesisItems(items []*pb.ProsthesisItem) []order.ProsthesisItem {
+	result := make([]order.ProsthesisItem, len(items))
+	for i, item := range items {
+		result[i] = order.ProsthesisItem{
+			Type:          item.GetType(),
+			Material:      item.GetMaterial(),
+			Shade:         item.GetShade(),
+			Quantity:      int(item.GetQuantity()),
+			Notes:         item.GetNotes(),
+			CatalogItemID: item.GetCatalogItemId(),
+		}
+	}
+	return result
+}
+
+// toSearchCriteria converts an order filter to search criteria
+func toSearchCriteria(f *pb.OrderFilter) order.SearchCriteria {
+	if f == nil {
+		return order.SearchCriteria{}
+	}
+	c := order.SearchCriteria{
+		ClientID:       f.GetClientId(),
+		TechnicianID:   f.GetTechnicianId(),
+		CreatedFrom:    timeOf(f.GetCreatedFrom()),
+		CreatedTo:      timeOf(f.GetCreatedTo()),
+		UpdatedFrom:    timeOf(f.GetUpdatedFrom()),
+		UpdatedTo:      timeOf(f.GetUpdatedTo()),
+		ProsthesisType: f.GetProsthesisType(),
+		Material:       f.GetMaterial(),
+		Text:           f.GetText(),
+	}
+	for _, status := range f.GetStatuses() {
+		c.Statuses = append(c.Statuses, toStatusValue(status))
+	}
+	return c
+}
+
+// toOrderStatus converts a domain status to its enum value
+func toOrderStatus(s order.Status) pb.OrderStatus {
+	return pb.OrderStatus(enumNumber(pb.OrderStatus_value, orderStatusPrefix, string(s)))
+}
+
+// toStatusValue converts an enum value to its domain status
+func toStatusValue(s pb.OrderStatus) order.Status {
+	return order.Status(domainValue(pb.OrderStatus_name, orderStatusPrefix, int32(s)))
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/pb"
+	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+)
+
+// prosthesisTypePrefix prefixes the values of the ProsthesisType enum
+const prosthesisTypePrefix = "PROSTHESIS_TYPE_"
+
+// prosthesisServer implements pb.ProsthesisServiceServer
+type prosthesisServer struct {
+	pb.UnimplementedProsthesisServiceServer
+	service *prosthesisapp.Service
+}
+
+// CreateProsthesis adds a prosthesis to the catalog of the laboratory
+func (s *prosthesisServer) CreateProsthesis(ctx context.Context, req *pb.CreateProsthesisRequest) (*pb.Prosthesis, error) {
+	p, err := s.service.CreateProsthesis(ctx, prosthesisapp.CreateInput{
+		LaboratoryID:   req.GetLaboratoryId(),
+		Type:           toProsthesisTypeValue(req.GetType()),
+		Material:       req.GetMaterial(),
+		Shade:          req.GetShade(),
+		Specifications: req.GetSpecifications(),
+		Notes:          req.GetNotes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toProsthesis(p), nil
+}
+
+// GetProsthesis retrieves a prosthesis of the laboratory
+func (s *prosthesisServer) GetProsthesis(ctx context.Context, req *pb.GetProsthesisRequest) (*pb.Prosthesis, error) {
+	p, err := s.service.GetProsthesis(ctx, req.GetId(), req.GetLaboratoryId())
+	if err != nil {
+		return nil, err
+	}
+	return toProsthesis(p), nil
+}
+
+// ListProstheses lists the prosthesis catalog of the laboratory
+func (s *prosthesisServer) ListProstheses(ctx context.Context, req *pb.ListProsthesesRequest) (*pb.ListProsthesesResponse, error) {
+	page, err := s.service.ListProstheses(ctx, req.GetLaboratoryId(), listQuery(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListProsthesesResponse{NextCursor: page.NextCursor, Total: int32(page.Total)}
+	for _, p := range page.Items {
+		resp.Items = append(resp.Items, toProsthesis(p))
+	}
+	return resp, nil
+}
+
+// UpdateProsthesis replaces the details of a prosthesis
+func (s *prosthesisServer) UpdateProsthesis(ctx context.Context, req *pb.UpdateProsthesisRequest) (*pb.Prosthesis, error) {
+	p, err := s.service.UpdateProsthesis(ctx, prosthesisapp.UpdateInput{
+		ID:             req.GetId(),
+		LaboratoryID:   req.GetLaboratoryId(),
+		Type:           toProsthesisTypeValue(req.GetType()),
+		Material:       req.GetMaterial(),
+		Shade:          req.GetShade(),
+		Specifications: req.GetSpecifications(),
+		Notes:          req.GetNotes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toProsthesis(p), nil
+}
+
+// DeleteProsthesis soft deletes a prosthesis of the laboratory
+func (s *prosthesisServer) DeleteProsthesis(ctx context.Context, req *pb.DeleteProsthesisRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteProsthesis(ctx, req.GetId(), req.GetLaboratoryId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// toProsthesis converts a domain prosthesis to its message
+func toProsthesis(p *prosthesis.Prosthesis) *pb.Prosthesis {
+	return &pb.Prosthesis{
+		Id:             p.ID,
+		LaboratoryId:   p.LaboratoryID,
+		Type:           pb.ProsthesisType(enumNumber(pb.ProsthesisType_value, prosthesisTypePrefix, string(p.Type))),
+		Material:       p.Material,
+		Shade:          p.Shade,
+		Specifications: p.Specifications,
+		Notes:          p.Notes,
+		CreatedAt:      timestamp(p.CreatedAt),
+		UpdatedAt:      timestamp(p.UpdatedAt),
+	}
+}
+
+// toProsthesisTypeValue converts an enum value to its domain type
+func toProsthesisTypeValue(t pb.ProsthesisType) prosthesis.ProsthesisType {
+	return prosthesis.ProsthesisType(domainValue(pb.ProsthesisType_name, prosthesisTypePrefix, int32(t)))
+}
@@ -0,0 +1,52 @@
+// Package server implements the gRPC services defined in api/proto over the
+// application services, next to the HTTP handlers.
+package server
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/pb"
+	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
+	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
+	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+)
+
+// Config holds the gRPC server configuration
+type Config struct {
+	Orders          *orderapp.Service
+	Clients         *clientapp.Service
+	Prostheses      *prosthesisapp.Service
+	Technicians     *techapp.Service
+	ClerkMiddleware *auth.ClerkMiddleware // Authentication is disabled when nil
+	RateLimiter     *ratelimit.Limiter    // Rate limiting is disabled when nil
+	Portal          *portalapp.Service    // Refuses portal identities, as on the staff REST routes; nil lets everyone in
+}
+
+// New creates a gRPC server with every service registered. Calls share the
+// rate limits of the REST routes of their resource. Idempotency-Key handling
+// is HTTP only, so gRPC clients must not blindly retry the calls that create
+// or change records.
+func New(cfg Config) *grpc.Server {
+	interceptors := []grpc.UnaryServerInterceptor{recoverPanics(), requestContext()}
+	if cfg.ClerkMiddleware != nil {
+		interceptors = append(interceptors, authenticate(cfg.ClerkMiddleware))
+	}
+	interceptors = append(interceptors, logRequests(), statusErrors())
+	if cfg.Portal != nil {
+		interceptors = append(interceptors, requireStaff(cfg.Portal))
+	}
+	if cfg.RateLimiter != nil {
+		interceptors = append(interceptors, rateLimit(cfg.RateLimiter))
+	}
+
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	pb.RegisterOrderServiceServer(s, &orderServer{service: cfg.Orders})
+	pb.RegisterClientServiceServer(s, &clientServer{service: cfg.Clients})
+	pb.RegisterProsthesisServiceServer(s, &prosthesisServer{service: cfg.Prostheses})
+	pb.RegisterTechnicianServiceServer(s, &technicianServer{service: cfg.Technicians})
+	return s
+}
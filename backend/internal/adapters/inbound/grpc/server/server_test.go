@@ -0,0 +1,395 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/pb"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
+	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
+	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+)
+
+type sequenceIDGenerator struct {
+	n int
+}
+
+func (g *sequenceIDGenerator) Generate() string {
+	g.n++
+	return "id-" + strconv.Itoa(g.n)
+}
+
+// dialTestServer starts a server over an in-memory connection with two
+// laboratories, lab-123 and lab-456
+func dialTestServer(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	labRepo := memory.NewLaboratoryRepository()
+	clientRepo := memory.NewClientRepository()
+	orderRepo := memory.NewOrderRepository()
+	techRepo := memory.NewTechnicianRepository()
+	catalog := memory.NewProsthesisRepository()
+	for _, id := range []string{"lab-123", "lab-456"} {
+		_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: id, Name: "Test Lab"})
+	}
+
+	idGen := &sequenceIDGenerator{}
+	auditor := auditapp.NopRecorder{}
+	srv := New(Config{
+		Orders:      orderapp.NewService(orderRepo, clientRepo, techRepo, catalog, memory.NewAttachmentStorage(), idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Clients:     clientapp.NewService(clientRepo, labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Prostheses:  prosthesisapp.NewService(catalog, labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Technicians: techapp.NewService(techRepo, labRepo, orderRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+	})
+
+	lis := bufconn.Listen(1024 * 1024)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial server: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestServer_Orders(t *testing.T) {
+	conn := dialTestServer(t)
+	clients := pb.NewClientServiceClient(conn)
+	orders := pb.NewOrderServiceClient(conn)
+	ctx := context.Background()
+
+	c, err := clients.CreateClient(ctx, &pb.CreateClientRequest{
+		LaboratoryId: "lab-123",
+		Name:         "Dr. Smith",
+		Email:        "smith@example.com",
+		Phone:        "+5511999999999",
+		Address:      &pb.Address{Street: "Rua A", City: "São Paulo", State: "SP", PostalCode: "01000-000", Country: "BR"},
+	})
+	if err != nil {
+		t.Fatalf("CreateClient() error = %v", err)
+	}
+
+	created, err := orders.CreateOrder(ctx, &pb.CreateOrderRequest{
+		LaboratoryId: "lab-123",
+		ClientId:     c.GetId(),
+		Prosthesis:   []*pb.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+	if created.GetStatus() != pb.OrderStatus_ORDER_STATUS_RECEIVED {
+		t.Errorf("CreateOrder() status = %v, want ORDER_STATUS_RECEIVED", created.GetStatus())
+	}
+
+	updated, err := orders.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{
+		LaboratoryId: "lab-123",
+		Id:           created.GetId(),
+		Status:       pb.OrderStatus_ORDER_STATUS_IN_PRODUCTION,
+	})
+	if err != nil {
+		t.Fatalf("UpdateOrderStatus() error = %v", err)
+	}
+	if h := updated.GetHistory(); len(h) != 2 || h[1].GetFrom() != pb.OrderStatus_ORDER_STATUS_RECEIVED {
+		t.Errorf("UpdateOrderStatus() history = %v, want the change from ORDER_STATUS_RECEIVED", h)
+	}
+
+	list, err := orders.ListOrders(ctx, &pb.ListOrdersRequest{
+		LaboratoryId: "lab-123",
+		Filter:       &pb.OrderFilter{Statuses: []pb.OrderStatus{pb.OrderStatus_ORDER_STATUS_IN_PRODUCTION}},
+	})
+	if err != nil {
+		t.Fatalf("ListOrders() error = %v", err)
+	}
+	if list.GetTotal() != 1 || list.GetItems()[0].GetId() != created.GetId() {
+		t.Errorf("ListOrders() = %v, want the created order", list)
+	}
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantCode codes.Code
+		wantInfo string
+	}{
+		{
+			name: "get order",
+			call: func() error {
+				_, err := orders.GetOrder(ctx, &pb.GetOrderRequest{LaboratoryId: "lab-123", Id: created.GetId()})
+				return err
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "order of another laboratory",
+			call: func() error {
+				_, err := orders.GetOrder(ctx, &pb.GetOrderRequest{LaboratoryId: "lab-456", Id: created.GetId()})
+				return err
+			},
+			wantCode: codes.NotFound,
+			wantInfo: "not_found",
+		},
+		{
+			name: "invalid status transition",
+			call: func() error {
+				_, err := orders.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{
+					LaboratoryId: "lab-123",
+					Id:           created.GetId(),
+					Status:       pb.OrderStatus_ORDER_STATUS_DELIVERED,
+				})
+				return err
+			},
+			wantCode: codes.FailedPrecondition,
+			wantInfo: "invalid_status_transition",
+		},
+		{
+			name: "validation error",
+			call: func() error {
+				_, err := orders.CreateOrder(ctx, &pb.CreateOrderRequest{LaboratoryId: "lab-123", ClientId: c.GetId()})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+			wantInfo: "validation_failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(tt.call())
+			if st.Code() != tt.wantCode {
+				t.Fatalf("status = %v (%s), want %v", st.Code(), st.Message(), tt.wantCode)
+			}
+			if tt.wantInfo == "" {
+				return
+			}
+			if info := errorInfoOf(st); info == nil || info.GetReason() != tt.wantInfo || info.GetDomain() != errorDomain {
+				t.Errorf("ErrorInfo = %v, want reason %s", info, tt.wantInfo)
+			}
+		})
+	}
+}
+
+func TestServer_ValidationDetails(t *testing.T) {
+	conn := dialTestServer(t)
+	technicians := pb.NewTechnicianServiceClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "en-US")
+	_, err := technicians.CreateTechnician(ctx, &pb.CreateTechnicianRequest{LaboratoryId: "lab-123"})
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("CreateTechnician() code = %v, want InvalidArgument", st.Code())
+	}
+
+	fields := map[string]string{}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields[v.GetField()] = v.GetDescription()
+			}
+		}
+	}
+	for _, field := range []string{"name", "email", "role"} {
+		if fields[field] == "" {
+			t.Errorf("BadRequest violations = %v, want one for %s", fields, field)
+		}
+	}
+	if fields["name"] != "name is required" {
+		t.Errorf("name violation = %q, want it in English", fields["name"])
+	}
+}
+
+func TestServer_Catalog(t *testing.T) {
+	conn := dialTestServer(t)
+	prostheses := pb.NewProsthesisServiceClient(conn)
+	ctx := context.Background()
+
+	created, err := prostheses.CreateProsthesis(ctx, &pb.CreateProsthesisRequest{
+		LaboratoryId: "lab-123",
+		Type:         pb.ProsthesisType_PROSTHESIS_TYPE_CROWN,
+		Material:     "zirconia",
+	})
+	if err != nil {
+		t.Fatalf("CreateProsthesis() error = %v", err)
+	}
+
+	got, err := prostheses.GetProsthesis(ctx, &pb.GetProsthesisRequest{LaboratoryId: "lab-123", Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("GetProsthesis() error = %v", err)
+	}
+	if got.GetType() != pb.ProsthesisType_PROSTHESIS_TYPE_CROWN {
+		t.Errorf("GetProsthesis() type = %v, want PROSTHESIS_TYPE_CROWN", got.GetType())
+	}
+
+	if _, err := prostheses.DeleteProsthesis(ctx, &pb.DeleteProsthesisRequest{LaboratoryId: "lab-123", Id: created.GetId()}); err != nil {
+		t.Fatalf("DeleteProsthesis() error = %v", err)
+	}
+	_, err = prostheses.GetProsthesis(ctx, &pb.GetProsthesisRequest{LaboratoryId: "lab-123", Id: created.GetId()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetProsthesis() after delete code = %v, want NotFound", status.Code(err))
+	}
+}
+
+func TestRecoverPanics(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: pb.OrderService_GetOrder_FullMethodName}
+	_, err := recoverPanics()(context.Background(), &pb.GetOrderRequest{}, info, func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("call of a panicking handler code = %v, want Internal", status.Code(err))
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Rules{
+		Groups: map[string]ratelimit.Rule{"orders": {RequestsPerMinute: 1, Burst: 1}},
+	}, nil)
+	interceptor := rateLimit(limiter)
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), &pb.GetOrderRequest{LaboratoryId: "lab-123"}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	if err := call(pb.OrderService_GetOrder_FullMethodName); err != nil {
+		t.Fatalf("first call error = %v, want nil", err)
+	}
+	if err := call(pb.OrderService_GetOrder_FullMethodName); !errors.Is(err, ratelimit.ErrRateLimited) {
+		t.Errorf("second call error = %v, want %v", err, ratelimit.ErrRateLimited)
+	}
+	// Other services have their own, here unlimited, buckets
+	if err := call(pb.ClientService_CreateClient_FullMethodName); err != nil {
+		t.Errorf("call of another service error = %v, want nil", err)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	interceptor := authenticate(auth.NewClerkMiddleware(auth.ClerkConfig{SecretKey: "sk_test_secret"}))
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	for _, authorization := range []string{"", "Basic dXNlcg==", "Bearer not-a-jwt"} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization))
+		_, err := interceptor(ctx, &pb.GetOrderRequest{}, &grpc.UnaryServerInfo{FullMethod: pb.OrderService_GetOrder_FullMethodName}, handler)
+		if s := status.Convert(err); s.Code() != codes.Unauthenticated || s.Message() != "unauthorized" {
+			t.Errorf("call with authorization %q = %v, want unauthenticated without the reason", authorization, err)
+		}
+	}
+}
+
+func TestRequireStaff(t *testing.T) {
+	clientRepo := memory.NewClientRepository()
+	_ = clientRepo.Create(context.Background(), &client.Client{ID: "client-123", LaboratoryID: "lab-123", Name: "Dr. Ana", PortalUserID: "user_dentist"})
+	interceptor := requireStaff(portalapp.NewService(clientRepo, nil))
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	tests := []struct {
+		userID  string
+		wantErr error
+	}{
+		{"user_dentist", domainerrors.ErrForbidden},
+		{"user_staff", nil},
+	}
+
+	for _, tt := range tests {
+		ctx := auth.WithUserID(context.Background(), tt.userID)
+		_, err := interceptor(ctx, &pb.GetOrderRequest{LaboratoryId: "lab-123"}, &grpc.UnaryServerInfo{FullMethod: pb.OrderService_GetOrder_FullMethodName}, handler)
+		if err != tt.wantErr {
+			t.Errorf("call as %s error = %v, want %v", tt.userID, err, tt.wantErr)
+		}
+	}
+}
+
+func TestStatusKindOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		want statusKind
+	}{
+		{domainerrors.Validation(domainerrors.Required("name")), statusValidation},
+		{domainerrors.ErrInvalidInput, statusInvalidInput},
+		{domainerrors.ErrInvalidStatusTransition, statusInvalidTransition},
+		{domainerrors.ErrUnauthorized, statusUnauthorized},
+		{auth.ErrUnauthorized, statusUnauthorized},
+		{domainerrors.ErrForbidden, statusForbidden},
+		{fmt.Errorf("get order: %w", domainerrors.ErrNotFound), statusNotFound},
+		{domainerrors.ErrDuplicateEmail, statusDuplicateEmail},
+		{domainerrors.ErrPortalUserAlreadyLinked, statusPortalUserLinked},
+		{ratelimit.ErrRateLimited, statusRateLimited},
+		{fmt.Errorf("connection refused"), statusInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want.reason, func(t *testing.T) {
+			if got := statusKindOf(tt.err); got != tt.want {
+				t.Errorf("statusKindOf(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumConversions(t *testing.T) {
+	statuses := []order.Status{
+		order.StatusReceived, order.StatusInProduction, order.StatusQualityCheck,
+		order.StatusReady, order.StatusDelivered, order.StatusRevision,
+	}
+	for _, s := range statuses {
+		if got := toStatusValue(toOrderStatus(s)); got != s {
+			t.Errorf("order status %s round-trips to %s", s, got)
+		}
+	}
+	for v := range prosthesis.ValidProsthesisTypes() {
+		if got := toProsthesisTypeValue(pb.ProsthesisType(enumNumber(pb.ProsthesisType_value, prosthesisTypePrefix, string(v)))); got != v {
+			t.Errorf("prosthesis type %s round-trips to %s", v, got)
+		}
+	}
+	roles := []technician.Role{technician.RoleSeniorTechnician, technician.RoleTechnician, technician.RoleApprentice}
+	for _, r := range roles {
+		if got := toRoleValue(pb.TechnicianRole(enumNumber(pb.TechnicianRole_value, technicianRolePrefix, string(r)))); got != r {
+			t.Errorf("technician role %s round-trips to %s", r, got)
+		}
+	}
+
+	if got := toStatusValue(pb.OrderStatus_ORDER_STATUS_UNSPECIFIED); got != "" {
+		t.Errorf("unspecified status = %q, want empty", got)
+	}
+	if got := toStatusValue(pb.OrderStatus(99)); got != "99" {
+		t.Errorf("unknown status = %q, want 99", got)
+	}
+}
+
+// errorInfoOf returns the ErrorInfo detail of a status
+func errorInfoOf(st *status.Status) *errdetails.ErrorInfo {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/grpc/pb"
+	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+)
+
+// technicianRolePrefix prefixes the values of the TechnicianRole enum
+const technicianRolePrefix = "TECHNICIAN_ROLE_"
+
+// technicianServer implements pb.TechnicianServiceServer
+type technicianServer struct {
+	pb.UnimplementedTechnicianServiceServer
+	service *techapp.Service
+}
+
+// CreateTechnician creates a technician of the laboratory
+func (s *technicianServer) CreateTechnician(ctx context.Context, req *pb.CreateTechnicianRequest) (*pb.Technician, error) {
+	t, err := s.service.CreateTechnician(ctx, techapp.CreateInput{
+		LaboratoryID:    req.GetLaboratoryId(),
+		Name:            req.GetName(),
+		Email:           req.GetEmail(),
+		Phone:           req.GetPhone(),
+		Role:            toRoleValue(req.GetRole()),
+		Specializations: req.GetSpecializations(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTechnician(t), nil
+}
+
+// GetTechnician retrieves a technician of the laboratory
+func (s *technicianServer) GetTechnician(ctx context.Context, req *pb.GetTechnicianRequest) (*pb.Technician, error) {
+	t, err := s.service.GetTechnician(ctx, req.GetId(), req.GetLaboratoryId())
+	if err != nil {
+		return nil, err
+	}
+	return toTechnician(t), nil
+}
+
+// ListTechnicians lists the technicians of the laboratory
+func (s *technicianServer) ListTechnicians(ctx context.Context, req *pb.ListTechniciansRequest) (*pb.ListTechniciansResponse, error) {
+	page, err := s.service.ListTechnicians(ctx, req.GetLaboratoryId(), listQuery(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListTechniciansResponse{NextCursor: page.NextCursor, Total: int32(page.Total)}
+	for _, t := range page.Items {
+		resp.Items = append(resp.Items, toTechnician(t))
+	}
+	return resp, nil
+}
+
+// UpdateTechnician replaces the details of a technician
+func (s *technicianServer) UpdateTechnician(ctx context.Context, req *pb.UpdateTechnicianRequest) (*pb.Technician, error) {
+	t, err := s.service.UpdateTechnician(ctx, techapp.UpdateInput{
+		ID:              req.GetId(),
+		LaboratoryID:    req.GetLaboratoryId(),
+		Name:            req.GetName(),
+		Email:           req.GetEmail(),
+		Phone:           req.GetPhone(),
+		Role:            toRoleValue(req.GetRole()),
+		Specializations: req.GetSpecializations(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTechnician(t), nil
+}
+
+// DeleteTechnician soft deletes a technician of the laboratory
+func (s *technicianServer) DeleteTechnician(ctx context.Context, req *pb.DeleteTechnicianRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteTechnician(ctx, req.GetId(), req.GetLaboratoryId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// toTechnician converts a domain technician to its message
+func toTechnician(t *technician.Technician) *pb.Technician {
+	return &pb.Technician{
+		Id:              t.ID,
+		LaboratoryId:    t.LaboratoryID,
+		Name:            t.Name,
+		Email:           t.Email,
+		Phone:           t.Phone,
+		Role:            pb.TechnicianRole(enumNumber(pb.TechnicianRole_value, technicianRolePrefix, string(t.Role))),
+		Specializations: t.Specializations,
+		CreatedAt:       timestamp(t.CreatedAt),
+		UpdatedAt:       timestamp(t.UpdatedAt),
+	}
+}
+
+// toRoleValue converts an enum value to its domain role
+func toRoleValue(r pb.TechnicianRole) technician.Role {
+	return technician.Role(domainValue(pb.TechnicianRole_name, technicianRolePrefix, int32(r)))
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+)
+
+// FieldChangeResponse represents a single changed field of an audit entry
+type FieldChangeResponse struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// AuditEntryResponse represents the response body for an audit entry
+type AuditEntryResponse struct {
+	ID           string                `json:"id"`
+	LaboratoryID string                `json:"laboratory_id"`
+	ActorID      string                `json:"actor_id"`
+	EntityType   string                `json:"entity_type"`
+	EntityID     string                `json:"entity_id"`
+	Action       string                `json:"action"`
+	Changes      []FieldChangeResponse `json:"changes"`
+	RequestID    string                `json:"request_id,omitempty"`
+	OccurredAt   time.Time             `json:"occurred_at"`
+}
+
+// ToAuditEntryResponse converts a domain audit entry to response DTO
+func ToAuditEntryResponse(e *audit.Entry) AuditEntryResponse {
+	changes := make([]FieldChangeResponse, len(e.Changes))
+	for i, c := range e.Changes {
+		changes[i] = FieldChangeResponse{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		}
+	}
+
+	return AuditEntryResponse{
+		ID:           e.ID,
+		LaboratoryID: e.LaboratoryID,
+		ActorID:      e.ActorID,
+		EntityType:   string(e.EntityType),
+		EntityID:     e.EntityID,
+		Action:       string(e.Action),
+		Changes:      changes,
+		RequestID:    e.RequestID,
+		OccurredAt:   e.OccurredAt,
+	}
+}
+
+// ToAuditEntryResponseList converts a list of domain audit entries to response DTOs
+func ToAuditEntryResponseList(entries []*audit.Entry) []AuditEntryResponse {
+	responses := make([]AuditEntryResponse, len(entries))
+	for i, e := range entries {
+		responses[i] = ToAuditEntryResponse(e)
+	}
+	return responses
+}
@@ -0,0 +1,55 @@
+package dto
+
+// BulkOrderStatusRequest represents the request body for changing the status of several orders
+type BulkOrderStatusRequest struct {
+	Atomic bool                  `json:"atomic"` // Apply all items or none
+	Items  []BulkOrderStatusItem `json:"items" binding:"required,min=1,max=500,dive"`
+}
+
+// BulkOrderStatusItem represents a status change in a bulk request
+type BulkOrderStatusItem struct {
+	ID     string `json:"id" binding:"required"`
+	Status string `json:"status" binding:"required"`
+}
+
+// BulkDeleteTechniciansRequest represents the request body for deleting several technicians
+type BulkDeleteTechniciansRequest struct {
+	Atomic bool                       `json:"atomic"` // Apply all items or none
+	Items  []BulkDeleteTechnicianItem `json:"items" binding:"required,min=1,max=500,dive"`
+}
+
+// BulkDeleteTechnicianItem represents a technician deletion in a bulk request
+type BulkDeleteTechnicianItem struct {
+	ID         string `json:"id" binding:"required"`
+	ReassignTo string `json:"reassign_to,omitempty"` // Technician taking over the open orders; omit to unassign them
+}
+
+// ImportClientsRequest represents the request body for importing clients. Items
+// are validated one by one, so an invalid client fails only its own item.
+type ImportClientsRequest struct {
+	Atomic bool                  `json:"atomic"` // Apply all items or none
+	Items  []CreateClientRequest `json:"items" binding:"required,min=1,max=500"`
+}
+
+// BulkResponse represents the response body of a bulk endpoint, with one
+// result per request item in the same order
+type BulkResponse[T any] struct {
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+	Skipped   int                 `json:"skipped"`
+	Results   []BulkItemResult[T] `json:"results"`
+}
+
+// BulkItemResult represents the outcome of a bulk request item
+type BulkItemResult[T any] struct {
+	Index  int      `json:"index"`
+	Status string   `json:"status"`
+	Data   *T       `json:"data,omitempty"`  // Set when the item succeeded
+	Error  *Problem `json:"error,omitempty"` // Set when the item failed
+}
+
+// TechnicianDeletionResponse represents a deleted technician in a bulk response
+type TechnicianDeletionResponse struct {
+	ID               string   `json:"id"`
+	ReassignedOrders []string `json:"reassigned_orders"`
+}
@@ -39,8 +39,13 @@ type ClientResponse struct {
 	Email        string                `json:"email"`
 	Phone        string                `json:"phone"`
 	Address      ClientAddressResponse `json:"address"`
+	PortalUserID string                `json:"portal_user_id,omitempty"`
+	EmailOptOut  bool                  `json:"email_opt_out"`
 	CreatedAt    time.Time             `json:"created_at"`
 	UpdatedAt    time.Time             `json:"updated_at"`
+
+	// Related resources, embedded when requested with expand
+	Laboratory *LaboratoryResponse `json:"laboratory,omitempty"`
 }
 
 // ClientAddressResponse represents the address in client response body
@@ -67,8 +72,10 @@ func ToClientResponse(c *client.Client) ClientResponse {
 			PostalCode: c.Address.PostalCode,
 			Country:    c.Address.Country,
 		},
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
+		PortalUserID: c.PortalUserID,
+		EmailOptOut:  c.EmailOptOut,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
 	}
 }
 
@@ -81,6 +88,23 @@ func ToClientResponseList(clients []*client.Client) []ClientResponse {
 	return responses
 }
 
+// ToUpdateClientRequest converts a domain client to the update request
+// describing its current state, the base of merge patches
+func ToUpdateClientRequest(c *client.Client) UpdateClientRequest {
+	return UpdateClientRequest{
+		Name:  c.Name,
+		Email: c.Email,
+		Phone: c.Phone,
+		Address: ClientAddressRequest{
+			Street:     c.Address.Street,
+			City:       c.Address.City,
+			State:      c.Address.State,
+			PostalCode: c.Address.PostalCode,
+			Country:    c.Address.Country,
+		},
+	}
+}
+
 // ToClientAddress converts client address request to domain address
 func (r *ClientAddressRequest) ToClientAddress() client.Address {
 	return client.Address{
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	commentapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+)
+
+// CreateCommentRequest represents the request body for adding a comment to an order
+type CreateCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// CommentAuthorResponse represents the author of a comment: a staff user ID,
+// or the client ID for comments of the client
+type CommentAuthorResponse struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+}
+
+// CommentResponse represents the response body for a comment
+type CommentResponse struct {
+	ID        string                `json:"id"`
+	OrderID   string                `json:"order_id"`
+	Author    CommentAuthorResponse `json:"author"`
+	Body      string                `json:"body"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+// CommentSignalMessage represents a message sent over the comment WebSocket
+type CommentSignalMessage struct {
+	Type      string                `json:"type"`
+	OrderID   string                `json:"order_id"`
+	Author    CommentAuthorResponse `json:"author"`
+	Comment   *CommentResponse      `json:"comment,omitempty"`    // comment.created
+	CommentID string                `json:"comment_id,omitempty"` // comment.deleted, read
+	At        time.Time             `json:"at"`
+}
+
+// CommentClientMessage represents a message received over the comment
+// WebSocket: {"type": "typing"} or {"type": "read", "comment_id": "..."}
+type CommentClientMessage struct {
+	Type      string `json:"type"`
+	CommentID string `json:"comment_id,omitempty"`
+}
+
+// ToCommentResponse converts a domain comment to response DTO
+func ToCommentResponse(c *comment.Comment) CommentResponse {
+	return CommentResponse{
+		ID:        c.ID,
+		OrderID:   c.OrderID,
+		Author:    toCommentAuthorResponse(c.Author),
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// ToCommentResponseList converts a list of domain comments to response DTOs
+func ToCommentResponseList(comments []*comment.Comment) []CommentResponse {
+	responses := make([]CommentResponse, len(comments))
+	for i, c := range comments {
+		responses[i] = ToCommentResponse(c)
+	}
+	return responses
+}
+
+// ToCommentSignalMessage converts a thread signal to a WebSocket message
+func ToCommentSignalMessage(s commentapp.Signal) CommentSignalMessage {
+	msg := CommentSignalMessage{
+		Type:      string(s.Type),
+		OrderID:   s.OrderID,
+		Author:    toCommentAuthorResponse(s.Author),
+		CommentID: s.CommentID,
+		At:        s.At,
+	}
+	if s.Comment != nil {
+		resp := ToCommentResponse(s.Comment)
+		msg.Comment = &resp
+	}
+	return msg
+}
+
+// toCommentAuthorResponse converts a comment author to response DTO
+func toCommentAuthorResponse(a comment.Author) CommentAuthorResponse {
+	return CommentAuthorResponse{ID: a.ID, Kind: string(a.Kind)}
+}
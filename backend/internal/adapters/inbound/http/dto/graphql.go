@@ -0,0 +1,28 @@
+package dto
+
+// GraphQLRequest represents the request body of a GraphQL query or mutation
+type GraphQLRequest struct {
+	Query         string         `json:"query" binding:"required"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLResponse represents the response body of a GraphQL request
+type GraphQLResponse struct {
+	Data   any            `json:"data"` // Shaped by the query; null when it could not be executed
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// GraphQLError represents an error of a GraphQL request
+type GraphQLError struct {
+	Message    string            `json:"message"`
+	Locations  []GraphQLLocation `json:"locations,omitempty"`
+	Path       []any             `json:"path,omitempty"`
+	Extensions *Problem          `json:"extensions,omitempty"` // Problem details of an error raised by a field
+}
+
+// GraphQLLocation represents a position in a GraphQL query
+type GraphQLLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
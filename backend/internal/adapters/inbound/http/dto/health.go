@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+
+// HealthResponse represents the liveness or readiness of the server
+type HealthResponse struct {
+	Status string                         `json:"status"`
+	Checks map[string]HealthCheckResponse `json:"checks,omitempty"`
+}
+
+// HealthCheckResponse represents the outcome of a readiness check
+type HealthCheckResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ToHealthResponse converts a readiness report to response DTO
+func ToHealthResponse(r health.Report) HealthResponse {
+	resp := HealthResponse{Status: r.Status}
+	if len(r.Checks) > 0 {
+		resp.Checks = make(map[string]HealthCheckResponse, len(r.Checks))
+		for _, c := range r.Checks {
+			resp.Checks[c.Name] = HealthCheckResponse{Status: c.Status, Error: c.Error}
+		}
+	}
+	return resp
+}
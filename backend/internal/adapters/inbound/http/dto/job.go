@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	schedulerapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/scheduler"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+)
+
+// JobResponse represents a scheduled background job with its last run
+type JobResponse struct {
+	Name      string          `json:"name"`
+	Schedule  string          `json:"schedule"`
+	TimeoutMS int64           `json:"timeout_ms"`
+	NextRunAt *time.Time      `json:"next_run_at,omitempty"`
+	Running   bool            `json:"running"`
+	LastRun   *JobRunResponse `json:"last_run,omitempty"`
+}
+
+// JobRunResponse represents a run of a background job
+type JobRunResponse struct {
+	ID          string     `json:"id"`
+	Job         string     `json:"job"`
+	Instance    string     `json:"instance"`
+	Status      string     `json:"status"`
+	Result      string     `json:"result,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	StartedAt   time.Time  `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	DurationMS  int64      `json:"duration_ms"`
+}
+
+// ToJobResponse converts a job status to response DTO
+func ToJobResponse(s schedulerapp.JobStatus) JobResponse {
+	resp := JobResponse{
+		Name:      s.Name,
+		Schedule:  s.Schedule,
+		TimeoutMS: s.Timeout.Milliseconds(),
+		NextRunAt: s.NextRunAt,
+		Running:   s.Running,
+	}
+	if s.LastRun != nil {
+		run := ToJobRunResponse(s.LastRun)
+		resp.LastRun = &run
+	}
+	return resp
+}
+
+// ToJobResponseList converts a list of job statuses to response DTOs
+func ToJobResponseList(statuses []schedulerapp.JobStatus) []JobResponse {
+	responses := make([]JobResponse, len(statuses))
+	for i, s := range statuses {
+		responses[i] = ToJobResponse(s)
+	}
+	return responses
+}
+
+// ToJobRunResponse converts a domain job run to response DTO
+func ToJobRunResponse(r *job.Run) JobRunResponse {
+	return JobRunResponse{
+		ID:          r.ID,
+		Job:         r.Job,
+		Instance:    r.Instance,
+		Status:      string(r.Status),
+		Result:      r.Result,
+		Error:       r.Error,
+		ScheduledAt: r.ScheduledAt,
+		StartedAt:   r.StartedAt,
+		FinishedAt:  r.FinishedAt,
+		DurationMS:  r.Duration().Milliseconds(),
+	}
+}
+
+// ToJobRunResponseList converts a list of domain job runs to response DTOs
+func ToJobRunResponseList(runs []*job.Run) []JobRunResponse {
+	responses := make([]JobRunResponse, len(runs))
+	for i, r := range runs {
+		responses[i] = ToJobRunResponse(r)
+	}
+	return responses
+}
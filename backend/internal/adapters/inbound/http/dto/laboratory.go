@@ -8,10 +8,11 @@ import (
 
 // CreateLaboratoryRequest represents the request body for creating a laboratory
 type CreateLaboratoryRequest struct {
-	Name    string         `json:"name" binding:"required"`
-	Email   string         `json:"email" binding:"required,email"`
-	Phone   string         `json:"phone" binding:"required"`
-	Address AddressRequest `json:"address" binding:"required"`
+	Name     string         `json:"name" binding:"required"`
+	Email    string         `json:"email" binding:"required,email"`
+	Phone    string         `json:"phone" binding:"required"`
+	Address  AddressRequest `json:"address" binding:"required"`
+	Language string         `json:"language"` // Defaults to pt-BR
 }
 
 // AddressRequest represents the address in request body
@@ -25,10 +26,11 @@ type AddressRequest struct {
 
 // UpdateLaboratoryRequest represents the request body for updating a laboratory
 type UpdateLaboratoryRequest struct {
-	Name    string         `json:"name" binding:"required"`
-	Email   string         `json:"email" binding:"required,email"`
-	Phone   string         `json:"phone" binding:"required"`
-	Address AddressRequest `json:"address" binding:"required"`
+	Name     string         `json:"name" binding:"required"`
+	Email    string         `json:"email" binding:"required,email"`
+	Phone    string         `json:"phone" binding:"required"`
+	Address  AddressRequest `json:"address" binding:"required"`
+	Language string         `json:"language"` // Omit to keep the current language
 }
 
 // LaboratoryResponse represents the response body for a laboratory
@@ -38,6 +40,7 @@ type LaboratoryResponse struct {
 	Email     string          `json:"email"`
 	Phone     string          `json:"phone"`
 	Address   AddressResponse `json:"address"`
+	Language  string          `json:"language"`
 	CreatedAt time.Time       `json:"created_at"`
 	UpdatedAt time.Time       `json:"updated_at"`
 }
@@ -65,6 +68,7 @@ func ToLaboratoryResponse(lab *laboratory.Laboratory) LaboratoryResponse {
 			PostalCode: lab.Address.PostalCode,
 			Country:    lab.Address.Country,
 		},
+		Language:  string(lab.Language),
 		CreatedAt: lab.CreatedAt,
 		UpdatedAt: lab.UpdatedAt,
 	}
@@ -79,6 +83,24 @@ func ToLaboratoryResponseList(labs []*laboratory.Laboratory) []LaboratoryRespons
 	return responses
 }
 
+// ToUpdateLaboratoryRequest converts a domain laboratory to the update request
+// describing its current state, the base of merge patches
+func ToUpdateLaboratoryRequest(lab *laboratory.Laboratory) UpdateLaboratoryRequest {
+	return UpdateLaboratoryRequest{
+		Name:  lab.Name,
+		Email: lab.Email,
+		Phone: lab.Phone,
+		Address: AddressRequest{
+			Street:     lab.Address.Street,
+			City:       lab.Address.City,
+			State:      lab.Address.State,
+			PostalCode: lab.Address.PostalCode,
+			Country:    lab.Address.Country,
+		},
+		Language: string(lab.Language),
+	}
+}
+
 // ToAddress converts address request to domain address
 func (r *AddressRequest) ToAddress() laboratory.Address {
 	return laboratory.Address{
@@ -90,9 +112,3 @@ func (r *AddressRequest) ToAddress() laboratory.Address {
 	}
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error   string            `json:"error"`
-	Details map[string]string `json:"details,omitempty"`
-}
-
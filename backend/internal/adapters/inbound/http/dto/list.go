@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+
+// ListResponse represents the response envelope of a paginated list endpoint
+type ListResponse[T any] struct {
+	Data       []T    `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      int    `json:"total"`
+}
+
+// NewListResponse wraps converted page items in a list response envelope
+func NewListResponse[E, T any](page listing.Page[E], data []T) ListResponse[T] {
+	return ListResponse[T]{
+		Data:       data,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
+	}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+)
+
+// UpdateNotificationSettingsRequest represents the request body for updating
+// the notification settings of a laboratory. Omitted fields use the defaults.
+type UpdateNotificationSettingsRequest struct {
+	SenderName  string `json:"sender_name"`
+	SenderEmail string `json:"sender_email"`
+	ReplyTo     string `json:"reply_to"`
+}
+
+// NotificationSettingsResponse represents the response body for the
+// notification settings of a laboratory
+type NotificationSettingsResponse struct {
+	LaboratoryID string     `json:"laboratory_id"`
+	SenderName   string     `json:"sender_name"`
+	SenderEmail  string     `json:"sender_email"`
+	ReplyTo      string     `json:"reply_to"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"` // Never updated when omitted
+}
+
+// ClientNotificationsRequest represents the request body for opting a client
+// in or out of notification emails
+type ClientNotificationsRequest struct {
+	EmailOptOut *bool `json:"email_opt_out" binding:"required"`
+}
+
+// ToNotificationSettingsResponse converts domain notification settings to response DTO
+func ToNotificationSettingsResponse(s *notification.Settings) NotificationSettingsResponse {
+	resp := NotificationSettingsResponse{
+		LaboratoryID: s.LaboratoryID,
+		SenderName:   s.SenderName,
+		SenderEmail:  s.SenderEmail,
+		ReplyTo:      s.ReplyTo,
+	}
+	if !s.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &s.UpdatedAt
+	}
+	return resp
+}
@@ -14,16 +14,18 @@ type CreateOrderRequest struct {
 
 // ProsthesisItemRequest represents a prosthesis item in the request body
 type ProsthesisItemRequest struct {
-	Type     string `json:"type" binding:"required"`
-	Material string `json:"material" binding:"required"`
-	Shade    string `json:"shade"`
-	Quantity int    `json:"quantity" binding:"required,gt=0"`
-	Notes    string `json:"notes"`
+	Type          string `json:"type" binding:"required"`
+	Material      string `json:"material" binding:"required"`
+	Shade         string `json:"shade"`
+	Quantity      int    `json:"quantity" binding:"required,gt=0"`
+	Notes         string `json:"notes"`
+	CatalogItemID string `json:"catalog_item_id,omitempty"` // Prosthesis of the laboratory's catalog, optional
 }
 
 // UpdateOrderRequest represents the request body for updating an order
 type UpdateOrderRequest struct {
-	Prosthesis []ProsthesisItemRequest `json:"prosthesis" binding:"required,dive"`
+	Prosthesis   []ProsthesisItemRequest `json:"prosthesis" binding:"required,dive"`
+	TechnicianID *string                 `json:"technician_id"` // Omit to keep, empty string to unassign
 }
 
 // UpdateOrderStatusRequest represents the request body for updating order status
@@ -36,19 +38,28 @@ type OrderResponse struct {
 	ID           string                    `json:"id"`
 	ClientID     string                    `json:"client_id"`
 	LaboratoryID string                    `json:"laboratory_id"`
+	TechnicianID string                    `json:"technician_id,omitempty"`
 	Status       string                    `json:"status"`
 	Prosthesis   []ProsthesisItemResponse  `json:"prosthesis"`
+	Attachments  []AttachmentResponse      `json:"attachments"`
 	CreatedAt    time.Time                 `json:"created_at"`
 	UpdatedAt    time.Time                 `json:"updated_at"`
+
+	// Related resources, embedded when requested with expand
+	Client     *ClientResponse     `json:"client,omitempty"`
+	Laboratory *LaboratoryResponse `json:"laboratory,omitempty"`
+	Technician *TechnicianResponse `json:"technician,omitempty"`
 }
 
 // ProsthesisItemResponse represents a prosthesis item in the response body
 type ProsthesisItemResponse struct {
-	Type     string `json:"type"`
-	Material string `json:"material"`
-	Shade    string `json:"shade,omitempty"`
-	Quantity int    `json:"quantity"`
-	Notes    string `json:"notes,omitempty"`
+	Type          string              `json:"type"`
+	Material      string              `json:"material"`
+	Shade         string              `json:"shade,omitempty"`
+	Quantity      int                 `json:"quantity"`
+	Notes         string              `json:"notes,omitempty"`
+	CatalogItemID string              `json:"catalog_item_id,omitempty"`
+	CatalogItem   *ProsthesisResponse `json:"catalog_item,omitempty"` // Embedded when requested with expand
 }
 
 // ToOrderResponse converts a domain order to response DTO
@@ -56,20 +67,28 @@ func ToOrderResponse(o *order.Order) OrderResponse {
 	prosthesisResponses := make([]ProsthesisItemResponse, len(o.Prosthesis))
 	for i, p := range o.Prosthesis {
 		prosthesisResponses[i] = ProsthesisItemResponse{
-			Type:     p.Type,
-			Material: p.Material,
-			Shade:    p.Shade,
-			Quantity: p.Quantity,
-			Notes:    p.Notes,
+			Type:          p.Type,
+			Material:      p.Material,
+			Shade:         p.Shade,
+			Quantity:      p.Quantity,
+			Notes:         p.Notes,
+			CatalogItemID: p.CatalogItemID,
 		}
 	}
 
+	attachments := make([]AttachmentResponse, len(o.Attachments))
+	for i, a := range o.Attachments {
+		attachments[i] = ToAttachmentResponse(&a)
+	}
+
 	return OrderResponse{
 		ID:           o.ID,
 		ClientID:     o.ClientID,
 		LaboratoryID: o.LaboratoryID,
+		TechnicianID: o.TechnicianID,
 		Status:       string(o.Status),
 		Prosthesis:   prosthesisResponses,
+		Attachments:  attachments,
 		CreatedAt:    o.CreatedAt,
 		UpdatedAt:    o.UpdatedAt,
 	}
@@ -89,11 +108,12 @@ func ToProsthesisItems(items []ProsthesisItemRequest) []order.ProsthesisItem {
 	result := make([]order.ProsthesisItem, len(items))
 	for i, item := range items {
 		result[i] = order.ProsthesisItem{
-			Type:     item.Type,
-			Material: item.Material,
-			Shade:    item.Shade,
-			Quantity: item.Quantity,
-			Notes:    item.Notes,
+			Type:          item.Type,
+			Material:      item.Material,
+			Shade:         item.Shade,
+			Quantity:      item.Quantity,
+			Notes:         item.Notes,
+			CatalogItemID: item.CatalogItemID,
 		}
 	}
 	return result
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	orderstreamapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/orderstream"
+)
+
+// OrderStreamEvent represents the data of an order change event of the order stream
+type OrderStreamEvent struct {
+	Type       string        `json:"type"`
+	Order      OrderResponse `json:"order"`
+	From       string        `json:"from,omitempty"`
+	To         string        `json:"to,omitempty"`
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+// OrderStreamReset represents the data of the reset event of the order stream,
+// sent when the changes missed by a resuming client are no longer kept
+type OrderStreamReset struct {
+	Reason string `json:"reason"`
+}
+
+// ToOrderStreamEvent converts an order stream message to response DTO
+func ToOrderStreamEvent(m orderstreamapp.Message) OrderStreamEvent {
+	return OrderStreamEvent{
+		Type:       string(m.Type),
+		Order:      ToOrderResponse(&m.Order),
+		From:       string(m.From),
+		To:         string(m.To),
+		OccurredAt: m.OccurredAt,
+	}
+}
@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// LinkPortalUserRequest represents the request body for binding a portal identity to a client
+type LinkPortalUserRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+}
+
+// PortalCreateOrderRequest represents the request body for creating an order from the portal
+type PortalCreateOrderRequest struct {
+	Prosthesis []ProsthesisItemRequest `json:"prosthesis" binding:"required,dive"`
+}
+
+// PortalClientResponse represents the client profile as seen from the portal
+type PortalClientResponse struct {
+	ID      string                `json:"id"`
+	Name    string                `json:"name"`
+	Email   string                `json:"email"`
+	Phone   string                `json:"phone"`
+	Address ClientAddressResponse `json:"address"`
+}
+
+// PortalOrderResponse represents an order as seen from the portal (no internal fields)
+type PortalOrderResponse struct {
+	ID          string                   `json:"id"`
+	Status      string                   `json:"status"`
+	Prosthesis  []ProsthesisItemResponse `json:"prosthesis"`
+	History     []StatusChangeResponse   `json:"history"`
+	Attachments []AttachmentResponse     `json:"attachments"`
+	CreatedAt   time.Time                `json:"created_at"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+// StatusChangeResponse represents a status transition in the response body
+type StatusChangeResponse struct {
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// AttachmentResponse represents an order attachment in the response body
+type AttachmentResponse struct {
+	ID          string    `json:"id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+// ToPortalClientResponse converts a domain client to portal response DTO
+func ToPortalClientResponse(c *client.Client) PortalClientResponse {
+	return PortalClientResponse{
+		ID:    c.ID,
+		Name:  c.Name,
+		Email: c.Email,
+		Phone: c.Phone,
+		Address: ClientAddressResponse{
+			Street:     c.Address.Street,
+			City:       c.Address.City,
+			State:      c.Address.State,
+			PostalCode: c.Address.PostalCode,
+			Country:    c.Address.Country,
+		},
+	}
+}
+
+// ToPortalOrderResponse converts a domain order to portal response DTO
+func ToPortalOrderResponse(o *order.Order) PortalOrderResponse {
+	history := make([]StatusChangeResponse, len(o.History))
+	for i, h := range o.History {
+		history[i] = StatusChangeResponse{
+			From:      string(h.From),
+			To:        string(h.To),
+			ChangedAt: h.ChangedAt,
+		}
+	}
+
+	attachments := make([]AttachmentResponse, len(o.Attachments))
+	for i, a := range o.Attachments {
+		attachments[i] = ToAttachmentResponse(&a)
+	}
+
+	return PortalOrderResponse{
+		ID:          o.ID,
+		Status:      string(o.Status),
+		Prosthesis:  ToOrderResponse(o).Prosthesis,
+		History:     history,
+		Attachments: attachments,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
+
+// ToPortalOrderResponseList converts a list of domain orders to portal response DTOs
+func ToPortalOrderResponseList(orders []*order.Order) []PortalOrderResponse {
+	responses := make([]PortalOrderResponse, len(orders))
+	for i, o := range orders {
+		responses[i] = ToPortalOrderResponse(o)
+	}
+	return responses
+}
+
+// ToAttachmentResponse converts a domain attachment to response DTO
+func ToAttachmentResponse(a *order.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          a.ID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		UploadedAt:  a.UploadedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package dto
+
+// ProblemContentType is the media type of RFC 7807 error responses
+const ProblemContentType = "application/problem+json"
+
+// Problem represents an RFC 7807 problem details error response
+type Problem struct {
+	Type      string               `json:"type"`
+	Title     string               `json:"title"`
+	Status    int                  `json:"status"`
+	Detail    string               `json:"detail,omitempty"`
+	Instance  string               `json:"instance,omitempty"`
+	Code      string               `json:"code"`                 // Stable, machine-readable error code
+	Errors    []FieldErrorResponse `json:"errors,omitempty"`     // Every invalid field of a validation problem
+	RequestID string               `json:"request_id,omitempty"` // Correlates with logs and the audit trail
+}
+
+// FieldErrorResponse represents a single invalid field of a validation problem
+type FieldErrorResponse struct {
+	Field   string            `json:"field"`
+	Message string            `json:"message"`          // Localised to the negotiated language
+	Code    string            `json:"code"`             // Stable message key, e.g. required
+	Params  map[string]string `json:"params,omitempty"` // Values substituted into the message
+}
@@ -60,3 +60,15 @@ func ToProsthesisResponseList(prostheses []*prosthesis.Prosthesis) []ProsthesisR
 	}
 	return responses
 }
+
+// ToUpdateProsthesisRequest converts a domain prosthesis to the update request
+// describing its current state, the base of merge patches
+func ToUpdateProsthesisRequest(p *prosthesis.Prosthesis) UpdateProsthesisRequest {
+	return UpdateProsthesisRequest{
+		Type:           string(p.Type),
+		Material:       p.Material,
+		Shade:          p.Shade,
+		Specifications: p.Specifications,
+		Notes:          p.Notes,
+	}
+}
@@ -62,6 +62,18 @@ func ToTechnicianResponseList(techs []*technician.Technician) []TechnicianRespon
 	return responses
 }
 
+// ToUpdateTechnicianRequest converts a domain technician to the update request
+// describing its current state, the base of merge patches
+func ToUpdateTechnicianRequest(tech *technician.Technician) UpdateTechnicianRequest {
+	return UpdateTechnicianRequest{
+		Name:            tech.Name,
+		Email:           tech.Email,
+		Phone:           tech.Phone,
+		Role:            string(tech.Role),
+		Specializations: tech.Specializations,
+	}
+}
+
 // ToRole converts a string to a technician Role
 func ToRole(roleStr string) (technician.Role, error) {
 	role := technician.Role(roleStr)
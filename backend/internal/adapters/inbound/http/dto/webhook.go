@@ -0,0 +1,132 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+// CreateWebhookRequest represents the request body for creating a webhook subscription
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types" binding:"required"`
+	Secret     string   `json:"secret" binding:"required"`
+}
+
+// UpdateWebhookRequest represents the request body for updating a webhook
+// subscription. An omitted secret keeps the current one.
+type UpdateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types" binding:"required"`
+	Secret     string   `json:"secret,omitempty"`
+}
+
+// WebhookResponse represents the response body for a webhook subscription.
+// The secret is never returned.
+type WebhookResponse struct {
+	ID           string    `json:"id"`
+	LaboratoryID string    `json:"laboratory_id"`
+	URL          string    `json:"url"`
+	EventTypes   []string  `json:"event_types"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// WebhookAttemptResponse represents a delivery attempt in the delivery log
+type WebhookAttemptResponse struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+}
+
+// WebhookDeliveryResponse represents the response body for a webhook delivery
+type WebhookDeliveryResponse struct {
+	ID             string                   `json:"id"`
+	SubscriptionID string                   `json:"subscription_id"`
+	EventID        string                   `json:"event_id"`
+	EventType      string                   `json:"event_type"`
+	Status         string                   `json:"status"`
+	Payload        json.RawMessage          `json:"payload"`
+	Attempts       []WebhookAttemptResponse `json:"attempts"`
+	NextAttemptAt  *time.Time               `json:"next_attempt_at,omitempty"`
+	RedeliveryOf   string                   `json:"redelivery_of,omitempty"`
+	CreatedAt      time.Time                `json:"created_at"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+}
+
+// ToEventTypes converts the event types of a request to domain event types
+func ToEventTypes(values []string) []webhook.EventType {
+	types := make([]webhook.EventType, len(values))
+	for i, v := range values {
+		types[i] = webhook.EventType(v)
+	}
+	return types
+}
+
+// ToWebhookResponse converts a domain webhook subscription to response DTO
+func ToWebhookResponse(s *webhook.Subscription) WebhookResponse {
+	types := make([]string, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		types[i] = string(t)
+	}
+
+	return WebhookResponse{
+		ID:           s.ID,
+		LaboratoryID: s.LaboratoryID,
+		URL:          s.URL,
+		EventTypes:   types,
+		CreatedAt:    s.CreatedAt,
+		UpdatedAt:    s.UpdatedAt,
+	}
+}
+
+// ToWebhookResponseList converts a list of domain webhook subscriptions to response DTOs
+func ToWebhookResponseList(subs []*webhook.Subscription) []WebhookResponse {
+	responses := make([]WebhookResponse, len(subs))
+	for i, s := range subs {
+		responses[i] = ToWebhookResponse(s)
+	}
+	return responses
+}
+
+// ToWebhookDeliveryResponse converts a domain webhook delivery to response DTO
+func ToWebhookDeliveryResponse(d *webhook.Delivery) WebhookDeliveryResponse {
+	attempts := make([]WebhookAttemptResponse, len(d.Attempts))
+	for i, a := range d.Attempts {
+		attempts[i] = WebhookAttemptResponse{
+			At:         a.At,
+			StatusCode: a.StatusCode,
+			Error:      a.Error,
+			DurationMS: a.Duration.Milliseconds(),
+		}
+	}
+
+	resp := WebhookDeliveryResponse{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Payload:        json.RawMessage(d.Payload),
+		Attempts:       attempts,
+		RedeliveryOf:   d.RedeliveryOf,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+	if d.Status == webhook.DeliveryPending {
+		next := d.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+	return resp
+}
+
+// ToWebhookDeliveryResponseList converts a list of domain webhook deliveries to response DTOs
+func ToWebhookDeliveryResponseList(deliveries []*webhook.Delivery) []WebhookDeliveryResponse {
+	responses := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		responses[i] = ToWebhookDeliveryResponse(d)
+	}
+	return responses
+}
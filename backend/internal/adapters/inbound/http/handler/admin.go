@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// RequireAdmin restricts the routes of a group to the given users. With no
+// users configured, every request is forbidden.
+func RequireAdmin(userIDs []string) gin.HandlerFunc {
+	admins := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		if id != "" {
+			admins[id] = struct{}{}
+		}
+	}
+
+	return func(c *gin.Context) {
+		if _, ok := admins[auth.GetUserID(c.Request.Context())]; !ok {
+			_ = c.Error(domainerrors.ErrForbidden)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
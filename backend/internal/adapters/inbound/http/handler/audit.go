@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// AuditHandler handles HTTP requests for the audit log
+type AuditHandler struct {
+	service *auditapp.Service
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(service *auditapp.Service) *AuditHandler {
+	return &AuditHandler{service: service}
+}
+
+// List handles GET /api/v1/audit?laboratory_id=xxx&entity_type=xxx&entity_id=xxx&actor_id=xxx&action=xxx&from=xxx&to=xxx&limit=n
+func (h *AuditHandler) List(c *gin.Context) {
+	laboratoryID := c.Query("laboratory_id")
+	if laboratoryID == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("laboratory_id")))
+		return
+	}
+
+	filter := audit.Filter{
+		LaboratoryID: laboratoryID,
+		ActorID:      c.Query("actor_id"),
+		EntityType:   audit.EntityType(c.Query("entity_type")),
+		EntityID:     c.Query("entity_id"),
+		Action:       audit.Action(c.Query("action")),
+	}
+
+	var err error
+	if filter.From, err = parseTimeQuery(c, "from"); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if filter.To, err = parseTimeQuery(c, "to"); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			_ = c.Error(domainerrors.Validation(domainerrors.PositiveInteger("limit")))
+			return
+		}
+		filter.Limit = limit
+	}
+
+	entries, err := h.service.ListEntries(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToAuditEntryResponseList(entries))
+}
+
+// parseTimeQuery parses an optional RFC3339 query parameter
+func parseTimeQuery(c *gin.Context, param string) (*time.Time, error) {
+	raw := c.Query(param)
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, domainerrors.Validation(domainerrors.InvalidTimestamp(param))
+	}
+	return &t, nil
+}
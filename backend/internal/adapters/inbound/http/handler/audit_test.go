@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+)
+
+func setupAuditTestRouter() (*gin.Engine, *auditapp.Service) {
+	gin.SetMode(gin.TestMode)
+
+	svc := auditapp.NewService(memory.NewAuditRepository(), &sequenceIDGenerator{})
+	handler := NewAuditHandler(svc)
+
+	r := gin.New()
+	r.Use(Problems())
+	r.Use(requestid.Middleware())
+	r.GET("/audit", handler.List)
+
+	return r, svc
+}
+
+// sequenceIDGenerator is a mock ID generator returning distinct IDs
+type sequenceIDGenerator struct {
+	n int
+}
+
+func (g *sequenceIDGenerator) Generate() string {
+	g.n++
+	return "id-" + strconv.Itoa(g.n)
+}
+
+func TestAuditHandler_List(t *testing.T) {
+	router, svc := setupAuditTestRouter()
+	ctx := context.Background()
+
+	svc.Record(ctx, auditapp.RecordInput{LaboratoryID: "lab-123", EntityType: audit.EntityClient, EntityID: "client-1", Action: audit.ActionCreate})
+	svc.Record(ctx, auditapp.RecordInput{LaboratoryID: "lab-123", EntityType: audit.EntityTechnician, EntityID: "tech-1", Action: audit.ActionDelete})
+	svc.Record(ctx, auditapp.RecordInput{LaboratoryID: "lab-456", EntityType: audit.EntityClient, EntityID: "client-2", Action: audit.ActionCreate})
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantCount  int
+	}{
+		{"all entries of a laboratory", "?laboratory_id=lab-123", http.StatusOK, 2},
+		{"filter by entity type", "?laboratory_id=lab-123&entity_type=technician", http.StatusOK, 1},
+		{"filter by action", "?laboratory_id=lab-123&action=create", http.StatusOK, 1},
+		{"limit", "?laboratory_id=lab-123&limit=1", http.StatusOK, 1},
+		{"time range in the past", "?laboratory_id=lab-123&to=2000-01-01T00:00:00Z", http.StatusOK, 0},
+		{"missing laboratory_id", "", http.StatusBadRequest, 0},
+		{"invalid from", "?laboratory_id=lab-123&from=yesterday", http.StatusBadRequest, 0},
+		{"invalid limit", "?laboratory_id=lab-123&limit=0", http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/audit"+tt.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("List() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp []dto.AuditEntryResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			if len(resp) != tt.wantCount {
+				t.Errorf("List() returned %d entries, want %d", len(resp), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestAuditHandler_RequestIDHeader(t *testing.T) {
+	router, _ := setupAuditTestRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/audit?laboratory_id=lab-123", nil)
+	req.Header.Set(requestid.Header, "req-from-client")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if got := w.Header().Get(requestid.Header); got != "req-from-client" {
+		t.Errorf("%s header = %q, want %q", requestid.Header, got, "req-from-client")
+	}
+}
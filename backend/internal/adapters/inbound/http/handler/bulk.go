@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
+)
+
+// bulkResponse converts batch results to a bulk response. convert renders the
+// value of a succeeded item; the error of a failed one becomes problem details
+// in the request's language.
+func bulkResponse[V, T any](c *gin.Context, results []bulk.Result[V], convert func(V) T) dto.BulkResponse[T] {
+	lang := requestLanguage(c)
+	resp := dto.BulkResponse[T]{
+		Succeeded: bulk.Count(results, bulk.StatusSucceeded),
+		Failed:    bulk.Count(results, bulk.StatusFailed),
+		Skipped:   bulk.Count(results, bulk.StatusSkipped),
+		Results:   make([]dto.BulkItemResult[T], len(results)),
+	}
+	for i, r := range results {
+		item := dto.BulkItemResult[T]{Index: i, Status: string(r.Status)}
+		switch r.Status {
+		case bulk.StatusSucceeded:
+			data := convert(r.Value)
+			item.Data = &data
+		case bulk.StatusFailed:
+			p := newProblem(r.Err, lang)
+			if p.Status == http.StatusInternalServerError {
+				slog.ErrorContext(c.Request.Context(), "bulk item failed", "method", c.Request.Method, "path", c.Request.URL.Path, "index", i, "error", r.Err)
+			}
+			item.Error = &p
+		}
+		resp.Results[i] = item
+	}
+	return resp
+}
@@ -1,31 +1,33 @@
 package handler
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
 	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	expandapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/expand"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
 )
 
 // ClientHandler handles HTTP requests for client operations
 type ClientHandler struct {
-	service *clientapp.Service
+	service  *clientapp.Service
+	expander *expandapp.Service
 }
 
 // NewClientHandler creates a new client handler
-func NewClientHandler(service *clientapp.Service) *ClientHandler {
-	return &ClientHandler{service: service}
+func NewClientHandler(service *clientapp.Service, expander *expandapp.Service) *ClientHandler {
+	return &ClientHandler{service: service, expander: expander}
 }
 
 // getLaboratoryID extracts laboratory_id from query parameter
 func (h *ClientHandler) getLaboratoryID(c *gin.Context) (string, error) {
 	laboratoryID := c.Query("laboratory_id")
 	if laboratoryID == "" {
-		return "", errors.New("laboratory_id query parameter is required")
+		return "", domainerrors.Validation(domainerrors.Required("laboratory_id"))
 	}
 	return laboratoryID, nil
 }
@@ -35,17 +37,13 @@ func (h *ClientHandler) Create(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	var req dto.CreateClientRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -59,39 +57,81 @@ func (h *ClientHandler) Create(c *gin.Context) {
 
 	client, err := h.service.CreateClient(c.Request.Context(), input)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, dto.ToClientResponse(client))
 }
 
+// Import handles POST /api/v1/clients/bulk/import
+func (h *ClientHandler) Import(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req dto.ImportClientsRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	items := make([]clientapp.CreateInput, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = clientapp.CreateInput{
+			Name:    item.Name,
+			Email:   item.Email,
+			Phone:   item.Phone,
+			Address: item.Address.ToClientAddress(),
+		}
+	}
+
+	results, err := h.service.ImportClients(c.Request.Context(), laboratoryID, items, req.Atomic)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, bulkResponse(c, results, dto.ToClientResponse))
+}
+
 // Get handles GET /api/v1/clients/:id
 func (h *ClientHandler) Get(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "client id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
-	client, err := h.service.GetClient(c.Request.Context(), id, laboratoryID)
+	fields, err := parseExpand(c, expandapp.ClientFields())
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToClientResponse(client))
+	cl, err := h.service.GetClient(c.Request.Context(), id, laboratoryID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	responses, err := h.responses(c, laboratoryID, []*client.Client{cl}, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses[0])
 }
 
 // Update handles PUT /api/v1/clients/:id
@@ -99,29 +139,71 @@ func (h *ClientHandler) Update(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "client id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	var req dto.UpdateClientRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	client, err := h.service.UpdateClient(c.Request.Context(), updateClientInput(id, laboratoryID, req))
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
-	input := clientapp.UpdateInput{
+	c.JSON(http.StatusOK, dto.ToClientResponse(client))
+}
+
+// Patch handles PATCH /api/v1/clients/:id with a JSON merge patch
+func (h *ClientHandler) Patch(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	client, err := h.service.PatchClient(c.Request.Context(), id, laboratoryID, func(current client.Client) (clientapp.UpdateInput, error) {
+		req := dto.ToUpdateClientRequest(&current)
+		if err := applyMergePatch(c, patch, &req); err != nil {
+			return clientapp.UpdateInput{}, err
+		}
+		return updateClientInput(id, laboratoryID, req), nil
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToClientResponse(client))
+}
+
+// updateClientInput returns the input replacing the client's fields with
+// those of the request
+func updateClientInput(id, laboratoryID string, req dto.UpdateClientRequest) clientapp.UpdateInput {
+	return clientapp.UpdateInput{
 		ID:           id,
 		LaboratoryID: laboratoryID,
 		Name:         req.Name,
@@ -129,10 +211,86 @@ func (h *ClientHandler) Update(c *gin.Context) {
 		Phone:        req.Phone,
 		Address:      req.Address.ToClientAddress(),
 	}
+}
+
+// LinkPortalUser handles PUT /api/v1/clients/:id/portal-user
+func (h *ClientHandler) LinkPortalUser(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	var req dto.LinkPortalUserRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	client, err := h.service.LinkPortalUser(c.Request.Context(), id, laboratoryID, req.UserID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToClientResponse(client))
+}
+
+// UnlinkPortalUser handles DELETE /api/v1/clients/:id/portal-user
+func (h *ClientHandler) UnlinkPortalUser(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
 
-	client, err := h.service.UpdateClient(c.Request.Context(), input)
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	client, err := h.service.UnlinkPortalUser(c.Request.Context(), id, laboratoryID)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToClientResponse(client))
+}
+
+// SetNotifications handles PUT /api/v1/clients/:id/notifications
+func (h *ClientHandler) SetNotifications(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	var req dto.ClientNotificationsRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	client, err := h.service.SetEmailNotifications(c.Request.Context(), id, laboratoryID, !*req.EmailOptOut)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -144,19 +302,52 @@ func (h *ClientHandler) List(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
+		return
+	}
+
+	q, err := parseListQuery(c, client.ListSpec)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
-	clients, err := h.service.ListClients(c.Request.Context(), laboratoryID)
+	fields, err := parseExpand(c, expandapp.ClientFields())
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToClientResponseList(clients))
+	page, err := h.service.ListClients(c.Request.Context(), laboratoryID, q)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	responses, err := h.responses(c, laboratoryID, page.Items, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewListResponse(page, responses))
+}
+
+// responses converts clients to response DTOs embedding the expanded fields
+func (h *ClientHandler) responses(c *gin.Context, laboratoryID string, clients []*client.Client, fields expandapp.Set) ([]dto.ClientResponse, error) {
+	responses := dto.ToClientResponseList(clients)
+	if len(fields) == 0 {
+		return responses, nil
+	}
+
+	related, err := h.expander.Clients(c.Request.Context(), laboratoryID, fields)
+	if err != nil {
+		return nil, err
+	}
+	for i := range responses {
+		embedClient(&responses[i], related)
+	}
+	return responses, nil
 }
 
 // Delete handles DELETE /api/v1/clients/:id
@@ -164,64 +355,21 @@ func (h *ClientHandler) Delete(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "client id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	err = h.service.DeleteClient(c.Request.Context(), id, laboratoryID)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
-
-// handleError converts domain errors to HTTP responses
-func (h *ClientHandler) handleError(c *gin.Context, err error) {
-	var validationErrors domainerrors.ValidationErrors
-	if errors.As(err, &validationErrors) {
-		details := make(map[string]string)
-		for _, ve := range validationErrors {
-			details[ve.Field] = ve.Message
-		}
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation failed",
-			Details: details,
-		})
-		return
-	}
-
-	switch {
-	case errors.Is(err, domainerrors.ErrNotFound):
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "client not found",
-		})
-	case errors.Is(err, domainerrors.ErrDuplicateEmail):
-		c.JSON(http.StatusConflict, dto.ErrorResponse{
-			Error: "email already exists",
-		})
-	case errors.Is(err, domainerrors.ErrUnauthorized):
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error: "unauthorized",
-		})
-	case errors.Is(err, domainerrors.ErrForbidden):
-		c.JSON(http.StatusForbidden, dto.ErrorResponse{
-			Error: "forbidden",
-		})
-	default:
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "internal server error",
-		})
-	}
-}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -12,8 +13,11 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
 	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	expandapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/expand"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
 )
@@ -33,15 +37,20 @@ func setupTestRouter() (*gin.Engine, *clientapp.Service, *memory.ClientRepositor
 	clientRepo := memory.NewClientRepository()
 	labRepo := memory.NewLaboratoryRepository()
 	idGen := &mockIDGenerator{id: "test-id-123"}
-	svc := clientapp.NewService(clientRepo, labRepo, idGen)
-	handler := NewClientHandler(svc)
+	svc := clientapp.NewService(clientRepo, labRepo, idGen, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor())
+	expander := expandapp.NewService(labRepo, clientRepo, memory.NewTechnicianRepository(), memory.NewProsthesisRepository())
+	handler := NewClientHandler(svc, expander)
 
 	r := gin.New()
+	r.Use(Problems())
 	r.POST("/clients", handler.Create)
+	r.POST("/clients/bulk/import", handler.Import)
 	r.GET("/clients/:id", handler.Get)
 	r.PUT("/clients/:id", handler.Update)
+	r.PATCH("/clients/:id", handler.Patch)
 	r.GET("/clients", handler.List)
 	r.DELETE("/clients/:id", handler.Delete)
+	r.PUT("/clients/:id/notifications", handler.SetNotifications)
 
 	return r, svc, clientRepo, labRepo
 }
@@ -181,6 +190,74 @@ func TestClientHandler_Create_InvalidBody(t *testing.T) {
 	}
 }
 
+func TestClientHandler_Import(t *testing.T) {
+	router, _, clientRepo, labRepo := setupTestRouter()
+	createTestLaboratory(labRepo, "lab-123")
+	createTestClient(clientRepo, "client-123", "lab-123")
+
+	address := dto.ClientAddressRequest{
+		Street:     "Test Street",
+		City:       "Test City",
+		State:      "SP",
+		PostalCode: "01234-567",
+		Country:    "Brazil",
+	}
+	reqBody := dto.ImportClientsRequest{
+		Items: []dto.CreateClientRequest{
+			{Name: "New Client", Email: "new@example.com", Phone: "+5511999999999", Address: address},
+			{Name: "Existing Client", Email: "test@example.com", Phone: "+5511999999999", Address: address},
+			{Email: "nameless@example.com", Phone: "+5511999999999", Address: address},
+		},
+	}
+
+	body, _ := json.Marshal(reqBody)
+	url := addLaboratoryIDQueryParam("/clients/bulk/import", "lab-123")
+	req := httptest.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "en")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Import() status = %d, want %d, body = %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp dto.BulkResponse[dto.ClientResponse]
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Succeeded != 1 || resp.Failed != 2 || resp.Skipped != 0 {
+		t.Errorf("Import() counts = %d/%d/%d, want 1/2/0", resp.Succeeded, resp.Failed, resp.Skipped)
+	}
+	if d := resp.Results[0].Data; d == nil || d.Email != "new@example.com" || d.LaboratoryID != "lab-123" {
+		t.Errorf("Import() results[0].Data = %+v, want the new client", d)
+	}
+	if e := resp.Results[1].Error; e == nil || e.Status != http.StatusConflict {
+		t.Errorf("Import() results[1].Error = %+v, want a %d problem", e, http.StatusConflict)
+	}
+	if e := resp.Results[2].Error; e == nil || len(e.Errors) == 0 || e.Errors[0].Field != "name" {
+		t.Errorf("Import() results[2].Error = %+v, want a name field error", e)
+	}
+}
+
+func TestClientHandler_Import_LaboratoryNotFound(t *testing.T) {
+	router, _, _, _ := setupTestRouter()
+
+	body := `{"items":[{"name":"New Client","email":"new@example.com","phone":"+5511999999999"}]}`
+	url := addLaboratoryIDQueryParam("/clients/bulk/import", "non-existent")
+	req := httptest.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Import() status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
 func TestClientHandler_Get_Success(t *testing.T) {
 	router, _, clientRepo, labRepo := setupTestRouter()
 	createTestLaboratory(labRepo, "lab-123")
@@ -206,6 +283,37 @@ func TestClientHandler_Get_Success(t *testing.T) {
 	}
 }
 
+func TestClientHandler_Get_ExpandLaboratory(t *testing.T) {
+	router, _, clientRepo, labRepo := setupTestRouter()
+	createTestLaboratory(labRepo, "lab-123")
+	createTestClient(clientRepo, "client-123", "lab-123")
+
+	req := httptest.NewRequest(http.MethodGet, addLaboratoryIDQueryParam("/clients/client-123?expand=laboratory", "lab-123"), nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Get() status = %d, want %d, body = %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp dto.ClientResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if resp.Laboratory == nil || resp.Laboratory.ID != "lab-123" {
+		t.Errorf("Get() Laboratory = %+v, want lab-123", resp.Laboratory)
+	}
+
+	// Orders are not expandable on clients
+	req = httptest.NewRequest(http.MethodGet, addLaboratoryIDQueryParam("/clients?expand=client", "lab-123"), nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
 func TestClientHandler_Get_NotFound(t *testing.T) {
 	router, _, _, labRepo := setupTestRouter()
 	createTestLaboratory(labRepo, "lab-123")
@@ -291,6 +399,165 @@ func TestClientHandler_Update_NotFound(t *testing.T) {
 	}
 }
 
+func TestClientHandler_Patch(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		patch      string
+		wantStatus int
+		wantPhone  string
+		wantCity   string
+		wantStreet string
+	}{
+		{
+			name:       "changes one field",
+			id:         "client-123",
+			patch:      `{"phone":"+5511777777777"}`,
+			wantStatus: http.StatusOK,
+			wantPhone:  "+5511777777777",
+			wantCity:   "Test City",
+			wantStreet: "Test Street",
+		},
+		{
+			name:       "changes one nested field",
+			id:         "client-123",
+			patch:      `{"address":{"city":"Campinas"}}`,
+			wantStatus: http.StatusOK,
+			wantPhone:  "+5511999999999",
+			wantCity:   "Campinas",
+			wantStreet: "Test Street",
+		},
+		{
+			name:       "empty patch keeps everything",
+			id:         "client-123",
+			patch:      `{}`,
+			wantStatus: http.StatusOK,
+			wantPhone:  "+5511999999999",
+			wantCity:   "Test City",
+			wantStreet: "Test Street",
+		},
+		{
+			name:       "removing a required field",
+			id:         "client-123",
+			patch:      `{"name":null}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid value",
+			id:         "client-123",
+			patch:      `{"email":"not-an-email"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "malformed patch",
+			id:         "client-123",
+			patch:      `{"phone":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown client",
+			id:         "non-existent",
+			patch:      `{"phone":"+5511777777777"}`,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, _, clientRepo, labRepo := setupTestRouter()
+			createTestLaboratory(labRepo, "lab-123")
+			createTestClient(clientRepo, "client-123", "lab-123")
+
+			url := addLaboratoryIDQueryParam("/clients/"+tt.id, "lab-123")
+			req := httptest.NewRequest(http.MethodPatch, url, strings.NewReader(tt.patch))
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Patch() status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp dto.ClientResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			if resp.Name != "Test Client" || resp.Email != "test@example.com" {
+				t.Errorf("Patch() changed untouched fields: name = %q, email = %q", resp.Name, resp.Email)
+			}
+			if resp.Phone != tt.wantPhone {
+				t.Errorf("Patch() Phone = %q, want %q", resp.Phone, tt.wantPhone)
+			}
+			if resp.Address.City != tt.wantCity || resp.Address.Street != tt.wantStreet {
+				t.Errorf("Patch() Address = %+v, want city %q and street %q", resp.Address, tt.wantCity, tt.wantStreet)
+			}
+		})
+	}
+}
+
+func TestClientHandler_Patch_RefusedBodies(t *testing.T) {
+	tests := []struct {
+		name            string
+		contentType     string
+		patch           string
+		wantStatus      int
+		wantAcceptPatch string
+	}{
+		{
+			name:            "plain JSON",
+			contentType:     "application/json",
+			patch:           `{"phone":"+5511777777777"}`,
+			wantStatus:      http.StatusUnsupportedMediaType,
+			wantAcceptPatch: "application/merge-patch+json",
+		},
+		{
+			name:            "no content type",
+			patch:           `{"phone":"+5511777777777"}`,
+			wantStatus:      http.StatusUnsupportedMediaType,
+			wantAcceptPatch: "application/merge-patch+json",
+		},
+		{
+			name:        "patch too large",
+			contentType: "application/merge-patch+json",
+			patch:       `{"phone":"` + strings.Repeat("a", maxMergePatchSize) + `"}`,
+			wantStatus:  http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, _, clientRepo, labRepo := setupTestRouter()
+			createTestLaboratory(labRepo, "lab-123")
+			createTestClient(clientRepo, "client-123", "lab-123")
+
+			url := addLaboratoryIDQueryParam("/clients/client-123", "lab-123")
+			req := httptest.NewRequest(http.MethodPatch, url, strings.NewReader(tt.patch))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Patch() status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if got := rec.Header().Get("Accept-Patch"); got != tt.wantAcceptPatch {
+				t.Errorf("Patch() Accept-Patch = %q, want %q", got, tt.wantAcceptPatch)
+			}
+
+			stored, _ := clientRepo.GetByID(context.Background(), "client-123")
+			if stored.Phone != "+5511999999999" {
+				t.Errorf("Patch() stored phone %q from a refused body", stored.Phone)
+			}
+		})
+	}
+}
+
 func TestClientHandler_List_Success(t *testing.T) {
 	router, _, clientRepo, labRepo := setupTestRouter()
 	createTestLaboratory(labRepo, "lab-123")
@@ -325,13 +592,13 @@ func TestClientHandler_List_Success(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.ClientResponse
+	var resp dto.ListResponse[dto.ClientResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 2 {
-		t.Errorf("List() got %d clients, want 2", len(resp))
+	if len(resp.Data) != 2 {
+		t.Errorf("List() got %d clients, want 2", len(resp.Data))
 	}
 }
 
@@ -349,13 +616,80 @@ func TestClientHandler_List_Empty(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.ClientResponse
+	var resp dto.ListResponse[dto.ClientResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 0 {
-		t.Errorf("List() got %d clients, want 0", len(resp))
+	if len(resp.Data) != 0 {
+		t.Errorf("List() got %d clients, want 0", len(resp.Data))
+	}
+}
+
+func TestClientHandler_List_Pagination(t *testing.T) {
+	router, _, clientRepo, labRepo := setupTestRouter()
+	createTestLaboratory(labRepo, "lab-123")
+	createTestClient(clientRepo, "client-1", "lab-123")
+	createTestClient(clientRepo, "client-2", "lab-123")
+	createTestClient(clientRepo, "client-3", "lab-123")
+
+	var seen []string
+	url := addLaboratoryIDQueryParam("/clients?limit=2&sort=name", "lab-123")
+	for url != "" {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("List() status = %d, want %d, body: %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+
+		var resp dto.ListResponse[dto.ClientResponse]
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if resp.Total != 3 {
+			t.Errorf("List() total = %d, want 3", resp.Total)
+		}
+		for _, c := range resp.Data {
+			seen = append(seen, c.ID)
+		}
+
+		url = ""
+		if resp.NextCursor != "" {
+			url = addLaboratoryIDQueryParam("/clients?limit=2&sort=name&cursor="+resp.NextCursor, "lab-123")
+		}
+	}
+
+	if strings.Join(seen, ",") != "client-1,client-2,client-3" {
+		t.Errorf("List() paged IDs = %v, want client-1, client-2, client-3", seen)
+	}
+}
+
+func TestClientHandler_List_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"non-numeric limit", "/clients?limit=ten"},
+		{"unknown sort field", "/clients?sort=phone"},
+		{"invalid order", "/clients?order=up"},
+		{"malformed cursor", "/clients?cursor=%25%25"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, _, _, labRepo := setupTestRouter()
+			createTestLaboratory(labRepo, "lab-123")
+
+			req := httptest.NewRequest(http.MethodGet, addLaboratoryIDQueryParam(tt.query, "lab-123"), nil)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("List() status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
 	}
 }
 
@@ -400,6 +734,47 @@ func TestClientHandler_Delete_NotFound(t *testing.T) {
 	}
 }
 
+func TestClientHandler_SetNotifications(t *testing.T) {
+	tests := []struct {
+		name            string
+		laboratoryID    string
+		body            string
+		wantStatus      int
+		wantEmailOptOut bool
+	}{
+		{"opt out", "lab-123", `{"email_opt_out": true}`, http.StatusOK, true},
+		{"opt back in", "lab-123", `{"email_opt_out": false}`, http.StatusOK, false},
+		{"missing email_opt_out", "lab-123", `{}`, http.StatusBadRequest, false},
+		{"other laboratory", "lab-456", `{"email_opt_out": true}`, http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, _, clientRepo, labRepo := setupTestRouter()
+			createTestLaboratory(labRepo, "lab-123")
+			createTestClient(clientRepo, "client-123", "lab-123")
+
+			url := addLaboratoryIDQueryParam("/clients/client-123/notifications", tt.laboratoryID)
+			req := httptest.NewRequest(http.MethodPut, url, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("SetNotifications() status = %d, want %d, body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp dto.ClientResponse
+			_ = json.Unmarshal(rec.Body.Bytes(), &resp)
+			if resp.EmailOptOut != tt.wantEmailOptOut {
+				t.Errorf("SetNotifications() email_opt_out = %v, want %v", resp.EmailOptOut, tt.wantEmailOptOut)
+			}
+		})
+	}
+}
+
 func TestClientHandler_LaboratoryScopedAccess(t *testing.T) {
 	router, _, clientRepo, labRepo := setupTestRouter()
 	createTestLaboratory(labRepo, "lab-123")
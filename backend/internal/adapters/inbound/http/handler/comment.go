@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	commentapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/comment"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// Comment WebSocket settings
+const (
+	commentWriteWait   = 10 * time.Second         // Time allowed to write a message
+	commentPongWait    = 60 * time.Second         // Time allowed between pongs of the peer
+	commentPingPeriod  = commentPongWait * 9 / 10 // Pings are sent before the pong wait expires
+	commentMaxReadSize = 1024                     // Largest message accepted from the peer
+)
+
+// Messages accepted from the peers of the comment WebSocket
+const (
+	CommentMessageTyping = "typing"
+	CommentMessageRead   = "read"
+)
+
+// CommentHandler handles HTTP and WebSocket requests for order comment
+// threads, for the laboratory staff and, under the portal, for the client
+// who placed the order
+type CommentHandler struct {
+	service  *commentapp.Service
+	hubs     *commentapp.Hubs
+	upgrader websocket.Upgrader
+}
+
+// threadOpener opens the thread of the order of a request for its participant
+type threadOpener func(c *gin.Context) (commentapp.Thread, error)
+
+// NewCommentHandler creates a new comment handler. WebSocket handshakes are
+// accepted from the API's own origin and from allowedOrigins.
+func NewCommentHandler(service *commentapp.Service, hubs *commentapp.Hubs, allowedOrigins []string) *CommentHandler {
+	return &CommentHandler{
+		service: service,
+		hubs:    hubs,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: checkOrigin(allowedOrigins),
+			// Completes the handshake of browsers sending their token as subprotocol
+			Subprotocols: []string{auth.WebSocketBearerProtocol},
+		},
+	}
+}
+
+// List handles GET /api/v1/orders/:id/comments
+func (h *CommentHandler) List(c *gin.Context) { h.list(c, h.staffThread) }
+
+// Create handles POST /api/v1/orders/:id/comments
+func (h *CommentHandler) Create(c *gin.Context) { h.create(c, h.staffThread) }
+
+// Delete handles DELETE /api/v1/orders/:id/comments/:comment_id
+func (h *CommentHandler) Delete(c *gin.Context) { h.delete(c, h.staffThread) }
+
+// Connect handles GET /api/v1/orders/:id/comments/ws
+func (h *CommentHandler) Connect(c *gin.Context) { h.connect(c, h.staffThread) }
+
+// PortalList handles GET /api/v1/portal/orders/:id/comments
+func (h *CommentHandler) PortalList(c *gin.Context) { h.list(c, h.clientThread) }
+
+// PortalCreate handles POST /api/v1/portal/orders/:id/comments
+func (h *CommentHandler) PortalCreate(c *gin.Context) { h.create(c, h.clientThread) }
+
+// PortalDelete handles DELETE /api/v1/portal/orders/:id/comments/:comment_id
+func (h *CommentHandler) PortalDelete(c *gin.Context) { h.delete(c, h.clientThread) }
+
+// PortalConnect handles GET /api/v1/portal/orders/:id/comments/ws
+func (h *CommentHandler) PortalConnect(c *gin.Context) { h.connect(c, h.clientThread) }
+
+// staffThread opens the thread for the staff user, in the laboratory of the
+// laboratory_id query parameter
+func (h *CommentHandler) staffThread(c *gin.Context) (commentapp.Thread, error) {
+	laboratoryID := c.Query("laboratory_id")
+	if laboratoryID == "" {
+		return commentapp.Thread{}, domainerrors.Validation(domainerrors.Required("laboratory_id"))
+	}
+	return h.service.StaffThread(c.Request.Context(), laboratoryID, c.Param("id"), auth.GetUserID(c.Request.Context()))
+}
+
+// clientThread opens the thread for the client bound to the portal identity
+func (h *CommentHandler) clientThread(c *gin.Context) (commentapp.Thread, error) {
+	t, err := h.service.ClientThread(c.Request.Context(), auth.GetUserID(c.Request.Context()), c.Param("id"))
+	return t, portalError(err)
+}
+
+// list replies with the comments of the thread
+func (h *CommentHandler) list(c *gin.Context, open threadOpener) {
+	t, err := open(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	comments, err := h.service.ListComments(c.Request.Context(), t)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCommentResponseList(comments))
+}
+
+// create adds a comment of the participant to the thread
+func (h *CommentHandler) create(c *gin.Context, open threadOpener) {
+	t, err := open(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req dto.CreateCommentRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	comment, err := h.service.CreateComment(c.Request.Context(), t, req.Body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToCommentResponse(comment))
+}
+
+// delete removes a comment of the participant from the thread
+func (h *CommentHandler) delete(c *gin.Context, open threadOpener) {
+	t, err := open(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := h.service.DeleteComment(c.Request.Context(), t, c.Param("comment_id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// connect upgrades the request to a WebSocket relaying the signals of the
+// thread to the participant, and its typing and read messages to the others
+func (h *CommentHandler) connect(c *gin.Context, open threadOpener) {
+	t, err := open(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// Joined before the handshake completes, so that a peer loading the
+	// thread once connected misses no comment
+	conn := h.hubs.Join(t)
+	ws, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		conn.Leave()
+		return // The upgrader already replied with an HTTP error
+	}
+
+	go writeSignals(ws, conn)
+	h.readMessages(c.Request.Context(), ws, conn)
+}
+
+// readMessages relays the messages of the peer until it disconnects. Unknown
+// or malformed messages are ignored, as are read receipts of comments not in
+// the thread.
+func (h *CommentHandler) readMessages(ctx context.Context, ws *websocket.Conn, conn *commentapp.Conn) {
+	defer conn.Leave() // Ends writeSignals
+
+	ws.SetReadLimit(commentMaxReadSize)
+	_ = ws.SetReadDeadline(time.Now().Add(commentPongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(commentPongWait))
+	})
+
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var msg dto.CommentClientMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+		switch msg.Type {
+		case CommentMessageTyping:
+			conn.Typing()
+		case CommentMessageRead:
+			if msg.CommentID == "" {
+				continue
+			}
+			if comment, err := h.service.GetComment(ctx, conn.Thread, msg.CommentID); err == nil {
+				conn.Read(comment.ID)
+			}
+		}
+	}
+}
+
+// writeSignals sends the signals of the thread and keeps the connection alive
+// with pings. When the hub drops the participant for falling behind, the
+// connection is closed with "try again later", and with "going away" when the
+// hubs close on shutdown, so that the peer reconnects and reloads the thread.
+func writeSignals(ws *websocket.Conn, conn *commentapp.Conn) {
+	ping := time.NewTicker(commentPingPeriod)
+	defer func() {
+		ping.Stop()
+		ws.Close()
+	}()
+
+	for {
+		select {
+		case s, ok := <-conn.C():
+			_ = ws.SetWriteDeadline(time.Now().Add(commentWriteWait))
+			if !ok {
+				closing := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+				switch {
+				case conn.Dropped():
+					closing = websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow")
+				case conn.GoingAway():
+					closing = websocket.FormatCloseMessage(websocket.CloseGoingAway, "shutting down")
+				}
+				_ = ws.WriteMessage(websocket.CloseMessage, closing)
+				return
+			}
+			if err := ws.WriteJSON(dto.ToCommentSignalMessage(s)); err != nil {
+				return
+			}
+		case <-ping.C:
+			_ = ws.SetWriteDeadline(time.Now().Add(commentWriteWait))
+			if err := ws.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// checkOrigin accepts WebSocket handshakes without an Origin header, from the
+// API's own host, or from one of the allowed origins
+func checkOrigin(allowed []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true // Not a browser
+		}
+		for _, o := range allowed {
+			if o == origin {
+				return true
+			}
+		}
+		u, err := url.Parse(origin)
+		return err == nil && u.Host == r.Host
+	}
+}
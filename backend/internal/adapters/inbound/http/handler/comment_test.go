@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	commentapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// testUserHeader carries the user of a test request, standing in for the
+// authentication middleware
+const testUserHeader = "X-Test-User"
+
+func withTestUserHeader() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if userID := c.GetHeader(testUserHeader); userID != "" {
+			c.Request = c.Request.WithContext(auth.WithUserID(c.Request.Context(), userID))
+		}
+		c.Next()
+	}
+}
+
+func setupCommentTestServer(t *testing.T) (*httptest.Server, *commentapp.Hubs) {
+	gin.SetMode(gin.TestMode)
+
+	orderRepo := memory.NewOrderRepository()
+	clientRepo := memory.NewClientRepository()
+	createTestClientForOrder(clientRepo, "client-123", "lab-123")
+	linked, _ := clientRepo.GetByID(context.Background(), "client-123")
+	linked.PortalUserID = "user_dentist"
+	_ = clientRepo.Update(context.Background(), linked)
+	createTestOrder(orderRepo, "order-123", "client-123", "lab-123")
+
+	// Comments reach the hubs through the bus, as in production
+	bus := eventbus.NewSyncBus()
+	hubs := commentapp.NewHubs(commentapp.DefaultHubConfig())
+	bus.SubscribeAll("comments", hubs.Handle)
+	svc := commentapp.NewService(memory.NewCommentRepository(), orderRepo, clientRepo, &sequenceIDGenerator{}, auditapp.NopRecorder{}, bus, memory.NewTransactor())
+	handler := NewCommentHandler(svc, hubs, []string{"https://app.example.com"})
+
+	r := gin.New()
+	r.Use(Problems())
+	r.Use(withTestUserHeader())
+	r.GET("/orders/:id/comments", handler.List)
+	r.POST("/orders/:id/comments", handler.Create)
+	r.DELETE("/orders/:id/comments/:comment_id", handler.Delete)
+	r.GET("/orders/:id/comments/ws", handler.Connect)
+	r.GET("/portal/orders/:id/comments", handler.PortalList)
+	r.POST("/portal/orders/:id/comments", handler.PortalCreate)
+	r.DELETE("/portal/orders/:id/comments/:comment_id", handler.PortalDelete)
+	r.GET("/portal/orders/:id/comments/ws", handler.PortalConnect)
+
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	return server, hubs
+}
+
+func doCommentRequest(t *testing.T, method, url, userID, body string) (*http.Response, []byte) {
+	req, _ := http.NewRequest(method, url, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(testUserHeader, userID)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s error = %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	var buf bytes.Buffer
+	_, _ = buf.ReadFrom(resp.Body)
+	return resp, buf.Bytes()
+}
+
+func dialComments(t *testing.T, server *httptest.Server, path, userID string) *websocket.Conn {
+	header := http.Header{}
+	header.Set(testUserHeader, userID)
+	ws, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+path, header)
+	if err != nil {
+		t.Fatalf("Dial(%s) error = %v, response: %v", path, err, resp)
+	}
+	t.Cleanup(func() { ws.Close() })
+	return ws
+}
+
+func readSignal(t *testing.T, ws *websocket.Conn) dto.CommentSignalMessage {
+	_ = ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var msg dto.CommentSignalMessage
+	if err := ws.ReadJSON(&msg); err != nil {
+		t.Fatalf("ReadJSON() error = %v", err)
+	}
+	return msg
+}
+
+func TestCommentHandler_Threads(t *testing.T) {
+	server, _ := setupCommentTestServer(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		userID     string
+		body       string
+		wantStatus int
+	}{
+		{"staff comment", http.MethodPost, "/orders/order-123/comments?laboratory_id=lab-123", "user_staff", `{"body": "Shade A2 or A3?"}`, http.StatusCreated},
+		{"client comment", http.MethodPost, "/portal/orders/order-123/comments", "user_dentist", `{"body": "A2"}`, http.StatusCreated},
+		{"missing body", http.MethodPost, "/orders/order-123/comments?laboratory_id=lab-123", "user_staff", `{}`, http.StatusBadRequest},
+		{"blank body", http.MethodPost, "/portal/orders/order-123/comments", "user_dentist", `{"body": "   "}`, http.StatusBadRequest},
+		{"missing laboratory_id", http.MethodGet, "/orders/order-123/comments", "user_staff", "", http.StatusBadRequest},
+		{"order of another laboratory", http.MethodGet, "/orders/order-123/comments?laboratory_id=lab-456", "user_staff", "", http.StatusNotFound},
+		{"identity without client", http.MethodGet, "/portal/orders/order-123/comments", "user_stranger", "", http.StatusForbidden},
+		{"missing identity", http.MethodGet, "/portal/orders/order-123/comments", "", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := doCommentRequest(t, tt.method, server.URL+tt.path, tt.userID, tt.body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %v, want %v, body: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+		})
+	}
+
+	_, body := doCommentRequest(t, http.MethodGet, server.URL+"/portal/orders/order-123/comments", "user_dentist", "")
+	var list []dto.CommentResponse
+	if err := json.Unmarshal(body, &list); err != nil || len(list) != 2 {
+		t.Fatalf("PortalList() body = %s, want both comments", body)
+	}
+	if list[0].Author.Kind != "staff" || list[1].Author.Kind != "client" || list[1].Author.ID != "client-123" {
+		t.Errorf("PortalList() authors = %+v, %+v, want the staff user then the client", list[0].Author, list[1].Author)
+	}
+
+	resp, _ := doCommentRequest(t, http.MethodDelete, server.URL+"/portal/orders/order-123/comments/"+list[0].ID, "user_dentist", "")
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("PortalDelete() of a staff comment status = %v, want %v", resp.StatusCode, http.StatusForbidden)
+	}
+	resp, _ = doCommentRequest(t, http.MethodDelete, server.URL+"/portal/orders/order-123/comments/"+list[1].ID, "user_dentist", "")
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("PortalDelete() status = %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestCommentHandler_WebSocket(t *testing.T) {
+	server, _ := setupCommentTestServer(t)
+
+	staff := dialComments(t, server, "/orders/order-123/comments/ws?laboratory_id=lab-123", "user_staff")
+	dentist := dialComments(t, server, "/portal/orders/order-123/comments/ws", "user_dentist")
+
+	// Typing is relayed to the other participants only
+	_ = dentist.WriteJSON(dto.CommentClientMessage{Type: CommentMessageTyping})
+	if got := readSignal(t, staff); got.Type != "typing" || got.OrderID != "order-123" || got.Author.ID != "client-123" || got.Author.Kind != "client" {
+		t.Fatalf("signal = %+v, want the client typing", got)
+	}
+
+	// Comments created over HTTP reach every participant, the author included
+	resp, body := doCommentRequest(t, http.MethodPost, server.URL+"/orders/order-123/comments?laboratory_id=lab-123", "user_staff", `{"body": "Ready on Friday"}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Create() status = %v, body: %s", resp.StatusCode, body)
+	}
+	var created dto.CommentResponse
+	_ = json.Unmarshal(body, &created)
+	for name, ws := range map[string]*websocket.Conn{"staff": staff, "dentist": dentist} {
+		if got := readSignal(t, ws); got.Type != "comment.created" || got.Comment == nil || got.Comment.Body != "Ready on Friday" {
+			t.Errorf("signal of the %s = %+v, want the new comment", name, got)
+		}
+	}
+
+	_ = dentist.WriteMessage(websocket.TextMessage, []byte("not json"))
+	_ = dentist.WriteJSON(dto.CommentClientMessage{Type: CommentMessageRead, CommentID: "<script>alert(1)</script>"})
+	_ = dentist.WriteJSON(dto.CommentClientMessage{Type: CommentMessageRead, CommentID: created.ID})
+	if got := readSignal(t, staff); got.Type != "read" || got.CommentID != created.ID {
+		t.Errorf("signal = %+v, want the client's read receipt after the malformed message and the unknown comment were ignored", got)
+	}
+}
+
+func TestCommentHandler_WebSocketClosesOnShutdown(t *testing.T) {
+	server, hubs := setupCommentTestServer(t)
+	staff := dialComments(t, server, "/orders/order-123/comments/ws?laboratory_id=lab-123", "user_staff")
+
+	hubs.Close()
+
+	_ = staff.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, _, err := staff.ReadMessage()
+	if !websocket.IsCloseError(err, websocket.CloseGoingAway) {
+		t.Errorf("ReadMessage() error = %v, want a going away close", err)
+	}
+}
+
+func TestCommentHandler_WebSocketHandshake(t *testing.T) {
+	server, _ := setupCommentTestServer(t)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/portal/orders/order-123/comments/ws"
+
+	tests := []struct {
+		name       string
+		origin     string
+		userID     string
+		wantStatus int
+	}{
+		{"allowed origin", "https://app.example.com", "user_dentist", http.StatusSwitchingProtocols},
+		{"foreign origin", "https://evil.example.com", "user_dentist", http.StatusForbidden},
+		{"identity without client", "", "user_stranger", http.StatusForbidden},
+		{"missing identity", "", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(testUserHeader, tt.userID)
+			if tt.origin != "" {
+				header.Set("Origin", tt.origin)
+			}
+			dialer := websocket.Dialer{Subprotocols: []string{auth.WebSocketBearerProtocol, "token"}}
+			ws, resp, err := dialer.Dial(url, header)
+			if resp == nil {
+				t.Fatalf("Dial() error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("handshake status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if ws != nil {
+				defer ws.Close()
+				if ws.Subprotocol() != auth.WebSocketBearerProtocol {
+					t.Errorf("Subprotocol() = %q, want %q", ws.Subprotocol(), auth.WebSocketBearerProtocol)
+				}
+			}
+		})
+	}
+}
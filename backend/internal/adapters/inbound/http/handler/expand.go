@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	expandapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/expand"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// parseExpand extracts the comma-separated expand query parameter, e.g.
+// expand=client,technician
+func parseExpand(c *gin.Context, allowed []expandapp.Field) (expandapp.Set, error) {
+	return expandapp.Parse(c.Query("expand"), allowed)
+}
+
+// embedOrder adds the related resources found to an order response
+func embedOrder(resp *dto.OrderResponse, o *order.Order, related expandapp.Related) {
+	if related.Laboratory != nil {
+		lab := dto.ToLaboratoryResponse(related.Laboratory)
+		resp.Laboratory = &lab
+	}
+	if c, ok := related.Clients[o.ClientID]; ok {
+		client := dto.ToClientResponse(c)
+		resp.Client = &client
+	}
+	if t, ok := related.Technicians[o.TechnicianID]; ok {
+		tech := dto.ToTechnicianResponse(t)
+		resp.Technician = &tech
+	}
+	for i, item := range o.Prosthesis {
+		if p, ok := related.CatalogItems[item.CatalogItemID]; ok {
+			catalogItem := dto.ToProsthesisResponse(p)
+			resp.Prosthesis[i].CatalogItem = &catalogItem
+		}
+	}
+}
+
+// embedClient adds the related resources found to a client response
+func embedClient(resp *dto.ClientResponse, related expandapp.Related) {
+	if related.Laboratory != nil {
+		lab := dto.ToLaboratoryResponse(related.Laboratory)
+		resp.Laboratory = &lab
+	}
+}
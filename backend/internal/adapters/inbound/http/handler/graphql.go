@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/graphql"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// GraphQLHandler handles GraphQL requests
+type GraphQLHandler struct {
+	api *graphql.API
+}
+
+// NewGraphQLHandler creates a new GraphQL handler
+func NewGraphQLHandler(api *graphql.API) *GraphQLHandler {
+	return &GraphQLHandler{api: api}
+}
+
+// Query handles POST /graphql. The request is scoped to the laboratory named
+// by the laboratory_id query parameter, as staff identities aren't bound to
+// one laboratory; portal identities never get here. Errors raised by fields
+// are answered with 200 like any GraphQL error, with their localised problem
+// details in the extensions.
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	laboratoryID := c.Query("laboratory_id")
+	if laboratoryID == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("laboratory_id")))
+		return
+	}
+
+	var req dto.GraphQLRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	result := h.api.Exec(c.Request.Context(), laboratoryID, req.Query, req.OperationName, req.Variables)
+
+	resp := dto.GraphQLResponse{Data: result.Data}
+	lang := requestLanguage(c)
+	for _, qe := range result.Errors {
+		e := dto.GraphQLError{Message: qe.Message, Path: qe.Path}
+		for _, loc := range qe.Locations {
+			e.Locations = append(e.Locations, dto.GraphQLLocation{Line: loc.Line, Column: loc.Column})
+		}
+		if qe.ResolverError != nil {
+			p := newProblem(qe.ResolverError, lang)
+			if p.Status == http.StatusInternalServerError {
+				slog.ErrorContext(c.Request.Context(), "graphql resolver failed", "path", qe.Path, "error", qe.ResolverError)
+			}
+			e.Message = p.Detail
+			e.Extensions = &p
+		}
+		resp.Errors = append(resp.Errors, e)
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Schema handles GET /graphql/schema
+func (h *GraphQLHandler) Schema(c *gin.Context) {
+	c.String(http.StatusOK, h.api.Schema())
+}
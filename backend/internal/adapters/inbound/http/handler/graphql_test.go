@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/graphql"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	clientapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/client"
+	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
+	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+)
+
+func setupGraphQLTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	labRepo := memory.NewLaboratoryRepository()
+	clientRepo := memory.NewClientRepository()
+	orderRepo := memory.NewOrderRepository()
+	techRepo := memory.NewTechnicianRepository()
+	catalog := memory.NewProsthesisRepository()
+	_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"})
+
+	idGen := &sequenceIDGenerator{}
+	auditor := auditapp.NopRecorder{}
+	handler := NewGraphQLHandler(graphql.New(graphql.Services{
+		Laboratories: labapp.NewService(labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Clients:      clientapp.NewService(clientRepo, labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Orders:       orderapp.NewService(orderRepo, clientRepo, techRepo, catalog, memory.NewAttachmentStorage(), idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Prostheses:   prosthesisapp.NewService(catalog, labRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+		Technicians:  techapp.NewService(techRepo, labRepo, orderRepo, idGen, auditor, eventbus.NewSyncBus(), memory.NewTransactor()),
+	}))
+
+	r := gin.New()
+	r.Use(Problems())
+	r.POST("/graphql", handler.Query)
+	r.GET("/graphql/schema", handler.Schema)
+
+	return r
+}
+
+func TestGraphQLHandler_Query(t *testing.T) {
+	router := setupGraphQLTestRouter()
+
+	tests := []struct {
+		name       string
+		url        string
+		body       string
+		wantStatus int
+		wantData   string
+		wantCode   string
+	}{
+		{
+			name:       "query",
+			url:        "/graphql?laboratory_id=lab-123",
+			body:       `{"query": "{ laboratory { name } }"}`,
+			wantStatus: http.StatusOK,
+			wantData:   `{"laboratory":{"name":"Test Lab"}}`,
+		},
+		{
+			name:       "field error with problem details",
+			url:        "/graphql?laboratory_id=lab-123",
+			body:       `{"query": "mutation($id: ID!) { updateOrderStatus(id: $id, status: READY) { id } }", "variables": {"id": "missing"}}`,
+			wantStatus: http.StatusOK,
+			wantData:   `null`,
+			wantCode:   "not_found",
+		},
+		{
+			name:       "invalid query",
+			url:        "/graphql?laboratory_id=lab-123",
+			body:       `{"query": "{ patients { id } }"}`,
+			wantStatus: http.StatusOK,
+			wantData:   `null`,
+		},
+		{
+			name:       "missing laboratory_id",
+			url:        "/graphql",
+			body:       `{"query": "{ laboratory { name } }"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing query",
+			url:        "/graphql?laboratory_id=lab-123",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Query() status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp struct {
+				Data   json.RawMessage    `json:"data"`
+				Errors []dto.GraphQLError `json:"errors"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			if string(resp.Data) != tt.wantData {
+				t.Errorf("Query() data = %s, want %s", resp.Data, tt.wantData)
+			}
+			if tt.wantCode != "" {
+				if len(resp.Errors) != 1 || resp.Errors[0].Extensions == nil || resp.Errors[0].Extensions.Code != tt.wantCode {
+					t.Errorf("Query() errors = %+v, want one %s problem", resp.Errors, tt.wantCode)
+				}
+			} else if tt.wantData == "null" && len(resp.Errors) == 0 {
+				t.Errorf("Query() errors = none, want the query error")
+			}
+		})
+	}
+}
+
+func TestGraphQLHandler_Schema(t *testing.T) {
+	router := setupGraphQLTestRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/graphql/schema", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "type Order {") {
+		t.Errorf("Schema() status = %d, body = %.80s, want the schema", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+)
+
+// HealthHandler handles the liveness and readiness probes
+type HealthHandler struct {
+	registry *health.Registry
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(registry *health.Registry) *HealthHandler {
+	return &HealthHandler{registry: registry}
+}
+
+// Live handles GET /livez, answering as long as the process serves requests
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.HealthResponse{Status: health.StatusUp})
+}
+
+// Ready handles GET /readyz, answering 503 unless every readiness check
+// passes and the server isn't shutting down
+func (h *HealthHandler) Ready(c *gin.Context) {
+	report := h.registry.Check(c.Request.Context())
+
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, dto.ToHealthResponse(report))
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+)
+
+func setupHealthTestRouter(registry *health.Registry) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewHealthHandler(registry)
+	r := gin.New()
+	r.GET("/livez", handler.Live)
+	r.GET("/readyz", handler.Ready)
+	return r
+}
+
+func TestHealthHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		jwks       error
+		drain      bool
+		wantStatus int
+		want       dto.HealthResponse
+	}{
+		{
+			name:       "live",
+			path:       "/livez",
+			jwks:       stderrors.New("connection refused"),
+			wantStatus: http.StatusOK,
+			want:       dto.HealthResponse{Status: health.StatusUp},
+		},
+		{
+			name:       "ready",
+			path:       "/readyz",
+			wantStatus: http.StatusOK,
+			want: dto.HealthResponse{Status: health.StatusUp, Checks: map[string]dto.HealthCheckResponse{
+				"jwks":         {Status: health.StatusUp},
+				"repositories": {Status: health.StatusUp},
+			}},
+		},
+		{
+			name:       "failed check",
+			path:       "/readyz",
+			jwks:       stderrors.New("connection refused"),
+			wantStatus: http.StatusServiceUnavailable,
+			want: dto.HealthResponse{Status: health.StatusDown, Checks: map[string]dto.HealthCheckResponse{
+				"jwks":         {Status: health.StatusDown, Error: "connection refused"},
+				"repositories": {Status: health.StatusUp},
+			}},
+		},
+		{
+			name:       "shutting down",
+			path:       "/readyz",
+			drain:      true,
+			wantStatus: http.StatusServiceUnavailable,
+			want:       dto.HealthResponse{Status: health.StatusDraining},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := health.NewRegistry(time.Second)
+			registry.Register("repositories", func(ctx context.Context) error { return nil })
+			registry.Register("jwks", func(ctx context.Context) error { return tt.jwks })
+			if tt.drain {
+				registry.Drain()
+			}
+
+			w := httptest.NewRecorder()
+			setupHealthTestRouter(registry).ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			var got dto.HealthResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("invalid body %s: %v", w.Body.String(), err)
+			}
+			if got.Status != tt.want.Status || len(got.Checks) != len(tt.want.Checks) {
+				t.Fatalf("body = %+v, want %+v", got, tt.want)
+			}
+			for name, check := range tt.want.Checks {
+				if got.Checks[name] != check {
+					t.Errorf("check %s = %+v, want %+v", name, got.Checks[name], check)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/idempotency"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key of a POST request
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayedHeader marks responses replayed from an earlier request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotentBodySize bounds the bodies read to fingerprint a request:
+	// an attachment upload with room for its multipart envelope
+	maxIdempotentBodySize = order.MaxAttachmentSize + 1<<20
+)
+
+// Idempotency makes POST requests safe to retry
+type Idempotency struct {
+	store  outbound.IdempotencyStore
+	window time.Duration
+}
+
+// NewIdempotency creates a new idempotency guard keeping responses for window
+func NewIdempotency(store outbound.IdempotencyStore, window time.Duration) *Idempotency {
+	return &Idempotency{
+		store:  store,
+		window: window,
+	}
+}
+
+// Middleware handles POST requests carrying an Idempotency-Key. The first
+// request with a key is processed and its response stored; retries with the
+// same key and body get the stored response, retries with a different body
+// are rejected with 422, and retries while the first request is still being
+// processed with 409. Keys are scoped to the laboratory, or to the caller for
+// routes without one. Failed requests, panics included, are not stored, so
+// they can be retried. Bodies above maxIdempotentBodySize are rejected with
+// 413. It must run after authentication so the caller's identity is known.
+func (i *Idempotency) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > idempotency.MaxKeyLength {
+			_ = c.Error(domainerrors.Validation(domainerrors.TooLong(IdempotencyKeyHeader, idempotency.MaxKeyLength)))
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxIdempotentBodySize))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			_ = c.Error(errRequestTooLarge)
+			c.Abort()
+			return
+		}
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now().UTC()
+		rec := &idempotency.Record{
+			Scope:       idempotencyScope(c),
+			Key:         key,
+			Fingerprint: fingerprint(c.Request, body),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(i.window),
+		}
+
+		existing, err := i.store.Reserve(c.Request.Context(), rec)
+		if err != nil {
+			// Fail open: an unavailable store must not take the API down
+			slog.ErrorContext(c.Request.Context(), "idempotency: failed to reserve the key", "key", key, "error", err)
+			c.Next()
+			return
+		}
+		if existing != nil {
+			replay(c, existing, rec.Fingerprint)
+			return
+		}
+
+		// A panicking handler must not leave the key in progress forever
+		defer func() {
+			if p := recover(); p != nil {
+				i.release(c, rec)
+				panic(p)
+			}
+		}()
+
+		w := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+
+		if !w.Written() || w.Status() >= http.StatusInternalServerError || len(c.Errors) > 0 {
+			i.release(c, rec)
+			return
+		}
+
+		rec.Completed = true
+		rec.StatusCode = w.Status()
+		rec.ContentType = w.Header().Get("Content-Type")
+		rec.Body = w.body.Bytes()
+		if err := i.store.Complete(c.Request.Context(), rec); err != nil {
+			slog.ErrorContext(c.Request.Context(), "idempotency: failed to store the response", "key", key, "error", err)
+		}
+	}
+}
+
+// release frees the key of a failed request so that it can be retried
+func (i *Idempotency) release(c *gin.Context, rec *idempotency.Record) {
+	if err := i.store.Release(c.Request.Context(), rec.Scope, rec.Key); err != nil {
+		slog.ErrorContext(c.Request.Context(), "idempotency: failed to release the key", "key", rec.Key, "error", err)
+	}
+}
+
+// replay answers a retry from the record of the first request
+func replay(c *gin.Context, existing *idempotency.Record, fingerprint string) {
+	switch {
+	case !existing.Matches(fingerprint):
+		_ = c.Error(domainerrors.ErrIdempotencyKeyReused)
+		c.Abort()
+	case !existing.Completed:
+		_ = c.Error(domainerrors.ErrIdempotencyRequestInProgress)
+		c.Abort()
+	default:
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+		c.Abort()
+	}
+}
+
+// idempotencyScope returns the tenant of a key: the laboratory, else the caller
+func idempotencyScope(c *gin.Context) string {
+	if laboratoryID := c.Query("laboratory_id"); laboratoryID != "" {
+		return "lab:" + laboratoryID
+	}
+	if userID := auth.GetUserID(c.Request.Context()); userID != "" {
+		return "user:" + userID
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// fingerprint hashes what makes two requests the same: method, URL and body
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordingWriter keeps a copy of the response body
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
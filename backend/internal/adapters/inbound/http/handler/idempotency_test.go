@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/idempotency"
+)
+
+// setupIdempotencyTestRouter returns a router whose POST /items counts its
+// calls and echoes the count, whose POST /fail always fails and whose
+// POST /panic panics on its first call
+func setupIdempotencyTestRouter(store *memory.IdempotencyStore) (*gin.Engine, *int) {
+	gin.SetMode(gin.TestMode)
+
+	calls := 0
+	r := gin.New()
+	r.Use(gin.CustomRecovery(func(c *gin.Context, err any) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
+	r.Use(Problems())
+	r.Use(NewIdempotency(store, time.Hour).Middleware())
+	r.POST("/items", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+	r.POST("/fail", func(c *gin.Context) {
+		calls++
+		_ = c.Error(domainerrors.ErrInvalidInput)
+	})
+	r.POST("/panic", func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			panic("boom")
+		}
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+	r.GET("/items", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusOK, gin.H{"call": calls})
+	})
+	return r, &calls
+}
+
+func doIdempotent(router *gin.Engine, method, target, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency_ReplaysResponse(t *testing.T) {
+	router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+	first := doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", "key-1", `{"name":"a"}`)
+	second := doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", "key-1", `{"name":"a"}`)
+
+	if *calls != 1 {
+		t.Errorf("handler calls = %d, want 1", *calls)
+	}
+	if second.Code != http.StatusCreated {
+		t.Errorf("replayed status = %d, want %d", second.Code, http.StatusCreated)
+	}
+	if second.Body.String() != first.Body.String() {
+		t.Errorf("replayed body = %s, want %s", second.Body.String(), first.Body.String())
+	}
+	if got := second.Header().Get(IdempotentReplayedHeader); got != "true" {
+		t.Errorf("%s = %q, want %q", IdempotentReplayedHeader, got, "true")
+	}
+	if got := first.Header().Get(IdempotentReplayedHeader); got != "" {
+		t.Errorf("first response %s = %q, want empty", IdempotentReplayedHeader, got)
+	}
+}
+
+func TestIdempotency_ScopesKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		key       string
+		wantCalls int
+	}{
+		{"same key in another laboratory", "/items?laboratory_id=lab-2", "key-1", 2},
+		{"another key", "/items?laboratory_id=lab-1", "key-2", 2},
+		{"no key", "/items?laboratory_id=lab-1", "", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+			doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", "key-1", `{}`)
+			doIdempotent(router, http.MethodPost, tt.target, tt.key, `{}`)
+
+			if *calls != tt.wantCalls {
+				t.Errorf("handler calls = %d, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIdempotency_RejectsReuseWithDifferentRequest(t *testing.T) {
+	router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+	doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", "key-1", `{"name":"a"}`)
+	rec := doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", "key-1", `{"name":"b"}`)
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+	if *calls != 1 {
+		t.Errorf("handler calls = %d, want 1", *calls)
+	}
+
+	var problem dto.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Failed to unmarshal problem: %v", err)
+	}
+	if problem.Code != "idempotency_key_reused" {
+		t.Errorf("code = %q, want %q", problem.Code, "idempotency_key_reused")
+	}
+}
+
+func TestIdempotency_RejectsRequestInProgress(t *testing.T) {
+	store := memory.NewIdempotencyStore()
+	router, calls := setupIdempotencyTestRouter(store)
+
+	// Reserve the key as a concurrent first request would
+	req := httptest.NewRequest(http.MethodPost, "/items?laboratory_id=lab-1", nil)
+	now := time.Now().UTC()
+	_, err := store.Reserve(context.Background(), &idempotency.Record{
+		Scope:       "lab:lab-1",
+		Key:         "key-1",
+		Fingerprint: fingerprint(req, []byte(`{}`)),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Reserve() error = %v", err)
+	}
+
+	rec := doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", "key-1", `{}`)
+
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	if *calls != 0 {
+		t.Errorf("handler calls = %d, want 0", *calls)
+	}
+}
+
+func TestIdempotency_ReleasesKeyOnFailure(t *testing.T) {
+	router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+	first := doIdempotent(router, http.MethodPost, "/fail?laboratory_id=lab-1", "key-1", `{}`)
+	second := doIdempotent(router, http.MethodPost, "/fail?laboratory_id=lab-1", "key-1", `{}`)
+
+	if first.Code != http.StatusBadRequest || second.Code != http.StatusBadRequest {
+		t.Errorf("statuses = %d, %d, want %d", first.Code, second.Code, http.StatusBadRequest)
+	}
+	if *calls != 2 {
+		t.Errorf("handler calls = %d, want 2", *calls)
+	}
+}
+
+func TestIdempotency_ReleasesKeyOnPanic(t *testing.T) {
+	router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+	first := doIdempotent(router, http.MethodPost, "/panic?laboratory_id=lab-1", "key-1", `{}`)
+	second := doIdempotent(router, http.MethodPost, "/panic?laboratory_id=lab-1", "key-1", `{}`)
+
+	if first.Code != http.StatusInternalServerError || second.Code != http.StatusCreated {
+		t.Errorf("statuses = %d, %d, want %d then %d", first.Code, second.Code, http.StatusInternalServerError, http.StatusCreated)
+	}
+	if *calls != 2 {
+		t.Errorf("handler calls = %d, want 2", *calls)
+	}
+}
+
+func TestIdempotency_RejectsLargeBody(t *testing.T) {
+	router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+	body := strings.Repeat("x", maxIdempotentBodySize+1)
+	rec := doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", "key-1", body)
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+	if *calls != 0 {
+		t.Errorf("handler calls = %d, want 0", *calls)
+	}
+}
+
+func TestIdempotency_IgnoresOtherMethods(t *testing.T) {
+	router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+	doIdempotent(router, http.MethodGet, "/items?laboratory_id=lab-1", "key-1", "")
+	doIdempotent(router, http.MethodGet, "/items?laboratory_id=lab-1", "key-1", "")
+
+	if *calls != 2 {
+		t.Errorf("handler calls = %d, want 2", *calls)
+	}
+}
+
+func TestIdempotency_RejectsLongKey(t *testing.T) {
+	router, calls := setupIdempotencyTestRouter(memory.NewIdempotencyStore())
+
+	key := strings.Repeat("k", idempotency.MaxKeyLength+1)
+	rec := doIdempotent(router, http.MethodPost, "/items?laboratory_id=lab-1", key, `{}`)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if *calls != 0 {
+		t.Errorf("handler calls = %d, want 0", *calls)
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	schedulerapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/scheduler"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// JobHandler handles HTTP requests for the background jobs of the scheduler
+type JobHandler struct {
+	scheduler *schedulerapp.Scheduler
+}
+
+// NewJobHandler creates a new job handler
+func NewJobHandler(scheduler *schedulerapp.Scheduler) *JobHandler {
+	return &JobHandler{scheduler: scheduler}
+}
+
+// List handles GET /api/v1/admin/jobs
+func (h *JobHandler) List(c *gin.Context) {
+	jobs, err := h.scheduler.Jobs(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToJobResponseList(jobs))
+}
+
+// ListRuns handles GET /api/v1/admin/jobs/:name/runs?limit=n
+func (h *JobHandler) ListRuns(c *gin.Context) {
+	limit := 0
+	if raw := c.Query("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			_ = c.Error(domainerrors.Validation(domainerrors.PositiveInteger("limit")))
+			return
+		}
+	}
+
+	runs, err := h.scheduler.Runs(c.Request.Context(), c.Param("name"), limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToJobRunResponseList(runs))
+}
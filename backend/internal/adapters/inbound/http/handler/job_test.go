@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	schedulerapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/scheduler"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+)
+
+func setupJobTestRouter(t *testing.T, admins []string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	runs := memory.NewJobRunRepository()
+	scheduler := schedulerapp.NewScheduler(runs, memory.NewJobLocker(), &sequenceIDGenerator{}, "host-1")
+	for _, name := range []string{"purge-deleted", "statements"} {
+		err := scheduler.Register(schedulerapp.Job{Name: name, Schedule: "0 3 * * *", Timeout: time.Minute, Task: func(ctx context.Context) (string, error) {
+			return "done", nil
+		}})
+		if err != nil {
+			t.Fatalf("Register() unexpected error = %v", err)
+		}
+	}
+
+	// Two past runs of the purge
+	at := time.Date(2024, 1, 14, 3, 0, 0, 0, time.UTC)
+	for i, id := range []string{"run-1", "run-2"} {
+		r := job.NewRun(id, "purge-deleted", "host-1", at.AddDate(0, 0, i), at.AddDate(0, 0, i))
+		r.Succeed("purged 2 orders", r.StartedAt.Add(1500*time.Millisecond))
+		_ = runs.Create(context.Background(), r)
+	}
+
+	handler := NewJobHandler(scheduler)
+
+	r := gin.New()
+	r.Use(Problems())
+	r.Use(withTestUserHeader())
+	admin := r.Group("/admin", RequireAdmin(admins))
+	admin.GET("/jobs", handler.List)
+	admin.GET("/jobs/:name/runs", handler.ListRuns)
+	return r
+}
+
+func serveJobRequest(router *gin.Engine, url, userID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	if userID != "" {
+		req.Header.Set(testUserHeader, userID)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestJobHandler_List(t *testing.T) {
+	router := setupJobTestRouter(t, []string{"user-1"})
+
+	w := serveJobRequest(router, "/admin/jobs", "user-1")
+	var jobs []dto.JobResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &jobs); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("List() status = %v, body: %s", w.Code, w.Body.String())
+	}
+	if len(jobs) != 2 || jobs[0].Name != "purge-deleted" || jobs[1].Name != "statements" {
+		t.Fatalf("List() = %+v, want both jobs by name", jobs)
+	}
+	if jobs[0].LastRun == nil || jobs[0].LastRun.ID != "run-2" || jobs[0].LastRun.Result != "purged 2 orders" || jobs[0].LastRun.DurationMS != 1500 {
+		t.Errorf("List() last run = %+v, want the most recent run", jobs[0].LastRun)
+	}
+	if jobs[1].LastRun != nil || jobs[1].NextRunAt == nil || jobs[1].TimeoutMS != 60000 {
+		t.Errorf("List() = %+v, want a job never run, due later", jobs[1])
+	}
+}
+
+func TestJobHandler_ListRuns(t *testing.T) {
+	router := setupJobTestRouter(t, []string{"user-1"})
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantRuns   int
+	}{
+		{"all runs", "/admin/jobs/purge-deleted/runs", http.StatusOK, 2},
+		{"limited", "/admin/jobs/purge-deleted/runs?limit=1", http.StatusOK, 1},
+		{"never run", "/admin/jobs/statements/runs", http.StatusOK, 0},
+		{"invalid limit", "/admin/jobs/purge-deleted/runs?limit=0", http.StatusBadRequest, 0},
+		{"unknown job", "/admin/jobs/backup/runs", http.StatusNotFound, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveJobRequest(router, tt.url, "user-1")
+			if w.Code != tt.wantStatus {
+				t.Fatalf("ListRuns() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var runs []dto.JobRunResponse
+			_ = json.Unmarshal(w.Body.Bytes(), &runs)
+			if len(runs) != tt.wantRuns {
+				t.Errorf("ListRuns() returned %d runs, want %d", len(runs), tt.wantRuns)
+			}
+			if len(runs) > 0 && (runs[0].ID != "run-2" || runs[0].Status != string(job.RunStatusSucceeded)) {
+				t.Errorf("ListRuns() = %+v, want the most recent run first", runs)
+			}
+		})
+	}
+}
+
+func TestRequireAdmin(t *testing.T) {
+	tests := []struct {
+		name       string
+		admins     []string
+		userID     string
+		wantStatus int
+	}{
+		{"admin", []string{"admin-1"}, "admin-1", http.StatusOK},
+		{"other user", []string{"admin-1"}, "user-1", http.StatusForbidden},
+		{"anonymous", []string{"admin-1"}, "", http.StatusForbidden},
+		{"no admins configured", nil, "user-1", http.StatusForbidden},
+		{"anonymous without admins", []string{""}, "", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupJobTestRouter(t, tt.admins)
+			if w := serveJobRequest(router, "/admin/jobs", tt.userID); w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -9,6 +8,7 @@ import (
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
 	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
 	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
 )
 
 // LaboratoryHandler handles HTTP requests for laboratory operations
@@ -24,23 +24,22 @@ func NewLaboratoryHandler(service *labapp.Service) *LaboratoryHandler {
 // Create handles POST /api/v1/laboratories
 func (h *LaboratoryHandler) Create(c *gin.Context) {
 	var req dto.CreateLaboratoryRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	input := labapp.CreateInput{
-		Name:    req.Name,
-		Email:   req.Email,
-		Phone:   req.Phone,
-		Address: req.Address.ToAddress(),
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Address:  req.Address.ToAddress(),
+		Language: req.Language,
 	}
 
 	lab, err := h.service.CreateLaboratory(c.Request.Context(), input)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -51,15 +50,13 @@ func (h *LaboratoryHandler) Create(c *gin.Context) {
 func (h *LaboratoryHandler) Get(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "laboratory id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	lab, err := h.service.GetLaboratory(c.Request.Context(), id)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -70,103 +67,97 @@ func (h *LaboratoryHandler) Get(c *gin.Context) {
 func (h *LaboratoryHandler) Update(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "laboratory id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	var req dto.UpdateLaboratoryRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
-	input := labapp.UpdateInput{
-		ID:      id,
-		Name:    req.Name,
-		Email:   req.Email,
-		Phone:   req.Phone,
-		Address: req.Address.ToAddress(),
+	lab, err := h.service.UpdateLaboratory(c.Request.Context(), updateLaboratoryInput(id, req))
+	if err != nil {
+		_ = c.Error(err)
+		return
 	}
 
-	lab, err := h.service.UpdateLaboratory(c.Request.Context(), input)
+	c.JSON(http.StatusOK, dto.ToLaboratoryResponse(lab))
+}
+
+// Patch handles PATCH /api/v1/laboratories/:id with a JSON merge patch
+func (h *LaboratoryHandler) Patch(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	lab, err := h.service.PatchLaboratory(c.Request.Context(), id, func(current laboratory.Laboratory) (labapp.UpdateInput, error) {
+		req := dto.ToUpdateLaboratoryRequest(&current)
+		if err := applyMergePatch(c, patch, &req); err != nil {
+			return labapp.UpdateInput{}, err
+		}
+		return updateLaboratoryInput(id, req), nil
+	})
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, dto.ToLaboratoryResponse(lab))
 }
 
+// updateLaboratoryInput returns the input replacing the laboratory's fields
+// with those of the request
+func updateLaboratoryInput(id string, req dto.UpdateLaboratoryRequest) labapp.UpdateInput {
+	return labapp.UpdateInput{
+		ID:       id,
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Address:  req.Address.ToAddress(),
+		Language: req.Language,
+	}
+}
+
 // List handles GET /api/v1/laboratories
 func (h *LaboratoryHandler) List(c *gin.Context) {
-	labs, err := h.service.ListLaboratories(c.Request.Context())
+	q, err := parseListQuery(c, laboratory.ListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	page, err := h.service.ListLaboratories(c.Request.Context(), q)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToLaboratoryResponseList(labs))
+	c.JSON(http.StatusOK, dto.NewListResponse(page, dto.ToLaboratoryResponseList(page.Items)))
 }
 
 // Delete handles DELETE /api/v1/laboratories/:id
 func (h *LaboratoryHandler) Delete(c *gin.Context) {
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "laboratory id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	err := h.service.DeleteLaboratory(c.Request.Context(), id)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
-
-// handleError converts domain errors to HTTP responses
-func (h *LaboratoryHandler) handleError(c *gin.Context, err error) {
-	var validationErrors domainerrors.ValidationErrors
-	if errors.As(err, &validationErrors) {
-		details := make(map[string]string)
-		for _, ve := range validationErrors {
-			details[ve.Field] = ve.Message
-		}
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation failed",
-			Details: details,
-		})
-		return
-	}
-
-	switch {
-	case errors.Is(err, domainerrors.ErrNotFound):
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "laboratory not found",
-		})
-	case errors.Is(err, domainerrors.ErrDuplicateEmail):
-		c.JSON(http.StatusConflict, dto.ErrorResponse{
-			Error: "email already exists",
-		})
-	case errors.Is(err, domainerrors.ErrUnauthorized):
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error: "unauthorized",
-		})
-	case errors.Is(err, domainerrors.ErrForbidden):
-		c.JSON(http.StatusForbidden, dto.ErrorResponse{
-			Error: "forbidden",
-		})
-	default:
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "internal server error",
-		})
-	}
-}
-
@@ -11,7 +11,9 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
 	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
 )
@@ -30,10 +32,11 @@ func setupLabTestRouter() (*gin.Engine, *labapp.Service, *memory.LaboratoryRepos
 
 	repo := memory.NewLaboratoryRepository()
 	idGen := &mockLabIDGenerator{id: "test-id-123"}
-	svc := labapp.NewService(repo, idGen)
+	svc := labapp.NewService(repo, idGen, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor())
 	handler := NewLaboratoryHandler(svc)
 
 	r := gin.New()
+	r.Use(Problems())
 	r.POST("/laboratories", handler.Create)
 	r.GET("/laboratories/:id", handler.Get)
 	r.PUT("/laboratories/:id", handler.Update)
@@ -243,13 +246,13 @@ func TestLaboratoryHandler_List_Success(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.LaboratoryResponse
+	var resp dto.ListResponse[dto.LaboratoryResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 2 {
-		t.Errorf("List() got %d labs, want 2", len(resp))
+	if len(resp.Data) != 2 {
+		t.Errorf("List() got %d labs, want 2", len(resp.Data))
 	}
 }
 
@@ -264,13 +267,13 @@ func TestLaboratoryHandler_List_Empty(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.LaboratoryResponse
+	var resp dto.ListResponse[dto.LaboratoryResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 0 {
-		t.Errorf("List() got %d labs, want 0", len(resp))
+	if len(resp.Data) != 0 {
+		t.Errorf("List() got %d labs, want 0", len(resp.Data))
 	}
 }
 
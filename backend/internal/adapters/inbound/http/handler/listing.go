@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+)
+
+// parseListQuery extracts limit, cursor, sort, order and the spec's filter fields
+// from the query string. Sort and filter fields are validated by the services.
+func parseListQuery(c *gin.Context, spec listing.Spec) (listing.Query, error) {
+	q := listing.Query{
+		Cursor:    c.Query("cursor"),
+		SortBy:    c.Query("sort"),
+		Direction: listing.Direction(c.Query("order")),
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			return q, domainerrors.Validation(domainerrors.PositiveInteger("limit"))
+		}
+		q.Limit = limit
+	}
+
+	for _, field := range spec.FilterFields {
+		if value := c.Query(field); value != "" {
+			if q.Filters == nil {
+				q.Filters = make(map[string]string)
+			}
+			q.Filters[field] = value
+		}
+	}
+
+	return q, nil
+}
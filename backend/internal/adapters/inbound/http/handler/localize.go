@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+)
+
+// Localizer picks the language of response messages
+type Localizer struct {
+	labs *labapp.Service
+}
+
+// NewLocalizer creates a new localizer that falls back to the laboratories'
+// default languages
+func NewLocalizer(labs *labapp.Service) *Localizer {
+	return &Localizer{labs: labs}
+}
+
+// Middleware negotiates the language from Accept-Language, falling back to the
+// default of the laboratory named by the laboratory_id query parameter and then
+// to pt-BR. The language is stored in the request context and sent back in the
+// Content-Language header.
+func (l *Localizer) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang, ok := i18n.Negotiate(c.GetHeader("Accept-Language"))
+		if !ok {
+			lang = l.laboratoryLanguage(c)
+		}
+
+		c.Request = c.Request.WithContext(i18n.WithLanguage(c.Request.Context(), lang))
+		c.Header("Content-Language", string(lang))
+
+		c.Next()
+	}
+}
+
+// laboratoryLanguage returns the default language of the requested laboratory
+func (l *Localizer) laboratoryLanguage(c *gin.Context) i18n.Language {
+	labID := c.Query("laboratory_id")
+	if labID == "" || l.labs == nil {
+		return i18n.Default
+	}
+
+	lab, err := l.labs.GetLaboratory(c.Request.Context(), labID)
+	if err != nil || lab.Language == "" {
+		return i18n.Default
+	}
+	return lab.Language
+}
+
+// requestLanguage returns the language chosen by the Localizer, negotiating it
+// from Accept-Language for requests that failed before the Localizer ran
+func requestLanguage(c *gin.Context) i18n.Language {
+	if lang, ok := i18n.FromContext(c.Request.Context()); ok {
+		return lang
+	}
+	if lang, ok := i18n.Negotiate(c.GetHeader("Accept-Language")); ok {
+		return lang
+	}
+	return i18n.Default
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	labapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/laboratory"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+)
+
+func setupLocalizeTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	repo := memory.NewLaboratoryRepository()
+	createTestLaboratoryForLab(repo, "lab-en")
+	lab, _ := repo.GetByID(nil, "lab-en")
+	if err := lab.SetLanguage("en"); err != nil {
+		t.Fatalf("SetLanguage() error = %v", err)
+	}
+	_ = repo.Update(nil, lab)
+
+	localizer := NewLocalizer(labapp.NewService(repo, &mockLabIDGenerator{}, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor()))
+
+	r := gin.New()
+	r.Use(Problems())
+	r.Use(localizer.Middleware())
+	r.GET("/fail", func(c *gin.Context) {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("name")))
+	})
+	return r
+}
+
+func TestLocalizer_NegotiatesLanguage(t *testing.T) {
+	router := setupLocalizeTestRouter(t)
+
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		wantLanguage   i18n.Language
+		wantTitle      string
+		wantMessage    string
+	}{
+		{
+			name:         "defaults to portuguese",
+			target:       "/fail",
+			wantLanguage: i18n.PtBR,
+			wantTitle:    "Falha de validação",
+			wantMessage:  "name é obrigatório",
+		},
+		{
+			name:           "accept-language wins",
+			target:         "/fail?laboratory_id=lab-en",
+			acceptLanguage: "es-ES,es;q=0.9",
+			wantLanguage:   i18n.Es,
+			wantTitle:      "Error de validación",
+			wantMessage:    "name es obligatorio",
+		},
+		{
+			name:         "laboratory default",
+			target:       "/fail?laboratory_id=lab-en",
+			wantLanguage: i18n.En,
+			wantTitle:    "Validation Failed",
+			wantMessage:  "name is required",
+		},
+		{
+			name:           "unsupported accept-language uses laboratory default",
+			target:         "/fail?laboratory_id=lab-en",
+			acceptLanguage: "fr-FR",
+			wantLanguage:   i18n.En,
+			wantTitle:      "Validation Failed",
+			wantMessage:    "name is required",
+		},
+		{
+			name:         "unknown laboratory",
+			target:       "/fail?laboratory_id=missing",
+			wantLanguage: i18n.PtBR,
+			wantTitle:    "Falha de validação",
+			wantMessage:  "name é obrigatório",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if got := rec.Header().Get("Content-Language"); got != string(tt.wantLanguage) {
+				t.Errorf("Content-Language = %q, want %q", got, tt.wantLanguage)
+			}
+
+			var p dto.Problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+				t.Fatalf("Failed to unmarshal problem: %v", err)
+			}
+			if p.Title != tt.wantTitle {
+				t.Errorf("title = %q, want %q", p.Title, tt.wantTitle)
+			}
+			if len(p.Errors) != 1 || p.Errors[0].Message != tt.wantMessage {
+				t.Fatalf("errors = %+v, want message %q", p.Errors, tt.wantMessage)
+			}
+			if p.Errors[0].Code != domainerrors.KeyRequired || p.Errors[0].Params["field"] != "name" {
+				t.Errorf("code = %q, params = %v", p.Errors[0].Code, p.Errors[0].Params)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	notificationapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/notification"
+)
+
+// NotificationHandler handles HTTP requests for the notification settings of laboratories
+type NotificationHandler struct {
+	service *notificationapp.Service
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(service *notificationapp.Service) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// GetSettings handles GET /api/v1/laboratories/:id/notifications
+func (h *NotificationHandler) GetSettings(c *gin.Context) {
+	settings, err := h.service.GetSettings(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToNotificationSettingsResponse(settings))
+}
+
+// UpdateSettings handles PUT /api/v1/laboratories/:id/notifications
+func (h *NotificationHandler) UpdateSettings(c *gin.Context) {
+	var req dto.UpdateNotificationSettingsRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(c.Request.Context(), notificationapp.UpdateSettingsInput{
+		LaboratoryID: c.Param("id"),
+		SenderName:   req.SenderName,
+		SenderEmail:  req.SenderEmail,
+		ReplyTo:      req.ReplyTo,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToNotificationSettingsResponse(settings))
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	notificationapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/notification"
+)
+
+func setupNotificationTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	labRepo := memory.NewLaboratoryRepository()
+	createTestLaboratory(labRepo, "lab-123")
+	handler := NewNotificationHandler(notificationapp.NewService(memory.NewNotificationSettingsRepository(), labRepo, auditapp.NopRecorder{}, []string{"smilelab.com"}))
+
+	r := gin.New()
+	r.Use(Problems())
+	r.GET("/laboratories/:id/notifications", handler.GetSettings)
+	r.PUT("/laboratories/:id/notifications", handler.UpdateSettings)
+	return r
+}
+
+func TestNotificationHandler_UpdateSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"sender of the laboratory", "/laboratories/lab-123/notifications", `{"sender_name": "Smile Lab Orders", "sender_email": "orders@smilelab.com", "reply_to": "desk@smilelab.com"}`, http.StatusOK},
+		{"defaults", "/laboratories/lab-123/notifications", `{}`, http.StatusOK},
+		{"invalid sender email", "/laboratories/lab-123/notifications", `{"sender_email": "orders"}`, http.StatusBadRequest},
+		{"unknown laboratory", "/laboratories/lab-999/notifications", `{}`, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupNotificationTestRouter()
+
+			req := httptest.NewRequest(http.MethodPut, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("UpdateSettings() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNotificationHandler_GetSettings(t *testing.T) {
+	router := setupNotificationTestRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/laboratories/lab-123/notifications", nil))
+	var defaults dto.NotificationSettingsResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &defaults)
+	if w.Code != http.StatusOK || defaults.LaboratoryID != "lab-123" || defaults.SenderEmail != "" || defaults.UpdatedAt != nil {
+		t.Fatalf("GetSettings() status = %v, body: %s, want the defaults", w.Code, w.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodPut, "/laboratories/lab-123/notifications", bytes.NewBufferString(`{"sender_email": "orders@smilelab.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/laboratories/lab-123/notifications", nil))
+	var got dto.NotificationSettingsResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &got)
+	if got.SenderEmail != "orders@smilelab.com" || got.UpdatedAt == nil {
+		t.Errorf("GetSettings() after update = %s, want the saved sender", w.Body.String())
+	}
+}
@@ -1,12 +1,12 @@
 package handler
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	expandapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/expand"
 	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
 	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
@@ -14,19 +14,20 @@ import (
 
 // OrderHandler handles HTTP requests for order operations
 type OrderHandler struct {
-	service *orderapp.Service
+	service  *orderapp.Service
+	expander *expandapp.Service
 }
 
 // NewOrderHandler creates a new order handler
-func NewOrderHandler(service *orderapp.Service) *OrderHandler {
-	return &OrderHandler{service: service}
+func NewOrderHandler(service *orderapp.Service, expander *expandapp.Service) *OrderHandler {
+	return &OrderHandler{service: service, expander: expander}
 }
 
 // getLaboratoryID extracts laboratory_id from query parameter
 func (h *OrderHandler) getLaboratoryID(c *gin.Context) (string, error) {
 	laboratoryID := c.Query("laboratory_id")
 	if laboratoryID == "" {
-		return "", errors.New("laboratory_id query parameter is required")
+		return "", domainerrors.Validation(domainerrors.Required("laboratory_id"))
 	}
 	return laboratoryID, nil
 }
@@ -36,17 +37,13 @@ func (h *OrderHandler) Create(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	var req dto.CreateOrderRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -58,7 +55,7 @@ func (h *OrderHandler) Create(c *gin.Context) {
 
 	order, err := h.service.CreateOrder(c.Request.Context(), input)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -70,27 +67,35 @@ func (h *OrderHandler) Get(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "order id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
-	order, err := h.service.GetOrder(c.Request.Context(), id, laboratoryID)
+	fields, err := parseExpand(c, expandapp.OrderFields())
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToOrderResponse(order))
+	o, err := h.service.GetOrder(c.Request.Context(), id, laboratoryID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	responses, err := h.responses(c, laboratoryID, []*order.Order{o}, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses[0])
 }
 
 // Update handles PUT /api/v1/orders/:id
@@ -98,25 +103,19 @@ func (h *OrderHandler) Update(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "order id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	var req dto.UpdateOrderRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -124,11 +123,12 @@ func (h *OrderHandler) Update(c *gin.Context) {
 		ID:           id,
 		LaboratoryID: laboratoryID,
 		Prosthesis:   dto.ToProsthesisItems(req.Prosthesis),
+		TechnicianID: req.TechnicianID,
 	}
 
 	order, err := h.service.UpdateOrder(c.Request.Context(), input)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -140,33 +140,25 @@ func (h *OrderHandler) UpdateStatus(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "order id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	var req dto.UpdateOrderStatusRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	// Validate status value
 	if !order.IsValidStatus(req.Status) {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid status value",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.InvalidChoice("status", order.AllStatuses())))
 		return
 	}
 
@@ -178,31 +170,77 @@ func (h *OrderHandler) UpdateStatus(c *gin.Context) {
 
 	o, err := h.service.UpdateOrderStatus(c.Request.Context(), input)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, dto.ToOrderResponse(o))
 }
 
-// List handles GET /api/v1/orders
+// BulkUpdateStatus handles POST /api/v1/orders/bulk/status
+func (h *OrderHandler) BulkUpdateStatus(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req dto.BulkOrderStatusRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	items := make([]orderapp.UpdateStatusInput, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = orderapp.UpdateStatusInput{ID: item.ID, Status: order.Status(item.Status)}
+	}
+
+	results := h.service.BulkUpdateOrderStatus(c.Request.Context(), laboratoryID, items, req.Atomic)
+	c.JSON(http.StatusOK, bulkResponse(c, results, dto.ToOrderResponse))
+}
+
+// List handles GET /api/v1/orders with optional search criteria (see parseOrderSearch)
 func (h *OrderHandler) List(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	orders, err := h.service.ListOrders(c.Request.Context(), laboratoryID)
+	q, err := parseListQuery(c, order.ListSpec)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToOrderResponseList(orders))
+	criteria, err := parseOrderSearch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	fields, err := parseExpand(c, expandapp.OrderFields())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	page, err := h.service.ListOrders(c.Request.Context(), laboratoryID, criteria, q)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	responses, err := h.responses(c, laboratoryID, page.Items, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewListResponse(page, responses))
 }
 
 // ListByClient handles GET /api/v1/clients/:id/orders
@@ -210,27 +248,47 @@ func (h *OrderHandler) ListByClient(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	clientID := c.Param("id")
 	if clientID == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "client id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
-	orders, err := h.service.ListOrdersByClient(c.Request.Context(), clientID, laboratoryID)
+	q, err := parseListQuery(c, order.ListSpec)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToOrderResponseList(orders))
+	criteria, err := parseOrderSearch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	fields, err := parseExpand(c, expandapp.OrderFields())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	page, err := h.service.ListOrdersByClient(c.Request.Context(), clientID, laboratoryID, criteria, q)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	responses, err := h.responses(c, laboratoryID, page.Items, fields)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewListResponse(page, responses))
 }
 
 // Delete handles DELETE /api/v1/orders/:id
@@ -238,64 +296,85 @@ func (h *OrderHandler) Delete(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "order id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	err = h.service.DeleteOrder(c.Request.Context(), id, laboratoryID)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
-// handleError converts domain errors to HTTP responses
-func (h *OrderHandler) handleError(c *gin.Context, err error) {
-	var validationErrors domainerrors.ValidationErrors
-	if errors.As(err, &validationErrors) {
-		details := make(map[string]string)
-		for _, ve := range validationErrors {
-			details[ve.Field] = ve.Message
-		}
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation failed",
-			Details: details,
-		})
-		return
-	}
-
-	switch {
-	case errors.Is(err, domainerrors.ErrNotFound):
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "order not found",
-		})
-	case errors.Is(err, domainerrors.ErrInvalidStatusTransition):
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid status transition",
-		})
-	case errors.Is(err, domainerrors.ErrUnauthorized):
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error: "unauthorized",
-		})
-	case errors.Is(err, domainerrors.ErrForbidden):
-		c.JSON(http.StatusForbidden, dto.ErrorResponse{
-			Error: "forbidden",
-		})
-	default:
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "internal server error",
-		})
+// ListAttachments handles GET /api/v1/orders/:id/attachments
+func (h *OrderHandler) ListAttachments(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	o, err := h.service.GetOrder(c.Request.Context(), id, laboratoryID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToOrderResponse(o).Attachments)
+}
+
+// DownloadAttachment handles GET /api/v1/orders/:id/attachments/:attachment_id
+func (h *OrderHandler) DownloadAttachment(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	id := c.Param("id")
+	attachmentID := c.Param("attachment_id")
+	if id == "" || attachmentID == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	attachment, data, err := h.service.DownloadAttachment(c.Request.Context(), id, laboratoryID, attachmentID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	serveAttachment(c, attachment, data)
+}
+
+// responses converts orders to response DTOs embedding the expanded fields
+func (h *OrderHandler) responses(c *gin.Context, laboratoryID string, orders []*order.Order, fields expandapp.Set) ([]dto.OrderResponse, error) {
+	responses := dto.ToOrderResponseList(orders)
+	if len(fields) == 0 {
+		return responses, nil
+	}
+
+	related, err := h.expander.Orders(c.Request.Context(), laboratoryID, orders, fields)
+	if err != nil {
+		return nil, err
+	}
+	for i, o := range orders {
+		embedOrder(&responses[i], o, related)
 	}
+	return responses, nil
 }
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// parseOrderSearch extracts the order search criteria from the query string:
+// status (comma-separated), client_id, technician_id, created_from, created_to,
+// updated_from, updated_to (RFC3339), prosthesis_type, material and q (free text).
+// Status values are validated by the service.
+func parseOrderSearch(c *gin.Context) (order.SearchCriteria, error) {
+	criteria := order.SearchCriteria{
+		ClientID:       c.Query("client_id"),
+		TechnicianID:   c.Query("technician_id"),
+		ProsthesisType: c.Query("prosthesis_type"),
+		Material:       c.Query("material"),
+		Text:           strings.TrimSpace(c.Query("q")),
+	}
+
+	for _, s := range strings.Split(c.Query("status"), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			criteria.Statuses = append(criteria.Statuses, order.Status(s))
+		}
+	}
+
+	var err error
+	if criteria.CreatedFrom, err = parseTimeQuery(c, "created_from"); err != nil {
+		return criteria, err
+	}
+	if criteria.CreatedTo, err = parseTimeQuery(c, "created_to"); err != nil {
+		return criteria, err
+	}
+	if criteria.UpdatedFrom, err = parseTimeQuery(c, "updated_from"); err != nil {
+		return criteria, err
+	}
+	if criteria.UpdatedTo, err = parseTimeQuery(c, "updated_to"); err != nil {
+		return criteria, err
+	}
+
+	return criteria, nil
+}
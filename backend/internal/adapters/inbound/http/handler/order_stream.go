@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	orderstreamapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/orderstream"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// Order stream event names, besides the order event types
+const (
+	StreamEventReset = "reset"
+)
+
+// OrderStreamHandler streams the order changes of a laboratory as Server-Sent Events
+type OrderStreamHandler struct {
+	broker    *orderstreamapp.Broker
+	heartbeat time.Duration
+}
+
+// NewOrderStreamHandler creates a new order stream handler sending a
+// heartbeat comment every heartbeat while no change is sent
+func NewOrderStreamHandler(broker *orderstreamapp.Broker, heartbeat time.Duration) *OrderStreamHandler {
+	return &OrderStreamHandler{broker: broker, heartbeat: heartbeat}
+}
+
+// Stream handles GET /api/v1/orders/stream?laboratory_id=xxx.
+// A Last-Event-ID header resumes the stream after that event.
+func (h *OrderStreamHandler) Stream(c *gin.Context) {
+	laboratoryID := c.Query("laboratory_id")
+	if laboratoryID == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("laboratory_id")))
+		return
+	}
+
+	sub, err := h.broker.Subscribe(c.Request.Context(), laboratoryID, c.GetHeader("Last-Event-ID"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	defer sub.Close()
+
+	// The stream outlives the server's write timeout
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", sse.ContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Disables proxy buffering
+	c.Status(http.StatusOK)
+
+	if sub.Reset {
+		// Carries the current ID so the client resumes from here once reloaded
+		_ = sse.Encode(c.Writer, sse.Event{
+			Id:    sub.Cursor,
+			Event: StreamEventReset,
+			Data:  dto.OrderStreamReset{Reason: "missed changes are no longer available, reload the orders"},
+		})
+	}
+	for _, m := range sub.Replay {
+		h.send(c, m)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case m, ok := <-sub.C():
+			if !ok {
+				return
+			}
+			h.send(c, m)
+			heartbeat.Reset(h.heartbeat)
+		case <-heartbeat.C:
+			_, _ = c.Writer.WriteString(": heartbeat\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
+
+// send writes a message as an event named after its type
+func (h *OrderStreamHandler) send(c *gin.Context, m orderstreamapp.Message) {
+	_ = sse.Encode(c.Writer, sse.Event{
+		Id:    m.ID,
+		Event: string(m.Type),
+		Data:  dto.ToOrderStreamEvent(m),
+	})
+}
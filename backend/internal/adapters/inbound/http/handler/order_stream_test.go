@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	orderstreamapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/orderstream"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+func setupOrderStreamTestServer(t *testing.T, heartbeat time.Duration) (*httptest.Server, *orderstreamapp.Broker) {
+	gin.SetMode(gin.TestMode)
+
+	labRepo := memory.NewLaboratoryRepository()
+	_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"})
+	broker := orderstreamapp.NewBroker(labRepo, orderstreamapp.DefaultConfig())
+	handler := NewOrderStreamHandler(broker, heartbeat)
+
+	r := gin.New()
+	r.Use(Problems())
+	r.GET("/orders/stream", handler.Stream)
+
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	return server, broker
+}
+
+// sseClient reads the events of a stream
+type sseClient struct {
+	resp    *http.Response
+	scanner *bufio.Scanner
+}
+
+func openStream(t *testing.T, ctx context.Context, url, lastEventID string) *sseClient {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s error = %v", url, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return &sseClient{resp: resp, scanner: bufio.NewScanner(resp.Body)}
+}
+
+// next returns the fields of the next event, or the comment of the next comment line
+func (c *sseClient) next(t *testing.T) map[string]string {
+	fields := make(map[string]string)
+	for c.scanner.Scan() {
+		line := c.scanner.Text()
+		if line == "" {
+			if len(fields) > 0 {
+				return fields
+			}
+			continue
+		}
+		name, value, _ := strings.Cut(line, ":")
+		fields[name] = strings.TrimSpace(value)
+	}
+	t.Fatalf("stream ended: %v", c.scanner.Err())
+	return nil
+}
+
+func TestOrderStreamHandler_Stream(t *testing.T) {
+	server, broker := setupOrderStreamTestServer(t, time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := openStream(t, ctx, server.URL+"/orders/stream?laboratory_id=lab-123", "")
+	if stream.resp.StatusCode != http.StatusOK || stream.resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("status = %v, content type = %s", stream.resp.StatusCode, stream.resp.Header.Get("Content-Type"))
+	}
+
+	_ = broker.Handle(ctx, event.OrderCreated{
+		Metadata: event.NewMetadata("lab-123"),
+		Order:    order.Order{ID: "order-1", LaboratoryID: "lab-123", Status: order.StatusReceived},
+	})
+	_ = broker.Handle(ctx, event.OrderStatusChanged{
+		Metadata: event.NewMetadata("lab-123"),
+		Order:    order.Order{ID: "order-1", LaboratoryID: "lab-123", Status: order.StatusInProduction},
+		From:     order.StatusReceived,
+		To:       order.StatusInProduction,
+	})
+
+	created := stream.next(t)
+	if created["event"] != "order.created" || created["id"] == "" || !strings.Contains(created["data"], `"id":"order-1"`) {
+		t.Errorf("first event = %v, want order.created of order-1", created)
+	}
+	changed := stream.next(t)
+	if changed["event"] != "order.status_changed" || !strings.Contains(changed["data"], `"to":"in_production"`) {
+		t.Errorf("second event = %v, want order.status_changed to in_production", changed)
+	}
+	cancel()
+
+	// Resuming after the first event replays the second
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resumed := openStream(t, ctx, server.URL+"/orders/stream?laboratory_id=lab-123", created["id"])
+	if replayed := resumed.next(t); replayed["id"] != changed["id"] || replayed["event"] != "order.status_changed" {
+		t.Errorf("replayed event = %v, want %v", replayed, changed)
+	}
+
+	// An unknown event ID tells the client to reload
+	reset := openStream(t, ctx, server.URL+"/orders/stream?laboratory_id=lab-123", "unknown-1")
+	if e := reset.next(t); e["event"] != StreamEventReset || e["id"] != changed["id"] {
+		t.Errorf("event = %v, want a reset carrying the latest ID", e)
+	}
+}
+
+func TestOrderStreamHandler_Heartbeat(t *testing.T) {
+	server, _ := setupOrderStreamTestServer(t, 20*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := openStream(t, ctx, server.URL+"/orders/stream?laboratory_id=lab-123", "")
+	if comment := stream.next(t); comment[""] != "heartbeat" {
+		t.Errorf("idle stream sent %v, want a heartbeat comment", comment)
+	}
+}
+
+func TestOrderStreamHandler_Errors(t *testing.T) {
+	server, _ := setupOrderStreamTestServer(t, time.Hour)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "missing laboratory_id", wantStatus: http.StatusBadRequest},
+		{name: "unknown laboratory", query: "?laboratory_id=lab-999", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(server.URL + "/orders/stream" + tt.query)
+			if err != nil {
+				t.Fatalf("GET error = %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
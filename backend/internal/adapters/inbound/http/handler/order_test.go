@@ -12,11 +12,16 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	expandapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/expand"
 	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
 	ord "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
 )
 
 // mockOrderIDGenerator is a mock ID generator for testing
@@ -29,25 +34,33 @@ func (m *mockOrderIDGenerator) Generate() string {
 }
 
 func setupOrderTestRouter() (*gin.Engine, *orderapp.Service, *memory.OrderRepository, *memory.ClientRepository, *memory.LaboratoryRepository) {
-	gin.SetMode(gin.TestMode)
-
 	orderRepo := memory.NewOrderRepository()
 	clientRepo := memory.NewClientRepository()
 	labRepo := memory.NewLaboratoryRepository()
+	r, orderSvc := newOrderTestRouter(orderRepo, clientRepo, labRepo, memory.NewTechnicianRepository(), memory.NewProsthesisRepository())
+	return r, orderSvc, orderRepo, clientRepo, labRepo
+}
+
+func newOrderTestRouter(orderRepo *memory.OrderRepository, clientRepo *memory.ClientRepository, labRepo *memory.LaboratoryRepository, techRepo *memory.TechnicianRepository, catalog *memory.ProsthesisRepository) (*gin.Engine, *orderapp.Service) {
+	gin.SetMode(gin.TestMode)
+
 	idGen := &mockOrderIDGenerator{id: "test-id-123"}
-	orderSvc := orderapp.NewService(orderRepo, clientRepo, idGen)
-	orderHandler := NewOrderHandler(orderSvc)
+	orderSvc := orderapp.NewService(orderRepo, clientRepo, techRepo, catalog, memory.NewAttachmentStorage(), idGen, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor())
+	expander := expandapp.NewService(labRepo, clientRepo, techRepo, catalog)
+	orderHandler := NewOrderHandler(orderSvc, expander)
 
 	r := gin.New()
+	r.Use(Problems())
 	r.POST("/orders", orderHandler.Create)
 	r.GET("/orders/:id", orderHandler.Get)
 	r.PUT("/orders/:id", orderHandler.Update)
 	r.PATCH("/orders/:id/status", orderHandler.UpdateStatus)
+	r.POST("/orders/bulk/status", orderHandler.BulkUpdateStatus)
 	r.GET("/orders", orderHandler.List)
 	r.GET("/clients/:id/orders", orderHandler.ListByClient)
 	r.DELETE("/orders/:id", orderHandler.Delete)
 
-	return r, orderSvc, orderRepo, clientRepo, labRepo
+	return r, orderSvc
 }
 
 func createTestLaboratoryForOrder(repo *memory.LaboratoryRepository, id string) {
@@ -234,6 +247,99 @@ func TestOrderHandler_Get_Success(t *testing.T) {
 	}
 }
 
+func TestOrderHandler_Expand(t *testing.T) {
+	orderRepo := memory.NewOrderRepository()
+	clientRepo := memory.NewClientRepository()
+	labRepo := memory.NewLaboratoryRepository()
+	techRepo := memory.NewTechnicianRepository()
+	catalog := memory.NewProsthesisRepository()
+	router, _ := newOrderTestRouter(orderRepo, clientRepo, labRepo, techRepo, catalog)
+
+	createTestLaboratoryForOrder(labRepo, "lab-123")
+	createTestClientForOrder(clientRepo, "client-123", "lab-123")
+	_ = techRepo.Create(nil, &technician.Technician{ID: "tech-123", LaboratoryID: "lab-123", Name: "John Doe", Role: technician.RoleTechnician})
+	_ = catalog.Create(nil, &prosthesis.Prosthesis{ID: "crown-123", LaboratoryID: "lab-123", Type: prosthesis.ProsthesisTypeCrown, Material: "zirconia"})
+	_ = orderRepo.Create(nil, &ord.Order{
+		ID:           "order-123",
+		ClientID:     "client-123",
+		LaboratoryID: "lab-123",
+		TechnicianID: "tech-123",
+		Status:       ord.StatusReceived,
+		Prosthesis: []ord.ProsthesisItem{
+			{Type: "crown", Material: "zirconia", Quantity: 1, CatalogItemID: "crown-123"},
+			{Type: "bridge", Material: "metal", Quantity: 1},
+		},
+	})
+
+	t.Run("get with every field", func(t *testing.T) {
+		url := addLaboratoryIDQueryParamForOrder("/orders/order-123?expand=client,laboratory,technician,catalog_item", "lab-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Get() status = %d, want %d, body = %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+
+		var resp dto.OrderResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if resp.Client == nil || resp.Client.Name != "Test Client" {
+			t.Errorf("Get() Client = %+v, want the order's client", resp.Client)
+		}
+		if resp.Laboratory == nil || resp.Laboratory.ID != "lab-123" {
+			t.Errorf("Get() Laboratory = %+v, want lab-123", resp.Laboratory)
+		}
+		if resp.Technician == nil || resp.Technician.Name != "John Doe" {
+			t.Errorf("Get() Technician = %+v, want the assigned technician", resp.Technician)
+		}
+		if item := resp.Prosthesis[0]; item.CatalogItem == nil || item.CatalogItem.ID != "crown-123" {
+			t.Errorf("Get() Prosthesis[0].CatalogItem = %+v, want crown-123", item.CatalogItem)
+		}
+		if resp.Prosthesis[1].CatalogItem != nil {
+			t.Errorf("Get() Prosthesis[1].CatalogItem = %+v, want nil", resp.Prosthesis[1].CatalogItem)
+		}
+	})
+
+	t.Run("list without expand", func(t *testing.T) {
+		url := addLaboratoryIDQueryParamForOrder("/orders", "lab-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if strings.Contains(rec.Body.String(), `"client":`) || strings.Contains(rec.Body.String(), `"catalog_item":`) {
+			t.Errorf("List() embedded resources without expand: %s", rec.Body.String())
+		}
+	})
+
+	t.Run("list by client", func(t *testing.T) {
+		url := addLaboratoryIDQueryParamForOrder("/clients/client-123/orders?expand=client", "lab-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		var resp dto.ListResponse[dto.OrderResponse]
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if len(resp.Data) != 1 || resp.Data[0].Client == nil || resp.Data[0].Technician != nil {
+			t.Errorf("ListByClient() Data = %+v, want one order with only its client embedded", resp.Data)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		url := addLaboratoryIDQueryParamForOrder("/orders?expand=patient", "lab-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("List() status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+}
+
 func TestOrderHandler_Get_NotFound(t *testing.T) {
 	router, _, _, _, labRepo := setupOrderTestRouter()
 	createTestLaboratoryForOrder(labRepo, "lab-123")
@@ -397,6 +503,97 @@ func TestOrderHandler_UpdateStatus_InvalidStatus(t *testing.T) {
 	}
 }
 
+func TestOrderHandler_BulkUpdateStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		atomic        bool
+		wantSucceeded int
+		wantFailed    int
+		wantSkipped   int
+		wantStatus    ord.Status // Status of order-1 afterwards
+	}{
+		{"applies valid items", false, 1, 2, 0, ord.StatusInProduction},
+		{"atomic batch applies nothing", true, 0, 2, 1, ord.StatusReceived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, _, orderRepo, clientRepo, labRepo := setupOrderTestRouter()
+			createTestLaboratoryForOrder(labRepo, "lab-123")
+			createTestClientForOrder(clientRepo, "client-123", "lab-123")
+			createTestOrder(orderRepo, "order-1", "client-123", "lab-123")
+			createTestOrder(orderRepo, "order-2", "client-123", "lab-123")
+
+			reqBody := dto.BulkOrderStatusRequest{
+				Atomic: tt.atomic,
+				Items: []dto.BulkOrderStatusItem{
+					{ID: "order-1", Status: string(ord.StatusInProduction)},
+					{ID: "order-2", Status: string(ord.StatusReady)}, // Invalid transition from received
+					{ID: "non-existent", Status: string(ord.StatusInProduction)},
+				},
+			}
+
+			body, _ := json.Marshal(reqBody)
+			url := addLaboratoryIDQueryParamForOrder("/orders/bulk/status", "lab-123")
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", "en")
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("BulkUpdateStatus() status = %d, want %d, body = %s", rec.Code, http.StatusOK, rec.Body.String())
+			}
+
+			var resp dto.BulkResponse[dto.OrderResponse]
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+
+			if resp.Succeeded != tt.wantSucceeded || resp.Failed != tt.wantFailed || resp.Skipped != tt.wantSkipped {
+				t.Errorf("BulkUpdateStatus() counts = %d/%d/%d, want %d/%d/%d",
+					resp.Succeeded, resp.Failed, resp.Skipped, tt.wantSucceeded, tt.wantFailed, tt.wantSkipped)
+			}
+			if len(resp.Results) != 3 {
+				t.Fatalf("BulkUpdateStatus() got %d results, want 3", len(resp.Results))
+			}
+			if e := resp.Results[1].Error; e == nil || e.Status != http.StatusBadRequest {
+				t.Errorf("BulkUpdateStatus() results[1].Error = %+v, want a %d problem", e, http.StatusBadRequest)
+			}
+			if e := resp.Results[2].Error; e == nil || e.Code != "not_found" {
+				t.Errorf("BulkUpdateStatus() results[2].Error = %+v, want a not_found problem", e)
+			}
+			if !tt.atomic && (resp.Results[0].Data == nil || resp.Results[0].Data.Status != string(ord.StatusInProduction)) {
+				t.Errorf("BulkUpdateStatus() results[0].Data = %+v, want the updated order", resp.Results[0].Data)
+			}
+
+			o, _ := orderRepo.GetByID(nil, "order-1")
+			if o.Status != tt.wantStatus {
+				t.Errorf("order-1 Status = %v, want %v", o.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestOrderHandler_BulkUpdateStatus_InvalidBody(t *testing.T) {
+	router, _, _, _, labRepo := setupOrderTestRouter()
+	createTestLaboratoryForOrder(labRepo, "lab-123")
+
+	for _, body := range []string{`{"items":[]}`, `{"items":[{"status":"in_production"}]}`} {
+		url := addLaboratoryIDQueryParamForOrder("/orders/bulk/status", "lab-123")
+		req := httptest.NewRequest(http.MethodPost, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("BulkUpdateStatus(%s) status = %d, want %d", body, rec.Code, http.StatusBadRequest)
+		}
+	}
+}
+
 func TestOrderHandler_List_Success(t *testing.T) {
 	router, _, orderRepo, clientRepo, labRepo := setupOrderTestRouter()
 	createTestLaboratoryForOrder(labRepo, "lab-123")
@@ -431,13 +628,84 @@ func TestOrderHandler_List_Success(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.OrderResponse
+	var resp dto.ListResponse[dto.OrderResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 2 {
-		t.Errorf("List() got %d orders, want 2", len(resp))
+	if len(resp.Data) != 2 {
+		t.Errorf("List() got %d orders, want 2", len(resp.Data))
+	}
+}
+
+func TestOrderHandler_List_Search(t *testing.T) {
+	router, _, orderRepo, clientRepo, labRepo := setupOrderTestRouter()
+	createTestLaboratoryForOrder(labRepo, "lab-123")
+	createTestClientForOrder(clientRepo, "client-123", "lab-123")
+	createTestOrder(orderRepo, "order-1", "client-123", "lab-123")
+	_ = orderRepo.Create(nil, &ord.Order{
+		ID:           "order-2",
+		ClientID:     "client-123",
+		LaboratoryID: "lab-123",
+		TechnicianID: "tech-1",
+		Status:       ord.StatusInProduction,
+		Prosthesis: []ord.ProsthesisItem{
+			{
+				Type:     "bridge",
+				Material: "porcelain",
+				Quantity: 1,
+				Notes:    "Rush delivery",
+			},
+		},
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	})
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantIDs    []string
+	}{
+		{"status set", "&status=received,in_production", http.StatusOK, []string{"order-1", "order-2"}},
+		{"single status", "&status=in_production", http.StatusOK, []string{"order-2"}},
+		{"technician", "&technician_id=tech-1", http.StatusOK, []string{"order-2"}},
+		{"prosthesis type", "&prosthesis_type=bridge", http.StatusOK, []string{"order-2"}},
+		{"material", "&material=porcelain", http.StatusOK, []string{"order-2"}},
+		{"free text", "&q=rush", http.StatusOK, []string{"order-2"}},
+		{"created range", "&created_from=2000-01-01T00:00:00Z&created_to=2000-12-31T00:00:00Z", http.StatusOK, nil},
+		{"invalid status", "&status=shipped", http.StatusBadRequest, nil},
+		{"invalid date", "&created_from=yesterday", http.StatusBadRequest, nil},
+		{"inverted range", "&updated_from=2001-01-01T00:00:00Z&updated_to=2000-01-01T00:00:00Z", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := addLaboratoryIDQueryParamForOrder("/orders", "lab-123") + tt.query
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("List() status = %d, want %d, body = %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp dto.ListResponse[dto.OrderResponse]
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			var got []string
+			for _, o := range resp.Data {
+				got = append(got, o.ID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("List() got %v, want %v", got, tt.wantIDs)
+			}
+		})
 	}
 }
 
@@ -475,13 +743,13 @@ func TestOrderHandler_ListByClient_Success(t *testing.T) {
 		t.Errorf("ListByClient() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.OrderResponse
+	var resp dto.ListResponse[dto.OrderResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 2 {
-		t.Errorf("ListByClient() got %d orders, want 2", len(resp))
+	if len(resp.Data) != 2 {
+		t.Errorf("ListByClient() got %d orders, want 2", len(resp.Data))
 	}
 }
 
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/mergepatch"
+)
+
+// maxMergePatchSize bounds the merge patches read
+const maxMergePatchSize = 64 << 10
+
+// readMergePatch reads the JSON merge patch (RFC 7396) in the request body.
+// Bodies of another media type are refused, telling the client the one
+// accepted in the Accept-Patch header.
+func readMergePatch(c *gin.Context) ([]byte, error) {
+	if c.ContentType() != mergepatch.ContentType {
+		c.Header("Accept-Patch", mergepatch.ContentType)
+		return nil, errUnsupportedMediaType
+	}
+
+	patch, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxMergePatchSize))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return nil, errRequestTooLarge
+	}
+	if err != nil {
+		return nil, errMalformedBody
+	}
+	return patch, nil
+}
+
+// applyMergePatch applies a JSON merge patch to obj, the update request
+// holding the resource's current state, and decodes and validates the result
+// into obj like bindJSON. Members missing from the patch keep their current
+// value; null members are cleared.
+func applyMergePatch(c *gin.Context, patch []byte, obj any) error {
+	current, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	merged, err := mergepatch.Apply(current, patch)
+	if err != nil {
+		return errMalformedBody
+	}
+
+	// Decode into an empty request so that removed members are cleared
+	reflect.ValueOf(obj).Elem().SetZero()
+	c.Request.Body = io.NopCloser(bytes.NewReader(merged))
+	return bindJSON(c, obj)
+}
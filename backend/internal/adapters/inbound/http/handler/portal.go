@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// PortalHandler handles HTTP requests for the client portal.
+// The acting client is always derived from the authenticated identity,
+// never from query parameters.
+type PortalHandler struct {
+	service *portalapp.Service
+}
+
+// NewPortalHandler creates a new client portal handler
+func NewPortalHandler(service *portalapp.Service) *PortalHandler {
+	return &PortalHandler{service: service}
+}
+
+// Me handles GET /api/v1/portal/me
+func (h *PortalHandler) Me(c *gin.Context) {
+	client, err := h.service.CurrentClient(c.Request.Context(), auth.GetUserID(c.Request.Context()))
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToPortalClientResponse(client))
+}
+
+// CreateOrder handles POST /api/v1/portal/orders
+func (h *PortalHandler) CreateOrder(c *gin.Context) {
+	var req dto.PortalCreateOrderRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	o, err := h.service.CreateOrder(c.Request.Context(), auth.GetUserID(c.Request.Context()), dto.ToProsthesisItems(req.Prosthesis))
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToPortalOrderResponse(o))
+}
+
+// ListOrders handles GET /api/v1/portal/orders
+func (h *PortalHandler) ListOrders(c *gin.Context) {
+	q, err := parseListQuery(c, order.ListSpec)
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	criteria, err := parseOrderSearch(c)
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	page, err := h.service.ListOrders(c.Request.Context(), auth.GetUserID(c.Request.Context()), criteria, q)
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewListResponse(page, dto.ToPortalOrderResponseList(page.Items)))
+}
+
+// GetOrder handles GET /api/v1/portal/orders/:id
+func (h *PortalHandler) GetOrder(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	o, err := h.service.GetOrder(c.Request.Context(), auth.GetUserID(c.Request.Context()), id)
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToPortalOrderResponse(o))
+}
+
+// UploadAttachment handles POST /api/v1/portal/orders/:id/attachments (multipart field "file")
+func (h *PortalHandler) UploadAttachment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("file")))
+		return
+	}
+	if fileHeader.Size > order.MaxAttachmentSize {
+		_ = c.Error(errPayloadTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		_ = c.Error(domainerrors.Validation(domainerrors.InvalidFile("file")))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, order.MaxAttachmentSize+1))
+	if err != nil {
+		_ = c.Error(domainerrors.Validation(domainerrors.InvalidFile("file")))
+		return
+	}
+
+	contentType := fileHeader.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	attachment, err := h.service.UploadAttachment(c.Request.Context(), portalapp.UploadAttachmentInput{
+		UserID:      auth.GetUserID(c.Request.Context()),
+		OrderID:     id,
+		FileName:    fileHeader.Filename,
+		ContentType: contentType,
+		Data:        data,
+	})
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToAttachmentResponse(attachment))
+}
+
+// DownloadAttachment handles GET /api/v1/portal/orders/:id/attachments/:attachment_id
+func (h *PortalHandler) DownloadAttachment(c *gin.Context) {
+	id := c.Param("id")
+	attachmentID := c.Param("attachment_id")
+	if id == "" || attachmentID == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	attachment, data, err := h.service.DownloadAttachment(c.Request.Context(), auth.GetUserID(c.Request.Context()), id, attachmentID)
+	if err != nil {
+		_ = c.Error(portalError(err))
+		return
+	}
+
+	serveAttachment(c, attachment, data)
+}
+
+// serveAttachment sends the contents of an attachment as a download under its
+// file name, quoted or encoded as needed
+func serveAttachment(c *gin.Context, attachment *order.Attachment, data []byte) {
+	if disposition := mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}); disposition != "" {
+		c.Header("Content-Disposition", disposition)
+	} else {
+		c.Header("Content-Disposition", "attachment")
+	}
+	c.Data(http.StatusOK, attachment.ContentType, data)
+}
+
+// portalError explains forbidden portal requests, which mean no client is linked to the user
+func portalError(err error) error {
+	if errors.Is(err, domainerrors.ErrForbidden) {
+		return withDetail(err, i18n.KeyNoLinkedClient)
+	}
+	return err
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// withTestUser simulates the authentication middleware by putting the user ID in the context
+func withTestUser(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if userID != "" {
+			c.Request = c.Request.WithContext(auth.WithUserID(c.Request.Context(), userID))
+		}
+		c.Next()
+	}
+}
+
+func setupPortalTestRouter(userID string) (*gin.Engine, *memory.OrderRepository, *memory.ClientRepository) {
+	gin.SetMode(gin.TestMode)
+
+	orderRepo := memory.NewOrderRepository()
+	clientRepo := memory.NewClientRepository()
+	idGen := &mockOrderIDGenerator{id: "test-id-123"}
+	storage := memory.NewAttachmentStorage()
+	orderSvc := orderapp.NewService(orderRepo, clientRepo, memory.NewTechnicianRepository(), memory.NewProsthesisRepository(), storage, idGen, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor())
+	portalSvc := portalapp.NewService(clientRepo, orderSvc)
+	portalHandler := NewPortalHandler(portalSvc)
+
+	r := gin.New()
+	r.Use(Problems())
+	r.Use(withTestUser(userID))
+	r.GET("/portal/me", portalHandler.Me)
+	r.POST("/portal/orders", portalHandler.CreateOrder)
+	r.GET("/portal/orders", portalHandler.ListOrders)
+	r.GET("/portal/orders/:id", portalHandler.GetOrder)
+	r.POST("/portal/orders/:id/attachments", portalHandler.UploadAttachment)
+	r.GET("/portal/orders/:id/attachments/:attachment_id", portalHandler.DownloadAttachment)
+
+	// The laboratory side of the attachments
+	orderHandler := NewOrderHandler(orderSvc, nil)
+	r.GET("/orders/:id/attachments", orderHandler.ListAttachments)
+	r.GET("/orders/:id/attachments/:attachment_id", orderHandler.DownloadAttachment)
+
+	createTestClientForOrder(clientRepo, "client-123", "lab-123")
+	linked, _ := clientRepo.GetByID(nil, "client-123")
+	linked.PortalUserID = "user_dentist"
+	_ = clientRepo.Update(nil, linked)
+	createTestClientForOrder(clientRepo, "client-456", "lab-123")
+
+	createTestOrder(orderRepo, "order-own", "client-123", "lab-123")
+	createTestOrder(orderRepo, "order-other", "client-456", "lab-123")
+
+	return r, orderRepo, clientRepo
+}
+
+func TestPortalHandler_Me(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     string
+		wantStatus int
+	}{
+		{"linked identity", "user_dentist", http.StatusOK},
+		{"missing identity", "", http.StatusUnauthorized},
+		{"identity without client", "user_stranger", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, _, _ := setupPortalTestRouter(tt.userID)
+
+			req := httptest.NewRequest(http.MethodGet, "/portal/me", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Me() status = %v, want %v", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestPortalHandler_ListOrders_HidesInternalFields(t *testing.T) {
+	router, _, _ := setupPortalTestRouter("user_dentist")
+
+	req := httptest.NewRequest(http.MethodGet, "/portal/orders", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("ListOrders() status = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	var resp dto.ListResponse[map[string]interface{}]
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	raw := resp.Data
+	if len(raw) != 1 || raw[0]["id"] != "order-own" {
+		t.Fatalf("ListOrders() = %v, want only order-own", raw)
+	}
+	for _, field := range []string{"laboratory_id", "client_id"} {
+		if _, ok := raw[0][field]; ok {
+			t.Errorf("ListOrders() response exposes internal field %q", field)
+		}
+	}
+}
+
+func TestPortalHandler_GetOrder_OtherClient(t *testing.T) {
+	router, _, _ := setupPortalTestRouter("user_dentist")
+
+	req := httptest.NewRequest(http.MethodGet, "/portal/orders/order-other", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetOrder() status = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestPortalHandler_CreateOrder(t *testing.T) {
+	router, _, _ := setupPortalTestRouter("user_dentist")
+
+	body, _ := json.Marshal(dto.PortalCreateOrderRequest{
+		Prosthesis: []dto.ProsthesisItemRequest{
+			{Type: "crown", Material: "zirconia", Quantity: 2},
+		},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/portal/orders", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("CreateOrder() status = %v, want %v, body: %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var resp dto.PortalOrderResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if resp.Status != "received" || len(resp.History) != 1 {
+		t.Errorf("CreateOrder() status/history = %v/%v, want received with one history entry", resp.Status, resp.History)
+	}
+}
+
+func TestPortalHandler_UploadAttachment(t *testing.T) {
+	router, orderRepo, _ := setupPortalTestRouter("user_dentist")
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	const fileName = `coroa "final" ç.stl`
+	part, _ := writer.CreateFormFile("file", fileName)
+	_, _ = part.Write([]byte("solid scan"))
+	_ = writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/portal/orders/order-own/attachments", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("UploadAttachment() status = %v, want %v, body: %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var resp dto.AttachmentResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	stored, _ := orderRepo.GetByID(nil, "order-own")
+	if len(stored.Attachments) != 1 {
+		t.Fatalf("UploadAttachment() stored %d attachments, want 1", len(stored.Attachments))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/portal/orders/order-own/attachments/"+resp.ID, nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK || w.Body.String() != "solid scan" {
+		t.Errorf("DownloadAttachment() = %v %q, want %v %q", w.Code, w.Body.String(), http.StatusOK, "solid scan")
+	}
+	if _, params, err := mime.ParseMediaType(w.Header().Get("Content-Disposition")); err != nil || params["filename"] != fileName {
+		t.Errorf("DownloadAttachment() Content-Disposition = %q, want the file name %q", w.Header().Get("Content-Disposition"), fileName)
+	}
+
+	// The laboratory lists and downloads it too
+	req = httptest.NewRequest(http.MethodGet, "/orders/order-own/attachments?laboratory_id=lab-123", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var listed []dto.AttachmentResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &listed); err != nil || w.Code != http.StatusOK || len(listed) != 1 || listed[0].ID != resp.ID {
+		t.Errorf("ListAttachments() = %v %s, want the uploaded attachment", w.Code, w.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/orders/order-own/attachments/"+resp.ID+"?laboratory_id=lab-123", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK || w.Body.String() != "solid scan" {
+		t.Errorf("laboratory DownloadAttachment() = %v %q, want %v %q", w.Code, w.Body.String(), http.StatusOK, "solid scan")
+	}
+
+	// Other laboratories don't see it
+	req = httptest.NewRequest(http.MethodGet, "/orders/order-own/attachments/"+resp.ID+"?laboratory_id=lab-456", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("DownloadAttachment() from another laboratory status = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestPortalHandler_UploadAttachment_MissingFile(t *testing.T) {
+	router, _, _ := setupPortalTestRouter("user_dentist")
+
+	req := httptest.NewRequest(http.MethodPost, "/portal/orders/order-own/attachments", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("UploadAttachment() status = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
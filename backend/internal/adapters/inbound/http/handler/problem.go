@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+)
+
+// problemTypeBase prefixes the problem type URIs, e.g. /problems/validation_failed
+const problemTypeBase = "/problems/"
+
+var (
+	// errMalformedBody indicates a request body that is not valid JSON
+	errMalformedBody = errors.New("request body is not valid JSON")
+
+	// errPayloadTooLarge indicates an uploaded file above the size limit
+	errPayloadTooLarge = errors.New("file must be at most 10 MB")
+
+	// errRequestTooLarge indicates a request body above the size the server reads
+	errRequestTooLarge = errors.New("request body is too large")
+
+	// errUnsupportedMediaType indicates a request body of a media type the
+	// route doesn't accept
+	errUnsupportedMediaType = errors.New("unsupported media type")
+)
+
+// problemKind describes how a class of errors is reported. Its title and
+// detail are translated from the catalog keys problem.<code>.title and .detail.
+type problemKind struct {
+	status int
+	code   string
+}
+
+var (
+	problemValidation        = problemKind{http.StatusBadRequest, "validation_failed"}
+	problemMalformedBody     = problemKind{http.StatusBadRequest, "malformed_request"}
+	problemPayloadTooLarge   = problemKind{http.StatusRequestEntityTooLarge, "payload_too_large"}
+	problemRequestTooLarge   = problemKind{http.StatusRequestEntityTooLarge, "request_too_large"}
+	problemUnsupportedMedia  = problemKind{http.StatusUnsupportedMediaType, "unsupported_media_type"}
+	problemInvalidInput      = problemKind{http.StatusBadRequest, "invalid_input"}
+	problemInvalidTransition = problemKind{http.StatusBadRequest, "invalid_status_transition"}
+	problemUnauthorized      = problemKind{http.StatusUnauthorized, "unauthorized"}
+	problemForbidden         = problemKind{http.StatusForbidden, "forbidden"}
+	problemNotFound          = problemKind{http.StatusNotFound, "not_found"}
+	problemDuplicateEmail    = problemKind{http.StatusConflict, "duplicate_email"}
+	problemPortalUserLinked  = problemKind{http.StatusConflict, "portal_user_already_linked"}
+	problemIdempotencyReused = problemKind{http.StatusUnprocessableEntity, "idempotency_key_reused"}
+	problemIdempotencyBusy   = problemKind{http.StatusConflict, "idempotency_request_in_progress"}
+	problemRateLimited       = problemKind{http.StatusTooManyRequests, "rate_limited"}
+	problemInternal          = problemKind{http.StatusInternalServerError, "internal_error"}
+)
+
+// Problems is the Gin middleware that maps errors recorded by handlers with
+// c.Error to RFC 7807 application/problem+json responses, translated to the
+// language chosen by Localizer or, before it ran, by Accept-Language.
+func Problems() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		lang := requestLanguage(c)
+		p := newProblem(err, lang)
+		if p.Status == http.StatusInternalServerError {
+			slog.ErrorContext(c.Request.Context(), "request failed", "method", c.Request.Method, "path", c.Request.URL.Path, "error", err)
+		}
+		p.Instance = c.Request.URL.Path
+		p.RequestID = requestid.FromContext(c.Request.Context())
+
+		c.Header("Content-Type", dto.ProblemContentType)
+		c.Header("Content-Language", string(lang))
+		c.JSON(p.Status, p)
+	}
+}
+
+// newProblem converts an error to problem details in the given language
+func newProblem(err error, lang i18n.Language) dto.Problem {
+	kind := problemKindOf(err)
+	p := dto.Problem{
+		Type:   problemTypeBase + kind.code,
+		Title:  i18n.Translate(lang, "problem."+kind.code+".title", nil),
+		Status: kind.status,
+		Detail: i18n.Translate(lang, "problem."+kind.code+".detail", nil),
+		Code:   kind.code,
+	}
+
+	var validationErrors domainerrors.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		p.Errors = make([]dto.FieldErrorResponse, len(validationErrors))
+		for i, ve := range validationErrors {
+			p.Errors[i] = fieldErrorResponse(ve, lang)
+		}
+	}
+
+	var de *detailError
+	if errors.As(err, &de) {
+		p.Detail = i18n.Translate(lang, de.key, nil)
+	}
+
+	return p
+}
+
+// fieldErrorResponse translates a field error, keeping the English message
+// of errors that have no message key
+func fieldErrorResponse(ve domainerrors.ValidationError, lang i18n.Language) dto.FieldErrorResponse {
+	message := ve.Message
+	if ve.Key != "" {
+		message = i18n.Translate(lang, ve.Key, ve.Params)
+	}
+	return dto.FieldErrorResponse{
+		Field:   ve.Field,
+		Message: message,
+		Code:    ve.Key,
+		Params:  ve.Params,
+	}
+}
+
+// problemKindOf classifies an error
+func problemKindOf(err error) problemKind {
+	var validationErrors domainerrors.ValidationErrors
+	switch {
+	case errors.As(err, &validationErrors):
+		return problemValidation
+	case errors.Is(err, errMalformedBody):
+		return problemMalformedBody
+	case errors.Is(err, errPayloadTooLarge):
+		return problemPayloadTooLarge
+	case errors.Is(err, errRequestTooLarge):
+		return problemRequestTooLarge
+	case errors.Is(err, errUnsupportedMediaType):
+		return problemUnsupportedMedia
+	case errors.Is(err, domainerrors.ErrInvalidStatusTransition):
+		return problemInvalidTransition
+	case errors.Is(err, domainerrors.ErrInvalidInput):
+		return problemInvalidInput
+	case errors.Is(err, domainerrors.ErrUnauthorized), errors.Is(err, auth.ErrUnauthorized):
+		return problemUnauthorized
+	case errors.Is(err, domainerrors.ErrForbidden):
+		return problemForbidden
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return problemNotFound
+	case errors.Is(err, domainerrors.ErrDuplicateEmail):
+		return problemDuplicateEmail
+	case errors.Is(err, domainerrors.ErrPortalUserAlreadyLinked):
+		return problemPortalUserLinked
+	case errors.Is(err, domainerrors.ErrIdempotencyKeyReused):
+		return problemIdempotencyReused
+	case errors.Is(err, domainerrors.ErrIdempotencyRequestInProgress):
+		return problemIdempotencyBusy
+	case errors.Is(err, ratelimit.ErrRateLimited):
+		return problemRateLimited
+	default:
+		return problemInternal
+	}
+}
+
+// detailError overrides the default problem detail of the error it wraps
+type detailError struct {
+	err error
+	key string // Catalog key of the detail
+}
+
+func (e *detailError) Error() string { return i18n.Translate(i18n.En, e.key, nil) }
+
+func (e *detailError) Unwrap() error { return e.err }
+
+// withDetail wraps an error with a specific problem detail, given as a catalog key
+func withDetail(err error, key string) error {
+	return &detailError{err: err, key: key}
+}
+
+// bindJSON decodes and validates the JSON request body. Binding failures are
+// returned as validation errors naming every invalid field by its JSON path.
+func bindJSON(c *gin.Context, obj any) error {
+	err := c.ShouldBindJSON(obj)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrors validator.ValidationErrors
+	if errors.As(err, &fieldErrors) {
+		validationErrors := make(domainerrors.ValidationErrors, len(fieldErrors))
+		for i, fe := range fieldErrors {
+			validationErrors[i] = bindingError(fieldPath(fe), fe)
+		}
+		return validationErrors
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return domainerrors.Validation(domainerrors.InvalidType(typeErrorPath(typeErr.Field), typeErr.Type.String()))
+	}
+
+	return errMalformedBody
+}
+
+// fieldPath returns the JSON path of an invalid field without the struct name,
+// e.g. prosthesis[0].quantity
+func fieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if i := strings.Index(ns, "."); i >= 0 {
+		return ns[i+1:]
+	}
+	return ns
+}
+
+// typeErrorPath converts a JSON decoding path such as prosthesis.0.quantity
+// to the notation of binding errors, prosthesis[0].quantity
+func typeErrorPath(field string) string {
+	parts := strings.Split(field, ".")
+	path := parts[0]
+	for _, part := range parts[1:] {
+		if _, err := strconv.Atoi(part); err == nil {
+			path += "[" + part + "]"
+		} else {
+			path += "." + part
+		}
+	}
+	return path
+}
+
+// bindingError converts a failed binding rule to a field error
+func bindingError(field string, fe validator.FieldError) domainerrors.ValidationError {
+	switch fe.Tag() {
+	case "required":
+		return domainerrors.Required(field)
+	case "email":
+		return domainerrors.InvalidEmail(field)
+	case "gt":
+		if min, err := strconv.Atoi(fe.Param()); err == nil {
+			return domainerrors.GreaterThan(field, min)
+		}
+		return domainerrors.Invalid(field)
+	case "gte", "min":
+		return domainerrors.AtLeast(field, fe.Param())
+	case "lte", "max":
+		return domainerrors.AtMost(field, fe.Param())
+	case "oneof":
+		return domainerrors.InvalidChoice(field, strings.Fields(fe.Param()))
+	default:
+		return domainerrors.Invalid(field)
+	}
+}
+
+// init makes binding errors name fields by their JSON names
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+			if name == "-" || name == "" {
+				return f.Name
+			}
+			return name
+		})
+	}
+}
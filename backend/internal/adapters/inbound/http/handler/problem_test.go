@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+)
+
+func serveProblem(t *testing.T, err error) (*httptest.ResponseRecorder, dto.Problem) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.Use(Problems())
+	r.GET("/fail", func(c *gin.Context) {
+		_ = c.Error(err)
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fail", nil))
+
+	var p dto.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("Failed to unmarshal problem: %v", err)
+	}
+	return rec, p
+}
+
+func TestProblems_MapsDomainErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"validation", domainerrors.Validation(domainerrors.Required("name")), http.StatusBadRequest, "validation_failed"},
+		{"malformed body", errMalformedBody, http.StatusBadRequest, "malformed_request"},
+		{"payload too large", errPayloadTooLarge, http.StatusRequestEntityTooLarge, "payload_too_large"},
+		{"request too large", errRequestTooLarge, http.StatusRequestEntityTooLarge, "request_too_large"},
+		{"unsupported media type", errUnsupportedMediaType, http.StatusUnsupportedMediaType, "unsupported_media_type"},
+		{"invalid transition", domainerrors.ErrInvalidStatusTransition, http.StatusBadRequest, "invalid_status_transition"},
+		{"unauthorized", domainerrors.ErrUnauthorized, http.StatusUnauthorized, "unauthorized"},
+		{"not authenticated", auth.ErrUnauthorized, http.StatusUnauthorized, "unauthorized"},
+		{"forbidden", domainerrors.ErrForbidden, http.StatusForbidden, "forbidden"},
+		{"not found", domainerrors.ErrNotFound, http.StatusNotFound, "not_found"},
+		{"duplicate email", domainerrors.ErrDuplicateEmail, http.StatusConflict, "duplicate_email"},
+		{"portal user linked", domainerrors.ErrPortalUserAlreadyLinked, http.StatusConflict, "portal_user_already_linked"},
+		{"idempotency key reused", domainerrors.ErrIdempotencyKeyReused, http.StatusUnprocessableEntity, "idempotency_key_reused"},
+		{"idempotency request in progress", domainerrors.ErrIdempotencyRequestInProgress, http.StatusConflict, "idempotency_request_in_progress"},
+		{"rate limited", ratelimit.ErrRateLimited, http.StatusTooManyRequests, "rate_limited"},
+		{"unknown", errors.New("boom"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, p := serveProblem(t, tt.err)
+
+			if rec.Code != tt.wantStatus || p.Status != tt.wantStatus {
+				t.Errorf("status = %d (body %d), want %d", rec.Code, p.Status, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Content-Type"); got != dto.ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", got, dto.ProblemContentType)
+			}
+			if p.Code != tt.wantCode || p.Type != "/problems/"+tt.wantCode {
+				t.Errorf("code = %q, type = %q, want %q", p.Code, p.Type, tt.wantCode)
+			}
+			if p.Instance != "/fail" {
+				t.Errorf("instance = %q, want /fail", p.Instance)
+			}
+		})
+	}
+}
+
+func TestProblems_InternalErrorHidesDetails(t *testing.T) {
+	_, p := serveProblem(t, errors.New("database password rejected"))
+
+	if strings.Contains(p.Detail, "password") {
+		t.Errorf("detail leaks internal error: %q", p.Detail)
+	}
+}
+
+func TestProblems_ListsEveryFieldError(t *testing.T) {
+	_, p := serveProblem(t, domainerrors.ValidationErrors{
+		{Field: "name", Message: "name is required"},
+		{Field: "email", Message: "invalid email format"},
+	})
+
+	if len(p.Errors) != 2 || p.Errors[0].Field != "name" || p.Errors[1].Field != "email" {
+		t.Errorf("errors = %+v, want name and email", p.Errors)
+	}
+}
+
+func TestProblems_WithDetail(t *testing.T) {
+	_, p := serveProblem(t, withDetail(domainerrors.ErrForbidden, i18n.KeyNoLinkedClient))
+
+	if p.Code != "forbidden" || p.Detail != "nenhum cliente está vinculado a este usuário" {
+		t.Errorf("code = %q, detail = %q", p.Code, p.Detail)
+	}
+}
+
+func TestBindJSON_ReportsEveryInvalidField(t *testing.T) {
+	router, _, _, _, _ := setupOrderTestRouter()
+
+	tests := []struct {
+		name       string
+		body       string
+		wantCode   string
+		wantFields []string
+	}{
+		{
+			name:       "missing and invalid fields",
+			body:       `{"prosthesis": [{"type": "crown", "quantity": 0}]}`,
+			wantCode:   "validation_failed",
+			wantFields: []string{"client_id", "prosthesis[0].material", "prosthesis[0].quantity"},
+		},
+		{
+			name:       "wrong type",
+			body:       `{"client_id": "client-123", "prosthesis": [{"type": "crown", "material": "zirconia", "quantity": "one"}]}`,
+			wantCode:   "validation_failed",
+			wantFields: []string{"prosthesis[0].quantity"},
+		},
+		{
+			name:     "malformed json",
+			body:     `{"client_id": `,
+			wantCode: "malformed_request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/orders?laboratory_id=lab-123", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+
+			var p dto.Problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+				t.Fatalf("Failed to unmarshal problem: %v", err)
+			}
+			if p.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", p.Code, tt.wantCode)
+			}
+
+			var fields []string
+			for _, fe := range p.Errors {
+				fields = append(fields, fe.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
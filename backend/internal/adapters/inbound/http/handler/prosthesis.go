@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -26,7 +25,7 @@ func NewProsthesisHandler(service *prosthesisapp.Service) *ProsthesisHandler {
 func (h *ProsthesisHandler) getLaboratoryID(c *gin.Context) (string, error) {
 	laboratoryID := c.Query("laboratory_id")
 	if laboratoryID == "" {
-		return "", errors.New("laboratory_id query parameter is required")
+		return "", domainerrors.Validation(domainerrors.Required("laboratory_id"))
 	}
 	return laboratoryID, nil
 }
@@ -36,25 +35,19 @@ func (h *ProsthesisHandler) Create(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	var req dto.CreateProsthesisRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	prosthesisType := prosthesis.ProsthesisType(req.Type)
 	if !prosthesisType.IsValid() {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid prosthesis type",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.InvalidChoice("type", prosthesis.AllProsthesisTypes())))
 		return
 	}
 
@@ -69,7 +62,7 @@ func (h *ProsthesisHandler) Create(c *gin.Context) {
 
 	p, err := h.service.CreateProsthesis(c.Request.Context(), input)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -81,23 +74,19 @@ func (h *ProsthesisHandler) Get(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "prosthesis id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	p, err := h.service.GetProsthesis(c.Request.Context(), id, laboratoryID)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -109,37 +98,82 @@ func (h *ProsthesisHandler) Update(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "prosthesis id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	var req dto.UpdateProsthesisRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
+	input, err := updateProsthesisInput(id, laboratoryID, req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	p, err := h.service.UpdateProsthesis(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToProsthesisResponse(p))
+}
+
+// Patch handles PATCH /api/v1/prostheses/:id with a JSON merge patch
+func (h *ProsthesisHandler) Patch(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	p, err := h.service.PatchProsthesis(c.Request.Context(), id, laboratoryID, func(current prosthesis.Prosthesis) (prosthesisapp.UpdateInput, error) {
+		req := dto.ToUpdateProsthesisRequest(&current)
+		if err := applyMergePatch(c, patch, &req); err != nil {
+			return prosthesisapp.UpdateInput{}, err
+		}
+		return updateProsthesisInput(id, laboratoryID, req)
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToProsthesisResponse(p))
+}
+
+// updateProsthesisInput returns the input replacing the prosthesis's fields
+// with those of the request
+func updateProsthesisInput(id, laboratoryID string, req dto.UpdateProsthesisRequest) (prosthesisapp.UpdateInput, error) {
 	prosthesisType := prosthesis.ProsthesisType(req.Type)
 	if !prosthesisType.IsValid() {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid prosthesis type",
-		})
-		return
+		return prosthesisapp.UpdateInput{}, domainerrors.Validation(domainerrors.InvalidChoice("type", prosthesis.AllProsthesisTypes()))
 	}
 
-	input := prosthesisapp.UpdateInput{
+	return prosthesisapp.UpdateInput{
 		ID:             id,
 		LaboratoryID:   laboratoryID,
 		Type:           prosthesisType,
@@ -147,15 +181,7 @@ func (h *ProsthesisHandler) Update(c *gin.Context) {
 		Shade:          req.Shade,
 		Specifications: req.Specifications,
 		Notes:          req.Notes,
-	}
-
-	p, err := h.service.UpdateProsthesis(c.Request.Context(), input)
-	if err != nil {
-		h.handleError(c, err)
-		return
-	}
-
-	c.JSON(http.StatusOK, dto.ToProsthesisResponse(p))
+	}, nil
 }
 
 // List handles GET /api/v1/prostheses
@@ -163,37 +189,29 @@ func (h *ProsthesisHandler) List(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	// Parse optional filters
-	var prosthesisType *prosthesis.ProsthesisType
-	if typeParam := c.Query("type"); typeParam != "" {
-		pt := prosthesis.ProsthesisType(typeParam)
-		if !pt.IsValid() {
-			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-				Error: "invalid prosthesis type",
-			})
-			return
-		}
-		prosthesisType = &pt
+	// Validate the type filter before it reaches the generic list query
+	if typeParam := c.Query("type"); typeParam != "" && !prosthesis.ProsthesisType(typeParam).IsValid() {
+		_ = c.Error(domainerrors.Validation(domainerrors.InvalidChoice("type", prosthesis.AllProsthesisTypes())))
+		return
 	}
 
-	var material *string
-	if materialParam := c.Query("material"); materialParam != "" {
-		material = &materialParam
+	q, err := parseListQuery(c, prosthesis.ListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
 	}
 
-	prostheses, err := h.service.ListProstheses(c.Request.Context(), laboratoryID, prosthesisType, material)
+	page, err := h.service.ListProstheses(c.Request.Context(), laboratoryID, q)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToProsthesisResponseList(prostheses))
+	c.JSON(http.StatusOK, dto.NewListResponse(page, dto.ToProsthesisResponseList(page.Items)))
 }
 
 // Delete handles DELETE /api/v1/prostheses/:id
@@ -201,60 +219,21 @@ func (h *ProsthesisHandler) Delete(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "prosthesis id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	err = h.service.DeleteProsthesis(c.Request.Context(), id, laboratoryID)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
-
-// handleError converts domain errors to HTTP responses
-func (h *ProsthesisHandler) handleError(c *gin.Context, err error) {
-	var validationErrors domainerrors.ValidationErrors
-	if errors.As(err, &validationErrors) {
-		details := make(map[string]string)
-		for _, ve := range validationErrors {
-			details[ve.Field] = ve.Message
-		}
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation failed",
-			Details: details,
-		})
-		return
-	}
-
-	switch {
-	case errors.Is(err, domainerrors.ErrNotFound):
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "prosthesis not found",
-		})
-	case errors.Is(err, domainerrors.ErrUnauthorized):
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error: "unauthorized",
-		})
-	case errors.Is(err, domainerrors.ErrForbidden):
-		c.JSON(http.StatusForbidden, dto.ErrorResponse{
-			Error: "forbidden",
-		})
-	default:
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "internal server error",
-		})
-	}
-}
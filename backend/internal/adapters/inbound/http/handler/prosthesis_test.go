@@ -12,7 +12,9 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
 	prosthesisapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/prosthesis"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
@@ -33,10 +35,11 @@ func setupProsthesisTestRouter() (*gin.Engine, *prosthesisapp.Service, *memory.P
 	prosthesisRepo := memory.NewProsthesisRepository()
 	labRepo := memory.NewLaboratoryRepository()
 	idGen := &mockProsthesisIDGenerator{id: "test-id-123"}
-	svc := prosthesisapp.NewService(prosthesisRepo, labRepo, idGen)
+	svc := prosthesisapp.NewService(prosthesisRepo, labRepo, idGen, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor())
 	handler := NewProsthesisHandler(svc)
 
 	r := gin.New()
+	r.Use(Problems())
 	r.POST("/prostheses", handler.Create)
 	r.GET("/prostheses/:id", handler.Get)
 	r.PUT("/prostheses/:id", handler.Update)
@@ -254,13 +257,13 @@ func TestProsthesisHandler_List_Success(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.ProsthesisResponse
+	var resp dto.ListResponse[dto.ProsthesisResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 2 {
-		t.Errorf("List() count = %v, want %v", len(resp), 2)
+	if len(resp.Data) != 2 {
+		t.Errorf("List() count = %v, want %v", len(resp.Data), 2)
 	}
 }
 
@@ -282,16 +285,16 @@ func TestProsthesisHandler_List_FilteredByType(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.ProsthesisResponse
+	var resp dto.ListResponse[dto.ProsthesisResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 1 {
-		t.Errorf("List() count = %v, want %v", len(resp), 1)
+	if len(resp.Data) != 1 {
+		t.Errorf("List() count = %v, want %v", len(resp.Data), 1)
 	}
-	if resp[0].Type != "crown" {
-		t.Errorf("List() Type = %v, want %v", resp[0].Type, "crown")
+	if resp.Data[0].Type != "crown" {
+		t.Errorf("List() Type = %v, want %v", resp.Data[0].Type, "crown")
 	}
 }
 
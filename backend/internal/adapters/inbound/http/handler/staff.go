@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// RequireStaff restricts the routes of a group to laboratory staff: identities
+// bound to a client through the portal are forbidden, as they would otherwise
+// reach any laboratory's data through its laboratory_id.
+func RequireStaff(portal *portalapp.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		isPortalUser, err := portal.IsPortalUser(c.Request.Context(), auth.GetUserID(c.Request.Context()))
+		if err == nil && isPortalUser {
+			err = domainerrors.ErrForbidden
+		}
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
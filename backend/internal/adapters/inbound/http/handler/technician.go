@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -26,7 +25,7 @@ func NewTechnicianHandler(service *techapp.Service) *TechnicianHandler {
 func (h *TechnicianHandler) getLaboratoryID(c *gin.Context) (string, error) {
 	laboratoryID := c.Query("laboratory_id")
 	if laboratoryID == "" {
-		return "", errors.New("laboratory_id query parameter is required")
+		return "", domainerrors.Validation(domainerrors.Required("laboratory_id"))
 	}
 	return laboratoryID, nil
 }
@@ -36,26 +35,20 @@ func (h *TechnicianHandler) Create(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	var req dto.CreateTechnicianRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
 	// Convert role string to Role enum
 	role, err := dto.ToRole(req.Role)
 	if err != nil || !role.IsValid() {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid role. Must be one of: senior_technician, technician, apprentice",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.InvalidChoice("role", technician.AllRoles())))
 		return
 	}
 
@@ -70,7 +63,7 @@ func (h *TechnicianHandler) Create(c *gin.Context) {
 
 	tech, err := h.service.CreateTechnician(c.Request.Context(), input)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -82,23 +75,19 @@ func (h *TechnicianHandler) Get(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "technician id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	tech, err := h.service.GetTechnician(c.Request.Context(), id, laboratoryID)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
@@ -110,38 +99,83 @@ func (h *TechnicianHandler) Update(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "technician id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	var req dto.UpdateTechnicianRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid request body",
-		})
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
 		return
 	}
 
+	input, err := updateTechnicianInput(id, laboratoryID, req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	tech, err := h.service.UpdateTechnician(c.Request.Context(), input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToTechnicianResponse(tech))
+}
+
+// Patch handles PATCH /api/v1/technicians/:id?laboratory_id=xxx with a JSON merge patch
+func (h *TechnicianHandler) Patch(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	tech, err := h.service.PatchTechnician(c.Request.Context(), id, laboratoryID, func(current technician.Technician) (techapp.UpdateInput, error) {
+		req := dto.ToUpdateTechnicianRequest(&current)
+		if err := applyMergePatch(c, patch, &req); err != nil {
+			return techapp.UpdateInput{}, err
+		}
+		return updateTechnicianInput(id, laboratoryID, req)
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToTechnicianResponse(tech))
+}
+
+// updateTechnicianInput returns the input replacing the technician's fields
+// with those of the request
+func updateTechnicianInput(id, laboratoryID string, req dto.UpdateTechnicianRequest) (techapp.UpdateInput, error) {
 	// Convert role string to Role enum
 	role, err := dto.ToRole(req.Role)
 	if err != nil || !role.IsValid() {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "invalid role. Must be one of: senior_technician, technician, apprentice",
-		})
-		return
+		return techapp.UpdateInput{}, domainerrors.Validation(domainerrors.InvalidChoice("role", technician.AllRoles()))
 	}
 
-	input := techapp.UpdateInput{
+	return techapp.UpdateInput{
 		ID:              id,
 		LaboratoryID:    laboratoryID,
 		Name:            req.Name,
@@ -149,15 +183,7 @@ func (h *TechnicianHandler) Update(c *gin.Context) {
 		Phone:           req.Phone,
 		Role:            role,
 		Specializations: req.Specializations,
-	}
-
-	tech, err := h.service.UpdateTechnician(c.Request.Context(), input)
-	if err != nil {
-		h.handleError(c, err)
-		return
-	}
-
-	c.JSON(http.StatusOK, dto.ToTechnicianResponse(tech))
+	}, nil
 }
 
 // List handles GET /api/v1/technicians?laboratory_id=xxx&role=xxx
@@ -165,33 +191,31 @@ func (h *TechnicianHandler) List(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	// Get optional role filter
-	var roleFilter *technician.Role
-	roleStr := c.Query("role")
-	if roleStr != "" {
-		role, err := dto.ToRole(roleStr)
-		if err != nil || !role.IsValid() {
-			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-				Error: "invalid role filter. Must be one of: senior_technician, technician, apprentice",
-			})
+	// Validate the role filter before it reaches the generic list query
+	if roleStr := c.Query("role"); roleStr != "" {
+		if _, err := dto.ToRole(roleStr); err != nil {
+			_ = c.Error(domainerrors.Validation(domainerrors.InvalidChoice("role", technician.AllRoles())))
 			return
 		}
-		roleFilter = &role
 	}
 
-	techs, err := h.service.ListTechnicians(c.Request.Context(), laboratoryID, roleFilter)
+	q, err := parseListQuery(c, technician.ListSpec)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.ToTechnicianResponseList(techs))
+	page, err := h.service.ListTechnicians(c.Request.Context(), laboratoryID, q)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewListResponse(page, dto.ToTechnicianResponseList(page.Items)))
 }
 
 // Delete handles DELETE /api/v1/technicians/:id?laboratory_id=xxx
@@ -199,64 +223,51 @@ func (h *TechnicianHandler) Delete(c *gin.Context) {
 	// Get laboratory ID from query parameter
 	laboratoryID, err := h.getLaboratoryID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	id := c.Param("id")
 	if id == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "technician id is required",
-		})
+		_ = c.Error(domainerrors.Validation(domainerrors.Required("id")))
 		return
 	}
 
 	err = h.service.DeleteTechnician(c.Request.Context(), id, laboratoryID)
 	if err != nil {
-		h.handleError(c, err)
+		_ = c.Error(err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
-// handleError converts domain errors to HTTP responses
-func (h *TechnicianHandler) handleError(c *gin.Context, err error) {
-	var validationErrors domainerrors.ValidationErrors
-	if errors.As(err, &validationErrors) {
-		details := make(map[string]string)
-		for _, ve := range validationErrors {
-			details[ve.Field] = ve.Message
-		}
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "validation failed",
-			Details: details,
-		})
+// BulkDelete handles POST /api/v1/technicians/bulk/delete?laboratory_id=xxx
+func (h *TechnicianHandler) BulkDelete(c *gin.Context) {
+	// Get laboratory ID from query parameter
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
-	switch {
-	case errors.Is(err, domainerrors.ErrNotFound):
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "technician not found",
-		})
-	case errors.Is(err, domainerrors.ErrDuplicateEmail):
-		c.JSON(http.StatusConflict, dto.ErrorResponse{
-			Error: "email already exists",
-		})
-	case errors.Is(err, domainerrors.ErrUnauthorized):
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error: "unauthorized",
-		})
-	case errors.Is(err, domainerrors.ErrForbidden):
-		c.JSON(http.StatusForbidden, dto.ErrorResponse{
-			Error: "forbidden",
-		})
-	default:
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "internal server error",
-		})
+	var req dto.BulkDeleteTechniciansRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
 	}
+
+	items := make([]techapp.DeletionInput, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = techapp.DeletionInput{ID: item.ID, ReassignTo: item.ReassignTo}
+	}
+
+	results := h.service.BulkDeleteTechnicians(c.Request.Context(), laboratoryID, items, req.Atomic)
+	c.JSON(http.StatusOK, bulkResponse(c, results, func(d techapp.Deletion) dto.TechnicianDeletionResponse {
+		orders := d.ReassignedOrders
+		if orders == nil {
+			orders = []string{}
+		}
+		return dto.TechnicianDeletionResponse{ID: d.Technician.ID, ReassignedOrders: orders}
+	}))
 }
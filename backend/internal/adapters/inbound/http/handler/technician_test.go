@@ -11,7 +11,9 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
 	techapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/technician"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
@@ -32,15 +34,18 @@ func setupTechTestRouter() (*gin.Engine, *techapp.Service, *memory.TechnicianRep
 	techRepo := memory.NewTechnicianRepository()
 	labRepo := memory.NewLaboratoryRepository()
 	idGen := &mockTechIDGenerator{id: "tech-123"}
-	svc := techapp.NewService(techRepo, labRepo, idGen)
+	svc := techapp.NewService(techRepo, labRepo, memory.NewOrderRepository(), idGen, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor())
 	handler := NewTechnicianHandler(svc)
 
 	r := gin.New()
+	r.Use(Problems())
 	r.POST("/technicians", handler.Create)
 	r.GET("/technicians/:id", handler.Get)
 	r.PUT("/technicians/:id", handler.Update)
+	r.PATCH("/technicians/:id", handler.Patch)
 	r.GET("/technicians", handler.List)
 	r.DELETE("/technicians/:id", handler.Delete)
+	r.POST("/technicians/bulk/delete", handler.BulkDelete)
 
 	return r, svc, techRepo, labRepo
 }
@@ -191,6 +196,46 @@ func TestTechnicianHandler_Get_NotFound(t *testing.T) {
 	}
 }
 
+func TestTechnicianHandler_Patch(t *testing.T) {
+	router, _, techRepo, labRepo := setupTechTestRouter()
+	createTestLaboratoryForTech(labRepo, "lab-123")
+	createTestTechnician(techRepo, "tech-1", "lab-123")
+
+	patches := []struct {
+		patch               string
+		wantRole            string
+		wantSpecializations []string
+	}{
+		{`{"role":"senior_technician","specializations":["crowns","bridges"]}`, "senior_technician", []string{"crowns", "bridges"}},
+		{`{"specializations":null}`, "senior_technician", nil},
+	}
+
+	for _, p := range patches {
+		req := httptest.NewRequest(http.MethodPatch, "/technicians/tech-1?laboratory_id=lab-123", bytes.NewReader([]byte(p.patch)))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Patch(%s) status = %d, want %d, body = %s", p.patch, rec.Code, http.StatusOK, rec.Body.String())
+		}
+
+		var resp dto.TechnicianResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if resp.Name != "John Doe" || resp.Email != "john@lab.com" {
+			t.Errorf("Patch(%s) changed untouched fields: name = %q, email = %q", p.patch, resp.Name, resp.Email)
+		}
+		if resp.Role != p.wantRole {
+			t.Errorf("Patch(%s) Role = %q, want %q", p.patch, resp.Role, p.wantRole)
+		}
+		if len(resp.Specializations) != len(p.wantSpecializations) {
+			t.Errorf("Patch(%s) Specializations = %v, want %v", p.patch, resp.Specializations, p.wantSpecializations)
+		}
+	}
+}
+
 func TestTechnicianHandler_List_Success(t *testing.T) {
 	router, _, techRepo, labRepo := setupTechTestRouter()
 	createTestLaboratoryForTech(labRepo, "lab-123")
@@ -205,13 +250,13 @@ func TestTechnicianHandler_List_Success(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.TechnicianResponse
+	var resp dto.ListResponse[dto.TechnicianResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 2 {
-		t.Errorf("List() got %d techs, want 2", len(resp))
+	if len(resp.Data) != 2 {
+		t.Errorf("List() got %d techs, want 2", len(resp.Data))
 	}
 }
 
@@ -240,16 +285,16 @@ func TestTechnicianHandler_List_FilteredByRole(t *testing.T) {
 		t.Errorf("List() status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp []dto.TechnicianResponse
+	var resp dto.ListResponse[dto.TechnicianResponse]
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(resp) != 1 {
-		t.Errorf("List() with role filter got %d techs, want 1", len(resp))
+	if len(resp.Data) != 1 {
+		t.Errorf("List() with role filter got %d techs, want 1", len(resp.Data))
 	}
-	if resp[0].Role != "senior_technician" {
-		t.Errorf("List() Role = %v, want %v", resp[0].Role, "senior_technician")
+	if resp.Data[0].Role != "senior_technician" {
+		t.Errorf("List() Role = %v, want %v", resp.Data[0].Role, "senior_technician")
 	}
 }
 
@@ -275,3 +320,51 @@ func TestTechnicianHandler_Delete_Success(t *testing.T) {
 		t.Errorf("Get() after Delete() status = %d, want %d", rec.Code, http.StatusNotFound)
 	}
 }
+
+func TestTechnicianHandler_BulkDelete(t *testing.T) {
+	router, _, techRepo, labRepo := setupTechTestRouter()
+	createTestLaboratoryForTech(labRepo, "lab-123")
+	createTestTechnician(techRepo, "tech-1", "lab-123")
+	createTestTechnician(techRepo, "tech-2", "lab-123")
+	createTestTechnician(techRepo, "tech-3", "lab-123")
+
+	reqBody := dto.BulkDeleteTechniciansRequest{
+		Items: []dto.BulkDeleteTechnicianItem{
+			{ID: "tech-1", ReassignTo: "tech-2"},
+			{ID: "tech-3", ReassignTo: "non-existent"},
+			{ID: "tech-1"},
+		},
+	}
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/technicians/bulk/delete?laboratory_id=lab-123", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "en")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("BulkDelete() status = %d, want %d, body = %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp dto.BulkResponse[dto.TechnicianDeletionResponse]
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if resp.Succeeded != 1 || resp.Failed != 2 {
+		t.Errorf("BulkDelete() succeeded/failed = %d/%d, want 1/2", resp.Succeeded, resp.Failed)
+	}
+	if d := resp.Results[0].Data; d == nil || d.ID != "tech-1" || d.ReassignedOrders == nil {
+		t.Errorf("BulkDelete() results[0].Data = %+v, want tech-1 with reassigned orders", d)
+	}
+	if e := resp.Results[1].Error; e == nil || len(e.Errors) != 1 || e.Errors[0].Field != "reassign_to" {
+		t.Errorf("BulkDelete() results[1].Error = %+v, want a reassign_to field error", e)
+	}
+	if e := resp.Results[2].Error; e == nil || len(e.Errors) != 1 || e.Errors[0].Field != "id" {
+		t.Errorf("BulkDelete() results[2].Error = %+v, want an id field error", e)
+	}
+
+	if _, err := techRepo.GetByID(nil, "tech-3"); err != nil {
+		t.Errorf("tech-3 deleted: GetByID() error = %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	webhookapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/webhook"
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+// WebhookHandler handles HTTP requests for webhook subscriptions and their delivery logs
+type WebhookHandler struct {
+	service *webhookapp.Service
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(service *webhookapp.Service) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+// getLaboratoryID extracts laboratory_id from query parameter
+func (h *WebhookHandler) getLaboratoryID(c *gin.Context) (string, error) {
+	laboratoryID := c.Query("laboratory_id")
+	if laboratoryID == "" {
+		return "", domainerrors.Validation(domainerrors.Required("laboratory_id"))
+	}
+	return laboratoryID, nil
+}
+
+// Create handles POST /api/v1/webhooks
+func (h *WebhookHandler) Create(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	sub, err := h.service.CreateSubscription(c.Request.Context(), webhookapp.CreateInput{
+		LaboratoryID: laboratoryID,
+		URL:          req.URL,
+		EventTypes:   dto.ToEventTypes(req.EventTypes),
+		Secret:       req.Secret,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToWebhookResponse(sub))
+}
+
+// List handles GET /api/v1/webhooks
+func (h *WebhookHandler) List(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	subs, err := h.service.ListSubscriptions(c.Request.Context(), laboratoryID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookResponseList(subs))
+}
+
+// Get handles GET /api/v1/webhooks/:id
+func (h *WebhookHandler) Get(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	sub, err := h.service.GetSubscription(c.Request.Context(), c.Param("id"), laboratoryID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookResponse(sub))
+}
+
+// Update handles PUT /api/v1/webhooks/:id
+func (h *WebhookHandler) Update(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := bindJSON(c, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	sub, err := h.service.UpdateSubscription(c.Request.Context(), webhookapp.UpdateInput{
+		ID:           c.Param("id"),
+		LaboratoryID: laboratoryID,
+		URL:          req.URL,
+		EventTypes:   dto.ToEventTypes(req.EventTypes),
+		Secret:       req.Secret,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookResponse(sub))
+}
+
+// Delete handles DELETE /api/v1/webhooks/:id
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := h.service.DeleteSubscription(c.Request.Context(), c.Param("id"), laboratoryID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListDeliveries handles GET /api/v1/webhooks/:id/deliveries?laboratory_id=xxx&status=xxx&limit=n
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := webhook.DeliveryFilter{
+		SubscriptionID: c.Param("id"),
+		Status:         webhook.DeliveryStatus(c.Query("status")),
+	}
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			_ = c.Error(domainerrors.Validation(domainerrors.PositiveInteger("limit")))
+			return
+		}
+		filter.Limit = limit
+	}
+
+	deliveries, err := h.service.ListDeliveries(c.Request.Context(), laboratoryID, filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookDeliveryResponseList(deliveries))
+}
+
+// GetDelivery handles GET /api/v1/webhooks/:id/deliveries/:delivery_id
+func (h *WebhookHandler) GetDelivery(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	d, err := h.service.GetDelivery(c.Request.Context(), c.Param("delivery_id"), c.Param("id"), laboratoryID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToWebhookDeliveryResponse(d))
+}
+
+// Redeliver handles POST /api/v1/webhooks/:id/deliveries/:delivery_id/redeliver.
+// The new delivery is queued and sent by the worker.
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	laboratoryID, err := h.getLaboratoryID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	d, err := h.service.Redeliver(c.Request.Context(), c.Param("delivery_id"), c.Param("id"), laboratoryID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.ToWebhookDeliveryResponse(d))
+}
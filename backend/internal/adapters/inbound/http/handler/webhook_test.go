@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/webhooksender"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	webhookapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/webhook"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+const testWebhookSecret = "0123456789abcdef"
+
+// webhookTestSetup holds the router and the background parts of webhooks
+type webhookTestSetup struct {
+	router     *gin.Engine
+	dispatcher *webhookapp.Dispatcher
+	worker     *webhookapp.Worker
+}
+
+// newTestSender returns a webhook sender trusting the certificate that
+// httptest TLS servers share and allowed to reach them on loopback
+func newTestSender() *webhooksender.HTTPSender {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	return webhooksender.NewHTTPSender(webhooksender.Config{Timeout: time.Second, AllowPrivateNetworks: true, RootCAs: pool})
+}
+
+func setupWebhookTestRouter() webhookTestSetup {
+	gin.SetMode(gin.TestMode)
+
+	labRepo := memory.NewLaboratoryRepository()
+	_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"})
+	subs := memory.NewWebhookSubscriptionRepository()
+	deliveries := memory.NewWebhookDeliveryRepository()
+	idGen := &sequenceIDGenerator{}
+
+	handler := NewWebhookHandler(webhookapp.NewService(subs, deliveries, labRepo, idGen, auditapp.NopRecorder{}))
+
+	r := gin.New()
+	r.Use(Problems())
+	r.POST("/webhooks", handler.Create)
+	r.GET("/webhooks", handler.List)
+	r.GET("/webhooks/:id", handler.Get)
+	r.PUT("/webhooks/:id", handler.Update)
+	r.DELETE("/webhooks/:id", handler.Delete)
+	r.GET("/webhooks/:id/deliveries", handler.ListDeliveries)
+	r.GET("/webhooks/:id/deliveries/:delivery_id", handler.GetDelivery)
+	r.POST("/webhooks/:id/deliveries/:delivery_id/redeliver", handler.Redeliver)
+
+	return webhookTestSetup{
+		router:     r,
+		dispatcher: webhookapp.NewDispatcher(subs, deliveries, idGen),
+		worker:     webhookapp.NewWorker(deliveries, subs, newTestSender(), webhookapp.DefaultWorkerConfig()),
+	}
+}
+
+func serveWebhookRequest(router *gin.Engine, method, url, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestWebhookHandler_Create(t *testing.T) {
+	setup := setupWebhookTestRouter()
+
+	tests := []struct {
+		name       string
+		query      string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "valid subscription",
+			query:      "?laboratory_id=lab-123",
+			body:       `{"url": "https://clinic.example.com/hooks", "event_types": ["order.ready", "order.delivered"], "secret": "0123456789abcdef"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "unknown event type",
+			query:      "?laboratory_id=lab-123",
+			body:       `{"url": "https://clinic.example.com/hooks", "event_types": ["order.lost"], "secret": "0123456789abcdef"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "short secret",
+			query:      "?laboratory_id=lab-123",
+			body:       `{"url": "https://clinic.example.com/hooks", "event_types": ["order.ready"], "secret": "short"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing secret",
+			query:      "?laboratory_id=lab-123",
+			body:       `{"url": "https://clinic.example.com/hooks", "event_types": ["order.ready"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing laboratory_id",
+			body:       `{"url": "https://clinic.example.com/hooks", "event_types": ["order.ready"], "secret": "0123456789abcdef"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown laboratory",
+			query:      "?laboratory_id=lab-999",
+			body:       `{"url": "https://clinic.example.com/hooks", "event_types": ["order.ready"], "secret": "0123456789abcdef"}`,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveWebhookRequest(setup.router, http.MethodPost, "/webhooks"+tt.query, tt.body)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("Create() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusCreated && strings.Contains(w.Body.String(), "secret") {
+				t.Errorf("Create() body = %s, want the secret left out", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_DeliveryLogAndRedeliver(t *testing.T) {
+	setup := setupWebhookTestRouter()
+	ctx := context.Background()
+
+	// The receiver fails until it is fixed
+	var fixed atomic.Bool
+	clinic := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !fixed.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer clinic.Close()
+
+	w := serveWebhookRequest(setup.router, http.MethodPost, "/webhooks?laboratory_id=lab-123",
+		`{"url": "`+clinic.URL+`", "event_types": ["order.ready"], "secret": "`+testWebhookSecret+`"}`)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Create() status = %v, body: %s", w.Code, w.Body.String())
+	}
+	var sub dto.WebhookResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &sub)
+
+	_ = setup.dispatcher.Handle(ctx, event.OrderStatusChanged{
+		Metadata: event.NewMetadata("lab-123"),
+		Order:    order.Order{ID: "order-1", LaboratoryID: "lab-123", Status: order.StatusReady},
+		From:     order.StatusQualityCheck,
+		To:       order.StatusReady,
+	})
+	_, _ = setup.worker.SendDue(ctx)
+
+	w = serveWebhookRequest(setup.router, http.MethodGet, "/webhooks/"+sub.ID+"/deliveries?laboratory_id=lab-123", "")
+	var log []dto.WebhookDeliveryResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &log); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("ListDeliveries() status = %v, body: %s", w.Code, w.Body.String())
+	}
+	if len(log) != 1 || log[0].Status != "pending" || len(log[0].Attempts) != 1 || log[0].Attempts[0].StatusCode != http.StatusBadGateway || log[0].NextAttemptAt == nil {
+		t.Fatalf("ListDeliveries() = %+v, want one failed attempt awaiting a retry", log)
+	}
+	if !strings.Contains(string(log[0].Payload), `"type":"order.ready"`) {
+		t.Errorf("delivery payload = %s, want the order.ready payload", log[0].Payload)
+	}
+
+	for _, tt := range []struct {
+		name       string
+		url        string
+		wantStatus int
+	}{
+		{"invalid status filter", "/webhooks/" + sub.ID + "/deliveries?laboratory_id=lab-123&status=lost", http.StatusBadRequest},
+		{"invalid limit", "/webhooks/" + sub.ID + "/deliveries?laboratory_id=lab-123&limit=0", http.StatusBadRequest},
+		{"another laboratory", "/webhooks/" + sub.ID + "/deliveries?laboratory_id=lab-456", http.StatusNotFound},
+		{"unknown delivery", "/webhooks/" + sub.ID + "/deliveries/missing?laboratory_id=lab-123", http.StatusNotFound},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := serveWebhookRequest(setup.router, http.MethodGet, tt.url, ""); w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+
+	fixed.Store(true)
+	w = serveWebhookRequest(setup.router, http.MethodPost, "/webhooks/"+sub.ID+"/deliveries/"+log[0].ID+"/redeliver?laboratory_id=lab-123", "")
+	var redelivery dto.WebhookDeliveryResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &redelivery)
+	if w.Code != http.StatusAccepted || redelivery.RedeliveryOf != log[0].ID || redelivery.EventID != log[0].EventID {
+		t.Fatalf("Redeliver() status = %v, body: %s, want a redelivery of the event", w.Code, w.Body.String())
+	}
+
+	_, _ = setup.worker.SendDue(ctx)
+
+	w = serveWebhookRequest(setup.router, http.MethodGet, "/webhooks/"+sub.ID+"/deliveries/"+redelivery.ID+"?laboratory_id=lab-123", "")
+	var got dto.WebhookDeliveryResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &got)
+	if got.Status != "delivered" || len(got.Attempts) != 1 || got.Attempts[0].StatusCode != http.StatusNoContent || got.NextAttemptAt != nil {
+		t.Errorf("GetDelivery() = %+v, want the redelivery delivered", got)
+	}
+}
+
+func TestWebhookHandler_UpdateAndDelete(t *testing.T) {
+	setup := setupWebhookTestRouter()
+
+	w := serveWebhookRequest(setup.router, http.MethodPost, "/webhooks?laboratory_id=lab-123",
+		`{"url": "https://clinic.example.com/hooks", "event_types": ["order.ready"], "secret": "`+testWebhookSecret+`"}`)
+	var sub dto.WebhookResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &sub)
+
+	w = serveWebhookRequest(setup.router, http.MethodPut, "/webhooks/"+sub.ID+"?laboratory_id=lab-123",
+		`{"url": "https://clinic.example.com/v2", "event_types": ["order.ready", "order.delivered"]}`)
+	var updated dto.WebhookResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &updated)
+	if w.Code != http.StatusOK || updated.URL != "https://clinic.example.com/v2" || len(updated.EventTypes) != 2 {
+		t.Fatalf("Update() status = %v, body: %s", w.Code, w.Body.String())
+	}
+
+	w = serveWebhookRequest(setup.router, http.MethodGet, "/webhooks?laboratory_id=lab-123", "")
+	var list []dto.WebhookResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &list)
+	if len(list) != 1 {
+		t.Errorf("List() = %s, want one subscription", w.Body.String())
+	}
+
+	if w := serveWebhookRequest(setup.router, http.MethodDelete, "/webhooks/"+sub.ID+"?laboratory_id=lab-456", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Delete() from another laboratory status = %v, want %v", w.Code, http.StatusNotFound)
+	}
+	if w := serveWebhookRequest(setup.router, http.MethodDelete, "/webhooks/"+sub.ID+"?laboratory_id=lab-123", ""); w.Code != http.StatusNoContent {
+		t.Errorf("Delete() status = %v, want %v", w.Code, http.StatusNoContent)
+	}
+	if w := serveWebhookRequest(setup.router, http.MethodGet, "/webhooks/"+sub.ID+"?laboratory_id=lab-123", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Get() after delete status = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
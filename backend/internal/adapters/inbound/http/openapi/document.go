@@ -0,0 +1,293 @@
+// Package openapi builds the OpenAPI 3.1 description of the HTTP API. Schemas
+// are generated from the dto types by reflection; routes are declared with Add.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/mergepatch"
+)
+
+// Version is the OpenAPI version of generated documents
+const Version = "3.1.0"
+
+// BearerAuth is the name of the security scheme for Clerk session tokens
+const BearerAuth = "bearerAuth"
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+
+	enums map[enumKey][]string
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem holds the operations of a path, keyed by lowercase HTTP method
+type PathItem map[string]*Operation
+
+// Operation describes a single route
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter describes a path, query or header parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes a request body
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response describes a response or references a shared one
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable parts of the document
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	Responses       map[string]*Response       `json:"responses,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme describes an authentication method
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// Route declares an operation
+type Route struct {
+	Method  string // HTTP method
+	Path    string // Gin path, e.g. /api/v1/orders/:id
+	Tag     string
+	Summary string
+	Public  bool        // Skips the bearer authentication requirement
+	Params  []Parameter // Query and header parameters; path parameters are derived from Path
+	Body    any         // JSON request body model, e.g. dto.CreateOrderRequest{}
+	Patch   any         // Request model patched by a JSON merge patch body, e.g. dto.UpdateClientRequest{}
+	Upload  string      // Name of the multipart file field, for file uploads
+	Status  int         // Success status, defaults to 200
+	Result  any         // JSON response model; nil for an empty response
+	Content string      // Response media type of non-JSON responses, e.g. text/html
+}
+
+// New creates an empty document. Errors are described by problem, the model
+// of the RFC 7807 problem details every operation may return.
+func New(info Info, problem any) *Document {
+	d := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			Schemas: make(map[string]*Schema),
+			SecuritySchemes: map[string]*SecurityScheme{
+				BearerAuth: {
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "Clerk session token",
+				},
+			},
+		},
+		enums: make(map[enumKey][]string),
+	}
+
+	d.Components.Responses = map[string]*Response{
+		"Problem": {
+			Description: "Error described by RFC 7807 problem details",
+			Content: map[string]*MediaType{
+				"application/problem+json": {Schema: d.schemaOf(reflect.TypeOf(problem))},
+			},
+		},
+	}
+
+	return d
+}
+
+// Enum restricts a field of a model to the given values. It must be called
+// before the model is first used by Add.
+func (d *Document) Enum(model any, field string, values []string) {
+	d.enums[enumKey{reflect.TypeOf(model), field}] = values
+}
+
+// Values converts string-based enum values, e.g. order.AllStatuses()
+func Values[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
+
+// Add declares an operation
+func (d *Document) Add(r Route) {
+	op := &Operation{
+		Summary:   r.Summary,
+		Responses: make(map[string]*Response),
+	}
+	if r.Tag != "" {
+		op.Tags = []string{r.Tag}
+	}
+	if !r.Public {
+		op.Security = []map[string][]string{{BearerAuth: {}}}
+	}
+
+	for _, name := range pathParams(r.Path) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	op.Parameters = append(op.Parameters, r.Params...)
+
+	switch {
+	case r.Body != nil:
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				"application/json": {Schema: d.schemaOf(reflect.TypeOf(r.Body))},
+			},
+		}
+	case r.Patch != nil:
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				mergepatch.ContentType: {Schema: d.patchRef(reflect.TypeOf(r.Patch))},
+			},
+		}
+	case r.Upload != "":
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				"multipart/form-data": {Schema: &Schema{
+					Type:       "object",
+					Properties: map[string]*Schema{r.Upload: {Type: "string", Format: "binary"}},
+					Required:   []string{r.Upload},
+				}},
+			},
+		}
+	}
+
+	status := r.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	resp := &Response{Description: http.StatusText(status)}
+	switch {
+	case r.Content != "":
+		resp.Content = map[string]*MediaType{r.Content: {Schema: &Schema{Type: "string", Format: "binary"}}}
+	case r.Result != nil:
+		resp.Content = map[string]*MediaType{"application/json": {Schema: d.schemaOf(reflect.TypeOf(r.Result))}}
+	}
+	op.Responses[strconv.Itoa(status)] = resp
+	op.Responses["default"] = &Response{Ref: "#/components/responses/Problem"}
+
+	path := Path(r.Path)
+	if d.Paths[path] == nil {
+		d.Paths[path] = make(PathItem)
+	}
+	d.Paths[path][strings.ToLower(r.Method)] = op
+}
+
+// Has reports whether the route with the given method and Gin path is documented
+func (d *Document) Has(method, ginPath string) bool {
+	_, ok := d.Paths[Path(ginPath)][strings.ToLower(method)]
+	return ok
+}
+
+// Path converts a Gin path to an OpenAPI path: /orders/:id becomes /orders/{id}
+// and /docs/*file becomes /docs/{file}
+func Path(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "*") {
+			segments[i] = "{" + s[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// pathParams returns the names of the parameters of a Gin path
+func pathParams(ginPath string) []string {
+	var names []string
+	for _, s := range strings.Split(ginPath, "/") {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "*") {
+			names = append(names, s[1:])
+		}
+	}
+	return names
+}
+
+// Query describes an optional string query parameter
+func Query(name, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "string"}}
+}
+
+// RequiredQuery describes a mandatory string query parameter
+func RequiredQuery(name, description string) Parameter {
+	p := Query(name, description)
+	p.Required = true
+	return p
+}
+
+// QueryInt describes an optional integer query parameter
+func QueryInt(name, description string) Parameter {
+	p := Query(name, description)
+	p.Schema = &Schema{Type: "integer", Minimum: float(1)}
+	return p
+}
+
+// QueryTime describes an optional RFC 3339 timestamp query parameter
+func QueryTime(name, description string) Parameter {
+	p := Query(name, description)
+	p.Schema = &Schema{Type: "string", Format: "date-time"}
+	return p
+}
+
+// QueryEnum describes an optional query parameter restricted to values
+func QueryEnum(name, description string, values []string) Parameter {
+	p := Query(name, description)
+	p.Schema.Enum = values
+	return p
+}
+
+// Header describes an optional string header
+func Header(name, description string) Parameter {
+	return Parameter{Name: name, In: "header", Description: description, Schema: &Schema{Type: "string"}}
+}
@@ -0,0 +1,67 @@
+package openapi
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+)
+
+// Handler serves the document as JSON. The document must not change afterwards.
+func (d *Document) Handler() gin.HandlerFunc {
+	body, err := json.Marshal(d)
+	return func(c *gin.Context) {
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+	}
+}
+
+var swaggerIndex = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="swagger-ui.css">
+  <link rel="icon" type="image/png" href="favicon-32x32.png" sizes="32x32">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="swagger-ui-bundle.js"></script>
+  <script src="swagger-ui-standalone-preset.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({
+      url: {{.SpecURL}},
+      dom_id: "#swagger-ui",
+      deepLinking: true,
+      presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+      layout: "StandaloneLayout"
+    });
+  </script>
+</body>
+</html>
+`))
+
+// SwaggerUI serves the bundled Swagger UI, pointed at the document served at
+// specURL. It must be mounted on a route with a *filepath wildcard.
+func SwaggerUI(title, specURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		file := c.Param("filepath")
+		if file == "" || file == "/" || file == "/index.html" {
+			c.Status(http.StatusOK)
+			c.Header("Content-Type", "text/html; charset=utf-8")
+			_ = swaggerIndex.Execute(c.Writer, struct{ Title, SpecURL string }{title, specURL})
+			return
+		}
+		if !strings.HasPrefix(file, "/swagger-ui") && !strings.HasPrefix(file, "/favicon") {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.FileFromFS(file, swaggerFiles.HTTP)
+	}
+}
@@ -0,0 +1,217 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema is a JSON Schema (draft 2020-12, as used by OpenAPI 3.1)
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 any                `json:"type,omitempty"` // A type name, or a list of them for nullable types
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	MinLength            *float64           `json:"minLength,omitempty"`
+	MaxLength            *float64           `json:"maxLength,omitempty"`
+}
+
+// enumKey identifies a field of a model
+type enumKey struct {
+	model reflect.Type
+	field string
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaOf returns the schema of a Go type. Structs are added to the
+// components and referenced.
+func (d *Document) schemaOf(t reflect.Type) *Schema {
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(d.schemaOf(t.Elem()))
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: d.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return d.ref(t)
+	default:
+		return &Schema{}
+	}
+}
+
+// ref adds a struct to the component schemas once and references it
+func (d *Document) ref(t reflect.Type) *Schema {
+	name := schemaName(t)
+	if _, ok := d.Components.Schemas[name]; !ok {
+		d.Components.Schemas[name] = &Schema{} // Placeholder for recursive types
+		d.Components.Schemas[name] = d.structSchema(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// structSchema describes the JSON properties of a struct. Request models
+// (named *Request) require the fields bound as required; other models
+// require every field that is always present, i.e. not omitempty.
+func (d *Document) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	isRequest := strings.HasSuffix(t.Name(), "Request")
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts, ok := jsonName(f)
+		if !ok {
+			continue
+		}
+
+		prop := d.schemaOf(f.Type)
+		rules := strings.Split(f.Tag.Get("binding"), ",")
+		applyRules(prop, rules)
+		if values, ok := d.enums[enumKey{t, name}]; ok {
+			prop.Enum = values
+		}
+		s.Properties[name] = prop
+
+		required := contains(rules, "required")
+		if !isRequest && f.Type.Kind() != reflect.Pointer {
+			required = !contains(strings.Split(opts, ","), "omitempty")
+		}
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+
+	return s
+}
+
+// patchRef adds the JSON merge patch model of a request struct to the
+// component schemas and references it. It has the properties of the request,
+// none of them required, with nested objects patched alike; e.g.
+// UpdateClientRequest becomes ClientPatch.
+func (d *Document) patchRef(t reflect.Type) *Schema {
+	name := strings.TrimSuffix(strings.TrimPrefix(t.Name(), "Update"), "Request") + "Patch"
+	if _, ok := d.Components.Schemas[name]; !ok {
+		d.Components.Schemas[name] = &Schema{} // Placeholder for recursive types
+		s := d.structSchema(t)
+		s.Required = nil
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if prop, _, ok := jsonName(f); ok && f.Type.Kind() == reflect.Struct && f.Type != timeType {
+				s.Properties[prop] = d.patchRef(f.Type)
+			}
+		}
+		d.Components.Schemas[name] = s
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// jsonName returns the JSON name and options of a struct field, and false for
+// fields that are not encoded
+func jsonName(f reflect.StructField) (string, string, bool) {
+	if !f.IsExported() {
+		return "", "", false
+	}
+	name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", "", false
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, opts, true
+}
+
+// applyRules maps binding rules to schema constraints
+func applyRules(s *Schema, rules []string) {
+	for _, rule := range rules {
+		tag, param, _ := strings.Cut(rule, "=")
+		n, err := strconv.ParseFloat(param, 64)
+		hasNumber := err == nil
+
+		switch {
+		case tag == "email":
+			s.Format = "email"
+		case tag == "oneof":
+			s.Enum = strings.Fields(param)
+		case tag == "gt" && hasNumber:
+			s.ExclusiveMinimum = &n
+		case (tag == "gte" || tag == "min") && hasNumber:
+			if s.Type == "string" {
+				s.MinLength = &n
+			} else {
+				s.Minimum = &n
+			}
+		case (tag == "lte" || tag == "max") && hasNumber:
+			if s.Type == "string" {
+				s.MaxLength = &n
+			} else {
+				s.Maximum = &n
+			}
+		}
+	}
+}
+
+// nullable allows null in addition to the schema
+func nullable(s *Schema) *Schema {
+	if s.Ref != "" {
+		return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+	}
+	if typ, ok := s.Type.(string); ok {
+		s.Type = []string{typ, "null"}
+	}
+	return s
+}
+
+// schemaName names the component of a struct. Instances of generic types are
+// named after their type argument, e.g. ListResponse[OrderResponse] becomes
+// OrderResponseList.
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	base, arg, generic := strings.Cut(name, "[")
+	if !generic {
+		return name
+	}
+	arg = strings.TrimSuffix(arg, "]")
+	arg = arg[strings.LastIndex(arg, ".")+1:]
+	return arg + strings.TrimSuffix(base, "Response")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func float(n float64) *float64 {
+	return &n
+}
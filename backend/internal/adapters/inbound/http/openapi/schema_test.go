@@ -0,0 +1,162 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testItemRequest struct {
+	Name     string  `json:"name" binding:"required"`
+	Email    string  `json:"email" binding:"required,email"`
+	Quantity int     `json:"quantity" binding:"required,gt=0"`
+	Size     string  `json:"size" binding:"oneof=small large"`
+	Owner    *string `json:"owner"`
+	Notes    string  `json:"notes"`
+}
+
+type UpdateTestOrderRequest struct {
+	Item  testItemRequest `json:"item" binding:"required"`
+	Notes string          `json:"notes" binding:"required"`
+}
+
+type testItemResponse struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	Notes     string    `json:"notes,omitempty"`
+	Internal  string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type testList[T any] struct {
+	Data []T `json:"data"`
+}
+
+type testProblem struct {
+	Code string `json:"code"`
+}
+
+func newTestDocument() *Document {
+	return New(Info{Title: "Test", Version: "1.0.0"}, testProblem{})
+}
+
+func TestSchema_RequestModel(t *testing.T) {
+	doc := newTestDocument()
+	doc.Add(Route{Method: http.MethodPost, Path: "/items", Body: testItemRequest{}})
+
+	s := doc.Components.Schemas["testItemRequest"]
+	if s == nil {
+		t.Fatal("testItemRequest is not a component schema")
+	}
+
+	if want := []string{"name", "email", "quantity"}; !reflect.DeepEqual(s.Required, want) {
+		t.Errorf("required = %v, want %v", s.Required, want)
+	}
+	if got := s.Properties["email"].Format; got != "email" {
+		t.Errorf("email format = %q, want email", got)
+	}
+	if got := s.Properties["quantity"].ExclusiveMinimum; got == nil || *got != 0 {
+		t.Errorf("quantity exclusiveMinimum = %v, want 0", got)
+	}
+	if got := s.Properties["size"].Enum; !reflect.DeepEqual(got, []string{"small", "large"}) {
+		t.Errorf("size enum = %v", got)
+	}
+	if got := s.Properties["owner"].Type; !reflect.DeepEqual(got, []string{"string", "null"}) {
+		t.Errorf("owner type = %v, want nullable string", got)
+	}
+}
+
+func TestSchema_PatchModel(t *testing.T) {
+	doc := newTestDocument()
+	doc.Add(Route{Method: http.MethodPatch, Path: "/orders/:id", Patch: UpdateTestOrderRequest{}})
+
+	op := doc.Paths["/orders/{id}"]["patch"]
+	media, ok := op.RequestBody.Content["application/merge-patch+json"]
+	if !ok {
+		t.Fatalf("request content = %v, want application/merge-patch+json", op.RequestBody.Content)
+	}
+	if media.Schema.Ref != "#/components/schemas/TestOrderPatch" {
+		t.Errorf("schema ref = %q, want TestOrderPatch", media.Schema.Ref)
+	}
+
+	s := doc.Components.Schemas["TestOrderPatch"]
+	if s == nil {
+		t.Fatal("TestOrderPatch is not a component schema")
+	}
+	if len(s.Required) != 0 {
+		t.Errorf("required = %v, want none", s.Required)
+	}
+	if got := s.Properties["item"].Ref; got != "#/components/schemas/testItemPatch" {
+		t.Errorf("item ref = %q, want testItemPatch", got)
+	}
+	if item := doc.Components.Schemas["testItemPatch"]; item == nil || len(item.Required) != 0 {
+		t.Errorf("testItemPatch = %+v, want no required properties", item)
+	}
+}
+
+func TestSchema_ResponseModel(t *testing.T) {
+	doc := newTestDocument()
+	doc.Enum(testItemResponse{}, "status", Values([]testStatus{"open", "closed"}))
+	doc.Add(Route{Method: http.MethodGet, Path: "/items/:id", Result: testItemResponse{}})
+
+	s := doc.Components.Schemas["testItemResponse"]
+	if s == nil {
+		t.Fatal("testItemResponse is not a component schema")
+	}
+
+	if want := []string{"id", "status", "created_at"}; !reflect.DeepEqual(s.Required, want) {
+		t.Errorf("required = %v, want %v", s.Required, want)
+	}
+	if _, ok := s.Properties["Internal"]; ok {
+		t.Error("field tagged json:\"-\" is documented")
+	}
+	if got := s.Properties["created_at"].Format; got != "date-time" {
+		t.Errorf("created_at format = %q, want date-time", got)
+	}
+	if got := s.Properties["status"].Enum; !reflect.DeepEqual(got, []string{"open", "closed"}) {
+		t.Errorf("status enum = %v", got)
+	}
+}
+
+type testStatus string
+
+func TestSchema_GenericModelName(t *testing.T) {
+	doc := newTestDocument()
+	doc.Add(Route{Method: http.MethodGet, Path: "/items", Result: testList[testItemResponse]{}})
+
+	if _, ok := doc.Components.Schemas["testItemResponsetestList"]; !ok {
+		names := make([]string, 0, len(doc.Components.Schemas))
+		for name := range doc.Components.Schemas {
+			names = append(names, name)
+		}
+		t.Errorf("schemas = %v, want testItemResponsetestList", names)
+	}
+}
+
+func TestDocument_Add(t *testing.T) {
+	doc := newTestDocument()
+	doc.Add(Route{Method: http.MethodDelete, Path: "/items/:id/files/*name", Status: http.StatusNoContent})
+	doc.Add(Route{Method: http.MethodGet, Path: "/health", Public: true})
+
+	if !doc.Has(http.MethodDelete, "/items/:id/files/*name") {
+		t.Fatal("Has() = false for a documented route")
+	}
+	if doc.Has(http.MethodGet, "/items/:id/files/*name") {
+		t.Error("Has() = true for an undocumented method")
+	}
+
+	op := doc.Paths["/items/{id}/files/{name}"]["delete"]
+	if len(op.Parameters) != 2 || op.Parameters[0].Name != "id" || op.Parameters[1].Name != "name" {
+		t.Errorf("parameters = %+v, want id and name", op.Parameters)
+	}
+	if _, ok := op.Responses["204"]; !ok {
+		t.Errorf("responses = %v, want 204", op.Responses)
+	}
+	if len(op.Security) != 1 {
+		t.Errorf("protected route security = %v", op.Security)
+	}
+	if health := doc.Paths["/health"]["get"]; len(health.Security) != 0 {
+		t.Errorf("public route security = %v", health.Security)
+	}
+}
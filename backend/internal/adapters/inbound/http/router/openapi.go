@@ -0,0 +1,343 @@
+package router
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/dto"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/handler"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/openapi"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
+	commentapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/comment"
+	expandapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/expand"
+	schedulerapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/scheduler"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+)
+
+const (
+	// OpenAPIPath serves the OpenAPI document
+	OpenAPIPath = "/api/v1/openapi.json"
+
+	// DocsPath serves the Swagger UI
+	DocsPath = "/api/v1/docs"
+
+	// MetricsPath serves the Prometheus metrics
+	MetricsPath = "/metrics"
+
+	// LivezPath serves the liveness probe
+	LivezPath = "/livez"
+
+	// ReadyzPath serves the readiness probe
+	ReadyzPath = "/readyz"
+
+	// GraphQLPath serves the GraphQL API, outside of the versioned REST routes
+	GraphQLPath = "/graphql"
+)
+
+// Spec describes every route registered by New. A route added to New must be
+// added here too; the router tests fail otherwise.
+func Spec() *openapi.Document {
+	doc := openapi.New(openapi.Info{
+		Title:       "Dental Prosthesis Laboratory API",
+		Version:     "1.0.0",
+		Description: "Multi-tenant API for dental prosthesis laboratories and their clients.",
+	}, dto.Problem{})
+
+	statuses := openapi.Values(order.AllStatuses())
+	doc.Enum(dto.UpdateOrderStatusRequest{}, "status", statuses)
+	doc.Enum(dto.BulkOrderStatusItem{}, "status", statuses)
+	doc.Enum(dto.OrderResponse{}, "status", statuses)
+	doc.Enum(dto.PortalOrderResponse{}, "status", statuses)
+	doc.Enum(dto.StatusChangeResponse{}, "from", statuses)
+	doc.Enum(dto.StatusChangeResponse{}, "to", statuses)
+
+	types := openapi.Values(prosthesis.AllProsthesisTypes())
+	doc.Enum(dto.CreateProsthesisRequest{}, "type", types)
+	doc.Enum(dto.UpdateProsthesisRequest{}, "type", types)
+	doc.Enum(dto.ProsthesisResponse{}, "type", types)
+
+	roles := openapi.Values(technician.AllRoles())
+	doc.Enum(dto.CreateTechnicianRequest{}, "role", roles)
+	doc.Enum(dto.UpdateTechnicianRequest{}, "role", roles)
+	doc.Enum(dto.TechnicianResponse{}, "role", roles)
+
+	languages := openapi.Values(i18n.Supported())
+	doc.Enum(dto.CreateLaboratoryRequest{}, "language", languages)
+	doc.Enum(dto.UpdateLaboratoryRequest{}, "language", languages)
+	doc.Enum(dto.LaboratoryResponse{}, "language", languages)
+
+	doc.Enum(dto.AuditEntryResponse{}, "entity_type", openapi.Values(audit.AllEntityTypes()))
+	doc.Enum(dto.AuditEntryResponse{}, "action", openapi.Values(audit.AllActions()))
+
+	doc.Enum(dto.CommentAuthorResponse{}, "kind", openapi.Values(comment.AllAuthorKinds()))
+	doc.Enum(dto.CommentSignalMessage{}, "type", openapi.Values(commentapp.AllSignalTypes()))
+
+	eventTypes := openapi.Values(webhook.AllEventTypes())
+	deliveryStatuses := openapi.Values(webhook.AllDeliveryStatuses())
+	doc.Enum(dto.WebhookDeliveryResponse{}, "event_type", eventTypes)
+	doc.Enum(dto.WebhookDeliveryResponse{}, "status", deliveryStatuses)
+
+	runStatuses := openapi.Values(job.AllRunStatuses())
+	doc.Enum(dto.JobRunResponse{}, "status", runStatuses)
+
+	outcomes := openapi.Values([]bulk.Status{bulk.StatusSucceeded, bulk.StatusFailed, bulk.StatusSkipped})
+	doc.Enum(dto.BulkItemResult[dto.OrderResponse]{}, "status", outcomes)
+	doc.Enum(dto.BulkItemResult[dto.ClientResponse]{}, "status", outcomes)
+	doc.Enum(dto.BulkItemResult[dto.TechnicianDeletionResponse]{}, "status", outcomes)
+
+	add := func(r openapi.Route) {
+		r.Params = append(r.Params, openapi.Header("Accept-Language",
+			"Language of error messages: "+strings.Join(languages, ", ")+". Defaults to the laboratory's language."))
+		if r.Method == http.MethodPost {
+			r.Params = append(r.Params, openapi.Header(handler.IdempotencyKeyHeader,
+				"Makes the request safe to retry: a retry with the same key and body replays the first response."))
+		}
+		doc.Add(r)
+	}
+
+	// Public
+	add(openapi.Route{Method: http.MethodGet, Path: LivezPath, Tag: "System", Summary: "Liveness probe",
+		Public: true, Result: dto.HealthResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: ReadyzPath, Tag: "System", Summary: "Readiness probe, 503 with the failed checks when not ready",
+		Public: true, Result: dto.HealthResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: OpenAPIPath, Tag: "System", Summary: "OpenAPI document",
+		Public: true, Content: "application/json"})
+	add(openapi.Route{Method: http.MethodGet, Path: DocsPath + "/*filepath", Tag: "System", Summary: "Swagger UI",
+		Public: true, Content: "text/html"})
+	add(openapi.Route{Method: http.MethodGet, Path: MetricsPath, Tag: "System", Summary: "Prometheus metrics",
+		Public: true, Content: "text/plain"})
+
+	// Laboratories
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/laboratories", Tag: "Laboratories", Summary: "Create a laboratory",
+		Body: dto.CreateLaboratoryRequest{}, Status: http.StatusCreated, Result: dto.LaboratoryResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/laboratories", Tag: "Laboratories", Summary: "List laboratories",
+		Params: listParams(laboratory.ListSpec, nil), Result: dto.ListResponse[dto.LaboratoryResponse]{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/laboratories/:id", Tag: "Laboratories", Summary: "Get a laboratory",
+		Result: dto.LaboratoryResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/laboratories/:id", Tag: "Laboratories", Summary: "Update a laboratory",
+		Body: dto.UpdateLaboratoryRequest{}, Result: dto.LaboratoryResponse{}})
+	add(openapi.Route{Method: http.MethodPatch, Path: "/api/v1/laboratories/:id", Tag: "Laboratories", Summary: "Partially update a laboratory",
+		Patch: dto.UpdateLaboratoryRequest{}, Result: dto.LaboratoryResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/laboratories/:id", Tag: "Laboratories", Summary: "Delete a laboratory",
+		Status: http.StatusNoContent})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/laboratories/:id/notifications", Tag: "Laboratories", Summary: "Get the notification email settings of a laboratory",
+		Result: dto.NotificationSettingsResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/laboratories/:id/notifications", Tag: "Laboratories", Summary: "Update the notification email settings of a laboratory",
+		Body: dto.UpdateNotificationSettingsRequest{}, Result: dto.NotificationSettingsResponse{}})
+
+	// Clients
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/clients", Tag: "Clients", Summary: "Create a client",
+		Params: labParam(), Body: dto.CreateClientRequest{}, Status: http.StatusCreated, Result: dto.ClientResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/clients", Tag: "Clients", Summary: "List clients",
+		Params: append(append(labParam(), listParams(client.ListSpec, nil)...), expandParam(expandapp.ClientFields())), Result: dto.ListResponse[dto.ClientResponse]{}})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/clients/bulk/import", Tag: "Clients", Summary: "Import clients",
+		Params: labParam(), Body: dto.ImportClientsRequest{}, Result: dto.BulkResponse[dto.ClientResponse]{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/clients/:id", Tag: "Clients", Summary: "Get a client",
+		Params: append(labParam(), expandParam(expandapp.ClientFields())), Result: dto.ClientResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/clients/:id", Tag: "Clients", Summary: "Update a client",
+		Params: labParam(), Body: dto.UpdateClientRequest{}, Result: dto.ClientResponse{}})
+	add(openapi.Route{Method: http.MethodPatch, Path: "/api/v1/clients/:id", Tag: "Clients", Summary: "Partially update a client",
+		Params: labParam(), Patch: dto.UpdateClientRequest{}, Result: dto.ClientResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/clients/:id", Tag: "Clients", Summary: "Delete a client",
+		Params: labParam(), Status: http.StatusNoContent})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/clients/:id/portal-user", Tag: "Clients", Summary: "Link a portal user to a client",
+		Params: labParam(), Body: dto.LinkPortalUserRequest{}, Result: dto.ClientResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/clients/:id/portal-user", Tag: "Clients", Summary: "Unlink the portal user of a client",
+		Params: labParam(), Result: dto.ClientResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/clients/:id/notifications", Tag: "Clients", Summary: "Opt a client in or out of notification emails",
+		Params: labParam(), Body: dto.ClientNotificationsRequest{}, Result: dto.ClientResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/clients/:id/orders", Tag: "Clients", Summary: "List the orders of a client",
+		Params: append(append(append(labParam(), listParams(order.ListSpec, nil)...), searchParams()...), expandParam(expandapp.OrderFields())), Result: dto.ListResponse[dto.OrderResponse]{}})
+
+	// Orders
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/orders", Tag: "Orders", Summary: "Create an order",
+		Params: labParam(), Body: dto.CreateOrderRequest{}, Status: http.StatusCreated, Result: dto.OrderResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/orders", Tag: "Orders", Summary: "Search orders",
+		Params: append(append(append(labParam(), listParams(order.ListSpec, nil)...), searchParams()...), expandParam(expandapp.OrderFields())), Result: dto.ListResponse[dto.OrderResponse]{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/orders/stream", Tag: "Orders", Summary: "Stream order changes as Server-Sent Events",
+		Params: append(labParam(), openapi.Header("Last-Event-ID", "Resumes the stream after this event")), Content: "text/event-stream"})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/orders/:id", Tag: "Orders", Summary: "Get an order",
+		Params: append(labParam(), expandParam(expandapp.OrderFields())), Result: dto.OrderResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/orders/:id", Tag: "Orders", Summary: "Update an order",
+		Params: labParam(), Body: dto.UpdateOrderRequest{}, Result: dto.OrderResponse{}})
+	add(openapi.Route{Method: http.MethodPatch, Path: "/api/v1/orders/:id/status", Tag: "Orders", Summary: "Change the status of an order",
+		Params: labParam(), Body: dto.UpdateOrderStatusRequest{}, Result: dto.OrderResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/orders/:id", Tag: "Orders", Summary: "Delete an order",
+		Params: labParam(), Status: http.StatusNoContent})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/orders/bulk/status", Tag: "Orders", Summary: "Change the status of several orders",
+		Params: labParam(), Body: dto.BulkOrderStatusRequest{}, Result: dto.BulkResponse[dto.OrderResponse]{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/orders/:id/attachments", Tag: "Orders", Summary: "List the attachments of an order",
+		Params: labParam(), Result: []dto.AttachmentResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/orders/:id/attachments/:attachment_id", Tag: "Orders", Summary: "Download an attachment of an order",
+		Params: labParam(), Content: "application/octet-stream"})
+
+	// Order comments
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/orders/:id/comments", Tag: "Comments", Summary: "List the comments of an order",
+		Params: labParam(), Result: []dto.CommentResponse{}})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/orders/:id/comments", Tag: "Comments", Summary: "Comment on an order",
+		Params: labParam(), Body: dto.CreateCommentRequest{}, Status: http.StatusCreated, Result: dto.CommentResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/orders/:id/comments/:comment_id", Tag: "Comments", Summary: "Delete an own comment",
+		Params: labParam(), Status: http.StatusNoContent})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/orders/:id/comments/ws", Tag: "Comments", Summary: "Follow the comments of an order over a WebSocket",
+		Params: labParam(), Status: http.StatusSwitchingProtocols, Result: dto.CommentSignalMessage{}})
+
+	// Prostheses
+	prosthesisFilters := map[string][]string{"type": types}
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/prostheses", Tag: "Prostheses", Summary: "Create a prosthesis",
+		Params: labParam(), Body: dto.CreateProsthesisRequest{}, Status: http.StatusCreated, Result: dto.ProsthesisResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/prostheses", Tag: "Prostheses", Summary: "List prostheses",
+		Params: append(labParam(), listParams(prosthesis.ListSpec, prosthesisFilters)...), Result: dto.ListResponse[dto.ProsthesisResponse]{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/prostheses/:id", Tag: "Prostheses", Summary: "Get a prosthesis",
+		Params: labParam(), Result: dto.ProsthesisResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/prostheses/:id", Tag: "Prostheses", Summary: "Update a prosthesis",
+		Params: labParam(), Body: dto.UpdateProsthesisRequest{}, Result: dto.ProsthesisResponse{}})
+	add(openapi.Route{Method: http.MethodPatch, Path: "/api/v1/prostheses/:id", Tag: "Prostheses", Summary: "Partially update a prosthesis",
+		Params: labParam(), Patch: dto.UpdateProsthesisRequest{}, Result: dto.ProsthesisResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/prostheses/:id", Tag: "Prostheses", Summary: "Delete a prosthesis",
+		Params: labParam(), Status: http.StatusNoContent})
+
+	// Technicians
+	technicianFilters := map[string][]string{"role": roles}
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/technicians", Tag: "Technicians", Summary: "Create a technician",
+		Params: labParam(), Body: dto.CreateTechnicianRequest{}, Status: http.StatusCreated, Result: dto.TechnicianResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/technicians", Tag: "Technicians", Summary: "List technicians",
+		Params: append(labParam(), listParams(technician.ListSpec, technicianFilters)...), Result: dto.ListResponse[dto.TechnicianResponse]{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/technicians/:id", Tag: "Technicians", Summary: "Get a technician",
+		Params: labParam(), Result: dto.TechnicianResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/technicians/:id", Tag: "Technicians", Summary: "Update a technician",
+		Params: labParam(), Body: dto.UpdateTechnicianRequest{}, Result: dto.TechnicianResponse{}})
+	add(openapi.Route{Method: http.MethodPatch, Path: "/api/v1/technicians/:id", Tag: "Technicians", Summary: "Partially update a technician",
+		Params: labParam(), Patch: dto.UpdateTechnicianRequest{}, Result: dto.TechnicianResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/technicians/:id", Tag: "Technicians", Summary: "Delete a technician",
+		Params: labParam(), Status: http.StatusNoContent})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/technicians/bulk/delete", Tag: "Technicians", Summary: "Delete several technicians",
+		Params: labParam(), Body: dto.BulkDeleteTechniciansRequest{}, Result: dto.BulkResponse[dto.TechnicianDeletionResponse]{}})
+
+	// Client portal
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/portal/me", Tag: "Portal", Summary: "Get the client linked to the user",
+		Result: dto.PortalClientResponse{}})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/portal/orders", Tag: "Portal", Summary: "Create an order",
+		Body: dto.PortalCreateOrderRequest{}, Status: http.StatusCreated, Result: dto.PortalOrderResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/portal/orders", Tag: "Portal", Summary: "Search the client's orders",
+		Params: append(listParams(order.ListSpec, nil), searchParams()...), Result: dto.ListResponse[dto.PortalOrderResponse]{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/portal/orders/:id", Tag: "Portal", Summary: "Get an order",
+		Result: dto.PortalOrderResponse{}})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/portal/orders/:id/attachments", Tag: "Portal", Summary: "Upload an attachment",
+		Upload: "file", Status: http.StatusCreated, Result: dto.AttachmentResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/portal/orders/:id/attachments/:attachment_id", Tag: "Portal", Summary: "Download an attachment",
+		Content: "application/octet-stream"})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/portal/orders/:id/comments", Tag: "Portal", Summary: "List the comments of an order",
+		Result: []dto.CommentResponse{}})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/portal/orders/:id/comments", Tag: "Portal", Summary: "Comment on an order",
+		Body: dto.CreateCommentRequest{}, Status: http.StatusCreated, Result: dto.CommentResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/portal/orders/:id/comments/:comment_id", Tag: "Portal", Summary: "Delete an own comment",
+		Status: http.StatusNoContent})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/portal/orders/:id/comments/ws", Tag: "Portal", Summary: "Follow the comments of an order over a WebSocket",
+		Status: http.StatusSwitchingProtocols, Result: dto.CommentSignalMessage{}})
+
+	// Audit log
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/audit", Tag: "Audit", Summary: "List audit entries",
+		Params: append(labParam(),
+			openapi.Query("actor_id", "Only entries by this user"),
+			openapi.QueryEnum("entity_type", "Only entries about this kind of entity", openapi.Values(audit.AllEntityTypes())),
+			openapi.Query("entity_id", "Only entries about this entity"),
+			openapi.QueryEnum("action", "Only entries with this action", openapi.Values(audit.AllActions())),
+			openapi.QueryTime("from", "Only entries at or after this time"),
+			openapi.QueryTime("to", "Only entries at or before this time"),
+			openapi.QueryInt("limit", "Maximum number of entries"),
+		),
+		Result: []dto.AuditEntryResponse{}})
+
+	// Webhooks
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/webhooks", Tag: "Webhooks", Summary: "Subscribe a URL to order events",
+		Params: labParam(), Body: dto.CreateWebhookRequest{}, Status: http.StatusCreated, Result: dto.WebhookResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/webhooks", Tag: "Webhooks", Summary: "List webhook subscriptions",
+		Params: labParam(), Result: []dto.WebhookResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/webhooks/:id", Tag: "Webhooks", Summary: "Get a webhook subscription",
+		Params: labParam(), Result: dto.WebhookResponse{}})
+	add(openapi.Route{Method: http.MethodPut, Path: "/api/v1/webhooks/:id", Tag: "Webhooks", Summary: "Update a webhook subscription",
+		Params: labParam(), Body: dto.UpdateWebhookRequest{}, Result: dto.WebhookResponse{}})
+	add(openapi.Route{Method: http.MethodDelete, Path: "/api/v1/webhooks/:id", Tag: "Webhooks", Summary: "Delete a webhook subscription",
+		Params: labParam(), Status: http.StatusNoContent})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/webhooks/:id/deliveries", Tag: "Webhooks", Summary: "List the deliveries of a subscription",
+		Params: append(labParam(),
+			openapi.QueryEnum("status", "Only deliveries with this status", deliveryStatuses),
+			openapi.QueryInt("limit", "Maximum number of deliveries"),
+		),
+		Result: []dto.WebhookDeliveryResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/webhooks/:id/deliveries/:delivery_id", Tag: "Webhooks", Summary: "Get a delivery with its attempts",
+		Params: labParam(), Result: dto.WebhookDeliveryResponse{}})
+	add(openapi.Route{Method: http.MethodPost, Path: "/api/v1/webhooks/:id/deliveries/:delivery_id/redeliver", Tag: "Webhooks", Summary: "Send a delivery again",
+		Params: labParam(), Status: http.StatusAccepted, Result: dto.WebhookDeliveryResponse{}})
+
+	// Admin
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/admin/jobs", Tag: "Admin", Summary: "List the background jobs with their last run",
+		Result: []dto.JobResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: "/api/v1/admin/jobs/:name/runs", Tag: "Admin", Summary: "List the runs of a background job",
+		Params: []openapi.Parameter{openapi.QueryInt("limit", "Maximum number of runs, most recent first (default "+strconv.Itoa(schedulerapp.DefaultRunsLimit)+")")},
+		Result: []dto.JobRunResponse{}})
+
+	// GraphQL
+	add(openapi.Route{Method: http.MethodPost, Path: GraphQLPath, Tag: "GraphQL", Summary: "Execute a GraphQL query or mutation",
+		Params: labParam(), Body: dto.GraphQLRequest{}, Result: dto.GraphQLResponse{}})
+	add(openapi.Route{Method: http.MethodGet, Path: GraphQLPath + "/schema", Tag: "GraphQL", Summary: "GraphQL schema",
+		Content: "text/plain"})
+
+	return doc
+}
+
+// labParam describes the laboratory_id query parameter of tenant-scoped routes
+func labParam() []openapi.Parameter {
+	return []openapi.Parameter{openapi.RequiredQuery("laboratory_id", "Laboratory (tenant) ID")}
+}
+
+// listParams describes the pagination, sorting and filter parameters of a list
+// route. filters restricts filter fields to enum values.
+func listParams(spec listing.Spec, filters map[string][]string) []openapi.Parameter {
+	params := []openapi.Parameter{
+		openapi.QueryInt("limit", "Page size, 1 to "+strconv.Itoa(listing.MaxLimit)+" (default "+strconv.Itoa(listing.DefaultLimit)+")"),
+		openapi.Query("cursor", "next_cursor of the previous page"),
+		openapi.QueryEnum("sort", "Sort field (default "+spec.DefaultSort+")", spec.SortFields),
+		openapi.QueryEnum("order", "Sort direction", openapi.Values([]listing.Direction{listing.Asc, listing.Desc})),
+	}
+	for _, field := range spec.FilterFields {
+		if values, ok := filters[field]; ok {
+			params = append(params, openapi.QueryEnum(field, "Exact match on "+field, values))
+		} else {
+			params = append(params, openapi.Query(field, "Exact match on "+field))
+		}
+	}
+	return params
+}
+
+// expandParam describes the expand query parameter embedding related resources
+func expandParam(fields []expandapp.Field) openapi.Parameter {
+	return openapi.Query("expand", "Comma-separated related resources to embed: "+strings.Join(openapi.Values(fields), ", "))
+}
+
+// searchParams describes the order search criteria
+func searchParams() []openapi.Parameter {
+	return []openapi.Parameter{
+		openapi.Query("status", "Comma-separated statuses: "+strings.Join(openapi.Values(order.AllStatuses()), ", ")),
+		openapi.Query("client_id", "Only orders of this client"),
+		openapi.Query("technician_id", "Only orders assigned to this technician"),
+		openapi.QueryTime("created_from", "Created at or after"),
+		openapi.QueryTime("created_to", "Created at or before"),
+		openapi.QueryTime("updated_from", "Updated at or after"),
+		openapi.QueryTime("updated_to", "Updated at or before"),
+		openapi.Query("prosthesis_type", "Orders with an item of this prosthesis type"),
+		openapi.Query("material", "Orders with an item of this material"),
+		openapi.Query("q", "Case-insensitive text search in item notes"),
+	}
+}
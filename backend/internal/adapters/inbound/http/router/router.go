@@ -4,56 +4,104 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/handler"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/openapi"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/metrics"
 	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/ratelimit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
 )
 
 // Config holds router configuration
 type Config struct {
-	LaboratoryHandler *handler.LaboratoryHandler
-	ClientHandler     *handler.ClientHandler
-	OrderHandler      *handler.OrderHandler
-	ProsthesisHandler *handler.ProsthesisHandler
-	TechnicianHandler *handler.TechnicianHandler
-	ClerkMiddleware   *auth.ClerkMiddleware
+	LaboratoryHandler   *handler.LaboratoryHandler
+	ClientHandler       *handler.ClientHandler
+	OrderHandler        *handler.OrderHandler
+	OrderStream         *handler.OrderStreamHandler
+	CommentHandler      *handler.CommentHandler
+	NotificationHandler *handler.NotificationHandler
+	ProsthesisHandler   *handler.ProsthesisHandler
+	TechnicianHandler   *handler.TechnicianHandler
+	PortalHandler       *handler.PortalHandler
+	AuditHandler        *handler.AuditHandler
+	GraphQLHandler      *handler.GraphQLHandler
+	WebhookHandler      *handler.WebhookHandler
+	JobHandler          *handler.JobHandler
+	HealthHandler       *handler.HealthHandler
+	Metrics             *metrics.Metrics // Metrics are not collected nor served when nil
+	Tracing             bool             // Start a span for every request
+	ClerkMiddleware     *auth.ClerkMiddleware
+	RateLimiter         *ratelimit.Limiter
+	Localizer           *handler.Localizer
+	Idempotency         *handler.Idempotency
+	AdminUserIDs        []string        // Users allowed on the admin routes, nobody when empty
+	StaffOnly           gin.HandlerFunc // Keeps portal identities off the staff routes; nil lets everyone in
 }
 
 // New creates a new Gin router with all routes configured
 func New(cfg Config) *gin.Engine {
-	r := gin.Default()
+	r := gin.New()
+	r.Use(gin.Recovery())
+	r.Use(requestid.Middleware())
+	r.Use(logging.Middleware())
+	if cfg.Tracing {
+		r.Use(tracing.Middleware())
+	}
+	if cfg.Metrics != nil {
+		r.Use(cfg.Metrics.Middleware())
+	}
+	r.Use(handler.Problems())
+
+	// Liveness and readiness probes (public)
+	r.GET(LivezPath, cfg.HealthHandler.Live)
+	r.GET(ReadyzPath, cfg.HealthHandler.Ready)
 
-	// Health check (public)
-	r.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{"status": "ok"})
-	})
+	// API documentation (public)
+	spec := Spec()
+	r.GET(OpenAPIPath, spec.Handler())
+	r.GET(DocsPath+"/*filepath", openapi.SwaggerUI(spec.Info.Title, OpenAPIPath))
+
+	// Prometheus metrics (public)
+	if cfg.Metrics != nil {
+		r.GET(MetricsPath, gin.WrapH(cfg.Metrics.Handler()))
+	}
 
 	// API v1 routes
 	v1 := r.Group("/api/v1")
 
 	// Laboratory routes (protected)
 	laboratories := v1.Group("/laboratories")
-	if cfg.ClerkMiddleware != nil {
-		laboratories.Use(cfg.ClerkMiddleware.Authenticate())
-	}
+	protectStaff(laboratories, cfg, "laboratories")
 	{
 		laboratories.POST("", cfg.LaboratoryHandler.Create)
 		laboratories.GET("", cfg.LaboratoryHandler.List)
 		laboratories.GET("/:id", cfg.LaboratoryHandler.Get)
 		laboratories.PUT("/:id", cfg.LaboratoryHandler.Update)
+		laboratories.PATCH("/:id", cfg.LaboratoryHandler.Patch)
 		laboratories.DELETE("/:id", cfg.LaboratoryHandler.Delete)
 	}
 
+	if cfg.NotificationHandler != nil {
+		laboratories.GET("/:id/notifications", cfg.NotificationHandler.GetSettings)
+		laboratories.PUT("/:id/notifications", cfg.NotificationHandler.UpdateSettings)
+	}
+
 	// Client routes (protected)
 	if cfg.ClientHandler != nil {
 		clients := v1.Group("/clients")
-		if cfg.ClerkMiddleware != nil {
-			clients.Use(cfg.ClerkMiddleware.Authenticate())
-		}
+		protectStaff(clients, cfg, "clients")
 		{
 			clients.POST("", cfg.ClientHandler.Create)
+			clients.POST("/bulk/import", cfg.ClientHandler.Import)
 			clients.GET("", cfg.ClientHandler.List)
 			clients.GET("/:id", cfg.ClientHandler.Get)
 			clients.PUT("/:id", cfg.ClientHandler.Update)
+			clients.PATCH("/:id", cfg.ClientHandler.Patch)
 			clients.DELETE("/:id", cfg.ClientHandler.Delete)
+			clients.PUT("/:id/portal-user", cfg.ClientHandler.LinkPortalUser)
+			clients.DELETE("/:id/portal-user", cfg.ClientHandler.UnlinkPortalUser)
+			clients.PUT("/:id/notifications", cfg.ClientHandler.SetNotifications)
 		}
 
 		// Nested route: GET /api/v1/clients/:id/orders
@@ -65,30 +113,40 @@ func New(cfg Config) *gin.Engine {
 	// Order routes (protected)
 	if cfg.OrderHandler != nil {
 		orders := v1.Group("/orders")
-		if cfg.ClerkMiddleware != nil {
-			orders.Use(cfg.ClerkMiddleware.Authenticate())
-		}
+		protectStaff(orders, cfg, "orders")
 		{
 			orders.POST("", cfg.OrderHandler.Create)
 			orders.GET("", cfg.OrderHandler.List)
+			if cfg.OrderStream != nil {
+				orders.GET("/stream", cfg.OrderStream.Stream)
+			}
 			orders.GET("/:id", cfg.OrderHandler.Get)
 			orders.PUT("/:id", cfg.OrderHandler.Update)
 			orders.PATCH("/:id/status", cfg.OrderHandler.UpdateStatus)
+			orders.POST("/bulk/status", cfg.OrderHandler.BulkUpdateStatus)
 			orders.DELETE("/:id", cfg.OrderHandler.Delete)
+			orders.GET("/:id/attachments", cfg.OrderHandler.ListAttachments)
+			orders.GET("/:id/attachments/:attachment_id", cfg.OrderHandler.DownloadAttachment)
+		}
+
+		if cfg.CommentHandler != nil {
+			orders.GET("/:id/comments", cfg.CommentHandler.List)
+			orders.POST("/:id/comments", cfg.CommentHandler.Create)
+			orders.DELETE("/:id/comments/:comment_id", cfg.CommentHandler.Delete)
+			orders.GET("/:id/comments/ws", cfg.CommentHandler.Connect)
 		}
 	}
 
 	// Prosthesis routes (protected)
 	if cfg.ProsthesisHandler != nil {
 		prostheses := v1.Group("/prostheses")
-		if cfg.ClerkMiddleware != nil {
-			prostheses.Use(cfg.ClerkMiddleware.Authenticate())
-		}
+		protectStaff(prostheses, cfg, "prostheses")
 		{
 			prostheses.POST("", cfg.ProsthesisHandler.Create)
 			prostheses.GET("", cfg.ProsthesisHandler.List)
 			prostheses.GET("/:id", cfg.ProsthesisHandler.Get)
 			prostheses.PUT("/:id", cfg.ProsthesisHandler.Update)
+			prostheses.PATCH("/:id", cfg.ProsthesisHandler.Patch)
 			prostheses.DELETE("/:id", cfg.ProsthesisHandler.Delete)
 		}
 	}
@@ -96,17 +154,112 @@ func New(cfg Config) *gin.Engine {
 	// Technician routes (protected)
 	if cfg.TechnicianHandler != nil {
 		technicians := v1.Group("/technicians")
-		if cfg.ClerkMiddleware != nil {
-			technicians.Use(cfg.ClerkMiddleware.Authenticate())
-		}
+		protectStaff(technicians, cfg, "technicians")
 		{
 			technicians.POST("", cfg.TechnicianHandler.Create)
 			technicians.GET("", cfg.TechnicianHandler.List)
 			technicians.GET("/:id", cfg.TechnicianHandler.Get)
 			technicians.PUT("/:id", cfg.TechnicianHandler.Update)
+			technicians.PATCH("/:id", cfg.TechnicianHandler.Patch)
 			technicians.DELETE("/:id", cfg.TechnicianHandler.Delete)
+			technicians.POST("/bulk/delete", cfg.TechnicianHandler.BulkDelete)
+		}
+	}
+
+	// Client portal routes (protected, scoped to the client bound to the identity)
+	if cfg.PortalHandler != nil {
+		portal := v1.Group("/portal")
+		protect(portal, cfg, "portal")
+		{
+			portal.GET("/me", cfg.PortalHandler.Me)
+			portal.POST("/orders", cfg.PortalHandler.CreateOrder)
+			portal.GET("/orders", cfg.PortalHandler.ListOrders)
+			portal.GET("/orders/:id", cfg.PortalHandler.GetOrder)
+			portal.POST("/orders/:id/attachments", cfg.PortalHandler.UploadAttachment)
+			portal.GET("/orders/:id/attachments/:attachment_id", cfg.PortalHandler.DownloadAttachment)
+		}
+
+		if cfg.CommentHandler != nil {
+			portal.GET("/orders/:id/comments", cfg.CommentHandler.PortalList)
+			portal.POST("/orders/:id/comments", cfg.CommentHandler.PortalCreate)
+			portal.DELETE("/orders/:id/comments/:comment_id", cfg.CommentHandler.PortalDelete)
+			portal.GET("/orders/:id/comments/ws", cfg.CommentHandler.PortalConnect)
+		}
+	}
+
+	// Audit log routes (protected, read-only)
+	if cfg.AuditHandler != nil {
+		auditLog := v1.Group("/audit")
+		protectStaff(auditLog, cfg, "audit")
+		{
+			auditLog.GET("", cfg.AuditHandler.List)
+		}
+	}
+
+	// Webhook routes (protected)
+	if cfg.WebhookHandler != nil {
+		webhooks := v1.Group("/webhooks")
+		protectStaff(webhooks, cfg, "webhooks")
+		{
+			webhooks.POST("", cfg.WebhookHandler.Create)
+			webhooks.GET("", cfg.WebhookHandler.List)
+			webhooks.GET("/:id", cfg.WebhookHandler.Get)
+			webhooks.PUT("/:id", cfg.WebhookHandler.Update)
+			webhooks.DELETE("/:id", cfg.WebhookHandler.Delete)
+			webhooks.GET("/:id/deliveries", cfg.WebhookHandler.ListDeliveries)
+			webhooks.GET("/:id/deliveries/:delivery_id", cfg.WebhookHandler.GetDelivery)
+			webhooks.POST("/:id/deliveries/:delivery_id/redeliver", cfg.WebhookHandler.Redeliver)
+		}
+	}
+
+	// Admin routes (protected, restricted to the admin users)
+	if cfg.JobHandler != nil {
+		admin := v1.Group("/admin")
+		protect(admin, cfg, "admin")
+		admin.Use(handler.RequireAdmin(cfg.AdminUserIDs))
+		{
+			admin.GET("/jobs", cfg.JobHandler.List)
+			admin.GET("/jobs/:name/runs", cfg.JobHandler.ListRuns)
+		}
+	}
+
+	// GraphQL routes (protected)
+	if cfg.GraphQLHandler != nil {
+		graphql := r.Group(GraphQLPath)
+		protectStaff(graphql, cfg, "graphql")
+		{
+			graphql.POST("", cfg.GraphQLHandler.Query)
+			graphql.GET("/schema", cfg.GraphQLHandler.Schema)
 		}
 	}
 
 	return r
 }
+
+// protectStaff protects a route group of the laboratory staff, forbidden to
+// portal identities
+func protectStaff(group *gin.RouterGroup, cfg Config, name string) {
+	if cfg.StaffOnly == nil {
+		protect(group, cfg, name)
+		return
+	}
+	protect(group, cfg, name, cfg.StaffOnly)
+}
+
+// protect applies authentication, the given access checks, localisation, the
+// group's rate limit and Idempotency-Key handling to a route group
+func protect(group *gin.RouterGroup, cfg Config, name string, checks ...gin.HandlerFunc) {
+	if cfg.ClerkMiddleware != nil {
+		group.Use(cfg.ClerkMiddleware.Authenticate())
+	}
+	group.Use(checks...)
+	if cfg.Localizer != nil {
+		group.Use(cfg.Localizer.Middleware())
+	}
+	if cfg.RateLimiter != nil {
+		group.Use(cfg.RateLimiter.Middleware(name))
+	}
+	if cfg.Idempotency != nil {
+		group.Use(cfg.Idempotency.Middleware())
+	}
+}
@@ -0,0 +1,174 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/handler"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/inbound/http/openapi"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/metrics"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	portalapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/portal"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// setupFullRouter registers every route. Handlers are never called, so they
+// don't need services.
+func setupFullRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	return New(Config{
+		LaboratoryHandler:   handler.NewLaboratoryHandler(nil),
+		ClientHandler:       handler.NewClientHandler(nil, nil),
+		OrderHandler:        handler.NewOrderHandler(nil, nil),
+		ProsthesisHandler:   handler.NewProsthesisHandler(nil),
+		TechnicianHandler:   handler.NewTechnicianHandler(nil),
+		PortalHandler:       handler.NewPortalHandler(nil),
+		AuditHandler:        handler.NewAuditHandler(nil),
+		GraphQLHandler:      handler.NewGraphQLHandler(nil),
+		WebhookHandler:      handler.NewWebhookHandler(nil),
+		JobHandler:          handler.NewJobHandler(nil),
+		HealthHandler:       handler.NewHealthHandler(nil),
+		OrderStream:         handler.NewOrderStreamHandler(nil, time.Second),
+		CommentHandler:      handler.NewCommentHandler(nil, nil, nil),
+		NotificationHandler: handler.NewNotificationHandler(nil),
+		Metrics:             metrics.New(nil),
+		Tracing:             true,
+	})
+}
+
+func TestSpec_DocumentsEveryRoute(t *testing.T) {
+	spec := Spec()
+
+	for _, route := range setupFullRouter().Routes() {
+		if !spec.Has(route.Method, route.Path) {
+			t.Errorf("route %s %s is not documented in Spec()", route.Method, route.Path)
+		}
+	}
+}
+
+func TestSpec_DocumentsNoMissingRoute(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, route := range setupFullRouter().Routes() {
+		registered[strings.ToLower(route.Method)+" "+openapi.Path(route.Path)] = true
+	}
+
+	for path, item := range Spec().Paths {
+		for method := range item {
+			if !registered[method+" "+path] {
+				t.Errorf("documented operation %s %s is not registered", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
+
+func TestNew_ServesOpenAPIDocument(t *testing.T) {
+	router := setupFullRouter()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, OpenAPIPath, nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var doc struct {
+		OpenAPI    string                     `json:"openapi"`
+		Paths      map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to unmarshal document: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("openapi = %q, want %q", doc.OpenAPI, openapi.Version)
+	}
+	if _, ok := doc.Paths["/api/v1/orders/{id}"]; !ok {
+		t.Error("paths are missing /api/v1/orders/{id}")
+	}
+	for _, name := range []string{"CreateOrderRequest", "OrderResponse", "OrderResponseList", "Problem"} {
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Errorf("schemas are missing %s", name)
+		}
+	}
+}
+
+func TestNew_ServesSwaggerUI(t *testing.T) {
+	router := setupFullRouter()
+
+	tests := []struct {
+		path        string
+		wantStatus  int
+		wantContent string
+	}{
+		{DocsPath + "/", http.StatusOK, OpenAPIPath},
+		{DocsPath + "/index.html", http.StatusOK, OpenAPIPath},
+		{DocsPath + "/swagger-ui-bundle.js", http.StatusOK, "SwaggerUIBundle"},
+		{DocsPath + "/go.mod", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantContent) {
+				t.Errorf("body does not contain %q", tt.wantContent)
+			}
+		})
+	}
+}
+
+func TestNew_StaffRoutesRefusePortalIdentities(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	clientRepo := memory.NewClientRepository()
+	_ = clientRepo.Create(context.Background(), &client.Client{ID: "client-123", LaboratoryID: "lab-123", Name: "Dr. Ana", PortalUserID: "user_dentist"})
+	router := New(Config{
+		OrderHandler:   handler.NewOrderHandler(nil, nil),
+		GraphQLHandler: handler.NewGraphQLHandler(nil),
+		HealthHandler:  handler.NewHealthHandler(nil),
+		StaffOnly:      handler.RequireStaff(portalapp.NewService(clientRepo, nil)),
+	})
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		userID     string
+		wantStatus int
+	}{
+		{"portal identity", http.MethodGet, "/api/v1/orders", "user_dentist", http.StatusForbidden},
+		// Past the guard, the handler asks for the laboratory
+		{"staff identity", http.MethodGet, "/api/v1/orders", "user_staff", http.StatusBadRequest},
+		{"portal identity on GraphQL", http.MethodPost, GraphQLPath, "user_dentist", http.StatusForbidden},
+		{"staff identity on GraphQL", http.MethodPost, GraphQLPath, "user_staff", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Stands in for the authentication middleware
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req = req.WithContext(auth.WithUserID(req.Context(), tt.userID))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("%s %s status = %d, want %d", tt.method, tt.path, rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Package metrics exposes Prometheus metrics: HTTP requests, repository
+// operations and the order KPIs of every laboratory
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// namespace prefixes the name of every metric
+const namespace = "dental"
+
+// Metrics holds the metrics of the server in their own registry
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests       *prometheus.CounterVec
+	httpDuration       *prometheus.HistogramVec
+	repositoryDuration *prometheus.HistogramVec
+	statusTransitions  *prometheus.CounterVec
+	statusDuration     *prometheus.HistogramVec
+}
+
+// New creates the metrics. The order gauges are read from stats on every
+// scrape; they are left out when stats is nil.
+func New(stats outbound.OrderStatsReader) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests served, by method, route and status code.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Time taken to serve HTTP requests, by method, route and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		repositoryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_operation_duration_seconds",
+			Help:      "Time taken by repository operations, by repository, operation and outcome (ok or error).",
+			Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+		}, []string{"repository", "operation", "outcome"}),
+		statusTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "order_status_transitions_total",
+			Help:      "Order status transitions, by laboratory and statuses left and entered.",
+		}, []string{"laboratory_id", "from", "to"}),
+		statusDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "order_status_duration_seconds",
+			Help:      "Time orders spent in a status before leaving it, by status.",
+			Buckets:   statusDurationBuckets,
+		}, []string{"status"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.repositoryDuration,
+		m.statusTransitions,
+		m.statusDuration,
+	)
+	if stats != nil {
+		m.registry.MustRegister(newOrderCollector(stats))
+	}
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/instrumented"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// scrape returns the metrics served by the handler
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("scrape status = %v, body: %s", rec.Code, rec.Body.String())
+	}
+	return rec.Body.String()
+}
+
+// assertContains fails unless every line is in the scraped metrics
+func assertContains(t *testing.T, scraped string, lines ...string) {
+	t.Helper()
+	for _, line := range lines {
+		if !strings.Contains(scraped, line+"\n") {
+			t.Errorf("metrics don't contain %q", line)
+		}
+	}
+}
+
+func TestMetrics_Middleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m := New(nil)
+
+	r := gin.New()
+	r.Use(m.Middleware())
+	r.GET("/orders/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+	for _, path := range []string{"/orders/order-1", "/orders/order-2", "/unknown"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	scraped := scrape(t, m)
+	assertContains(t, scraped,
+		`dental_http_requests_total{method="GET",route="/orders/:id",status="200"} 2`,
+		`dental_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`dental_http_request_duration_seconds_count{method="GET",route="/orders/:id",status="200"} 2`,
+	)
+	if !strings.Contains(scraped, "go_goroutines") {
+		t.Error("metrics don't contain the runtime metrics")
+	}
+}
+
+func TestMetrics_ObserveRepository(t *testing.T) {
+	m := New(nil)
+	ctx := context.Background()
+
+	for _, call := range []struct {
+		op  instrumented.Operation
+		err error
+	}{
+		{instrumented.Operation{Repository: "orders", Name: "GetByID", ID: "order-1"}, nil},
+		{instrumented.Operation{Repository: "orders", Name: "GetByID", ID: "order-2"}, errors.ErrNotFound},
+		{instrumented.Operation{Repository: "orders", Name: "Create", ID: "order-3"}, stderrors.New("store unavailable")},
+	} {
+		_, done := m.ObserveRepository(ctx, call.op)
+		done(call.err)
+	}
+
+	assertContains(t, scrape(t, m),
+		`dental_repository_operation_duration_seconds_count{operation="GetByID",outcome="ok",repository="orders"} 2`,
+		`dental_repository_operation_duration_seconds_count{operation="Create",outcome="error",repository="orders"} 1`,
+	)
+}
+
+func TestMetrics_Orders(t *testing.T) {
+	repo := memory.NewOrderRepository()
+	ctx := context.Background()
+	m := New(repo)
+
+	at := time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)
+	o := &order.Order{ID: "order-1", LaboratoryID: "lab-1", Status: order.StatusReceived,
+		History: []order.StatusChange{{To: order.StatusReceived, ChangedAt: at}}}
+	_ = repo.Create(ctx, o)
+	_ = repo.Create(ctx, &order.Order{ID: "order-2", LaboratoryID: "lab-1", Status: order.StatusReceived})
+
+	// The first order spends two hours received, then three in production
+	// before going to revision
+	for _, step := range []struct {
+		status order.Status
+		after  time.Duration
+	}{
+		{order.StatusInProduction, 2 * time.Hour},
+		{order.StatusQualityCheck, 3 * time.Hour},
+		{order.StatusRevision, time.Minute},
+	} {
+		from := o.Status
+		at = at.Add(step.after)
+		o.History = append(o.History, order.StatusChange{From: from, To: step.status, ChangedAt: at})
+		o.Status = step.status
+		_ = repo.Update(ctx, o)
+		_ = m.Handle(ctx, event.OrderStatusChanged{Metadata: event.NewMetadata("lab-1"), Order: *o, From: from, To: step.status})
+	}
+	_ = m.Handle(ctx, event.OrderDeleted{Metadata: event.NewMetadata("lab-1"), Order: *o})
+
+	assertContains(t, scrape(t, m),
+		`dental_orders{laboratory_id="lab-1",status="received"} 1`,
+		`dental_orders{laboratory_id="lab-1",status="revision"} 1`,
+		`dental_orders{laboratory_id="lab-1",status="delivered"} 0`,
+		`dental_order_revision_rate{laboratory_id="lab-1"} 0.5`,
+		`dental_order_status_transitions_total{from="quality_check",laboratory_id="lab-1",to="revision"} 1`,
+		`dental_order_status_duration_seconds_sum{status="received"} 7200`,
+		`dental_order_status_duration_seconds_bucket{status="in_production",le="14400"} 1`,
+		`dental_order_status_duration_seconds_bucket{status="in_production",le="3600"} 0`,
+	)
+}
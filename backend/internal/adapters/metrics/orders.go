@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// statusDurationBuckets spans the time orders stay in a status, from minutes
+// to a month
+var statusDurationBuckets = []float64{
+	(5 * time.Minute).Seconds(),
+	(30 * time.Minute).Seconds(),
+	time.Hour.Seconds(),
+	(4 * time.Hour).Seconds(),
+	(12 * time.Hour).Seconds(),
+	(24 * time.Hour).Seconds(),
+	(2 * 24 * time.Hour).Seconds(),
+	(4 * 24 * time.Hour).Seconds(),
+	(7 * 24 * time.Hour).Seconds(),
+	(14 * 24 * time.Hour).Seconds(),
+	(30 * 24 * time.Hour).Seconds(),
+}
+
+// Handle counts the status transitions of orders and the time they spent in
+// the status they left. The outbox relay doesn't retry subscribers that
+// already handled an event, so an event is counted twice only when the outcome
+// of its delivery is lost.
+func (m *Metrics) Handle(ctx context.Context, e event.Event) error {
+	changed, ok := e.(event.OrderStatusChanged)
+	if !ok {
+		return nil
+	}
+
+	m.statusTransitions.WithLabelValues(changed.Meta().LaboratoryID, string(changed.From), string(changed.To)).Inc()
+	if status, d, ok := changed.Order.LastStatusDuration(); ok {
+		m.statusDuration.WithLabelValues(string(status)).Observe(d.Seconds())
+	}
+	return nil
+}
+
+// orderCollector reports the orders of every laboratory by status and their
+// revision rate, read from the repository on every scrape
+type orderCollector struct {
+	stats        outbound.OrderStatsReader
+	orders       *prometheus.Desc
+	revisionRate *prometheus.Desc
+}
+
+func newOrderCollector(stats outbound.OrderStatsReader) *orderCollector {
+	return &orderCollector{
+		stats: stats,
+		orders: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "orders"),
+			"Active orders, by laboratory and status.", []string{"laboratory_id", "status"}, nil),
+		revisionRate: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "order_revision_rate"),
+			"Share of the active orders of a laboratory sent to revision at least once.", []string{"laboratory_id"}, nil),
+	}
+}
+
+// Describe sends the descriptors of the order metrics
+func (c *orderCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.orders
+	ch <- c.revisionRate
+}
+
+// Collect sends the current order metrics of every laboratory, every status
+// included so that emptied statuses drop to zero
+func (c *orderCollector) Collect(ch chan<- prometheus.Metric) {
+	stats, err := c.stats.Stats(context.Background())
+	if err != nil {
+		slog.Error("metrics: failed to read the order stats", "error", err)
+		ch <- prometheus.NewInvalidMetric(c.orders, err)
+		return
+	}
+
+	for _, s := range stats {
+		for _, status := range order.AllStatuses() {
+			ch <- prometheus.MustNewConstMetric(c.orders, prometheus.GaugeValue, float64(s.ByStatus[status]), s.LaboratoryID, string(status))
+		}
+		ch <- prometheus.MustNewConstMetric(c.revisionRate, prometheus.GaugeValue, s.RevisionRate(), s.LaboratoryID)
+	}
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/instrumented"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// ObserveRepository times a repository operation; the returned func ends it
+// with its error. Not found errors are expected answers, counted as ok.
+func (m *Metrics) ObserveRepository(ctx context.Context, op instrumented.Operation) (context.Context, func(err error)) {
+	start := time.Now()
+	return ctx, func(err error) {
+		outcome := "ok"
+		if err != nil && err != errors.ErrNotFound {
+			outcome = "error"
+		}
+		m.repositoryDuration.WithLabelValues(op.Repository, op.Name, outcome).Observe(time.Since(start).Seconds())
+	}
+}
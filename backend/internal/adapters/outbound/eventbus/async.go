@@ -0,0 +1,81 @@
+package eventbus
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+// AsyncBus queues events and delivers them to their handlers in the
+// background, so that publishers don't wait for them. Handlers get the
+// values of the publisher's context but not its cancellation. With a single
+// worker, events are delivered in the order they were published.
+type AsyncBus struct {
+	subscriptions
+	queue  chan queued
+	mu     sync.RWMutex // Guards closed against sends on the closed queue
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// queued is an event waiting for delivery
+type queued struct {
+	ctx   context.Context
+	event event.Event
+}
+
+// NewAsyncBus creates an asynchronous event bus holding up to buffer
+// pending events, delivered by the given number of workers
+func NewAsyncBus(buffer, workers int) *AsyncBus {
+	if workers < 1 {
+		workers = 1
+	}
+	b := &AsyncBus{queue: make(chan queued, buffer)}
+	b.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go b.work()
+	}
+	return b
+}
+
+// Publish queues events for delivery. It blocks while the queue is full,
+// unless ctx is done, in which case the remaining events are dropped.
+func (b *AsyncBus) Publish(ctx context.Context, events ...event.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	detached := context.WithoutCancel(ctx)
+	for _, e := range events {
+		if b.closed {
+			slog.WarnContext(ctx, "eventbus: dropped an event, bus closed", "event", e.Name())
+			continue
+		}
+		select {
+		case b.queue <- queued{ctx: detached, event: e}:
+		case <-ctx.Done():
+			slog.WarnContext(ctx, "eventbus: dropped an event", "event", e.Name(), "error", ctx.Err())
+		}
+	}
+	return nil
+}
+
+// Close stops accepting events and waits until the queued ones are delivered
+func (b *AsyncBus) Close() {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		close(b.queue)
+	}
+	b.mu.Unlock()
+	b.wg.Wait()
+}
+
+// work delivers queued events until the queue is closed
+func (b *AsyncBus) work() {
+	defer b.wg.Done()
+	for q := range b.queue {
+		b.dispatch(q.ctx, q.event)
+	}
+}
@@ -0,0 +1,132 @@
+// Package eventbus implements in-memory event publishers that deliver domain
+// events to the handlers subscribed to them
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// Bus publishes events to the handlers subscribed to them
+type Bus interface {
+	outbound.EventPublisher
+	Subscriber
+	Deliver(ctx context.Context, e event.Event, done []string) ([]string, error)
+	// Close stops accepting events once the published ones are delivered
+	Close()
+}
+
+var (
+	_ Bus = (*SyncBus)(nil)
+	_ Bus = (*AsyncBus)(nil)
+)
+
+// Handler reacts to an event. A failing handler doesn't affect the others.
+type Handler func(ctx context.Context, e event.Event) error
+
+// Subscriber registers handlers of events. Each handler belongs to a named
+// subscriber, unique among the handlers of an event, so that a failed delivery
+// is retried for the failing subscribers only.
+type Subscriber interface {
+	// Subscribe registers a handler of the events with the given name
+	Subscribe(subscriber string, name event.Name, h Handler)
+	// SubscribeAll registers a handler of every event
+	SubscribeAll(subscriber string, h Handler)
+}
+
+// On subscribes a handler of a single kind of event
+func On[E event.Event](s Subscriber, subscriber string, h func(ctx context.Context, e E) error) {
+	var zero E
+	s.Subscribe(subscriber, zero.Name(), func(ctx context.Context, e event.Event) error {
+		typed, ok := e.(E)
+		if !ok {
+			return nil
+		}
+		return h(ctx, typed)
+	})
+}
+
+// subscription is a handler of a subscriber
+type subscription struct {
+	subscriber string
+	handler    Handler
+}
+
+// subscriptions holds the handlers of a bus
+type subscriptions struct {
+	mu     sync.RWMutex
+	byName map[event.Name][]subscription
+	all    []subscription
+}
+
+// Subscribe registers a handler of the events with the given name
+func (s *subscriptions) Subscribe(subscriber string, name event.Name, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byName == nil {
+		s.byName = make(map[event.Name][]subscription)
+	}
+	s.byName[name] = append(s.byName[name], subscription{subscriber, h})
+}
+
+// SubscribeAll registers a handler of every event
+func (s *subscriptions) SubscribeAll(subscriber string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.all = append(s.all, subscription{subscriber, h})
+}
+
+// dispatch calls the handlers of an event in the order they subscribed,
+// logging their failures
+func (s *subscriptions) dispatch(ctx context.Context, e event.Event) {
+	if _, err := s.Deliver(ctx, e, nil); err != nil {
+		slog.ErrorContext(ctx, "eventbus: failed to handle an event", "event", e.Name(), "error", err)
+	}
+}
+
+// Deliver calls the handlers of an event right away, in the order they
+// subscribed, skipping the subscribers in done. It returns the subscribers
+// that handled the event and the failures of the others, for the caller to
+// retry the delivery to them only.
+func (s *subscriptions) Deliver(ctx context.Context, e event.Event, done []string) ([]string, error) {
+	s.mu.RLock()
+	subs := make([]subscription, 0, len(s.byName[e.Name()])+len(s.all))
+	subs = append(subs, s.byName[e.Name()]...)
+	subs = append(subs, s.all...)
+	s.mu.RUnlock()
+
+	skip := make(map[string]bool, len(done))
+	for _, subscriber := range done {
+		skip[subscriber] = true
+	}
+
+	var handled []string
+	var errs []error
+	for _, sub := range subs {
+		if skip[sub.subscriber] {
+			continue
+		}
+		if err := call(ctx, sub.handler, e); err != nil {
+			errs = append(errs, fmt.Errorf("%s handler of %s failed: %w", sub.subscriber, e.Name(), err))
+			continue
+		}
+		handled = append(handled, sub.subscriber)
+	}
+	return handled, errors.Join(errs...)
+}
+
+// call calls a handler, turning a panic into an error
+func call(ctx context.Context, h Handler, e event.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return h(ctx, e)
+}
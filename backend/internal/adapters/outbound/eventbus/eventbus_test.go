@@ -0,0 +1,161 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+)
+
+// recorder records the events delivered to its handler
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) handle(ctx context.Context, e event.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, string(e.Name())+":"+e.Meta().LaboratoryID)
+	return nil
+}
+
+func (r *recorder) got() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.events
+}
+
+func created(laboratoryID string) event.Event {
+	return event.OrderCreated{Metadata: event.NewMetadata(laboratoryID)}
+}
+
+func deleted(laboratoryID string) event.Event {
+	return event.OrderDeleted{Metadata: event.NewMetadata(laboratoryID)}
+}
+
+func TestSyncBus_Publish(t *testing.T) {
+	bus := NewSyncBus()
+
+	byName, all := &recorder{}, &recorder{}
+	bus.Subscribe("by_name", event.NameOrderCreated, byName.handle)
+	bus.Subscribe("failing", event.NameOrderCreated, func(ctx context.Context, e event.Event) error {
+		return errors.New("failed")
+	})
+	bus.Subscribe("panicking", event.NameOrderCreated, func(ctx context.Context, e event.Event) error {
+		panic("boom")
+	})
+	bus.SubscribeAll("all", all.handle)
+
+	bus.Publish(context.Background(), created("lab-1"), deleted("lab-1"), created("lab-2"))
+
+	if want := []string{"order.created:lab-1", "order.created:lab-2"}; !reflect.DeepEqual(byName.got(), want) {
+		t.Errorf("handler of order.created got %v, want %v", byName.got(), want)
+	}
+	if want := []string{"order.created:lab-1", "order.deleted:lab-1", "order.created:lab-2"}; !reflect.DeepEqual(all.got(), want) {
+		t.Errorf("handler of every event got %v, want %v", all.got(), want)
+	}
+}
+
+func TestSyncBus_Deliver(t *testing.T) {
+	bus := NewSyncBus()
+
+	done, failing := &recorder{}, &recorder{}
+	bus.SubscribeAll("done", done.handle)
+	bus.SubscribeAll("failing", func(ctx context.Context, e event.Event) error {
+		_ = failing.handle(ctx, e)
+		return errors.New("failed")
+	})
+	bus.SubscribeAll("ok", func(ctx context.Context, e event.Event) error { return nil })
+
+	handled, err := bus.Deliver(context.Background(), created("lab-1"), []string{"done"})
+	if err == nil || !reflect.DeepEqual(handled, []string{"ok"}) {
+		t.Errorf("Deliver() = %v, %v, want [ok] and the failure", handled, err)
+	}
+	if len(done.got()) != 0 || len(failing.got()) != 1 {
+		t.Errorf("handlers got done %v, failing %v, want the done subscriber skipped", done.got(), failing.got())
+	}
+}
+
+func TestOn(t *testing.T) {
+	bus := NewSyncBus()
+
+	var changes []order.Status
+	On(bus, "changes", func(ctx context.Context, e event.OrderStatusChanged) error {
+		changes = append(changes, e.To)
+		return nil
+	})
+
+	bus.Publish(context.Background(),
+		created("lab-1"),
+		event.OrderStatusChanged{Metadata: event.NewMetadata("lab-1"), From: order.StatusReceived, To: order.StatusInProduction},
+	)
+
+	if want := []order.Status{order.StatusInProduction}; !reflect.DeepEqual(changes, want) {
+		t.Errorf("On() handler got %v, want %v", changes, want)
+	}
+}
+
+func TestAsyncBus_Publish(t *testing.T) {
+	bus := NewAsyncBus(2, 1)
+
+	all := &recorder{}
+	bus.SubscribeAll("all", all.handle)
+
+	var requestIDs []string
+	var cancelled bool
+	bus.Subscribe("deletions", event.NameOrderDeleted, func(ctx context.Context, e event.Event) error {
+		requestIDs = append(requestIDs, requestid.FromContext(ctx))
+		cancelled = ctx.Err() != nil
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(requestid.WithRequestID(context.Background(), "req-1"))
+	bus.Publish(ctx, created("lab-1"), deleted("lab-1"), created("lab-2"), deleted("lab-2"))
+	cancel()
+	bus.Close()
+
+	want := []string{"order.created:lab-1", "order.deleted:lab-1", "order.created:lab-2", "order.deleted:lab-2"}
+	if !reflect.DeepEqual(all.got(), want) {
+		t.Errorf("handler of every event got %v, want %v", all.got(), want)
+	}
+	if !reflect.DeepEqual(requestIDs, []string{"req-1", "req-1"}) || cancelled {
+		t.Errorf("handler context request IDs = %v, cancelled = %v, want the publisher's values without its cancellation", requestIDs, cancelled)
+	}
+
+	// Events published once closed are dropped
+	bus.Publish(context.Background(), created("lab-3"))
+	bus.Close()
+	if len(all.got()) != len(want) {
+		t.Errorf("handler got %v after Close, want no more events", all.got())
+	}
+}
+
+func TestAsyncBus_Publish_CancelledWhileFull(t *testing.T) {
+	bus := NewAsyncBus(0, 1)
+
+	release := make(chan struct{})
+	all := &recorder{}
+	bus.SubscribeAll("all", func(ctx context.Context, e event.Event) error {
+		<-release
+		return all.handle(ctx, e)
+	})
+
+	// The worker holds the first event, so the unbuffered queue is full
+	bus.Publish(context.Background(), created("lab-1"))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	bus.Publish(ctx, created("lab-2"))
+
+	close(release)
+	bus.Close()
+
+	if want := []string{"order.created:lab-1"}; !reflect.DeepEqual(all.got(), want) {
+		t.Errorf("handler got %v, want %v", all.got(), want)
+	}
+}
@@ -0,0 +1,29 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+// SyncBus delivers events to their handlers before Publish returns, within
+// the context of the publisher
+type SyncBus struct {
+	subscriptions
+}
+
+// NewSyncBus creates a synchronous event bus
+func NewSyncBus() *SyncBus {
+	return &SyncBus{}
+}
+
+// Publish delivers events, in order, to their handlers
+func (b *SyncBus) Publish(ctx context.Context, events ...event.Event) error {
+	for _, e := range events {
+		b.dispatch(ctx, e)
+	}
+	return nil
+}
+
+// Close does nothing: published events are delivered by then
+func (b *SyncBus) Close() {}
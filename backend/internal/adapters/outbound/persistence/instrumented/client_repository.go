@@ -0,0 +1,76 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// ClientRepository reports the calls of a client repository
+type ClientRepository struct {
+	next     outbound.ClientRepository
+	observer Observer
+}
+
+// NewClientRepository wraps next to report its calls to observer
+func NewClientRepository(next outbound.ClientRepository, observer Observer) *ClientRepository {
+	return &ClientRepository{next: next, observer: observer}
+}
+
+// Create stores a new client
+func (r *ClientRepository) Create(ctx context.Context, c *client.Client) error {
+	return exec(ctx, r.observer, Operation{Repository: "clients", Name: "Create", ID: c.ID, LaboratoryID: c.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Create(ctx, c)
+	})
+}
+
+// GetByID retrieves a client by ID (excludes soft-deleted)
+func (r *ClientRepository) GetByID(ctx context.Context, id string) (*client.Client, error) {
+	return call(ctx, r.observer, Operation{Repository: "clients", Name: "GetByID", ID: id}, func(ctx context.Context) (*client.Client, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// soft-deleted); IDs without a match are skipped
+func (r *ClientRepository) GetByIDs(ctx context.Context, ids []string) ([]*client.Client, error) {
+	return call(ctx, r.observer, Operation{Repository: "clients", Name: "GetByIDs"}, func(ctx context.Context) ([]*client.Client, error) {
+		return r.next.GetByIDs(ctx, ids)
+	})
+}
+
+// GetByEmail retrieves a client by email within a laboratory (excludes soft-deleted)
+func (r *ClientRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*client.Client, error) {
+	return call(ctx, r.observer, Operation{Repository: "clients", Name: "GetByEmail", LaboratoryID: laboratoryID}, func(ctx context.Context) (*client.Client, error) {
+		return r.next.GetByEmail(ctx, laboratoryID, email)
+	})
+}
+
+// GetByPortalUserID retrieves the client bound to a portal identity (excludes soft-deleted)
+func (r *ClientRepository) GetByPortalUserID(ctx context.Context, userID string) (*client.Client, error) {
+	return call(ctx, r.observer, Operation{Repository: "clients", Name: "GetByPortalUserID"}, func(ctx context.Context) (*client.Client, error) {
+		return r.next.GetByPortalUserID(ctx, userID)
+	})
+}
+
+// Update updates an existing client
+func (r *ClientRepository) Update(ctx context.Context, c *client.Client) error {
+	return exec(ctx, r.observer, Operation{Repository: "clients", Name: "Update", ID: c.ID, LaboratoryID: c.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Update(ctx, c)
+	})
+}
+
+// Delete performs a soft delete on a client
+func (r *ClientRepository) Delete(ctx context.Context, id string) error {
+	return exec(ctx, r.observer, Operation{Repository: "clients", Name: "Delete", ID: id}, func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// List retrieves a page of active (non-deleted) clients for a laboratory
+func (r *ClientRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*client.Client], error) {
+	return call(ctx, r.observer, Operation{Repository: "clients", Name: "List", LaboratoryID: laboratoryID}, func(ctx context.Context) (listing.Page[*client.Client], error) {
+		return r.next.List(ctx, laboratoryID, q)
+	})
+}
@@ -0,0 +1,47 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// CommentRepository reports the calls of a comment repository
+type CommentRepository struct {
+	next     outbound.CommentRepository
+	observer Observer
+}
+
+// NewCommentRepository wraps next to report its calls to observer
+func NewCommentRepository(next outbound.CommentRepository, observer Observer) *CommentRepository {
+	return &CommentRepository{next: next, observer: observer}
+}
+
+// Create stores a new comment
+func (r *CommentRepository) Create(ctx context.Context, c *comment.Comment) error {
+	return exec(ctx, r.observer, Operation{Repository: "comments", Name: "Create", ID: c.ID, LaboratoryID: c.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Create(ctx, c)
+	})
+}
+
+// GetByID retrieves a comment by ID (excludes soft-deleted)
+func (r *CommentRepository) GetByID(ctx context.Context, id string) (*comment.Comment, error) {
+	return call(ctx, r.observer, Operation{Repository: "comments", Name: "GetByID", ID: id}, func(ctx context.Context) (*comment.Comment, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// Delete performs a soft delete on a comment
+func (r *CommentRepository) Delete(ctx context.Context, id string) error {
+	return exec(ctx, r.observer, Operation{Repository: "comments", Name: "Delete", ID: id}, func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// ListByOrder retrieves the active comments of an order, oldest first
+func (r *CommentRepository) ListByOrder(ctx context.Context, orderID string) ([]*comment.Comment, error) {
+	return call(ctx, r.observer, Operation{Repository: "comments", Name: "ListByOrder"}, func(ctx context.Context) ([]*comment.Comment, error) {
+		return r.next.ListByOrder(ctx, orderID)
+	})
+}
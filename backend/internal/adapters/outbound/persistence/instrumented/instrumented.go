@@ -0,0 +1,53 @@
+// Package instrumented wraps the repositories to report every call to an
+// observer, e.g. to time or trace them
+package instrumented
+
+import "context"
+
+// Operation describes a repository call
+type Operation struct {
+	Repository   string // Repository name, e.g. orders
+	Name         string // Method called, e.g. GetByID
+	ID           string // Entity read or written, when the call targets one
+	LaboratoryID string // Laboratory the call is scoped to, when known
+}
+
+// Observer is notified of the repository calls
+type Observer interface {
+	// ObserveRepository is called when an operation starts and returns the
+	// context to run it with; the returned func is called with its error when
+	// it ends
+	ObserveRepository(ctx context.Context, op Operation) (context.Context, func(err error))
+}
+
+// Observers notifies every observer of the calls, in order
+type Observers []Observer
+
+// ObserveRepository notifies every observer that the operation starts
+func (o Observers) ObserveRepository(ctx context.Context, op Operation) (context.Context, func(err error)) {
+	dones := make([]func(err error), len(o))
+	for i, observer := range o {
+		ctx, dones[i] = observer.ObserveRepository(ctx, op)
+	}
+	return ctx, func(err error) {
+		for i := len(dones) - 1; i >= 0; i-- {
+			dones[i](err)
+		}
+	}
+}
+
+// call runs an operation returning a value, reporting it to the observer
+func call[T any](ctx context.Context, o Observer, op Operation, fn func(ctx context.Context) (T, error)) (T, error) {
+	ctx, done := o.ObserveRepository(ctx, op)
+	v, err := fn(ctx)
+	done(err)
+	return v, err
+}
+
+// exec runs an operation, reporting it to the observer
+func exec(ctx context.Context, o Observer, op Operation, fn func(ctx context.Context) error) error {
+	ctx, done := o.ObserveRepository(ctx, op)
+	err := fn(ctx)
+	done(err)
+	return err
+}
@@ -0,0 +1,122 @@
+package instrumented
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+)
+
+// observation is a repository call reported to the recorder
+type observation struct {
+	op  Operation
+	err error
+}
+
+// recorder records the calls reported to it
+type recorder struct {
+	observations []observation
+}
+
+func (r *recorder) ObserveRepository(ctx context.Context, op Operation) (context.Context, func(err error)) {
+	return ctx, func(err error) {
+		r.observations = append(r.observations, observation{op, err})
+	}
+}
+
+func TestOrderRepository(t *testing.T) {
+	rec := &recorder{}
+	repo := NewOrderRepository(memory.NewOrderRepository(), rec)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, &order.Order{ID: "order-1", LaboratoryID: "lab-1", Status: order.StatusReceived}); err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+	if o, err := repo.GetByID(ctx, "order-1"); err != nil || o.ID != "order-1" {
+		t.Fatalf("GetByID() = %v, %v, want the created order", o, err)
+	}
+	if _, err := repo.GetByID(ctx, "order-2"); err != errors.ErrNotFound {
+		t.Fatalf("GetByID() of an unknown order error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	want := []observation{
+		{Operation{Repository: "orders", Name: "Create", ID: "order-1", LaboratoryID: "lab-1"}, nil},
+		{Operation{Repository: "orders", Name: "GetByID", ID: "order-1"}, nil},
+		{Operation{Repository: "orders", Name: "GetByID", ID: "order-2"}, errors.ErrNotFound},
+	}
+	if len(rec.observations) != len(want) {
+		t.Fatalf("observed %+v, want %+v", rec.observations, want)
+	}
+	for i, o := range want {
+		if rec.observations[i] != o {
+			t.Errorf("observation %d = %+v, want %+v", i, rec.observations[i], o)
+		}
+	}
+}
+
+func TestObservers(t *testing.T) {
+	first, second := &recorder{}, &recorder{}
+	repo := NewOrderRepository(memory.NewOrderRepository(), Observers{first, second})
+
+	_ = repo.Delete(context.Background(), "order-1")
+
+	want := observation{Operation{Repository: "orders", Name: "Delete", ID: "order-1"}, errors.ErrNotFound}
+	for i, rec := range []*recorder{first, second} {
+		if len(rec.observations) != 1 || rec.observations[0] != want {
+			t.Errorf("observer %d observed %+v, want %+v", i, rec.observations, want)
+		}
+	}
+}
+
+func TestTracer(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(tp)
+
+	repo := NewOrderRepository(memory.NewOrderRepository(), NewTracer())
+	ctx := logging.WithLaboratoryID(context.Background(), "lab-1")
+
+	_ = repo.Create(ctx, &order.Order{ID: "order-1", LaboratoryID: "lab-1", Status: order.StatusReceived})
+	_, _ = repo.GetByID(ctx, "order-2")
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("ended %d spans, want 2", len(ended))
+	}
+	tests := []struct {
+		name       string
+		wantAttrs  map[attribute.Key]string
+		wantStatus codes.Code
+	}{
+		{"orders.Create", map[attribute.Key]string{"operation": "Create", "entity_id": "order-1", "laboratory_id": "lab-1"}, codes.Unset},
+		{"orders.GetByID", map[attribute.Key]string{"operation": "GetByID", "entity_id": "order-2", "laboratory_id": "lab-1"}, codes.Error},
+	}
+	for i, tt := range tests {
+		span := ended[i]
+		if span.Name() != tt.name {
+			t.Errorf("span %d name = %q, want %q", i, span.Name(), tt.name)
+		}
+		attrs := map[attribute.Key]string{}
+		for _, kv := range span.Attributes() {
+			attrs[kv.Key] = kv.Value.Emit()
+		}
+		for key, value := range tt.wantAttrs {
+			if attrs[key] != value {
+				t.Errorf("span %s attribute %s = %q, want %q", tt.name, key, attrs[key], value)
+			}
+		}
+		if span.Status().Code != tt.wantStatus {
+			t.Errorf("span %s status = %v, want %v", tt.name, span.Status().Code, tt.wantStatus)
+		}
+	}
+}
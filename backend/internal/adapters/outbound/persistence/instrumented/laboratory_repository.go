@@ -0,0 +1,62 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// LaboratoryRepository reports the calls of a laboratory repository
+type LaboratoryRepository struct {
+	next     outbound.LaboratoryRepository
+	observer Observer
+}
+
+// NewLaboratoryRepository wraps next to report its calls to observer
+func NewLaboratoryRepository(next outbound.LaboratoryRepository, observer Observer) *LaboratoryRepository {
+	return &LaboratoryRepository{next: next, observer: observer}
+}
+
+// Create stores a new laboratory
+func (r *LaboratoryRepository) Create(ctx context.Context, lab *laboratory.Laboratory) error {
+	return exec(ctx, r.observer, Operation{Repository: "laboratories", Name: "Create", ID: lab.ID, LaboratoryID: lab.ID}, func(ctx context.Context) error {
+		return r.next.Create(ctx, lab)
+	})
+}
+
+// GetByID retrieves a laboratory by ID (excludes soft-deleted)
+func (r *LaboratoryRepository) GetByID(ctx context.Context, id string) (*laboratory.Laboratory, error) {
+	return call(ctx, r.observer, Operation{Repository: "laboratories", Name: "GetByID", ID: id}, func(ctx context.Context) (*laboratory.Laboratory, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// GetByEmail retrieves a laboratory by email (excludes soft-deleted)
+func (r *LaboratoryRepository) GetByEmail(ctx context.Context, email string) (*laboratory.Laboratory, error) {
+	return call(ctx, r.observer, Operation{Repository: "laboratories", Name: "GetByEmail"}, func(ctx context.Context) (*laboratory.Laboratory, error) {
+		return r.next.GetByEmail(ctx, email)
+	})
+}
+
+// Update updates an existing laboratory
+func (r *LaboratoryRepository) Update(ctx context.Context, lab *laboratory.Laboratory) error {
+	return exec(ctx, r.observer, Operation{Repository: "laboratories", Name: "Update", ID: lab.ID, LaboratoryID: lab.ID}, func(ctx context.Context) error {
+		return r.next.Update(ctx, lab)
+	})
+}
+
+// Delete performs a soft delete on a laboratory
+func (r *LaboratoryRepository) Delete(ctx context.Context, id string) error {
+	return exec(ctx, r.observer, Operation{Repository: "laboratories", Name: "Delete", ID: id}, func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// List retrieves a page of active (non-deleted) laboratories
+func (r *LaboratoryRepository) List(ctx context.Context, q listing.Query) (listing.Page[*laboratory.Laboratory], error) {
+	return call(ctx, r.observer, Operation{Repository: "laboratories", Name: "List"}, func(ctx context.Context) (listing.Page[*laboratory.Laboratory], error) {
+		return r.next.List(ctx, q)
+	})
+}
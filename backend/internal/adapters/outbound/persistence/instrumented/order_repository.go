@@ -0,0 +1,76 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// OrderRepository reports the calls of an order repository
+type OrderRepository struct {
+	next     outbound.OrderRepository
+	observer Observer
+}
+
+// NewOrderRepository wraps next to report its calls to observer
+func NewOrderRepository(next outbound.OrderRepository, observer Observer) *OrderRepository {
+	return &OrderRepository{next: next, observer: observer}
+}
+
+// Create stores a new order
+func (r *OrderRepository) Create(ctx context.Context, o *order.Order) error {
+	return exec(ctx, r.observer, Operation{Repository: "orders", Name: "Create", ID: o.ID, LaboratoryID: o.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Create(ctx, o)
+	})
+}
+
+// GetByID retrieves an order by ID (excludes soft-deleted)
+func (r *OrderRepository) GetByID(ctx context.Context, id string) (*order.Order, error) {
+	return call(ctx, r.observer, Operation{Repository: "orders", Name: "GetByID", ID: id}, func(ctx context.Context) (*order.Order, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// Update updates an existing order
+func (r *OrderRepository) Update(ctx context.Context, o *order.Order) error {
+	return exec(ctx, r.observer, Operation{Repository: "orders", Name: "Update", ID: o.ID, LaboratoryID: o.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Update(ctx, o)
+	})
+}
+
+// UpdateStatus updates only the order status
+func (r *OrderRepository) UpdateStatus(ctx context.Context, id string, status order.Status) error {
+	return exec(ctx, r.observer, Operation{Repository: "orders", Name: "UpdateStatus", ID: id}, func(ctx context.Context) error {
+		return r.next.UpdateStatus(ctx, id, status)
+	})
+}
+
+// AddAttachment appends an attachment to an order, leaving its other fields as stored
+func (r *OrderRepository) AddAttachment(ctx context.Context, id string, a order.Attachment) error {
+	return exec(ctx, r.observer, Operation{Repository: "orders", Name: "AddAttachment", ID: id}, func(ctx context.Context) error {
+		return r.next.AddAttachment(ctx, id, a)
+	})
+}
+
+// Delete performs a soft delete on an order
+func (r *OrderRepository) Delete(ctx context.Context, id string) error {
+	return exec(ctx, r.observer, Operation{Repository: "orders", Name: "Delete", ID: id}, func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// Search retrieves a page of active (non-deleted) orders of a laboratory matching the criteria
+func (r *OrderRepository) Search(ctx context.Context, laboratoryID string, criteria order.SearchCriteria, q listing.Query) (listing.Page[*order.Order], error) {
+	return call(ctx, r.observer, Operation{Repository: "orders", Name: "Search", LaboratoryID: laboratoryID}, func(ctx context.Context) (listing.Page[*order.Order], error) {
+		return r.next.Search(ctx, laboratoryID, criteria, q)
+	})
+}
+
+// ListByClientID retrieves all active orders for a specific client
+func (r *OrderRepository) ListByClientID(ctx context.Context, clientID string) ([]*order.Order, error) {
+	return call(ctx, r.observer, Operation{Repository: "orders", Name: "ListByClientID"}, func(ctx context.Context) ([]*order.Order, error) {
+		return r.next.ListByClientID(ctx, clientID)
+	})
+}
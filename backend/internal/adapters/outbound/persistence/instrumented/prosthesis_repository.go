@@ -0,0 +1,76 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// ProsthesisRepository reports the calls of a prosthesis repository
+type ProsthesisRepository struct {
+	next     outbound.ProsthesisRepository
+	observer Observer
+}
+
+// NewProsthesisRepository wraps next to report its calls to observer
+func NewProsthesisRepository(next outbound.ProsthesisRepository, observer Observer) *ProsthesisRepository {
+	return &ProsthesisRepository{next: next, observer: observer}
+}
+
+// Create stores a new prosthesis
+func (r *ProsthesisRepository) Create(ctx context.Context, p *prosthesis.Prosthesis) error {
+	return exec(ctx, r.observer, Operation{Repository: "prostheses", Name: "Create", ID: p.ID, LaboratoryID: p.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Create(ctx, p)
+	})
+}
+
+// GetByID retrieves a prosthesis by ID (excludes soft-deleted)
+func (r *ProsthesisRepository) GetByID(ctx context.Context, id string) (*prosthesis.Prosthesis, error) {
+	return call(ctx, r.observer, Operation{Repository: "prostheses", Name: "GetByID", ID: id}, func(ctx context.Context) (*prosthesis.Prosthesis, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// soft-deleted); IDs without a match are skipped
+func (r *ProsthesisRepository) GetByIDs(ctx context.Context, ids []string) ([]*prosthesis.Prosthesis, error) {
+	return call(ctx, r.observer, Operation{Repository: "prostheses", Name: "GetByIDs"}, func(ctx context.Context) ([]*prosthesis.Prosthesis, error) {
+		return r.next.GetByIDs(ctx, ids)
+	})
+}
+
+// Update updates an existing prosthesis
+func (r *ProsthesisRepository) Update(ctx context.Context, p *prosthesis.Prosthesis) error {
+	return exec(ctx, r.observer, Operation{Repository: "prostheses", Name: "Update", ID: p.ID, LaboratoryID: p.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Update(ctx, p)
+	})
+}
+
+// Delete performs a soft delete on a prosthesis
+func (r *ProsthesisRepository) Delete(ctx context.Context, id string) error {
+	return exec(ctx, r.observer, Operation{Repository: "prostheses", Name: "Delete", ID: id}, func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// List retrieves a page of active (non-deleted) prostheses for a laboratory
+func (r *ProsthesisRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*prosthesis.Prosthesis], error) {
+	return call(ctx, r.observer, Operation{Repository: "prostheses", Name: "List", LaboratoryID: laboratoryID}, func(ctx context.Context) (listing.Page[*prosthesis.Prosthesis], error) {
+		return r.next.List(ctx, laboratoryID, q)
+	})
+}
+
+// FindByType retrieves prostheses filtered by type for a laboratory
+func (r *ProsthesisRepository) FindByType(ctx context.Context, laboratoryID string, prosthesisType prosthesis.ProsthesisType) ([]*prosthesis.Prosthesis, error) {
+	return call(ctx, r.observer, Operation{Repository: "prostheses", Name: "FindByType", LaboratoryID: laboratoryID}, func(ctx context.Context) ([]*prosthesis.Prosthesis, error) {
+		return r.next.FindByType(ctx, laboratoryID, prosthesisType)
+	})
+}
+
+// FindByMaterial retrieves prostheses filtered by material for a laboratory
+func (r *ProsthesisRepository) FindByMaterial(ctx context.Context, laboratoryID string, material string) ([]*prosthesis.Prosthesis, error) {
+	return call(ctx, r.observer, Operation{Repository: "prostheses", Name: "FindByMaterial", LaboratoryID: laboratoryID}, func(ctx context.Context) ([]*prosthesis.Prosthesis, error) {
+		return r.next.FindByMaterial(ctx, laboratoryID, material)
+	})
+}
@@ -0,0 +1,83 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// TechnicianRepository reports the calls of a technician repository
+type TechnicianRepository struct {
+	next     outbound.TechnicianRepository
+	observer Observer
+}
+
+// NewTechnicianRepository wraps next to report its calls to observer
+func NewTechnicianRepository(next outbound.TechnicianRepository, observer Observer) *TechnicianRepository {
+	return &TechnicianRepository{next: next, observer: observer}
+}
+
+// Create stores a new technician
+func (r *TechnicianRepository) Create(ctx context.Context, tech *technician.Technician) error {
+	return exec(ctx, r.observer, Operation{Repository: "technicians", Name: "Create", ID: tech.ID, LaboratoryID: tech.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Create(ctx, tech)
+	})
+}
+
+// GetByID retrieves a technician by ID (excludes soft-deleted)
+func (r *TechnicianRepository) GetByID(ctx context.Context, id string) (*technician.Technician, error) {
+	return call(ctx, r.observer, Operation{Repository: "technicians", Name: "GetByID", ID: id}, func(ctx context.Context) (*technician.Technician, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// soft-deleted); IDs without a match are skipped
+func (r *TechnicianRepository) GetByIDs(ctx context.Context, ids []string) ([]*technician.Technician, error) {
+	return call(ctx, r.observer, Operation{Repository: "technicians", Name: "GetByIDs"}, func(ctx context.Context) ([]*technician.Technician, error) {
+		return r.next.GetByIDs(ctx, ids)
+	})
+}
+
+// GetByEmail retrieves a technician by email within a laboratory (excludes soft-deleted)
+func (r *TechnicianRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*technician.Technician, error) {
+	return call(ctx, r.observer, Operation{Repository: "technicians", Name: "GetByEmail", LaboratoryID: laboratoryID}, func(ctx context.Context) (*technician.Technician, error) {
+		return r.next.GetByEmail(ctx, laboratoryID, email)
+	})
+}
+
+// Update updates an existing technician
+func (r *TechnicianRepository) Update(ctx context.Context, tech *technician.Technician) error {
+	return exec(ctx, r.observer, Operation{Repository: "technicians", Name: "Update", ID: tech.ID, LaboratoryID: tech.LaboratoryID}, func(ctx context.Context) error {
+		return r.next.Update(ctx, tech)
+	})
+}
+
+// Delete performs a soft delete on a technician
+func (r *TechnicianRepository) Delete(ctx context.Context, id string) error {
+	return exec(ctx, r.observer, Operation{Repository: "technicians", Name: "Delete", ID: id}, func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// Restore reverts the soft delete of a technician
+func (r *TechnicianRepository) Restore(ctx context.Context, id string) error {
+	return exec(ctx, r.observer, Operation{Repository: "technicians", Name: "Restore", ID: id}, func(ctx context.Context) error {
+		return r.next.Restore(ctx, id)
+	})
+}
+
+// List retrieves a page of active (non-deleted) technicians for a laboratory
+func (r *TechnicianRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*technician.Technician], error) {
+	return call(ctx, r.observer, Operation{Repository: "technicians", Name: "List", LaboratoryID: laboratoryID}, func(ctx context.Context) (listing.Page[*technician.Technician], error) {
+		return r.next.List(ctx, laboratoryID, q)
+	})
+}
+
+// ListByRole retrieves all active technicians for a laboratory filtered by role
+func (r *TechnicianRepository) ListByRole(ctx context.Context, laboratoryID string, role technician.Role) ([]*technician.Technician, error) {
+	return call(ctx, r.observer, Operation{Repository: "technicians", Name: "ListByRole", LaboratoryID: laboratoryID}, func(ctx context.Context) ([]*technician.Technician, error) {
+		return r.next.ListByRole(ctx, laboratoryID, role)
+	})
+}
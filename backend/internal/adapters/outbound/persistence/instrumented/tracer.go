@@ -0,0 +1,43 @@
+package instrumented
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
+)
+
+// Tracer reports every repository call as a span, named after the repository
+// and method, e.g. orders.GetByID. Spans carry the entity and laboratory of
+// the call, the laboratory of the request when the call has none.
+type Tracer struct{}
+
+// NewTracer creates a tracer of the repository calls
+func NewTracer() *Tracer {
+	return &Tracer{}
+}
+
+// ObserveRepository starts the span of the operation
+func (t *Tracer) ObserveRepository(ctx context.Context, op Operation) (context.Context, func(err error)) {
+	attrs := []attribute.KeyValue{
+		attribute.String("repository", op.Repository),
+		attribute.String("operation", op.Name),
+	}
+	if op.ID != "" {
+		attrs = append(attrs, attribute.String("entity_id", op.ID))
+	}
+	labID := op.LaboratoryID
+	if labID == "" {
+		labID = logging.LaboratoryID(ctx)
+	}
+	if labID != "" {
+		attrs = append(attrs, tracing.LaboratoryID(labID))
+	}
+
+	ctx, span := tracing.Start(ctx, op.Repository+"."+op.Name, attrs...)
+	return ctx, func(err error) {
+		tracing.End(span, &err)
+	}
+}
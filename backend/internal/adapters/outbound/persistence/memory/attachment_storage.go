@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// AttachmentStorage is an in-memory implementation of the attachment storage
+type AttachmentStorage struct {
+	mu   sync.RWMutex
+	data map[string]storedAttachment
+}
+
+type storedAttachment struct {
+	contentType string
+	data        []byte
+}
+
+// NewAttachmentStorage creates a new in-memory attachment storage
+func NewAttachmentStorage() *AttachmentStorage {
+	return &AttachmentStorage{
+		data: make(map[string]storedAttachment),
+	}
+}
+
+// Save stores the attachment contents under the given key
+func (s *AttachmentStorage) Save(ctx context.Context, key, contentType string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Copy to avoid external modifications
+	stored := make([]byte, len(data))
+	copy(stored, data)
+	s.data[key] = storedAttachment{contentType: contentType, data: stored}
+	return nil
+}
+
+// Get retrieves the attachment contents and content type by key
+func (s *AttachmentStorage) Get(ctx context.Context, key string) ([]byte, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, exists := s.data[key]
+	if !exists {
+		return nil, "", errors.ErrNotFound
+	}
+
+	data := make([]byte, len(stored.data))
+	copy(data, stored.data)
+	return data, stored.contentType, nil
+}
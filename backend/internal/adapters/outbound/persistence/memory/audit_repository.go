@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// AuditRepository is an in-memory, append-only implementation of the audit repository
+type AuditRepository struct {
+	mu      sync.RWMutex
+	entries []*audit.Entry
+	ids     map[string]struct{}
+}
+
+// NewAuditRepository creates a new in-memory audit repository
+func NewAuditRepository() *AuditRepository {
+	return &AuditRepository{
+		ids: make(map[string]struct{}),
+	}
+}
+
+// Append stores a new audit entry, when the transaction of ctx commits if
+// there is one
+func (r *AuditRepository) Append(ctx context.Context, e *audit.Entry) error {
+	r.mu.RLock()
+	_, exists := r.ids[e.ID]
+	r.mu.RUnlock()
+	if exists {
+		logDuplicate(ctx, "audit entry", e.ID)
+		return errors.ErrInternal // ID already exists, entries are never overwritten
+	}
+
+	// Clone to avoid external modifications
+	entry := r.clone(e)
+	deferToCommit(ctx, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.entries = append(r.entries, entry)
+		r.ids[entry.ID] = struct{}{}
+	})
+	return nil
+}
+
+// List retrieves audit entries matching the filter, most recent first
+func (r *AuditRepository) List(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []*audit.Entry
+	// Entries are appended in order, so walking backwards yields most recent first
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		if !filter.Matches(r.entries[i]) {
+			continue
+		}
+		entries = append(entries, r.clone(r.entries[i]))
+		if filter.Limit > 0 && len(entries) >= filter.Limit {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// clone creates a deep copy of an audit entry to avoid external modifications
+func (r *AuditRepository) clone(e *audit.Entry) *audit.Entry {
+	clone := *e
+	clone.Changes = make([]audit.FieldChange, len(e.Changes))
+	copy(clone.Changes, e.Changes)
+	return &clone
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+)
+
+func TestAuditRepository_AppendAndList(t *testing.T) {
+	repo := NewAuditRepository()
+	ctx := context.Background()
+
+	for i, id := range []string{"entry-1", "entry-2", "entry-3"} {
+		err := repo.Append(ctx, &audit.Entry{
+			ID:           id,
+			LaboratoryID: "lab-123",
+			EntityType:   audit.EntityClient,
+			EntityID:     "client-123",
+			Action:       audit.ActionUpdate,
+			Changes:      []audit.FieldChange{{Field: "name", Before: "a", After: "b"}},
+			OccurredAt:   time.Now().UTC().Add(time.Duration(i) * time.Second),
+		})
+		if err != nil {
+			t.Fatalf("Append() unexpected error = %v", err)
+		}
+	}
+	_ = repo.Append(ctx, &audit.Entry{ID: "entry-other", LaboratoryID: "lab-456"})
+
+	entries, err := repo.List(ctx, audit.Filter{LaboratoryID: "lab-123"})
+	if err != nil {
+		t.Fatalf("List() unexpected error = %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("List() returned %d entries, want 3", len(entries))
+	}
+	if entries[0].ID != "entry-3" {
+		t.Errorf("List() first entry = %v, want most recent entry-3", entries[0].ID)
+	}
+
+	limited, _ := repo.List(ctx, audit.Filter{LaboratoryID: "lab-123", Limit: 2})
+	if len(limited) != 2 {
+		t.Errorf("List() with limit returned %d entries, want 2", len(limited))
+	}
+
+	// Returned entries must not alias stored ones
+	entries[0].Changes[0].After = "tampered"
+	again, _ := repo.List(ctx, audit.Filter{EntityID: "client-123", Limit: 1})
+	if again[0].Changes[0].After != "b" {
+		t.Errorf("List() returned an entry sharing storage with the repository")
+	}
+}
+
+func TestAuditRepository_Append_DuplicateID(t *testing.T) {
+	repo := NewAuditRepository()
+	ctx := context.Background()
+
+	_ = repo.Append(ctx, &audit.Entry{ID: "entry-1", LaboratoryID: "lab-123"})
+
+	if err := repo.Append(ctx, &audit.Entry{ID: "entry-1", LaboratoryID: "lab-123"}); err == nil {
+		t.Error("Append() with duplicate ID expected error, got nil")
+	}
+}
+
+func TestAuditRepository_AppendWithinTransaction(t *testing.T) {
+	repo := NewAuditRepository()
+	tx := NewTransactor()
+	ctx := context.Background()
+
+	_ = tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		return repo.Append(ctx, &audit.Entry{ID: "entry-1", LaboratoryID: "lab-123"})
+	})
+	failed := errors.New("write failed")
+	_ = tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		_ = repo.Append(ctx, &audit.Entry{ID: "entry-2", LaboratoryID: "lab-123"})
+		return failed
+	})
+
+	entries, _ := repo.List(ctx, audit.Filter{LaboratoryID: "lab-123"})
+	if len(entries) != 1 || entries[0].ID != "entry-1" {
+		t.Errorf("List() = %d entries, want only entry-1 of the committed transaction", len(entries))
+	}
+}
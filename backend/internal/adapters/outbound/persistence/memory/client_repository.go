@@ -2,10 +2,13 @@ package memory
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 // ClientRepository is an in-memory implementation of the client repository
@@ -21,6 +24,28 @@ func NewClientRepository() *ClientRepository {
 	}
 }
 
+// Ping reports whether the store answers; it doesn't while a write holds it
+// longer than the ping waits
+func (r *ClientRepository) Ping(ctx context.Context) error {
+	return ping(ctx, &r.mu)
+}
+
+// clientListFields maps list query fields to client values
+var clientListFields = listFields[*client.Client]{
+	id: func(c *client.Client) string { return c.ID },
+	sort: map[string]func(*client.Client) string{
+		"name":       func(c *client.Client) string { return strings.ToLower(c.Name) },
+		"email":      func(c *client.Client) string { return strings.ToLower(c.Email) },
+		"created_at": func(c *client.Client) string { return timeKey(c.CreatedAt) },
+		"updated_at": func(c *client.Client) string { return timeKey(c.UpdatedAt) },
+	},
+	filter: map[string]func(*client.Client) string{
+		"email": func(c *client.Client) string { return c.Email },
+		"city":  func(c *client.Client) string { return c.Address.City },
+		"state": func(c *client.Client) string { return c.Address.State },
+	},
+}
+
 // Create stores a new client
 func (r *ClientRepository) Create(ctx context.Context, c *client.Client) error {
 	r.mu.Lock()
@@ -32,6 +57,7 @@ func (r *ClientRepository) Create(ctx context.Context, c *client.Client) error {
 
 	// Clone to avoid external modifications
 	r.data[c.ID] = r.clone(c)
+	logWrite(ctx, "client", "created", c.ID)
 	return nil
 }
 
@@ -48,6 +74,21 @@ func (r *ClientRepository) GetByID(ctx context.Context, id string) (*client.Clie
 	return r.clone(c), nil
 }
 
+// GetByIDs retrieves the clients with the given IDs (excludes soft-deleted)
+func (r *ClientRepository) GetByIDs(ctx context.Context, ids []string) ([]*client.Client, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*client.Client
+	for _, id := range ids {
+		if c, exists := r.data[id]; exists && !c.IsDeleted() {
+			result = append(result, r.clone(c))
+		}
+	}
+
+	return result, nil
+}
+
 // GetByEmail retrieves a client by email within a laboratory (excludes soft-deleted)
 func (r *ClientRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*client.Client, error) {
 	r.mu.RLock()
@@ -62,6 +103,20 @@ func (r *ClientRepository) GetByEmail(ctx context.Context, laboratoryID, email s
 	return nil, errors.ErrNotFound
 }
 
+// GetByPortalUserID retrieves the client bound to a portal identity (excludes soft-deleted)
+func (r *ClientRepository) GetByPortalUserID(ctx context.Context, userID string) (*client.Client, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, c := range r.data {
+		if c.PortalUserID != "" && c.PortalUserID == userID && !c.IsDeleted() {
+			return r.clone(c), nil
+		}
+	}
+
+	return nil, errors.ErrNotFound
+}
+
 // Update updates an existing client
 func (r *ClientRepository) Update(ctx context.Context, c *client.Client) error {
 	r.mu.Lock()
@@ -73,6 +128,7 @@ func (r *ClientRepository) Update(ctx context.Context, c *client.Client) error {
 	}
 
 	r.data[c.ID] = r.clone(c)
+	logWrite(ctx, "client", "updated", c.ID)
 	return nil
 }
 
@@ -87,11 +143,21 @@ func (r *ClientRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	c.Delete()
+	logWrite(ctx, "client", "deleted", id)
 	return nil
 }
 
-// List retrieves all active (non-deleted) clients for a laboratory
-func (r *ClientRepository) List(ctx context.Context, laboratoryID string) ([]*client.Client, error) {
+// PurgeDeleted permanently removes the clients deleted before the given time
+func (r *ClientRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := purgeDeleted(r.data, func(c *client.Client) *time.Time { return c.DeletedAt }, deletedBefore)
+	return len(purged), nil
+}
+
+// List retrieves a page of active (non-deleted) clients for a laboratory
+func (r *ClientRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*client.Client], error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -102,7 +168,7 @@ func (r *ClientRepository) List(ctx context.Context, laboratoryID string) ([]*cl
 		}
 	}
 
-	return clients, nil
+	return paginate(clients, q, clientListFields)
 }
 
 // clone creates a deep copy of a client to avoid external modifications
@@ -7,6 +7,7 @@ import (
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 func TestClientRepository_Create(t *testing.T) {
@@ -101,6 +102,32 @@ func TestClientRepository_GetByID_SoftDeleted(t *testing.T) {
 	}
 }
 
+func TestClientRepository_GetByIDs(t *testing.T) {
+	repo := NewClientRepository()
+	ctx := context.Background()
+
+	for _, id := range []string{"client-1", "client-2", "client-3"} {
+		_ = repo.Create(ctx, &client.Client{ID: id, LaboratoryID: "lab-123", Name: "Test Client"})
+	}
+	_ = repo.Delete(ctx, "client-3")
+
+	found, err := repo.GetByIDs(ctx, []string{"client-2", "non-existent", "client-3", "client-1"})
+	if err != nil {
+		t.Fatalf("GetByIDs() unexpected error = %v", err)
+	}
+
+	if len(found) != 2 || found[0].ID != "client-2" || found[1].ID != "client-1" {
+		t.Errorf("GetByIDs() = %v, want client-2 and client-1", found)
+	}
+
+	// Returned clients are copies
+	found[0].Name = "Changed"
+	stored, _ := repo.GetByID(ctx, "client-2")
+	if stored.Name != "Test Client" {
+		t.Errorf("GetByIDs() returned a stored client, name changed to %q", stored.Name)
+	}
+}
+
 func TestClientRepository_GetByEmail(t *testing.T) {
 	repo := NewClientRepository()
 	ctx := context.Background()
@@ -176,6 +203,51 @@ func TestClientRepository_GetByEmail_LaboratoryScoped(t *testing.T) {
 	}
 }
 
+func TestClientRepository_GetByPortalUserID(t *testing.T) {
+	repo := NewClientRepository()
+	ctx := context.Background()
+
+	// Unlinked clients must never match an empty identity
+	unlinked := &client.Client{
+		ID:           "client-1",
+		LaboratoryID: "lab-123",
+		Name:         "Unlinked Client",
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+	_ = repo.Create(ctx, unlinked)
+
+	_, err := repo.GetByPortalUserID(ctx, "")
+	if err != errors.ErrNotFound {
+		t.Errorf("GetByPortalUserID() error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	linked := &client.Client{
+		ID:           "client-2",
+		LaboratoryID: "lab-123",
+		Name:         "Linked Client",
+		PortalUserID: "user_abc",
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+	_ = repo.Create(ctx, linked)
+
+	found, err := repo.GetByPortalUserID(ctx, "user_abc")
+	if err != nil {
+		t.Fatalf("GetByPortalUserID() unexpected error = %v", err)
+	}
+	if found.ID != "client-2" {
+		t.Errorf("GetByPortalUserID() ID = %v, want client-2", found.ID)
+	}
+
+	// Soft-deleted clients are excluded
+	_ = repo.Delete(ctx, "client-2")
+	_, err = repo.GetByPortalUserID(ctx, "user_abc")
+	if err != errors.ErrNotFound {
+		t.Errorf("GetByPortalUserID() after delete error = %v, want %v", err, errors.ErrNotFound)
+	}
+}
+
 func TestClientRepository_Update(t *testing.T) {
 	repo := NewClientRepository()
 	ctx := context.Background()
@@ -260,7 +332,7 @@ func TestClientRepository_List(t *testing.T) {
 	ctx := context.Background()
 
 	// Empty list
-	clients, err := repo.List(ctx, "lab-123")
+	clients, err := items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -294,7 +366,7 @@ func TestClientRepository_List(t *testing.T) {
 		UpdatedAt:    time.Now().UTC(),
 	})
 
-	clients, err = repo.List(ctx, "lab-123")
+	clients, err = items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -327,7 +399,7 @@ func TestClientRepository_List_ExcludesDeleted(t *testing.T) {
 	// Delete one
 	_ = repo.Delete(ctx, "client-1")
 
-	clients, err := repo.List(ctx, "lab-123")
+	clients, err := items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// CommentRepository is an in-memory implementation of the comment repository
+type CommentRepository struct {
+	mu      sync.RWMutex
+	data    map[string]*comment.Comment
+	byOrder map[string][]string // Comment IDs of each order, in creation order
+}
+
+// NewCommentRepository creates a new in-memory comment repository
+func NewCommentRepository() *CommentRepository {
+	return &CommentRepository{
+		data:    make(map[string]*comment.Comment),
+		byOrder: make(map[string][]string),
+	}
+}
+
+// Ping reports whether the store answers; it doesn't while a write holds it
+// longer than the ping waits
+func (r *CommentRepository) Ping(ctx context.Context) error {
+	return ping(ctx, &r.mu)
+}
+
+// Create stores a new comment
+func (r *CommentRepository) Create(ctx context.Context, c *comment.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.data[c.ID]; exists {
+		logDuplicate(ctx, "comment", c.ID)
+		return errors.ErrInternal // ID already exists
+	}
+
+	// Clone to avoid external modifications
+	r.data[c.ID] = cloneComment(c)
+	r.byOrder[c.OrderID] = append(r.byOrder[c.OrderID], c.ID)
+	logWrite(ctx, "comment", "created", c.ID)
+	return nil
+}
+
+// GetByID retrieves a comment by ID (excludes soft-deleted)
+func (r *CommentRepository) GetByID(ctx context.Context, id string) (*comment.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, exists := r.data[id]
+	if !exists || c.IsDeleted() {
+		return nil, errors.ErrNotFound
+	}
+
+	return cloneComment(c), nil
+}
+
+// Delete performs a soft delete on a comment
+func (r *CommentRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, exists := r.data[id]
+	if !exists || c.IsDeleted() {
+		return errors.ErrNotFound
+	}
+
+	c.Delete()
+	logWrite(ctx, "comment", "deleted", id)
+	return nil
+}
+
+// PurgeDeleted permanently removes the comments deleted before the given time
+func (r *CommentRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := purgeDeleted(r.data, func(c *comment.Comment) *time.Time { return c.DeletedAt }, deletedBefore)
+	for _, c := range purged {
+		ids := r.byOrder[c.OrderID]
+		for i, id := range ids {
+			if id == c.ID {
+				ids = append(ids[:i:i], ids[i+1:]...)
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(r.byOrder, c.OrderID)
+		} else {
+			r.byOrder[c.OrderID] = ids
+		}
+	}
+	return len(purged), nil
+}
+
+// ListByOrder retrieves the active comments of an order, oldest first
+func (r *CommentRepository) ListByOrder(ctx context.Context, orderID string) ([]*comment.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var comments []*comment.Comment
+	for _, id := range r.byOrder[orderID] {
+		if c := r.data[id]; !c.IsDeleted() {
+			comments = append(comments, cloneComment(c))
+		}
+	}
+
+	return comments, nil
+}
+
+// cloneComment creates a deep copy of a comment
+func cloneComment(c *comment.Comment) *comment.Comment {
+	clone := *c
+	if c.DeletedAt != nil {
+		deletedAt := *c.DeletedAt
+		clone.DeletedAt = &deletedAt
+	}
+	return &clone
+}
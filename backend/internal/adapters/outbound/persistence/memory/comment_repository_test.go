@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+func TestCommentRepository(t *testing.T) {
+	repo := NewCommentRepository()
+	ctx := context.Background()
+	staff := comment.Author{ID: "user-1", Kind: comment.AuthorStaff}
+
+	comments := []*comment.Comment{
+		{ID: "comment-1", LaboratoryID: "lab-123", OrderID: "order-1", Author: staff, Body: "First"},
+		{ID: "comment-2", LaboratoryID: "lab-123", OrderID: "order-2", Author: staff, Body: "Other order"},
+		{ID: "comment-3", LaboratoryID: "lab-123", OrderID: "order-1", Author: staff, Body: "Second"},
+	}
+	for _, c := range comments {
+		if err := repo.Create(ctx, c); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+	if err := repo.Create(ctx, comments[0]); err != errors.ErrInternal {
+		t.Errorf("Create() with an existing ID error = %v, want %v", err, errors.ErrInternal)
+	}
+
+	// Stored copies are not affected by changes of the caller's value
+	comments[0].Body = "Changed"
+	if got, err := repo.GetByID(ctx, "comment-1"); err != nil || got.Body != "First" {
+		t.Errorf("GetByID() = %+v, %v, want the stored body", got, err)
+	}
+
+	list, _ := repo.ListByOrder(ctx, "order-1")
+	if len(list) != 2 || list[0].ID != "comment-1" || list[1].ID != "comment-3" {
+		t.Errorf("ListByOrder() = %v, want the order's comments oldest first", list)
+	}
+
+	if err := repo.Delete(ctx, "comment-1"); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	if err := repo.Delete(ctx, "comment-1"); err != errors.ErrNotFound {
+		t.Errorf("Delete() twice error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if _, err := repo.GetByID(ctx, "comment-1"); err != errors.ErrNotFound {
+		t.Errorf("GetByID() after delete error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if list, _ := repo.ListByOrder(ctx, "order-1"); len(list) != 1 || list[0].ID != "comment-3" {
+		t.Errorf("ListByOrder() after delete = %v, want only comment-3", list)
+	}
+}
+
+func TestCommentRepository_PurgeDeleted(t *testing.T) {
+	repo := NewCommentRepository()
+	ctx := context.Background()
+	staff := comment.Author{ID: "user-1", Kind: comment.AuthorStaff}
+
+	for _, id := range []string{"comment-1", "comment-2", "comment-3"} {
+		_ = repo.Create(ctx, &comment.Comment{ID: id, LaboratoryID: "lab-123", OrderID: "order-1", Author: staff, Body: id})
+	}
+	_ = repo.Delete(ctx, "comment-1")
+	_ = repo.Delete(ctx, "comment-2")
+
+	if n, err := repo.PurgeDeleted(ctx, time.Now().UTC().Add(-time.Hour)); err != nil || n != 0 {
+		t.Errorf("PurgeDeleted() of recent deletions = %d, %v, want 0", n, err)
+	}
+	if n, err := repo.PurgeDeleted(ctx, time.Now().UTC().Add(time.Hour)); err != nil || n != 2 {
+		t.Fatalf("PurgeDeleted() = %d, %v, want 2", n, err)
+	}
+
+	if list, _ := repo.ListByOrder(ctx, "order-1"); len(list) != 1 || list[0].ID != "comment-3" {
+		t.Errorf("ListByOrder() after purge = %v, want the active comment", list)
+	}
+	if err := repo.Delete(ctx, "comment-1"); err != errors.ErrNotFound {
+		t.Errorf("Delete() of a purged comment error = %v, want %v", err, errors.ErrNotFound)
+	}
+}
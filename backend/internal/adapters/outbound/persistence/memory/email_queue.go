@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+)
+
+// EmailQueue is an in-memory implementation of the email queue.
+// Emails are per process and lost on restart.
+type EmailQueue struct {
+	mu     sync.Mutex
+	emails []*notification.Email // In enqueue order
+}
+
+// NewEmailQueue creates a new in-memory email queue
+func NewEmailQueue() *EmailQueue {
+	return &EmailQueue{}
+}
+
+// Enqueue stores new emails, skipping those whose ID is already queued
+func (q *EmailQueue) Enqueue(ctx context.Context, emails ...*notification.Email) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, e := range emails {
+		if q.queued(e.ID) {
+			continue
+		}
+		q.emails = append(q.emails, cloneEmail(e))
+	}
+	return nil
+}
+
+// queued reports whether an email with the ID is queued
+func (q *EmailQueue) queued(id string) bool {
+	for _, e := range q.emails {
+		if e.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Claim returns up to limit emails due at now, oldest first, and holds them
+// back from other claims for the lease duration
+func (q *EmailQueue) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*notification.Email, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var claimed []*notification.Email
+	for _, e := range q.emails {
+		if len(claimed) == limit {
+			break
+		}
+		if e.IsDue(now) {
+			e.NextAttemptAt = now.Add(lease)
+			claimed = append(claimed, cloneEmail(e))
+		}
+	}
+	return claimed, nil
+}
+
+// Update stores the outcome of a send attempt
+func (q *EmailQueue) Update(ctx context.Context, e *notification.Email) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, existing := range q.emails {
+		if existing.ID == e.ID {
+			q.emails[i] = cloneEmail(e)
+			return nil
+		}
+	}
+	return errors.ErrNotFound
+}
+
+// Purge deletes the emails sent or dead before the given time
+func (q *EmailQueue) Purge(ctx context.Context, before time.Time) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	kept := q.emails[:0]
+	for _, e := range q.emails {
+		if e.IsSent() && e.SentAt.Before(before) || e.IsDead() && e.DeadAt.Before(before) {
+			continue
+		}
+		kept = append(kept, e)
+	}
+	purged := len(q.emails) - len(kept)
+	for i := len(kept); i < len(q.emails); i++ {
+		q.emails[i] = nil
+	}
+	q.emails = kept
+	return purged, nil
+}
+
+func cloneEmail(e *notification.Email) *notification.Email {
+	c := *e
+	if e.ReplyTo != nil {
+		replyTo := *e.ReplyTo
+		c.ReplyTo = &replyTo
+	}
+	if e.SentAt != nil {
+		at := *e.SentAt
+		c.SentAt = &at
+	}
+	if e.DeadAt != nil {
+		at := *e.DeadAt
+		c.DeadAt = &at
+	}
+	return &c
+}
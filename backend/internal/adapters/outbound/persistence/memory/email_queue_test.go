@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+)
+
+func TestEmailQueue_ClaimAndPurge(t *testing.T) {
+	queue := NewEmailQueue()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	_ = queue.Enqueue(ctx,
+		&notification.Email{ID: "email-1", NextAttemptAt: now, CreatedAt: now},
+		&notification.Email{ID: "email-2", NextAttemptAt: now.Add(time.Hour), CreatedAt: now},
+	)
+	_ = queue.Enqueue(ctx, &notification.Email{ID: "email-1", NextAttemptAt: now, CreatedAt: now}) // Already queued
+
+	emails, err := queue.Claim(ctx, now, 10, time.Minute)
+	if err != nil || len(emails) != 1 || emails[0].ID != "email-1" {
+		t.Fatalf("Claim() = %v, %v, want only the due email", emails, err)
+	}
+	// Held back by the lease
+	if again, _ := queue.Claim(ctx, now, 10, time.Minute); len(again) != 0 {
+		t.Errorf("Claim() during the lease = %d emails, want none", len(again))
+	}
+
+	emails[0].MarkSent(now)
+	if err := queue.Update(ctx, emails[0]); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if purged, _ := queue.Purge(ctx, now.Add(time.Second)); purged != 1 {
+		t.Errorf("Purge() = %d, want the sent email purged", purged)
+	}
+	if err := queue.Update(ctx, emails[0]); err == nil {
+		t.Error("Update() of a purged email succeeded, want not found")
+	}
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/idempotency"
+)
+
+// IdempotencyStore is an in-memory implementation of the idempotency store.
+// Records are per process; a shared store is needed across several instances.
+type IdempotencyStore struct {
+	mu        sync.Mutex
+	records   map[idempotencyKey]*idempotency.Record
+	lastPurge time.Time
+	now       func() time.Time
+}
+
+// purgeInterval is how often expired records are dropped
+const purgeInterval = time.Minute
+
+type idempotencyKey struct {
+	scope string
+	key   string
+}
+
+// NewIdempotencyStore creates a new in-memory idempotency store
+func NewIdempotencyStore() *IdempotencyStore {
+	return &IdempotencyStore{
+		records: make(map[idempotencyKey]*idempotency.Record),
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Reserve stores rec as in progress unless an unexpired record exists for its
+// scope and key, which is returned instead
+func (s *IdempotencyStore) Reserve(ctx context.Context, rec *idempotency.Record) (*idempotency.Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastPurge) >= purgeInterval {
+		s.purgeExpired(now)
+	}
+
+	k := idempotencyKey{rec.Scope, rec.Key}
+	if existing, ok := s.records[k]; ok && !existing.Expired(now) {
+		return cloneRecord(existing), nil
+	}
+
+	stored := cloneRecord(rec)
+	stored.Completed = false
+	s.records[k] = stored
+	return nil, nil
+}
+
+// Complete stores the response of a reserved record
+func (s *IdempotencyStore) Complete(ctx context.Context, rec *idempotency.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := idempotencyKey{rec.Scope, rec.Key}
+	if _, ok := s.records[k]; !ok {
+		return errors.ErrNotFound
+	}
+
+	s.records[k] = cloneRecord(rec)
+	return nil
+}
+
+// Release deletes a reserved record so the request can be retried
+func (s *IdempotencyStore) Release(ctx context.Context, scope, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, idempotencyKey{scope, key})
+	return nil
+}
+
+// purgeExpired drops records past their window. Callers must hold the lock.
+func (s *IdempotencyStore) purgeExpired(now time.Time) {
+	for k, rec := range s.records {
+		if rec.Expired(now) {
+			delete(s.records, k)
+		}
+	}
+	s.lastPurge = now
+}
+
+// cloneRecord creates a deep copy of a record to avoid external modifications
+func cloneRecord(rec *idempotency.Record) *idempotency.Record {
+	clone := *rec
+	clone.Body = append([]byte(nil), rec.Body...)
+	return &clone
+}
@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/idempotency"
+)
+
+func newTestRecord(scope, key string, now time.Time) *idempotency.Record {
+	return &idempotency.Record{
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: "fingerprint",
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+	}
+}
+
+func TestIdempotencyStore_ReserveCompleteRelease(t *testing.T) {
+	store := NewIdempotencyStore()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	rec := newTestRecord("lab:lab-123", "key-1", now)
+	if existing, err := store.Reserve(ctx, rec); err != nil || existing != nil {
+		t.Fatalf("Reserve() = %v, %v, want nil, nil", existing, err)
+	}
+
+	existing, err := store.Reserve(ctx, newTestRecord("lab:lab-123", "key-1", now))
+	if err != nil || existing == nil || existing.Completed {
+		t.Fatalf("Reserve() of a reserved key = %+v, %v, want the in-progress record", existing, err)
+	}
+
+	// The same key in another scope is independent
+	if existing, _ := store.Reserve(ctx, newTestRecord("lab:lab-456", "key-1", now)); existing != nil {
+		t.Error("Reserve() in another scope returned a record")
+	}
+
+	rec.Completed = true
+	rec.StatusCode = 201
+	rec.Body = []byte(`{"id":"order-1"}`)
+	if err := store.Complete(ctx, rec); err != nil {
+		t.Fatalf("Complete() unexpected error = %v", err)
+	}
+	rec.Body[0] = 'X'
+
+	existing, _ = store.Reserve(ctx, newTestRecord("lab:lab-123", "key-1", now))
+	if existing == nil || !existing.Completed || existing.StatusCode != 201 || string(existing.Body) != `{"id":"order-1"}` {
+		t.Errorf("Reserve() of a completed key = %+v", existing)
+	}
+
+	if err := store.Release(ctx, "lab:lab-123", "key-1"); err != nil {
+		t.Fatalf("Release() unexpected error = %v", err)
+	}
+	if existing, _ := store.Reserve(ctx, newTestRecord("lab:lab-123", "key-1", now)); existing != nil {
+		t.Error("Reserve() after Release() returned a record")
+	}
+}
+
+func TestIdempotencyStore_CompleteWithoutReserve(t *testing.T) {
+	store := NewIdempotencyStore()
+
+	if err := store.Complete(context.Background(), newTestRecord("lab:lab-123", "key-1", time.Now().UTC())); err == nil {
+		t.Error("Complete() of an unreserved key expected error")
+	}
+}
+
+func TestIdempotencyStore_ExpiredRecordsAreIgnored(t *testing.T) {
+	store := NewIdempotencyStore()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	_, _ = store.Reserve(ctx, newTestRecord("lab:lab-123", "key-1", now))
+
+	store.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if existing, _ := store.Reserve(ctx, newTestRecord("lab:lab-123", "key-1", now.Add(2*time.Hour))); existing != nil {
+		t.Errorf("Reserve() returned expired record %+v", existing)
+	}
+}
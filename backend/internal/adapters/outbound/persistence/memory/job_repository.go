@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+)
+
+// JobRunRepository is an in-memory implementation of the job run history,
+// keeping the last job.HistoryLimit runs of each job
+type JobRunRepository struct {
+	mu   sync.RWMutex
+	runs map[string][]*job.Run // Runs of each job, oldest first
+}
+
+// NewJobRunRepository creates a new in-memory job run repository
+func NewJobRunRepository() *JobRunRepository {
+	return &JobRunRepository{runs: make(map[string][]*job.Run)}
+}
+
+// Create stores a new run, dropping the oldest runs of the job beyond the history limit
+func (r *JobRunRepository) Create(ctx context.Context, run *job.Run) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	runs := append(r.runs[run.Job], cloneRun(run))
+	if over := len(runs) - job.HistoryLimit; over > 0 {
+		runs = append([]*job.Run(nil), runs[over:]...)
+	}
+	r.runs[run.Job] = runs
+	return nil
+}
+
+// Update stores the outcome of a run
+func (r *JobRunRepository) Update(ctx context.Context, run *job.Run) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, existing := range r.runs[run.Job] {
+		if existing.ID == run.ID {
+			r.runs[run.Job][i] = cloneRun(run)
+			return nil
+		}
+	}
+	return errors.ErrNotFound
+}
+
+// ListByJob retrieves up to limit runs of a job, most recent first
+func (r *JobRunRepository) ListByJob(ctx context.Context, name string, limit int) ([]*job.Run, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	runs := r.runs[name]
+	result := make([]*job.Run, 0, min(limit, len(runs)))
+	for i := len(runs) - 1; i >= 0 && len(result) < limit; i-- {
+		result = append(result, cloneRun(runs[i]))
+	}
+	return result, nil
+}
+
+func cloneRun(run *job.Run) *job.Run {
+	clone := *run
+	if run.FinishedAt != nil {
+		finishedAt := *run.FinishedAt
+		clone.FinishedAt = &finishedAt
+	}
+	return &clone
+}
+
+// JobLocker is an in-memory implementation of the job locker. Locks are per
+// process; a shared locker (e.g. a database row or Redis key) is needed for
+// jobs to run once across several instances.
+type JobLocker struct {
+	mu    sync.Mutex
+	locks map[string]jobLock
+	now   func() time.Time
+}
+
+// jobLock is a lock held until it expires or its owner releases it
+type jobLock struct {
+	owner     string
+	expiresAt time.Time
+}
+
+// NewJobLocker creates a new in-memory job locker
+func NewJobLocker() *JobLocker {
+	return &JobLocker{
+		locks: make(map[string]jobLock),
+		now:   func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// TryLock acquires the lock of a job for owner unless another owner holds an unexpired lock
+func (l *JobLocker) TryLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if lock, held := l.locks[name]; held && lock.owner != owner && now.Before(lock.expiresAt) {
+		return false, nil
+	}
+	l.locks[name] = jobLock{owner: owner, expiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+// Unlock releases the lock of a job held by owner
+func (l *JobLocker) Unlock(ctx context.Context, name, owner string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, held := l.locks[name]; held && lock.owner == owner {
+		delete(l.locks, name)
+	}
+	return nil
+}
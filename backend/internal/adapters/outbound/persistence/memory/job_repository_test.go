@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+)
+
+func TestJobRunRepository_History(t *testing.T) {
+	repo := NewJobRunRepository()
+	ctx := context.Background()
+	now := time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+
+	for i := 0; i < job.HistoryLimit+5; i++ {
+		at := now.Add(time.Duration(i) * time.Hour)
+		run := job.NewRun(fmt.Sprintf("run-%d", i), "purge-deleted", "host-1", at, at)
+		if err := repo.Create(ctx, run); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+	_ = repo.Create(ctx, job.NewRun("other-1", "statements", "host-1", now, now))
+
+	latest := job.NewRun(fmt.Sprintf("run-%d", job.HistoryLimit+4), "purge-deleted", "host-1", now, now)
+	latest.Succeed("purged 2 orders", now.Add(time.Second))
+	if err := repo.Update(ctx, latest); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+	latest.Result = "changed"
+
+	runs, err := repo.ListByJob(ctx, "purge-deleted", 100)
+	if err != nil {
+		t.Fatalf("ListByJob() unexpected error = %v", err)
+	}
+	if len(runs) != job.HistoryLimit {
+		t.Fatalf("ListByJob() returned %d runs, want the last %d", len(runs), job.HistoryLimit)
+	}
+	if runs[0].ID != latest.ID || runs[0].Result != "purged 2 orders" || runs[len(runs)-1].ID != "run-5" {
+		t.Errorf("ListByJob() = %s ... %s, want the most recent first", runs[0].ID, runs[len(runs)-1].ID)
+	}
+
+	if runs, _ := repo.ListByJob(ctx, "purge-deleted", 1); len(runs) != 1 {
+		t.Errorf("ListByJob() with limit 1 returned %d runs", len(runs))
+	}
+	if err := repo.Update(ctx, job.NewRun("run-0", "purge-deleted", "host-1", now, now)); err == nil {
+		t.Error("Update() of a dropped run should fail")
+	}
+}
+
+func TestJobLocker_TryLock(t *testing.T) {
+	locker := NewJobLocker()
+	now := time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+	locker.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if ok, _ := locker.TryLock(ctx, "purge-deleted", "host-1", time.Minute); !ok {
+		t.Fatal("TryLock() of a free job = false, want true")
+	}
+	if ok, _ := locker.TryLock(ctx, "purge-deleted", "host-2", time.Minute); ok {
+		t.Error("TryLock() of a job locked by another owner = true, want false")
+	}
+	if ok, _ := locker.TryLock(ctx, "statements", "host-2", time.Minute); !ok {
+		t.Error("TryLock() of another job = false, want true")
+	}
+
+	// Expired locks are taken over
+	now = now.Add(time.Minute)
+	if ok, _ := locker.TryLock(ctx, "purge-deleted", "host-2", time.Minute); !ok {
+		t.Fatal("TryLock() of an expired lock = false, want true")
+	}
+
+	// Only the owner releases its lock
+	_ = locker.Unlock(ctx, "purge-deleted", "host-1")
+	if ok, _ := locker.TryLock(ctx, "purge-deleted", "host-1", time.Minute); ok {
+		t.Error("Unlock() by another owner released the lock")
+	}
+	_ = locker.Unlock(ctx, "purge-deleted", "host-2")
+	if ok, _ := locker.TryLock(ctx, "purge-deleted", "host-1", time.Minute); !ok {
+		t.Error("TryLock() after Unlock() = false, want true")
+	}
+}
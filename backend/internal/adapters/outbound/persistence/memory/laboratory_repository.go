@@ -2,10 +2,12 @@ package memory
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 // LaboratoryRepository is an in-memory implementation of the laboratory repository
@@ -21,6 +23,26 @@ func NewLaboratoryRepository() *LaboratoryRepository {
 	}
 }
 
+// Ping reports whether the store answers; it doesn't while a write holds it
+// longer than the ping waits
+func (r *LaboratoryRepository) Ping(ctx context.Context) error {
+	return ping(ctx, &r.mu)
+}
+
+// laboratoryListFields maps list query fields to laboratory values
+var laboratoryListFields = listFields[*laboratory.Laboratory]{
+	id: func(lab *laboratory.Laboratory) string { return lab.ID },
+	sort: map[string]func(*laboratory.Laboratory) string{
+		"name":       func(lab *laboratory.Laboratory) string { return strings.ToLower(lab.Name) },
+		"created_at": func(lab *laboratory.Laboratory) string { return timeKey(lab.CreatedAt) },
+		"updated_at": func(lab *laboratory.Laboratory) string { return timeKey(lab.UpdatedAt) },
+	},
+	filter: map[string]func(*laboratory.Laboratory) string{
+		"city":  func(lab *laboratory.Laboratory) string { return lab.Address.City },
+		"state": func(lab *laboratory.Laboratory) string { return lab.Address.State },
+	},
+}
+
 // Create stores a new laboratory
 func (r *LaboratoryRepository) Create(ctx context.Context, lab *laboratory.Laboratory) error {
 	r.mu.Lock()
@@ -32,6 +54,7 @@ func (r *LaboratoryRepository) Create(ctx context.Context, lab *laboratory.Labor
 
 	// Clone to avoid external modifications
 	r.data[lab.ID] = r.clone(lab)
+	logWrite(ctx, "laboratory", "created", lab.ID)
 	return nil
 }
 
@@ -73,6 +96,7 @@ func (r *LaboratoryRepository) Update(ctx context.Context, lab *laboratory.Labor
 	}
 
 	r.data[lab.ID] = r.clone(lab)
+	logWrite(ctx, "laboratory", "updated", lab.ID)
 	return nil
 }
 
@@ -87,11 +111,12 @@ func (r *LaboratoryRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	lab.Delete()
+	logWrite(ctx, "laboratory", "deleted", id)
 	return nil
 }
 
-// List retrieves all active (non-deleted) laboratories
-func (r *LaboratoryRepository) List(ctx context.Context) ([]*laboratory.Laboratory, error) {
+// List retrieves a page of active (non-deleted) laboratories
+func (r *LaboratoryRepository) List(ctx context.Context, q listing.Query) (listing.Page[*laboratory.Laboratory], error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -102,7 +127,7 @@ func (r *LaboratoryRepository) List(ctx context.Context) ([]*laboratory.Laborato
 		}
 	}
 
-	return labs, nil
+	return paginate(labs, q, laboratoryListFields)
 }
 
 // clone creates a deep copy of a laboratory to avoid external modifications
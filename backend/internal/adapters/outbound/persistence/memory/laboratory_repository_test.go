@@ -7,6 +7,7 @@ import (
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 func TestLaboratoryRepository_Create(t *testing.T) {
@@ -210,7 +211,7 @@ func TestLaboratoryRepository_List(t *testing.T) {
 	ctx := context.Background()
 
 	// Empty list
-	labs, err := repo.List(ctx)
+	labs, err := items(repo.List(ctx, listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -234,7 +235,7 @@ func TestLaboratoryRepository_List(t *testing.T) {
 		UpdatedAt: time.Now().UTC(),
 	})
 
-	labs, err = repo.List(ctx)
+	labs, err = items(repo.List(ctx, listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -265,7 +266,7 @@ func TestLaboratoryRepository_List_ExcludesDeleted(t *testing.T) {
 	// Delete one
 	_ = repo.Delete(ctx, "lab-1")
 
-	labs, err := repo.List(ctx)
+	labs, err := items(repo.List(ctx, listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
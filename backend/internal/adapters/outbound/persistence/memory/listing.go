@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+)
+
+// listFields maps the sortable and filterable fields of an entity to their values.
+// Sort values must compare lexicographically in the intended order.
+type listFields[T any] struct {
+	id     func(T) string
+	sort   map[string]func(T) string
+	filter map[string]func(T) string
+}
+
+// paginate filters, sorts and slices items according to the list query.
+// Items are ordered by the sort field and then by ID, so pages are stable
+// and a cursor stays valid when the item it points to is deleted.
+func paginate[T any](items []T, q listing.Query, f listFields[T]) (listing.Page[T], error) {
+	var page listing.Page[T]
+
+	// Filter
+	matched := items[:0:0]
+	for _, item := range items {
+		ok := true
+		for field, want := range q.Filters {
+			value, exists := f.filter[field]
+			if !exists {
+				return page, errors.ErrInvalidInput
+			}
+			if !strings.EqualFold(value(item), want) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matched = append(matched, item)
+		}
+	}
+	page.Total = len(matched)
+
+	// Sort
+	sortBy := q.SortBy
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	key, exists := f.sort[sortBy]
+	if !exists {
+		return page, errors.ErrInvalidInput
+	}
+	desc := q.Direction == listing.Desc
+	less := func(ka, ia, kb, ib string) bool {
+		if ka != kb {
+			return (ka < kb) != desc
+		}
+		return (ia < ib) != desc
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return less(key(matched[i]), f.id(matched[i]), key(matched[j]), f.id(matched[j]))
+	})
+
+	// Skip up to and including the cursor position
+	start := 0
+	if q.Cursor != "" {
+		c, err := listing.DecodeCursor(q.Cursor)
+		if err != nil {
+			return page, err
+		}
+		start = sort.Search(len(matched), func(i int) bool {
+			return less(c.Key, c.ID, key(matched[i]), f.id(matched[i]))
+		})
+	}
+
+	end := len(matched)
+	if q.Limit > 0 && start+q.Limit < end {
+		end = start + q.Limit
+		last := matched[end-1]
+		page.NextCursor = listing.Cursor{
+			SortBy:    sortBy,
+			Direction: q.Direction,
+			Key:       key(last),
+			ID:        f.id(last),
+		}.Encode()
+	}
+
+	page.Items = matched[start:end]
+	return page, nil
+}
+
+// timeKey formats a time so that lexicographic order matches chronological order
+func timeKey(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000000")
+}
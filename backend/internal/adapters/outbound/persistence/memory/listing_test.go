@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+)
+
+// items discards the pagination metadata of a list result
+func items[T any](page listing.Page[T], err error) ([]T, error) {
+	return page.Items, err
+}
+
+func ids(clients []*client.Client) []string {
+	out := make([]string, len(clients))
+	for i, c := range clients {
+		out[i] = c.ID
+	}
+	return out
+}
+
+func setupListingRepo(t *testing.T) *ClientRepository {
+	t.Helper()
+
+	repo := NewClientRepository()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, c := range []struct{ id, name, city string }{
+		{"client-a", "Charlie", "Santos"},
+		{"client-b", "alpha", "Campinas"},
+		{"client-c", "Bravo", "Santos"},
+		{"client-d", "Delta", "Santos"},
+		{"client-e", "Echo", "Campinas"},
+	} {
+		_ = repo.Create(context.Background(), &client.Client{
+			ID:           c.id,
+			LaboratoryID: "lab-123",
+			Name:         c.name,
+			Address:      client.Address{City: c.city},
+			CreatedAt:    base.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	return repo
+}
+
+func TestPaginate_SortAndFilter(t *testing.T) {
+	repo := setupListingRepo(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		query     listing.Query
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "default sort by creation",
+			query:     listing.Query{},
+			wantIDs:   []string{"client-a", "client-b", "client-c", "client-d", "client-e"},
+			wantTotal: 5,
+		},
+		{
+			name:      "case-insensitive sort by name descending",
+			query:     listing.Query{SortBy: "name", Direction: listing.Desc},
+			wantIDs:   []string{"client-e", "client-d", "client-a", "client-c", "client-b"},
+			wantTotal: 5,
+		},
+		{
+			name:      "filter by city",
+			query:     listing.Query{SortBy: "name", Filters: map[string]string{"city": "santos"}},
+			wantIDs:   []string{"client-c", "client-a", "client-d"},
+			wantTotal: 3,
+		},
+		{
+			name:      "limit",
+			query:     listing.Query{Limit: 2},
+			wantIDs:   []string{"client-a", "client-b"},
+			wantTotal: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := repo.List(ctx, "lab-123", tt.query)
+			if err != nil {
+				t.Fatalf("List() unexpected error = %v", err)
+			}
+			if got := ids(page.Items); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("List() IDs = %v, want %v", got, tt.wantIDs)
+			}
+			if page.Total != tt.wantTotal {
+				t.Errorf("List() Total = %v, want %v", page.Total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestPaginate_Cursor(t *testing.T) {
+	repo := setupListingRepo(t)
+	ctx := context.Background()
+	q := listing.Query{Limit: 2, SortBy: "name", Direction: listing.Asc}
+
+	var got []string
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("List() cursor did not terminate")
+		}
+		page, err := repo.List(ctx, "lab-123", q)
+		if err != nil {
+			t.Fatalf("List() unexpected error = %v", err)
+		}
+		got = append(got, ids(page.Items)...)
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+
+		// Deleting the last item of a page must not break its cursor
+		if pages == 0 {
+			_ = repo.Delete(ctx, page.Items[len(page.Items)-1].ID)
+		}
+	}
+
+	want := []string{"client-b", "client-c", "client-a", "client-d", "client-e"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("List() paged IDs = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,16 @@
+package memory
+
+import (
+	"context"
+	"log/slog"
+)
+
+// logWrite logs a write to a repository at debug level, e.g. "order created"
+func logWrite(ctx context.Context, entity, action, id string) {
+	slog.DebugContext(ctx, "memory: "+entity+" "+action, "entity", entity, "id", id)
+}
+
+// logDuplicate logs the creation of a record whose ID is already taken
+func logDuplicate(ctx context.Context, entity, id string) {
+	slog.ErrorContext(ctx, "memory: ID already exists", "entity", entity, "id", id)
+}
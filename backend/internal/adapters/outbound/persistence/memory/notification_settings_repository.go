@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+)
+
+// NotificationSettingsRepository is an in-memory implementation of the notification settings repository
+type NotificationSettingsRepository struct {
+	mu   sync.RWMutex
+	data map[string]notification.Settings // By laboratory ID
+}
+
+// NewNotificationSettingsRepository creates a new in-memory notification settings repository
+func NewNotificationSettingsRepository() *NotificationSettingsRepository {
+	return &NotificationSettingsRepository{
+		data: make(map[string]notification.Settings),
+	}
+}
+
+// Get retrieves the settings of a laboratory
+func (r *NotificationSettingsRepository) Get(ctx context.Context, laboratoryID string) (*notification.Settings, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, exists := r.data[laboratoryID]
+	if !exists {
+		return nil, errors.ErrNotFound
+	}
+
+	return &s, nil
+}
+
+// Save creates or replaces the settings of a laboratory
+func (r *NotificationSettingsRepository) Save(ctx context.Context, s *notification.Settings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Stored by value to avoid external modifications
+	r.data[s.LaboratoryID] = *s
+	return nil
+}
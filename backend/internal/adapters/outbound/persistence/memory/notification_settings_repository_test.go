@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+)
+
+func TestNotificationSettingsRepository(t *testing.T) {
+	repo := NewNotificationSettingsRepository()
+	ctx := context.Background()
+
+	if _, err := repo.Get(ctx, "lab-123"); err != errors.ErrNotFound {
+		t.Fatalf("Get() before Save() error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	s := &notification.Settings{LaboratoryID: "lab-123", SenderName: "Smile Lab"}
+	if err := repo.Save(ctx, s); err != nil {
+		t.Fatalf("Save() unexpected error = %v", err)
+	}
+	s.SenderName = "Changed"
+
+	got, err := repo.Get(ctx, "lab-123")
+	if err != nil || got.SenderName != "Smile Lab" {
+		t.Fatalf("Get() = %+v, %v, want the saved settings", got, err)
+	}
+
+	if err := repo.Save(ctx, &notification.Settings{LaboratoryID: "lab-123", ReplyTo: "desk@smilelab.com"}); err != nil {
+		t.Fatalf("Save() unexpected error = %v", err)
+	}
+	if got, _ := repo.Get(ctx, "lab-123"); got.SenderName != "" || got.ReplyTo != "desk@smilelab.com" {
+		t.Errorf("Get() after a second Save() = %+v, want the settings replaced", got)
+	}
+}
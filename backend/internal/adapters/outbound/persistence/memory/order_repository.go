@@ -2,36 +2,69 @@ package memory
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
 )
 
-// OrderRepository is an in-memory implementation of the order repository
+// OrderRepository is an in-memory implementation of the order repository.
+// Active orders are indexed by laboratory, client and status so searches
+// only visit candidate orders instead of scanning all data.
 type OrderRepository struct {
-	mu   sync.RWMutex
-	data map[string]*order.Order
+	mu       sync.RWMutex
+	data     map[string]*order.Order
+	byLab    map[string]idSet
+	byClient map[string]idSet
+	byStatus map[order.Status]idSet
 }
 
+// idSet is a set of order IDs
+type idSet map[string]struct{}
+
 // NewOrderRepository creates a new in-memory order repository
 func NewOrderRepository() *OrderRepository {
 	return &OrderRepository{
-		data: make(map[string]*order.Order),
+		data:     make(map[string]*order.Order),
+		byLab:    make(map[string]idSet),
+		byClient: make(map[string]idSet),
+		byStatus: make(map[order.Status]idSet),
 	}
 }
 
+// Ping reports whether the store answers; it doesn't while a write holds it
+// longer than the ping waits
+func (r *OrderRepository) Ping(ctx context.Context) error {
+	return ping(ctx, &r.mu)
+}
+
+// orderListFields maps list query fields to order values
+var orderListFields = listFields[*order.Order]{
+	id: func(o *order.Order) string { return o.ID },
+	sort: map[string]func(*order.Order) string{
+		"status":     func(o *order.Order) string { return string(o.Status) },
+		"created_at": func(o *order.Order) string { return timeKey(o.CreatedAt) },
+		"updated_at": func(o *order.Order) string { return timeKey(o.UpdatedAt) },
+	},
+}
+
 // Create stores a new order
 func (r *OrderRepository) Create(ctx context.Context, o *order.Order) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if _, exists := r.data[o.ID]; exists {
+		logDuplicate(ctx, "order", o.ID)
 		return errors.ErrInternal // ID already exists
 	}
 
 	// Clone to avoid external modifications
 	r.data[o.ID] = r.clone(o)
+	r.index(r.data[o.ID])
+	logWrite(ctx, "order", "created", o.ID)
 	return nil
 }
 
@@ -58,7 +91,10 @@ func (r *OrderRepository) Update(ctx context.Context, o *order.Order) error {
 		return errors.ErrNotFound
 	}
 
+	r.unindex(existing)
 	r.data[o.ID] = r.clone(o)
+	r.index(r.data[o.ID])
+	logWrite(ctx, "order", "updated", o.ID)
 	return nil
 }
 
@@ -72,7 +108,26 @@ func (r *OrderRepository) UpdateStatus(ctx context.Context, id string, status or
 		return errors.ErrNotFound
 	}
 
+	r.unindex(o)
 	o.Status = status
+	r.index(o)
+	logWrite(ctx, "order", "updated", id)
+	return nil
+}
+
+// AddAttachment appends an attachment to an order, leaving its other fields as stored
+func (r *OrderRepository) AddAttachment(ctx context.Context, id string, a order.Attachment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	o, exists := r.data[id]
+	if !exists || o.IsDeleted() {
+		return errors.ErrNotFound
+	}
+
+	o.Attachments = append(o.Attachments, a)
+	o.UpdatedAt = a.UploadedAt
+	logWrite(ctx, "order", "updated", id)
 	return nil
 }
 
@@ -86,23 +141,57 @@ func (r *OrderRepository) Delete(ctx context.Context, id string) error {
 		return errors.ErrNotFound
 	}
 
+	r.unindex(o)
 	o.Delete()
+	logWrite(ctx, "order", "deleted", id)
 	return nil
 }
 
-// List retrieves all active (non-deleted) orders for a laboratory
-func (r *OrderRepository) List(ctx context.Context, laboratoryID string) ([]*order.Order, error) {
+// PurgeDeleted permanently removes the orders deleted before the given time
+func (r *OrderRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := purgeDeleted(r.data, func(o *order.Order) *time.Time { return o.DeletedAt }, deletedBefore)
+	return len(purged), nil
+}
+
+// Stats returns the stats of the active orders of each laboratory having
+// some, sorted by laboratory
+func (r *OrderRepository) Stats(ctx context.Context) ([]*order.Stats, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stats := make([]*order.Stats, 0, len(r.byLab))
+	for laboratoryID, ids := range r.byLab {
+		if len(ids) == 0 {
+			continue
+		}
+		s := order.NewStats(laboratoryID)
+		for id := range ids {
+			s.Add(r.data[id])
+		}
+		stats = append(stats, s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].LaboratoryID < stats[j].LaboratoryID })
+	return stats, nil
+}
+
+// Search retrieves a page of active (non-deleted) orders of a laboratory matching the criteria
+func (r *OrderRepository) Search(ctx context.Context, laboratoryID string, criteria order.SearchCriteria, q listing.Query) (listing.Page[*order.Order], error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	var orders []*order.Order
-	for _, o := range r.data {
-		if o.LaboratoryID == laboratoryID && !o.IsDeleted() {
+	for id := range r.candidates(laboratoryID, criteria) {
+		o := r.data[id]
+		if o.LaboratoryID == laboratoryID && criteria.Matches(o) {
 			orders = append(orders, r.clone(o))
 		}
 	}
 
-	return orders, nil
+	return paginate(orders, q, orderListFields)
 }
 
 // ListByClientID retrieves all active orders for a specific client
@@ -111,15 +200,70 @@ func (r *OrderRepository) ListByClientID(ctx context.Context, clientID string) (
 	defer r.mu.RUnlock()
 
 	var orders []*order.Order
-	for _, o := range r.data {
-		if o.ClientID == clientID && !o.IsDeleted() {
-			orders = append(orders, r.clone(o))
-		}
+	for id := range r.byClient[clientID] {
+		orders = append(orders, r.clone(r.data[id]))
 	}
 
 	return orders, nil
 }
 
+// candidates returns the smallest indexed set of orders that may match the search
+func (r *OrderRepository) candidates(laboratoryID string, criteria order.SearchCriteria) idSet {
+	best := r.byLab[laboratoryID]
+	if criteria.ClientID != "" && len(r.byClient[criteria.ClientID]) < len(best) {
+		best = r.byClient[criteria.ClientID]
+	}
+	if len(criteria.Statuses) > 0 {
+		size := 0
+		for _, s := range criteria.Statuses {
+			size += len(r.byStatus[s])
+		}
+		if size < len(best) {
+			best = make(idSet, size)
+			for _, s := range criteria.Statuses {
+				for id := range r.byStatus[s] {
+					best[id] = struct{}{}
+				}
+			}
+		}
+	}
+	return best
+}
+
+// index adds an active order to the secondary indexes
+func (r *OrderRepository) index(o *order.Order) {
+	if o.IsDeleted() {
+		return
+	}
+	indexAdd(r.byLab, o.LaboratoryID, o.ID)
+	indexAdd(r.byClient, o.ClientID, o.ID)
+	indexAdd(r.byStatus, o.Status, o.ID)
+}
+
+// unindex removes an order from the secondary indexes
+func (r *OrderRepository) unindex(o *order.Order) {
+	indexRemove(r.byLab, o.LaboratoryID, o.ID)
+	indexRemove(r.byClient, o.ClientID, o.ID)
+	indexRemove(r.byStatus, o.Status, o.ID)
+}
+
+func indexAdd[K comparable](index map[K]idSet, key K, id string) {
+	set, exists := index[key]
+	if !exists {
+		set = make(idSet)
+		index[key] = set
+	}
+	set[id] = struct{}{}
+}
+
+func indexRemove[K comparable](index map[K]idSet, key K, id string) {
+	set := index[key]
+	delete(set, id)
+	if len(set) == 0 {
+		delete(index, key)
+	}
+}
+
 // clone creates a deep copy of an order to avoid external modifications
 func (r *OrderRepository) clone(o *order.Order) *order.Order {
 	clone := *o
@@ -130,5 +274,9 @@ func (r *OrderRepository) clone(o *order.Order) *order.Order {
 	// Clone prosthesis items
 	clone.Prosthesis = make([]order.ProsthesisItem, len(o.Prosthesis))
 	copy(clone.Prosthesis, o.Prosthesis)
+	clone.History = make([]order.StatusChange, len(o.History))
+	copy(clone.History, o.History)
+	clone.Attachments = make([]order.Attachment, len(o.Attachments))
+	copy(clone.Attachments, o.Attachments)
 	return &clone
 }
@@ -2,10 +2,12 @@ package memory
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
 )
 
@@ -267,17 +269,17 @@ func TestOrderRepository_Delete_NotFound(t *testing.T) {
 	}
 }
 
-func TestOrderRepository_List(t *testing.T) {
+func TestOrderRepository_Search(t *testing.T) {
 	repo := NewOrderRepository()
 	ctx := context.Background()
 
 	// Empty list
-	orders, err := repo.List(ctx, "lab-123")
+	orders, err := items(repo.Search(ctx, "lab-123", order.SearchCriteria{}, listing.Query{}))
 	if err != nil {
-		t.Fatalf("List() unexpected error = %v", err)
+		t.Fatalf("Search() unexpected error = %v", err)
 	}
 	if len(orders) != 0 {
-		t.Errorf("List() got %d orders, want 0", len(orders))
+		t.Errorf("Search() got %d orders, want 0", len(orders))
 	}
 
 	// Add orders
@@ -327,16 +329,16 @@ func TestOrderRepository_List(t *testing.T) {
 		UpdatedAt: time.Now().UTC(),
 	})
 
-	orders, err = repo.List(ctx, "lab-123")
+	orders, err = items(repo.Search(ctx, "lab-123", order.SearchCriteria{}, listing.Query{}))
 	if err != nil {
-		t.Fatalf("List() unexpected error = %v", err)
+		t.Fatalf("Search() unexpected error = %v", err)
 	}
 	if len(orders) != 2 {
-		t.Errorf("List() got %d orders, want 2", len(orders))
+		t.Errorf("Search() got %d orders, want 2", len(orders))
 	}
 }
 
-func TestOrderRepository_List_ExcludesDeleted(t *testing.T) {
+func TestOrderRepository_Search_ExcludesDeleted(t *testing.T) {
 	repo := NewOrderRepository()
 	ctx := context.Background()
 
@@ -374,15 +376,15 @@ func TestOrderRepository_List_ExcludesDeleted(t *testing.T) {
 	// Delete one
 	_ = repo.Delete(ctx, "order-1")
 
-	orders, err := repo.List(ctx, "lab-123")
+	orders, err := items(repo.Search(ctx, "lab-123", order.SearchCriteria{}, listing.Query{}))
 	if err != nil {
-		t.Fatalf("List() unexpected error = %v", err)
+		t.Fatalf("Search() unexpected error = %v", err)
 	}
 	if len(orders) != 1 {
-		t.Errorf("List() got %d orders, want 1", len(orders))
+		t.Errorf("Search() got %d orders, want 1", len(orders))
 	}
 	if orders[0].ID != "order-2" {
-		t.Errorf("List() remaining order ID = %v, want order-2", orders[0].ID)
+		t.Errorf("Search() remaining order ID = %v, want order-2", orders[0].ID)
 	}
 }
 
@@ -540,3 +542,153 @@ func TestOrderRepository_ImmutableClone(t *testing.T) {
 		t.Errorf("Repository prosthesis was mutated, got %v, want crown", original.Prosthesis[0].Type)
 	}
 }
+
+func TestOrderRepository_Search_Criteria(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	seed := []*order.Order{
+		{
+			ID: "order-1", ClientID: "client-1", LaboratoryID: "lab-123", TechnicianID: "tech-1",
+			Status:     order.StatusReceived,
+			Prosthesis: []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1, Notes: "Urgent, patient traveling"}},
+			CreatedAt:  now.Add(-48 * time.Hour), UpdatedAt: now.Add(-48 * time.Hour),
+		},
+		{
+			ID: "order-2", ClientID: "client-1", LaboratoryID: "lab-123",
+			Status: order.StatusInProduction,
+			Prosthesis: []order.ProsthesisItem{
+				{Type: "bridge", Material: "porcelain", Quantity: 1},
+				{Type: "crown", Material: "emax", Quantity: 2},
+			},
+			CreatedAt: now.Add(-24 * time.Hour), UpdatedAt: now,
+		},
+		{
+			ID: "order-3", ClientID: "client-2", LaboratoryID: "lab-123", TechnicianID: "tech-1",
+			Status:     order.StatusReady,
+			Prosthesis: []order.ProsthesisItem{{Type: "denture", Material: "acrylic", Quantity: 1}},
+			CreatedAt:  now, UpdatedAt: now,
+		},
+		{
+			ID: "order-4", ClientID: "client-3", LaboratoryID: "lab-456", TechnicianID: "tech-1",
+			Status:     order.StatusReceived,
+			Prosthesis: []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1, Notes: "urgent"}},
+			CreatedAt:  now, UpdatedAt: now,
+		},
+	}
+	for _, o := range seed {
+		if err := repo.Create(ctx, o); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+
+	from := now.Add(-36 * time.Hour)
+	recent := now.Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		criteria order.SearchCriteria
+		want     []string
+	}{
+		{"no criteria", order.SearchCriteria{}, []string{"order-1", "order-2", "order-3"}},
+		{"status set", order.SearchCriteria{Statuses: []order.Status{order.StatusReceived, order.StatusReady}}, []string{"order-1", "order-3"}},
+		{"client", order.SearchCriteria{ClientID: "client-1"}, []string{"order-1", "order-2"}},
+		{"client of another lab", order.SearchCriteria{ClientID: "client-3"}, nil},
+		{"technician", order.SearchCriteria{TechnicianID: "tech-1"}, []string{"order-1", "order-3"}},
+		{"created from", order.SearchCriteria{CreatedFrom: &from}, []string{"order-2", "order-3"}},
+		{"updated to", order.SearchCriteria{UpdatedTo: &recent}, []string{"order-1"}},
+		{"type in any item", order.SearchCriteria{ProsthesisType: "CROWN"}, []string{"order-1", "order-2"}},
+		{"material in any item", order.SearchCriteria{Material: "emax"}, []string{"order-2"}},
+		{"text in notes", order.SearchCriteria{Text: "urgent"}, []string{"order-1"}},
+		{"combined", order.SearchCriteria{ClientID: "client-1", ProsthesisType: "crown", Statuses: []order.Status{order.StatusInProduction}}, []string{"order-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orders, err := items(repo.Search(ctx, "lab-123", tt.criteria, listing.Query{}))
+			if err != nil {
+				t.Fatalf("Search() unexpected error = %v", err)
+			}
+			var got []string
+			for _, o := range orders {
+				got = append(got, o.ID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderRepository_Search_IndexesFollowChanges(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+
+	o := &order.Order{
+		ID:           "order-1",
+		ClientID:     "client-1",
+		LaboratoryID: "lab-123",
+		Status:       order.StatusReceived,
+		Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+	_ = repo.Create(ctx, o)
+
+	count := func(criteria order.SearchCriteria) int {
+		orders, err := items(repo.Search(ctx, "lab-123", criteria, listing.Query{}))
+		if err != nil {
+			t.Fatalf("Search() unexpected error = %v", err)
+		}
+		return len(orders)
+	}
+	received := order.SearchCriteria{Statuses: []order.Status{order.StatusReceived}}
+	inProduction := order.SearchCriteria{Statuses: []order.Status{order.StatusInProduction}}
+
+	_ = repo.UpdateStatus(ctx, o.ID, order.StatusInProduction)
+	if count(received) != 0 || count(inProduction) != 1 {
+		t.Errorf("status index not updated by UpdateStatus()")
+	}
+
+	o.Status = order.StatusQualityCheck
+	o.ClientID = "client-2"
+	_ = repo.Update(ctx, o)
+	if count(inProduction) != 0 || count(order.SearchCriteria{ClientID: "client-1"}) != 0 || count(order.SearchCriteria{ClientID: "client-2"}) != 1 {
+		t.Errorf("indexes not updated by Update()")
+	}
+
+	_ = repo.Delete(ctx, o.ID)
+	if count(order.SearchCriteria{}) != 0 || len(repo.byLab) != 0 || len(repo.byClient) != 0 || len(repo.byStatus) != 0 {
+		t.Errorf("indexes not cleared by Delete()")
+	}
+}
+
+func TestOrderRepository_Stats(t *testing.T) {
+	repo := NewOrderRepository()
+	ctx := context.Background()
+
+	for _, o := range []*order.Order{
+		{ID: "order-1", LaboratoryID: "lab-2", Status: order.StatusReceived},
+		{ID: "order-2", LaboratoryID: "lab-1", Status: order.StatusReceived},
+		{ID: "order-3", LaboratoryID: "lab-1", Status: order.StatusInProduction, History: []order.StatusChange{
+			{From: order.StatusQualityCheck, To: order.StatusRevision},
+			{From: order.StatusRevision, To: order.StatusInProduction},
+		}},
+		{ID: "order-4", LaboratoryID: "lab-3", Status: order.StatusReady},
+	} {
+		_ = repo.Create(ctx, o)
+	}
+	_ = repo.Delete(ctx, "order-4")
+
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() unexpected error = %v", err)
+	}
+	if len(stats) != 2 || stats[0].LaboratoryID != "lab-1" || stats[1].LaboratoryID != "lab-2" {
+		t.Fatalf("Stats() = %+v, want the laboratories with active orders, sorted", stats)
+	}
+	if stats[0].ByStatus[order.StatusReceived] != 1 || stats[0].ByStatus[order.StatusInProduction] != 1 || stats[0].Revised != 1 {
+		t.Errorf("Stats() of lab-1 = %+v, want an order received and a revised one in production", stats[0])
+	}
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+// OutboxStore is an in-memory implementation of the outbox store.
+// Messages are per process and lost on restart; a durable store is needed
+// for delivery to survive crashes.
+type OutboxStore struct {
+	mu       sync.Mutex
+	messages []*outbox.Message // In append order
+}
+
+// NewOutboxStore creates a new in-memory outbox store
+func NewOutboxStore() *OutboxStore {
+	return &OutboxStore{}
+}
+
+// Append stores new messages, when the transaction of ctx commits if there is one
+func (s *OutboxStore) Append(ctx context.Context, msgs ...*outbox.Message) error {
+	stored := make([]*outbox.Message, len(msgs))
+	for i, m := range msgs {
+		stored[i] = cloneMessage(m)
+	}
+
+	deferToCommit(ctx, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.messages = append(s.messages, stored...)
+	})
+	return nil
+}
+
+// Claim returns up to limit messages due at now, oldest first, and holds them
+// back from other claims for the lease duration
+func (s *OutboxStore) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*outbox.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var claimed []*outbox.Message
+	for _, m := range s.messages {
+		if len(claimed) == limit {
+			break
+		}
+		if m.IsDue(now) {
+			m.NextAttemptAt = now.Add(lease)
+			claimed = append(claimed, cloneMessage(m))
+		}
+	}
+	return claimed, nil
+}
+
+// Update stores the outcome of a delivery attempt
+func (s *OutboxStore) Update(ctx context.Context, m *outbox.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.messages {
+		if existing.ID == m.ID {
+			s.messages[i] = cloneMessage(m)
+			return nil
+		}
+	}
+	return errors.ErrNotFound
+}
+
+// Purge deletes the messages delivered or dead before the given time
+func (s *OutboxStore) Purge(ctx context.Context, before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.messages[:0]
+	for _, m := range s.messages {
+		if m.IsDelivered() && m.DeliveredAt.Before(before) || m.IsDead() && m.DeadAt.Before(before) {
+			continue
+		}
+		kept = append(kept, m)
+	}
+	purged := len(s.messages) - len(kept)
+	for i := len(kept); i < len(s.messages); i++ {
+		s.messages[i] = nil
+	}
+	s.messages = kept
+	return purged, nil
+}
+
+func cloneMessage(m *outbox.Message) *outbox.Message {
+	c := *m
+	if m.DeliveredAt != nil {
+		at := *m.DeliveredAt
+		c.DeliveredAt = &at
+	}
+	if m.DeadAt != nil {
+		at := *m.DeadAt
+		c.DeadAt = &at
+	}
+	c.DeliveredTo = append([]string(nil), m.DeliveredTo...)
+	return &c
+}
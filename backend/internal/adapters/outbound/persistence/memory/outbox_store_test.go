@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+func newTestMessage(id string, now time.Time) *outbox.Message {
+	return outbox.NewMessage(id, event.OrderCreated{Metadata: event.Metadata{LaboratoryID: "lab-123", OccurredAt: now}}, now)
+}
+
+func claimedIDs(msgs []*outbox.Message) []string {
+	ids := make([]string, len(msgs))
+	for i, m := range msgs {
+		ids[i] = m.ID
+	}
+	return ids
+}
+
+func TestOutboxStore_AppendWithinTransaction(t *testing.T) {
+	store := NewOutboxStore()
+	tx := NewTransactor()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	err := tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := store.Append(ctx, newTestMessage("msg-1", now)); err != nil {
+			return err
+		}
+		// Nested units of work join the transaction
+		if err := tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			return store.Append(ctx, newTestMessage("msg-2", now))
+		}); err != nil {
+			return err
+		}
+
+		if msgs, _ := store.Claim(ctx, now, 10, time.Minute); len(msgs) != 0 {
+			t.Errorf("Claim() within the transaction = %v, want no messages before commit", claimedIDs(msgs))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithinTransaction() unexpected error = %v", err)
+	}
+
+	failed := errors.New("write failed")
+	err = tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		_ = store.Append(ctx, newTestMessage("msg-3", now))
+		return failed
+	})
+	if err != failed {
+		t.Fatalf("WithinTransaction() error = %v, want %v", err, failed)
+	}
+
+	msgs, err := store.Claim(ctx, now, 10, time.Minute)
+	if err != nil {
+		t.Fatalf("Claim() unexpected error = %v", err)
+	}
+	if got := claimedIDs(msgs); len(got) != 2 || got[0] != "msg-1" || got[1] != "msg-2" {
+		t.Errorf("Claim() = %v, want the messages of the committed transaction", got)
+	}
+}
+
+func TestOutboxStore_ClaimUpdatePurge(t *testing.T) {
+	store := NewOutboxStore()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	_ = store.Append(ctx, newTestMessage("msg-1", now), newTestMessage("msg-2", now), newTestMessage("msg-3", now))
+
+	msgs, _ := store.Claim(ctx, now, 2, time.Minute)
+	if got := claimedIDs(msgs); len(got) != 2 || got[0] != "msg-1" || got[1] != "msg-2" {
+		t.Fatalf("Claim() = %v, want the 2 oldest messages", got)
+	}
+
+	// Claimed messages are held back until their lease ends
+	if got := claimedIDs(mustClaim(t, store, now)); len(got) != 1 || got[0] != "msg-3" {
+		t.Errorf("Claim() during the lease = %v, want only msg-3", got)
+	}
+
+	msgs[0].MarkDelivered(now)
+	msgs[1].MarkFailed(errors.New("handler failed"), now, outbox.RetryPolicy{Backoff: outbox.Backoff{Initial: time.Second, Max: time.Second}})
+	for _, m := range msgs {
+		if err := store.Update(ctx, m); err != nil {
+			t.Fatalf("Update() unexpected error = %v", err)
+		}
+	}
+	if err := store.Update(ctx, newTestMessage("unknown", now)); err == nil {
+		t.Error("Update() of an unknown message expected an error")
+	}
+
+	if got := claimedIDs(mustClaim(t, store, now.Add(time.Second))); len(got) != 1 || got[0] != "msg-2" {
+		t.Errorf("Claim() after the backoff = %v, want the failed message", got)
+	}
+	if got := claimedIDs(mustClaim(t, store, now.Add(2*time.Minute))); len(got) != 2 {
+		t.Errorf("Claim() after the leases = %v, want the undelivered messages", got)
+	}
+
+	purged, err := store.Purge(ctx, now.Add(time.Second))
+	if err != nil || purged != 1 {
+		t.Errorf("Purge() = %d, %v, want 1 delivered message", purged, err)
+	}
+}
+
+func mustClaim(t *testing.T, store *OutboxStore, now time.Time) []*outbox.Message {
+	t.Helper()
+	msgs, err := store.Claim(context.Background(), now, 10, time.Minute)
+	if err != nil {
+		t.Fatalf("Claim() unexpected error = %v", err)
+	}
+	return msgs
+}
+
+func TestOutboxStore_PurgeDead(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := NewOutboxStore()
+	if err := store.Append(ctx, newTestMessage("msg-1", now)); err != nil {
+		t.Fatalf("Append() unexpected error = %v", err)
+	}
+
+	m := mustClaim(t, store, now)[0]
+	m.MarkFailed(errors.New("handler failed"), now, outbox.RetryPolicy{MaxAttempts: 1})
+	if err := store.Update(ctx, m); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+
+	if got := mustClaim(t, store, now.Add(time.Hour)); len(got) != 0 {
+		t.Errorf("Claim() of a dead message = %v, want none", claimedIDs(got))
+	}
+	purged, err := store.Purge(ctx, now.Add(time.Second))
+	if err != nil || purged != 1 {
+		t.Errorf("Purge() = %d, %v, want 1 dead message", purged, err)
+	}
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	pingInterval = 10 * time.Millisecond // How often a ping retries the lock of a busy store
+	maxPingWait  = time.Second           // How long a ping waits for a busy store at most
+)
+
+// errStoreBusy is returned by a ping when a write held the store until ctx was done
+var errStoreBusy = errors.New("store busy: a write holds it")
+
+// ping reports whether a store guarded by mu answers: it fails unless a read
+// lock is acquired within maxPingWait, or before ctx is done if sooner
+func ping(ctx context.Context, mu *sync.RWMutex) error {
+	ctx, cancel := context.WithTimeout(ctx, maxPingWait)
+	defer cancel()
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for !mu.TryRLock() {
+		select {
+		case <-ctx.Done():
+			return errStoreBusy
+		case <-ticker.C:
+		}
+	}
+	mu.RUnlock()
+	return nil
+}
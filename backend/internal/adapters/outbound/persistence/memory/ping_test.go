@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRepository_Ping(t *testing.T) {
+	repo := NewClientRepository()
+
+	if err := repo.Ping(context.Background()); err != nil {
+		t.Errorf("Ping() error = %v, want nil", err)
+	}
+
+	// A write holding the store fails the ping once its wait ends
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := repo.Ping(ctx); err != errStoreBusy {
+		t.Errorf("Ping() of a busy store error = %v, want %v", err, errStoreBusy)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
 )
 
@@ -22,17 +24,41 @@ func NewProsthesisRepository() *ProsthesisRepository {
 	}
 }
 
+// Ping reports whether the store answers; it doesn't while a write holds it
+// longer than the ping waits
+func (r *ProsthesisRepository) Ping(ctx context.Context) error {
+	return ping(ctx, &r.mu)
+}
+
+// prosthesisListFields maps list query fields to prosthesis values
+var prosthesisListFields = listFields[*prosthesis.Prosthesis]{
+	id: func(p *prosthesis.Prosthesis) string { return p.ID },
+	sort: map[string]func(*prosthesis.Prosthesis) string{
+		"type":       func(p *prosthesis.Prosthesis) string { return string(p.Type) },
+		"material":   func(p *prosthesis.Prosthesis) string { return strings.ToLower(p.Material) },
+		"created_at": func(p *prosthesis.Prosthesis) string { return timeKey(p.CreatedAt) },
+		"updated_at": func(p *prosthesis.Prosthesis) string { return timeKey(p.UpdatedAt) },
+	},
+	filter: map[string]func(*prosthesis.Prosthesis) string{
+		"type":     func(p *prosthesis.Prosthesis) string { return string(p.Type) },
+		"material": func(p *prosthesis.Prosthesis) string { return p.Material },
+		"shade":    func(p *prosthesis.Prosthesis) string { return p.Shade },
+	},
+}
+
 // Create stores a new prosthesis
 func (r *ProsthesisRepository) Create(ctx context.Context, p *prosthesis.Prosthesis) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if _, exists := r.data[p.ID]; exists {
+		logDuplicate(ctx, "prosthesis", p.ID)
 		return errors.ErrInternal // ID already exists
 	}
 
 	// Clone to avoid external modifications
 	r.data[p.ID] = r.clone(p)
+	logWrite(ctx, "prosthesis", "created", p.ID)
 	return nil
 }
 
@@ -49,6 +75,21 @@ func (r *ProsthesisRepository) GetByID(ctx context.Context, id string) (*prosthe
 	return r.clone(p), nil
 }
 
+// GetByIDs retrieves the prostheses with the given IDs (excludes soft-deleted)
+func (r *ProsthesisRepository) GetByIDs(ctx context.Context, ids []string) ([]*prosthesis.Prosthesis, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*prosthesis.Prosthesis
+	for _, id := range ids {
+		if p, exists := r.data[id]; exists && !p.IsDeleted() {
+			result = append(result, r.clone(p))
+		}
+	}
+
+	return result, nil
+}
+
 // Update updates an existing prosthesis
 func (r *ProsthesisRepository) Update(ctx context.Context, p *prosthesis.Prosthesis) error {
 	r.mu.Lock()
@@ -60,6 +101,7 @@ func (r *ProsthesisRepository) Update(ctx context.Context, p *prosthesis.Prosthe
 	}
 
 	r.data[p.ID] = r.clone(p)
+	logWrite(ctx, "prosthesis", "updated", p.ID)
 	return nil
 }
 
@@ -74,11 +116,21 @@ func (r *ProsthesisRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	p.Delete()
+	logWrite(ctx, "prosthesis", "deleted", id)
 	return nil
 }
 
-// List retrieves all active (non-deleted) prostheses for a laboratory
-func (r *ProsthesisRepository) List(ctx context.Context, laboratoryID string) ([]*prosthesis.Prosthesis, error) {
+// PurgeDeleted permanently removes the prostheses deleted before the given time
+func (r *ProsthesisRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := purgeDeleted(r.data, func(p *prosthesis.Prosthesis) *time.Time { return p.DeletedAt }, deletedBefore)
+	return len(purged), nil
+}
+
+// List retrieves a page of active (non-deleted) prostheses for a laboratory
+func (r *ProsthesisRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*prosthesis.Prosthesis], error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -89,7 +141,7 @@ func (r *ProsthesisRepository) List(ctx context.Context, laboratoryID string) ([
 		}
 	}
 
-	return prostheses, nil
+	return paginate(prostheses, q, prosthesisListFields)
 }
 
 // FindByType retrieves prostheses filtered by type for a laboratory
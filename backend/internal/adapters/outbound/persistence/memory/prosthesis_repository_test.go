@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
 )
 
@@ -173,7 +174,7 @@ func TestProsthesisRepository_List(t *testing.T) {
 	_ = repo.Create(ctx, p3)
 
 	// List prostheses for lab-123
-	prostheses, err := repo.List(ctx, "lab-123")
+	prostheses, err := items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -194,7 +195,7 @@ func TestProsthesisRepository_List_Empty(t *testing.T) {
 	repo := NewProsthesisRepository()
 	ctx := context.Background()
 
-	prostheses, err := repo.List(ctx, "lab-123")
+	prostheses, err := items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -217,7 +218,7 @@ func TestProsthesisRepository_List_ExcludesSoftDeleted(t *testing.T) {
 	_ = repo.Delete(ctx, p1.ID)
 
 	// List should only return non-deleted
-	prostheses, err := repo.List(ctx, "lab-123")
+	prostheses, err := items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
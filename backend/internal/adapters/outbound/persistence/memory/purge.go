@@ -0,0 +1,16 @@
+package memory
+
+import "time"
+
+// purgeDeleted removes the records of data deleted before the given time
+// and returns them
+func purgeDeleted[T any](data map[string]*T, deletedAt func(*T) *time.Time, before time.Time) []*T {
+	var purged []*T
+	for id, rec := range data {
+		if at := deletedAt(rec); at != nil && at.Before(before) {
+			delete(data, id)
+			purged = append(purged, rec)
+		}
+	}
+	return purged
+}
@@ -2,9 +2,12 @@ package memory
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
 )
 
@@ -21,17 +24,40 @@ func NewTechnicianRepository() *TechnicianRepository {
 	}
 }
 
+// Ping reports whether the store answers; it doesn't while a write holds it
+// longer than the ping waits
+func (r *TechnicianRepository) Ping(ctx context.Context) error {
+	return ping(ctx, &r.mu)
+}
+
+// technicianListFields maps list query fields to technician values
+var technicianListFields = listFields[*technician.Technician]{
+	id: func(tech *technician.Technician) string { return tech.ID },
+	sort: map[string]func(*technician.Technician) string{
+		"name":       func(tech *technician.Technician) string { return strings.ToLower(tech.Name) },
+		"email":      func(tech *technician.Technician) string { return strings.ToLower(tech.Email) },
+		"role":       func(tech *technician.Technician) string { return string(tech.Role) },
+		"created_at": func(tech *technician.Technician) string { return timeKey(tech.CreatedAt) },
+		"updated_at": func(tech *technician.Technician) string { return timeKey(tech.UpdatedAt) },
+	},
+	filter: map[string]func(*technician.Technician) string{
+		"role": func(tech *technician.Technician) string { return string(tech.Role) },
+	},
+}
+
 // Create stores a new technician
 func (r *TechnicianRepository) Create(ctx context.Context, tech *technician.Technician) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if _, exists := r.data[tech.ID]; exists {
+		logDuplicate(ctx, "technician", tech.ID)
 		return errors.ErrInternal // ID already exists
 	}
 
 	// Clone to avoid external modifications
 	r.data[tech.ID] = r.clone(tech)
+	logWrite(ctx, "technician", "created", tech.ID)
 	return nil
 }
 
@@ -48,6 +74,21 @@ func (r *TechnicianRepository) GetByID(ctx context.Context, id string) (*technic
 	return r.clone(tech), nil
 }
 
+// GetByIDs retrieves the technicians with the given IDs (excludes soft-deleted)
+func (r *TechnicianRepository) GetByIDs(ctx context.Context, ids []string) ([]*technician.Technician, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*technician.Technician
+	for _, id := range ids {
+		if tech, exists := r.data[id]; exists && !tech.IsDeleted() {
+			result = append(result, r.clone(tech))
+		}
+	}
+
+	return result, nil
+}
+
 // GetByEmail retrieves a technician by email within a laboratory (excludes soft-deleted)
 func (r *TechnicianRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*technician.Technician, error) {
 	r.mu.RLock()
@@ -73,6 +114,7 @@ func (r *TechnicianRepository) Update(ctx context.Context, tech *technician.Tech
 	}
 
 	r.data[tech.ID] = r.clone(tech)
+	logWrite(ctx, "technician", "updated", tech.ID)
 	return nil
 }
 
@@ -87,11 +129,35 @@ func (r *TechnicianRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	tech.Delete()
+	logWrite(ctx, "technician", "deleted", id)
+	return nil
+}
+
+// PurgeDeleted permanently removes the technicians deleted before the given time
+func (r *TechnicianRepository) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := purgeDeleted(r.data, func(t *technician.Technician) *time.Time { return t.DeletedAt }, deletedBefore)
+	return len(purged), nil
+}
+
+// Restore reverts the soft delete of a technician
+func (r *TechnicianRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tech, exists := r.data[id]
+	if !exists || !tech.IsDeleted() {
+		return errors.ErrNotFound
+	}
+
+	tech.DeletedAt = nil
 	return nil
 }
 
-// List retrieves all active (non-deleted) technicians for a laboratory
-func (r *TechnicianRepository) List(ctx context.Context, laboratoryID string) ([]*technician.Technician, error) {
+// List retrieves a page of active (non-deleted) technicians for a laboratory
+func (r *TechnicianRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*technician.Technician], error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -102,7 +168,7 @@ func (r *TechnicianRepository) List(ctx context.Context, laboratoryID string) ([
 		}
 	}
 
-	return techs, nil
+	return paginate(techs, q, technicianListFields)
 }
 
 // ListByRole retrieves all active technicians for a laboratory filtered by role
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
 )
 
@@ -215,12 +216,43 @@ func TestTechnicianRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestTechnicianRepository_Restore(t *testing.T) {
+	repo := NewTechnicianRepository()
+	ctx := context.Background()
+
+	tech := &technician.Technician{
+		ID:           "tech-123",
+		LaboratoryID: "lab-123",
+		Name:         "John Doe",
+		Email:        "john@lab.com",
+		Phone:        "+5511999999999",
+		Role:         technician.RoleTechnician,
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+	_ = repo.Create(ctx, tech)
+
+	// Only deleted technicians can be restored
+	if err := repo.Restore(ctx, tech.ID); err != errors.ErrNotFound {
+		t.Errorf("Restore() of active technician error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	_ = repo.Delete(ctx, tech.ID)
+	if err := repo.Restore(ctx, tech.ID); err != nil {
+		t.Fatalf("Restore() unexpected error = %v", err)
+	}
+
+	if _, err := repo.GetByID(ctx, tech.ID); err != nil {
+		t.Errorf("GetByID() after restore error = %v, want nil", err)
+	}
+}
+
 func TestTechnicianRepository_List(t *testing.T) {
 	repo := NewTechnicianRepository()
 	ctx := context.Background()
 
 	// Empty list
-	techs, err := repo.List(ctx, "lab-123")
+	techs, err := items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
@@ -260,7 +292,7 @@ func TestTechnicianRepository_List(t *testing.T) {
 		UpdatedAt:    time.Now().UTC(),
 	})
 
-	techs, err = repo.List(ctx, "lab-123")
+	techs, err = items(repo.List(ctx, "lab-123", listing.Query{}))
 	if err != nil {
 		t.Fatalf("List() unexpected error = %v", err)
 	}
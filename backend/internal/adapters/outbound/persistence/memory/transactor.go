@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// Transactor is an in-memory implementation of the transactor. Repository
+// writes are applied immediately, so it can't roll them back; writes that
+// support transactions, such as outbox appends, are held until commit and
+// dropped if the unit of work fails.
+type Transactor struct{}
+
+// NewTransactor creates a new in-memory transactor
+func NewTransactor() *Transactor {
+	return &Transactor{}
+}
+
+// transaction holds the writes deferred to the commit of a unit of work
+type transaction struct {
+	onCommit []func()
+}
+
+type transactionKey struct{}
+
+// WithinTransaction runs fn in a transaction, committed if fn returns nil
+func (t *Transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(transactionKey{}).(*transaction); ok {
+		return fn(ctx)
+	}
+
+	tx := &transaction{}
+	if err := fn(context.WithValue(ctx, transactionKey{}, tx)); err != nil {
+		return err
+	}
+	for _, commit := range tx.onCommit {
+		commit()
+	}
+	return nil
+}
+
+// deferToCommit runs write when the transaction of ctx commits, or right away
+// outside of a transaction
+func deferToCommit(ctx context.Context, write func()) {
+	if tx, ok := ctx.Value(transactionKey{}).(*transaction); ok {
+		tx.onCommit = append(tx.onCommit, write)
+		return
+	}
+	write()
+}
+
+// withoutTransaction returns a copy of ctx outside of its transaction
+func withoutTransaction(ctx context.Context) context.Context {
+	return context.WithValue(ctx, transactionKey{}, nil)
+}
+
+// CommitPublisher publishes the events of a transaction when it commits and
+// drops them if it fails, like the outbox does, for event buses used without
+// the outbox
+type CommitPublisher struct {
+	next outbound.EventPublisher
+}
+
+// NewCommitPublisher creates a publisher handing events over to next once
+// their transaction commits
+func NewCommitPublisher(next outbound.EventPublisher) *CommitPublisher {
+	return &CommitPublisher{next: next}
+}
+
+// Publish hands events over when the transaction of ctx commits, or right
+// away outside of a transaction
+func (p *CommitPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	if _, ok := ctx.Value(transactionKey{}).(*transaction); !ok {
+		return p.next.Publish(ctx, events...)
+	}
+
+	deferToCommit(ctx, func() {
+		// Subscribers run after the commit, so their writes aren't part of it
+		if err := p.next.Publish(withoutTransaction(ctx), events...); err != nil {
+			slog.ErrorContext(ctx, "memory: failed to publish the events of a transaction", "error", err)
+		}
+	})
+	return nil
+}
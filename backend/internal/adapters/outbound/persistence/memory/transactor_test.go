@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+// recordingPublisher records the events it is handed over and whether they
+// were published within a transaction
+type recordingPublisher struct {
+	events        []event.Event
+	inTransaction bool
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	if _, ok := ctx.Value(transactionKey{}).(*transaction); ok {
+		p.inTransaction = true
+	}
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func TestCommitPublisher(t *testing.T) {
+	next := &recordingPublisher{}
+	publisher := NewCommitPublisher(next)
+	tx := NewTransactor()
+	ctx := context.Background()
+
+	err := tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		_ = publisher.Publish(ctx, event.OrderCreated{})
+		if len(next.events) != 0 {
+			t.Errorf("Publish() within the transaction handed over %d events before commit", len(next.events))
+		}
+		return nil
+	})
+	if err != nil || len(next.events) != 1 {
+		t.Fatalf("WithinTransaction() error = %v, handed over %d events, want 1 after commit", err, len(next.events))
+	}
+	if next.inTransaction {
+		t.Error("Publish() handed events over within the committed transaction")
+	}
+
+	failed := errors.New("write failed")
+	_ = tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		_ = publisher.Publish(ctx, event.OrderUpdated{})
+		return failed
+	})
+	if len(next.events) != 1 {
+		t.Errorf("Publish() in a failed transaction handed over %d events, want none", len(next.events)-1)
+	}
+
+	_ = publisher.Publish(ctx, event.OrderDeleted{})
+	if len(next.events) != 2 {
+		t.Errorf("Publish() outside of a transaction handed over %d events, want 1", len(next.events)-1)
+	}
+}
@@ -0,0 +1,220 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+// WebhookSubscriptionRepository is an in-memory implementation of the webhook subscription repository
+type WebhookSubscriptionRepository struct {
+	mu   sync.RWMutex
+	data map[string]*webhook.Subscription
+}
+
+// NewWebhookSubscriptionRepository creates a new in-memory webhook subscription repository
+func NewWebhookSubscriptionRepository() *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{
+		data: make(map[string]*webhook.Subscription),
+	}
+}
+
+// Create stores a new subscription
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, s *webhook.Subscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.data[s.ID]; exists {
+		logDuplicate(ctx, "webhook subscription", s.ID)
+		return errors.ErrInternal // ID already exists
+	}
+
+	// Clone to avoid external modifications
+	r.data[s.ID] = cloneSubscription(s)
+	return nil
+}
+
+// GetByID retrieves a subscription by ID (excludes soft-deleted)
+func (r *WebhookSubscriptionRepository) GetByID(ctx context.Context, id string) (*webhook.Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, exists := r.data[id]
+	if !exists || s.IsDeleted() {
+		return nil, errors.ErrNotFound
+	}
+
+	return cloneSubscription(s), nil
+}
+
+// Update updates an existing subscription
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, s *webhook.Subscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.data[s.ID]
+	if !exists || existing.IsDeleted() {
+		return errors.ErrNotFound
+	}
+
+	r.data[s.ID] = cloneSubscription(s)
+	return nil
+}
+
+// Delete performs a soft delete on a subscription
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, exists := r.data[id]
+	if !exists || s.IsDeleted() {
+		return errors.ErrNotFound
+	}
+
+	s.Delete()
+	return nil
+}
+
+// ListByLaboratory retrieves the active subscriptions of a laboratory, oldest first
+func (r *WebhookSubscriptionRepository) ListByLaboratory(ctx context.Context, laboratoryID string) ([]*webhook.Subscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*webhook.Subscription
+	for _, s := range r.data {
+		if s.LaboratoryID == laboratoryID && !s.IsDeleted() {
+			result = append(result, cloneSubscription(s))
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+// cloneSubscription creates a deep copy of a subscription to avoid external modifications
+func cloneSubscription(s *webhook.Subscription) *webhook.Subscription {
+	clone := *s
+	clone.EventTypes = append([]webhook.EventType(nil), s.EventTypes...)
+	if s.DeletedAt != nil {
+		deletedAt := *s.DeletedAt
+		clone.DeletedAt = &deletedAt
+	}
+	return &clone
+}
+
+// WebhookDeliveryRepository is an in-memory implementation of the webhook delivery repository
+type WebhookDeliveryRepository struct {
+	mu         sync.Mutex
+	deliveries []*webhook.Delivery // In creation order
+	byID       map[string]*webhook.Delivery
+}
+
+// NewWebhookDeliveryRepository creates a new in-memory webhook delivery repository
+func NewWebhookDeliveryRepository() *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		byID: make(map[string]*webhook.Delivery),
+	}
+}
+
+// Create stores new deliveries
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, deliveries ...*webhook.Delivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, d := range deliveries {
+		if _, exists := r.byID[d.ID]; exists {
+			logDuplicate(ctx, "webhook delivery", d.ID)
+			return errors.ErrInternal // ID already exists
+		}
+	}
+
+	for _, d := range deliveries {
+		stored := cloneDelivery(d)
+		r.deliveries = append(r.deliveries, stored)
+		r.byID[d.ID] = stored
+	}
+	return nil
+}
+
+// GetByID retrieves a delivery by ID
+func (r *WebhookDeliveryRepository) GetByID(ctx context.Context, id string) (*webhook.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, exists := r.byID[id]
+	if !exists {
+		return nil, errors.ErrNotFound
+	}
+
+	return cloneDelivery(d), nil
+}
+
+// Update stores the outcome of a delivery attempt
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, d *webhook.Delivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.byID[d.ID]
+	if !exists {
+		return errors.ErrNotFound
+	}
+
+	*existing = *cloneDelivery(d)
+	return nil
+}
+
+// List retrieves the deliveries matching the filter, most recent first
+func (r *WebhookDeliveryRepository) List(ctx context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []*webhook.Delivery
+	// Deliveries are created in order, so walking backwards yields most recent first
+	for i := len(r.deliveries) - 1; i >= 0; i-- {
+		if !filter.Matches(r.deliveries[i]) {
+			continue
+		}
+		result = append(result, cloneDelivery(r.deliveries[i]))
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// Claim returns up to limit deliveries due at now, oldest first, and holds
+// them back from other claims for the lease duration
+func (r *WebhookDeliveryRepository) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*webhook.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var claimed []*webhook.Delivery
+	for _, d := range r.deliveries {
+		if len(claimed) == limit {
+			break
+		}
+		if d.IsDue(now) {
+			d.NextAttemptAt = now.Add(lease)
+			claimed = append(claimed, cloneDelivery(d))
+		}
+	}
+	return claimed, nil
+}
+
+// cloneDelivery creates a deep copy of a delivery to avoid external modifications
+func cloneDelivery(d *webhook.Delivery) *webhook.Delivery {
+	clone := *d
+	clone.Payload = append([]byte(nil), d.Payload...)
+	clone.Attempts = append([]webhook.Attempt(nil), d.Attempts...)
+	return &clone
+}
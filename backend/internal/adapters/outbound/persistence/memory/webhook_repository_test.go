@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+func deliveryIDs(deliveries []*webhook.Delivery) []string {
+	ids := make([]string, len(deliveries))
+	for i, d := range deliveries {
+		ids[i] = d.ID
+	}
+	return ids
+}
+
+func TestWebhookSubscriptionRepository(t *testing.T) {
+	repo := NewWebhookSubscriptionRepository()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	subs := []*webhook.Subscription{
+		{ID: "hook-2", LaboratoryID: "lab-123", EventTypes: []webhook.EventType{webhook.EventOrderReady}, CreatedAt: now.Add(time.Second)},
+		{ID: "hook-1", LaboratoryID: "lab-123", EventTypes: []webhook.EventType{webhook.EventOrderReady}, CreatedAt: now},
+		{ID: "hook-3", LaboratoryID: "lab-456", EventTypes: []webhook.EventType{webhook.EventOrderReady}, CreatedAt: now},
+	}
+	for _, s := range subs {
+		if err := repo.Create(ctx, s); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+	if err := repo.Create(ctx, subs[0]); err != errors.ErrInternal {
+		t.Errorf("Create() with an existing ID error = %v, want %v", err, errors.ErrInternal)
+	}
+
+	// Stored copies are not affected by changes of the caller's value
+	subs[0].EventTypes[0] = webhook.EventOrderDelivered
+	got, err := repo.GetByID(ctx, "hook-2")
+	if err != nil || got.EventTypes[0] != webhook.EventOrderReady {
+		t.Errorf("GetByID() = %+v, %v, want the stored event types", got, err)
+	}
+
+	list, _ := repo.ListByLaboratory(ctx, "lab-123")
+	if len(list) != 2 || list[0].ID != "hook-1" || list[1].ID != "hook-2" {
+		t.Errorf("ListByLaboratory() = %v, want the laboratory's subscriptions oldest first", list)
+	}
+
+	if err := repo.Delete(ctx, "hook-1"); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	if _, err := repo.GetByID(ctx, "hook-1"); err != errors.ErrNotFound {
+		t.Errorf("GetByID() after Delete() error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if err := repo.Update(ctx, &webhook.Subscription{ID: "hook-1"}); err != errors.ErrNotFound {
+		t.Errorf("Update() after Delete() error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if list, _ := repo.ListByLaboratory(ctx, "lab-123"); len(list) != 1 {
+		t.Errorf("ListByLaboratory() after Delete() = %d subscriptions, want 1", len(list))
+	}
+}
+
+func TestWebhookDeliveryRepository_ClaimUpdateList(t *testing.T) {
+	repo := NewWebhookDeliveryRepository()
+	ctx := context.Background()
+	now := time.Now().UTC()
+	sub := &webhook.Subscription{ID: "hook-1", LaboratoryID: "lab-123"}
+	other := &webhook.Subscription{ID: "hook-2", LaboratoryID: "lab-123"}
+
+	_ = repo.Create(ctx,
+		webhook.NewDelivery("dlv-1", sub, "evt-1", webhook.EventOrderReady, []byte(`{}`), now),
+		webhook.NewDelivery("dlv-2", sub, "evt-2", webhook.EventOrderReady, []byte(`{}`), now),
+		webhook.NewDelivery("dlv-3", other, "evt-2", webhook.EventOrderReady, []byte(`{}`), now),
+	)
+
+	claimed, _ := repo.Claim(ctx, now, 2, time.Minute)
+	if got := deliveryIDs(claimed); len(got) != 2 || got[0] != "dlv-1" || got[1] != "dlv-2" {
+		t.Fatalf("Claim() = %v, want the 2 oldest deliveries", got)
+	}
+	if again, _ := repo.Claim(ctx, now, 10, time.Minute); len(again) != 1 || again[0].ID != "dlv-3" {
+		t.Errorf("Claim() while leased = %v, want only the unclaimed delivery", deliveryIDs(again))
+	}
+
+	policy := webhook.RetryPolicy{MaxAttempts: 3, Backoff: outbox.Backoff{Initial: time.Second, Max: time.Minute}}
+	claimed[0].Record(webhook.Attempt{At: now, StatusCode: 200}, policy)
+	claimed[1].Record(webhook.Attempt{At: now, StatusCode: 500, Error: "status 500"}, policy)
+	for _, d := range claimed {
+		if err := repo.Update(ctx, d); err != nil {
+			t.Fatalf("Update() unexpected error = %v", err)
+		}
+	}
+	if err := repo.Update(ctx, &webhook.Delivery{ID: "missing"}); err != errors.ErrNotFound {
+		t.Errorf("Update() of a missing delivery error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	if due, _ := repo.Claim(ctx, now.Add(time.Second), 10, time.Minute); len(due) != 1 || due[0].ID != "dlv-2" {
+		t.Errorf("Claim() after the backoff = %v, want the failed delivery", deliveryIDs(due))
+	}
+
+	list, _ := repo.List(ctx, webhook.DeliveryFilter{SubscriptionID: "hook-1"})
+	if got := deliveryIDs(list); len(got) != 2 || got[0] != "dlv-2" || got[1] != "dlv-1" {
+		t.Errorf("List() = %v, want the subscription's deliveries most recent first", got)
+	}
+	delivered, _ := repo.List(ctx, webhook.DeliveryFilter{SubscriptionID: "hook-1", Status: webhook.DeliveryDelivered})
+	if len(delivered) != 1 || delivered[0].ID != "dlv-1" || len(delivered[0].Attempts) != 1 {
+		t.Errorf("List() of delivered = %v, want dlv-1 with its attempt", deliveryIDs(delivered))
+	}
+	if limited, _ := repo.List(ctx, webhook.DeliveryFilter{SubscriptionID: "hook-1", Limit: 1}); len(limited) != 1 {
+		t.Errorf("List() with limit 1 = %d deliveries, want 1", len(limited))
+	}
+
+	if _, err := repo.GetByID(ctx, "missing"); err != errors.ErrNotFound {
+		t.Errorf("GetByID() of a missing delivery error = %v, want %v", err, errors.ErrNotFound)
+	}
+}
@@ -0,0 +1,197 @@
+package smtpmailer
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// smtpStub is a local SMTP server accepting a single session
+type smtpStub struct {
+	addr     *net.TCPAddr
+	rejectTo string // Recipient refused with 550
+
+	done     chan struct{}
+	auth     string
+	mailFrom string
+	rcptTo   string
+	data     string
+}
+
+func startSMTPStub(t *testing.T, rejectTo string) *smtpStub {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &smtpStub{addr: l.Addr().(*net.TCPAddr), rejectTo: rejectTo, done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(conn)
+	}()
+	return s
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	reply("220 stub ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch verb {
+		case "EHLO":
+			reply("250-stub")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			s.auth = line
+			reply("235 Authenticated")
+		case "MAIL":
+			s.mailFrom = line
+			reply("250 OK")
+		case "RCPT":
+			s.rcptTo = line
+			if s.rejectTo != "" && strings.Contains(line, s.rejectTo) {
+				reply("550 No such user")
+				continue
+			}
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.data = data.String()
+			reply("250 Queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func testEmail(to string) outbound.Email {
+	return outbound.Email{
+		From:    mail.Address{Name: "Laboratório Sorriso", Address: "notifications@smilelab.com"},
+		ReplyTo: &mail.Address{Address: "desk@smilelab.com"},
+		To:      mail.Address{Name: "Dr. Ana", Address: to},
+		Subject: "Seu pedido está pronto",
+		Text:    "Olá, Dr. Ana. O pedido order-123 está pronto.",
+		HTML:    "<p>Olá, Dr. Ana. O pedido <b>order-123</b> está pronto.</p>",
+	}
+}
+
+func TestMailer_Send(t *testing.T) {
+	stub := startSMTPStub(t, "")
+	mailer := NewMailer(Config{Host: "127.0.0.1", Port: stub.addr.Port, Username: "user", Password: "secret", Timeout: 2 * time.Second})
+
+	if err := mailer.Send(context.Background(), testEmail("ana@clinic.com")); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	<-stub.done
+
+	if !strings.HasPrefix(stub.auth, "AUTH PLAIN") {
+		t.Errorf("AUTH = %q, want PLAIN authentication", stub.auth)
+	}
+	if stub.mailFrom != "MAIL FROM:<notifications@smilelab.com>" || stub.rcptTo != "RCPT TO:<ana@clinic.com>" {
+		t.Errorf("envelope = %q, %q, want the sender and the recipient", stub.mailFrom, stub.rcptTo)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(stub.data))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+	var dec mime.WordDecoder
+	subject, _ := dec.DecodeHeader(msg.Header.Get("Subject"))
+	from, _ := msg.Header.AddressList("From")
+	if subject != "Seu pedido está pronto" || len(from) != 1 || from[0].Name != "Laboratório Sorriso" || msg.Header.Get("Reply-To") != "<desk@smilelab.com>" {
+		t.Errorf("headers = %v, want the encoded subject, sender and reply-to", msg.Header)
+	}
+	if msg.Header.Get("Message-ID") == "" || !strings.HasSuffix(msg.Header.Get("Message-ID"), "@smilelab.com>") {
+		t.Errorf("Message-ID = %q, want one in the domain of the sender", msg.Header.Get("Message-ID"))
+	}
+
+	mediaType, params, _ := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, want multipart/alternative", mediaType)
+	}
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for _, want := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", testEmail("").Text},
+		{"text/html; charset=utf-8", testEmail("").HTML},
+	} {
+		p, err := parts.NextPart() // Decodes quoted-printable
+		if err != nil {
+			t.Fatalf("NextPart() error = %v", err)
+		}
+		content, _ := io.ReadAll(p)
+		if p.Header.Get("Content-Type") != want.contentType || string(content) != want.content {
+			t.Errorf("part %s = %q, want %q", p.Header.Get("Content-Type"), content, want.content)
+		}
+	}
+}
+
+func TestMailer_Send_Rejected(t *testing.T) {
+	stub := startSMTPStub(t, "gone@clinic.com")
+	mailer := NewMailer(Config{Host: "127.0.0.1", Port: stub.addr.Port, Timeout: 2 * time.Second})
+
+	err := mailer.Send(context.Background(), testEmail("gone@clinic.com"))
+	if err == nil || !strings.Contains(err.Error(), "550") {
+		t.Errorf("Send() error = %v, want the rejection of the recipient", err)
+	}
+}
+
+func TestMailer_Send_Unreachable(t *testing.T) {
+	l, _ := net.Listen("tcp", "127.0.0.1:0")
+	port := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+
+	mailer := NewMailer(Config{Host: "127.0.0.1", Port: port, Timeout: time.Second})
+	if err := mailer.Send(context.Background(), testEmail("ana@clinic.com")); err == nil {
+		t.Error("Send() to a closed port error = nil, want an error")
+	}
+}
+
+func TestCompose_SubjectCannotInjectHeaders(t *testing.T) {
+	email := testEmail("ana@clinic.com")
+	email.Subject = "Ready\r\nBcc: everyone@example.com"
+
+	msg, err := compose(email, time.Now())
+	if err != nil {
+		t.Fatalf("compose() error = %v", err)
+	}
+	parsed, _ := mail.ReadMessage(strings.NewReader(string(msg)))
+	if parsed.Header.Get("Bcc") != "" {
+		t.Errorf("compose() headers = %v, want no Bcc header", parsed.Header)
+	}
+}
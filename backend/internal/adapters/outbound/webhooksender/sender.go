@@ -0,0 +1,140 @@
+// Package webhooksender posts webhook payloads over HTTP
+package webhooksender
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// Headers sent with every payload. Receivers verify the signature, see
+// webhook.Sign, and drop the deliveries of an event they already processed.
+const (
+	HeaderDeliveryID = "X-Webhook-Delivery"
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderTimestamp  = "X-Webhook-Timestamp" // Unix seconds
+	HeaderSignature  = "X-Webhook-Signature"
+)
+
+// userAgent identifies the sender to receivers
+const userAgent = "dental-prosthesis-webhooks/1.0"
+
+// ErrForbiddenAddress is returned when a receiver resolves to an address
+// webhooks may not reach, such as loopback, private or link-local ones
+var ErrForbiddenAddress = errors.New("receiver address is not public")
+
+// Config holds the sender settings
+type Config struct {
+	Timeout              time.Duration  // Give up on a receiver after this long
+	AllowPrivateNetworks bool           // Reach loopback, private and link-local receivers, e.g. in development
+	RootCAs              *x509.CertPool // Authorities trusted for receiver certificates; nil trusts the system ones
+}
+
+// HTTPSender posts webhook payloads as JSON. It connects to public addresses
+// only, checked once the receiver's name is resolved so that DNS can't point
+// it at internal services, and doesn't follow redirects.
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender creates a new webhook sender
+func NewHTTPSender(cfg Config) *HTTPSender {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = publicOnly
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Through a proxy, the dialer would check the proxy's address instead
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	transport.TLSClientConfig = &tls.Config{RootCAs: cfg.RootCAs, MinVersion: tls.VersionTLS12}
+
+	return &HTTPSender{client: &http.Client{
+		Transport: transport,
+		Timeout:   cfg.Timeout,
+		// A redirect is answered as is, and fails the delivery unless 2xx
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// reservedNetworks aren't publicly routable, though net.IP doesn't classify
+// them as private
+var reservedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // This network
+	netip.MustParsePrefix("100.64.0.0/10"), // Carrier-grade NAT
+	netip.MustParsePrefix("198.18.0.0/15"), // Benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, reaching IPv4 addresses through IPv6
+}
+
+// publicOnly refuses connections to addresses that aren't publicly routable
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		isReserved(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+// isReserved reports whether the IP is in one of the reserved networks
+func isReserved(ip net.IP) bool {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, network := range reservedNetworks {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Send posts the request and returns the status code of the response, zero
+// if none was received. An error is returned unless the status is 2xx.
+func (s *HTTPSender) Send(ctx context.Context, req outbound.WebhookRequest) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(HeaderDeliveryID, req.DeliveryID)
+	httpReq.Header.Set(HeaderEvent, string(req.EventType))
+	httpReq.Header.Set(HeaderTimestamp, strconv.FormatInt(req.Timestamp.Unix(), 10))
+	httpReq.Header.Set(HeaderSignature, req.Signature)
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a bounded part of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,147 @@
+package webhooksender
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// newTestSender returns a sender trusting the receiver's certificate and
+// allowed to reach it on loopback
+func newTestSender(receiver *httptest.Server) *HTTPSender {
+	pool := x509.NewCertPool()
+	pool.AddCert(receiver.Certificate())
+	return NewHTTPSender(Config{Timeout: time.Second, AllowPrivateNetworks: true, RootCAs: pool})
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	const secret = "0123456789abcdef"
+	ts := time.Unix(1705312800, 0)
+	payload := []byte(`{"type":"order.ready"}`)
+
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus int
+		wantErr    bool
+	}{
+		{name: "accepted", status: http.StatusOK, wantStatus: http.StatusOK},
+		{name: "accepted without content", status: http.StatusNoContent, wantStatus: http.StatusNoContent},
+		{name: "server error", status: http.StatusInternalServerError, wantStatus: http.StatusInternalServerError, wantErr: true},
+		{name: "not modified is not a success", status: http.StatusNotModified, wantStatus: http.StatusNotModified, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			var body []byte
+			receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			status, err := newTestSender(receiver).Send(context.Background(), outbound.WebhookRequest{
+				URL:        receiver.URL,
+				DeliveryID: "dlv-1",
+				EventType:  webhook.EventOrderReady,
+				Timestamp:  ts,
+				Signature:  webhook.Sign(secret, ts, payload),
+				Payload:    payload,
+			})
+			if status != tt.wantStatus || (err != nil) != tt.wantErr {
+				t.Fatalf("Send() = %d, %v, want %d and error %v", status, err, tt.wantStatus, tt.wantErr)
+			}
+
+			if got.Method != http.MethodPost || got.Header.Get("Content-Type") != "application/json" ||
+				got.Header.Get(HeaderDeliveryID) != "dlv-1" || got.Header.Get(HeaderEvent) != "order.ready" {
+				t.Errorf("request = %s with headers %v, want a JSON POST identifying the delivery", got.Method, got.Header)
+			}
+
+			unix, _ := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+			if !webhook.Verify(secret, got.Header.Get(HeaderSignature), time.Unix(unix, 0), body) {
+				t.Errorf("signature %q does not verify against the received body", got.Header.Get(HeaderSignature))
+			}
+		})
+	}
+}
+
+func TestHTTPSender_Send_Unreachable(t *testing.T) {
+	receiver := httptest.NewTLSServer(http.NotFoundHandler())
+	sender := newTestSender(receiver)
+	url := receiver.URL
+	receiver.Close()
+
+	status, err := sender.Send(context.Background(), outbound.WebhookRequest{URL: url, Payload: []byte(`{}`)})
+	if status != 0 || err == nil {
+		t.Errorf("Send() = %d, %v, want no status and an error", status, err)
+	}
+}
+
+func TestHTTPSender_Send_RefusesPrivateAddresses(t *testing.T) {
+	called := false
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer receiver.Close()
+
+	status, err := NewHTTPSender(Config{Timeout: time.Second}).Send(context.Background(), outbound.WebhookRequest{URL: receiver.URL, Payload: []byte(`{}`)})
+	if status != 0 || !errors.Is(err, ErrForbiddenAddress) || called {
+		t.Errorf("Send() to loopback = %d, %v, want %v and no request", status, err, ErrForbiddenAddress)
+	}
+}
+
+func TestPublicOnly(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{"93.184.216.34:443", true},
+		{"[2606:2800:220:1:248:1893:25c8:1946]:443", true},
+		{"127.0.0.1:443", false},
+		{"10.0.0.1:443", false},
+		{"169.254.169.254:80", false},
+		{"0.0.0.0:443", false},
+		{"0.1.2.3:443", false},
+		{"100.64.0.1:443", false},
+		{"100.127.255.254:443", false},
+		{"198.18.0.1:443", false},
+		{"198.19.255.254:443", false},
+		{"[64:ff9b::7f00:1]:443", false},
+		{"[::ffff:100.64.0.1]:443", false},
+	}
+
+	for _, tt := range tests {
+		err := publicOnly("tcp", tt.address, nil)
+		if tt.allowed && err != nil || !tt.allowed && !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("publicOnly(%s) = %v, want allowed %v", tt.address, err, tt.allowed)
+		}
+	}
+}
+
+func TestHTTPSender_Send_DoesNotFollowRedirects(t *testing.T) {
+	redirected := false
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			redirected = true
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	status, err := newTestSender(receiver).Send(context.Background(), outbound.WebhookRequest{URL: receiver.URL + "/hooks", Payload: []byte(`{}`)})
+	if status != http.StatusTemporaryRedirect || err == nil || redirected {
+		t.Errorf("Send() = %d, %v, want the redirect answered as a failure and not followed", status, err)
+	}
+}
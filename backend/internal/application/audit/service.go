@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
+)
+
+// DefaultListLimit is the number of entries returned when no limit is given
+const DefaultListLimit = 100
+
+// MaxListLimit is the maximum number of entries returned by a single query
+const MaxListLimit = 1000
+
+// Recorder records write operations in the audit log
+type Recorder interface {
+	Record(ctx context.Context, input RecordInput)
+}
+
+// NopRecorder is a Recorder that discards every entry
+type NopRecorder struct{}
+
+// Record discards the entry
+func (NopRecorder) Record(ctx context.Context, input RecordInput) {}
+
+// Service provides audit log use cases
+type Service struct {
+	repo  outbound.AuditRepository
+	idGen IDGenerator
+}
+
+// IDGenerator generates unique IDs
+type IDGenerator interface {
+	Generate() string
+}
+
+// NewService creates a new audit service
+func NewService(repo outbound.AuditRepository, idGen IDGenerator) *Service {
+	return &Service{
+		repo:  repo,
+		idGen: idGen,
+	}
+}
+
+// RecordInput represents a write operation to be audited.
+// Before is nil for creates and After is nil for deletes.
+type RecordInput struct {
+	LaboratoryID string
+	EntityType   audit.EntityType
+	EntityID     string
+	Action       audit.Action
+	Before       interface{}
+	After        interface{}
+}
+
+// Record appends an audit entry for a write operation. The actor and request ID
+// are taken from the context. The write being audited has already been persisted,
+// so failures are logged rather than returned.
+func (s *Service) Record(ctx context.Context, input RecordInput) {
+	ctx, span := tracing.Start(ctx, "audit.Record", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("entity", input.EntityID))
+	defer span.End()
+
+	entry := &audit.Entry{
+		ID:           s.idGen.Generate(),
+		LaboratoryID: input.LaboratoryID,
+		ActorID:      auth.GetUserID(ctx),
+		EntityType:   input.EntityType,
+		EntityID:     input.EntityID,
+		Action:       input.Action,
+		Changes:      audit.Diff(input.Before, input.After),
+		RequestID:    requestid.FromContext(ctx),
+		OccurredAt:   time.Now().UTC(),
+	}
+
+	if err := s.repo.Append(ctx, entry); err != nil {
+		slog.ErrorContext(logging.WithLaboratoryID(ctx, entry.LaboratoryID), "audit: failed to record an entry", "action", entry.Action, "entity_type", entry.EntityType, "entity_id", entry.EntityID, "error", err)
+	}
+}
+
+// ListEntries retrieves audit entries for a laboratory matching the filter
+func (s *Service) ListEntries(ctx context.Context, filter audit.Filter) (_ []*audit.Entry, err error) {
+	ctx, span := tracing.Start(ctx, "audit.ListEntries", tracing.LaboratoryID(filter.LaboratoryID))
+	defer tracing.End(span, &err)
+
+	if filter.LaboratoryID == "" {
+		return nil, errors.Validation(errors.Required("laboratory_id"))
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	} else if filter.Limit > MaxListLimit {
+		filter.Limit = MaxListLimit
+	}
+
+	entries, err := s.repo.List(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "audit: failed to list entries", "laboratory_id", filter.LaboratoryID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+)
+
+// mockIDGenerator is a mock ID generator
+type mockIDGenerator struct {
+	id string
+}
+
+func (m *mockIDGenerator) Generate() string {
+	return m.id
+}
+
+type entity struct {
+	ID   string
+	Name string
+}
+
+func TestService_Record(t *testing.T) {
+	repo := memory.NewAuditRepository()
+	svc := NewService(repo, &mockIDGenerator{id: "entry-1"})
+
+	ctx := auth.WithUserID(context.Background(), "user_123")
+	ctx = requestid.WithRequestID(ctx, "req-abc")
+
+	svc.Record(ctx, RecordInput{
+		LaboratoryID: "lab-123",
+		EntityType:   audit.EntityClient,
+		EntityID:     "client-1",
+		Action:       audit.ActionUpdate,
+		Before:       &entity{ID: "client-1", Name: "Old"},
+		After:        &entity{ID: "client-1", Name: "New"},
+	})
+
+	entries, err := svc.ListEntries(context.Background(), audit.Filter{LaboratoryID: "lab-123"})
+	if err != nil {
+		t.Fatalf("ListEntries() unexpected error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("ListEntries() returned %d entries, want 1", len(entries))
+	}
+
+	e := entries[0]
+	if e.ActorID != "user_123" {
+		t.Errorf("Record() ActorID = %v, want user_123", e.ActorID)
+	}
+	if e.RequestID != "req-abc" {
+		t.Errorf("Record() RequestID = %v, want req-abc", e.RequestID)
+	}
+	if len(e.Changes) != 1 || e.Changes[0] != (audit.FieldChange{Field: "name", Before: "Old", After: "New"}) {
+		t.Errorf("Record() Changes = %v, want only name Old -> New", e.Changes)
+	}
+	if e.OccurredAt.IsZero() {
+		t.Error("Record() OccurredAt should be set")
+	}
+}
+
+func TestService_ListEntries_RequiresLaboratory(t *testing.T) {
+	svc := NewService(memory.NewAuditRepository(), &mockIDGenerator{id: "entry-1"})
+
+	_, err := svc.ListEntries(context.Background(), audit.Filter{})
+	if !stderrors.Is(err, errors.ErrInvalidInput) {
+		t.Errorf("ListEntries() error = %v, want %v", err, errors.ErrInvalidInput)
+	}
+}
@@ -0,0 +1,148 @@
+// Package bulk runs batches of use cases with per-item results
+package bulk
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// MaxItems bounds the number of items of a batch
+const MaxItems = 500
+
+// Status is the outcome of an item of a batch
+type Status string
+
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusSkipped   Status = "skipped" // Not applied because another item of an atomic batch failed
+)
+
+// Result is the outcome of an item of a batch
+type Result[T any] struct {
+	Status Status
+	Value  T     // Set when the item succeeded
+	Err    error // Set when the item failed
+}
+
+// errAborted rolls back the transaction of an atomic batch with a failed item
+var errAborted = errors.New("batch aborted")
+
+// Step is an item that passed validation. Commit applies it, checking it
+// again against the state at that time. Undo restores what Commit wrote when
+// a later item of an atomic batch fails to commit, for repositories whose
+// writes are not rolled back with the transaction; it publishes no events and
+// is nil when there is nothing to restore.
+type Step[T any] struct {
+	Commit func(ctx context.Context) (T, error)
+	Undo   func(ctx context.Context) error
+}
+
+// PrepareFunc validates the item at index i against the current state
+type PrepareFunc[I, T any] func(ctx context.Context, i int, item I) (Step[T], error)
+
+// Run processes items in order and returns one result per item.
+//
+// Without atomic, each item is prepared and committed before the next one, and
+// a failed item does not affect the others. With atomic, every item is
+// prepared first and nothing is committed unless all of them pass. The items
+// are then committed in a single transaction, so that their events are only
+// published if every commit succeeds; if one fails, the items committed before
+// it are undone. Items not applied because of another item's failure are
+// skipped.
+func Run[I, T any](ctx context.Context, tx outbound.Transactor, items []I, atomic bool, prepare PrepareFunc[I, T]) []Result[T] {
+	results := make([]Result[T], len(items))
+	if !atomic {
+		for i, item := range items {
+			results[i] = apply(ctx, i, item, prepare)
+		}
+		return results
+	}
+
+	steps := make([]Step[T], len(items))
+	failed := false
+	for i, item := range items {
+		step, err := prepare(ctx, i, item)
+		if err != nil {
+			results[i] = Result[T]{Status: StatusFailed, Err: err}
+			failed = true
+			continue
+		}
+		steps[i] = step
+	}
+	if failed {
+		return skipRest(results)
+	}
+
+	err := tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		for i, step := range steps {
+			value, err := step.Commit(ctx)
+			if err != nil {
+				results[i] = Result[T]{Status: StatusFailed, Err: err}
+				undo(ctx, steps[:i], results)
+				return errAborted
+			}
+			results[i] = Result[T]{Status: StatusSucceeded, Value: value}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errAborted) {
+		slog.ErrorContext(ctx, "bulk: failed to commit a batch", "error", err)
+		for i := range results {
+			results[i] = Result[T]{Status: StatusFailed, Err: domainerrors.ErrInternal}
+		}
+	}
+	return skipRest(results)
+}
+
+// undo reverts committed steps, last first. A step that can't be reverted
+// keeps its result, as it is still applied.
+func undo[T any](ctx context.Context, steps []Step[T], results []Result[T]) {
+	for i := len(steps) - 1; i >= 0; i-- {
+		if steps[i].Undo != nil {
+			if err := steps[i].Undo(ctx); err != nil {
+				slog.ErrorContext(ctx, "bulk: failed to undo an item of an aborted batch, it stays applied", "index", i, "error", err)
+				continue
+			}
+		}
+		results[i] = Result[T]{}
+	}
+}
+
+// Count returns the number of results with the given status
+func Count[T any](results []Result[T], status Status) int {
+	n := 0
+	for _, r := range results {
+		if r.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// apply prepares and commits a single item
+func apply[I, T any](ctx context.Context, i int, item I, prepare PrepareFunc[I, T]) Result[T] {
+	step, err := prepare(ctx, i, item)
+	if err != nil {
+		return Result[T]{Status: StatusFailed, Err: err}
+	}
+	value, err := step.Commit(ctx)
+	if err != nil {
+		return Result[T]{Status: StatusFailed, Err: err}
+	}
+	return Result[T]{Status: StatusSucceeded, Value: value}
+}
+
+// skipRest marks every item without an outcome as skipped
+func skipRest[T any](results []Result[T]) []Result[T] {
+	for i := range results {
+		if results[i].Status == "" {
+			results[i] = Result[T]{Status: StatusSkipped}
+		}
+	}
+	return results
+}
@@ -0,0 +1,178 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var errOdd = errors.New("odd")
+
+// ledger records the values committed and undone by steps, and the outcome
+// of the transactions they ran in
+type ledger struct {
+	committed    []int
+	undone       []int
+	transactions []bool // Whether each transaction committed
+	failUndo     int    // Index of the item that can't be undone
+}
+
+// WithinTransaction runs fn and records whether the transaction committed
+func (l *ledger) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := fn(ctx)
+	l.transactions = append(l.transactions, err == nil)
+	return err
+}
+
+// prepare fails odd items when failOdd is set and makes the commit of item
+// failCommit fail
+func (l *ledger) prepare(failOdd bool, failCommit int) PrepareFunc[int, int] {
+	return func(ctx context.Context, i int, item int) (Step[int], error) {
+		if failOdd && item%2 == 1 {
+			return Step[int]{}, errOdd
+		}
+		return Step[int]{
+			Commit: func(ctx context.Context) (int, error) {
+				if i == failCommit {
+					return 0, errOdd
+				}
+				l.committed = append(l.committed, item)
+				return item * 10, nil
+			},
+			Undo: func(ctx context.Context) error {
+				if i == l.failUndo {
+					return errOdd
+				}
+				l.undone = append(l.undone, item)
+				return nil
+			},
+		}, nil
+	}
+}
+
+func statuses(results []Result[int]) []Status {
+	s := make([]Status, len(results))
+	for i, r := range results {
+		s[i] = r.Status
+	}
+	return s
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name          string
+		items         []int
+		atomic        bool
+		failOdd       bool
+		failCommit    int
+		failUndo      int
+		wantStatuses  []Status
+		wantCommitted []int
+		wantUndone    []int
+		wantTx        []bool
+	}{
+		{
+			name:          "all items succeed",
+			items:         []int{2, 4, 6},
+			failCommit:    -1,
+			failUndo:      -1,
+			wantStatuses:  []Status{StatusSucceeded, StatusSucceeded, StatusSucceeded},
+			wantCommitted: []int{2, 4, 6},
+		},
+		{
+			name:          "failed items do not affect the others",
+			items:         []int{2, 3, 6},
+			failOdd:       true,
+			failCommit:    -1,
+			failUndo:      -1,
+			wantStatuses:  []Status{StatusSucceeded, StatusFailed, StatusSucceeded},
+			wantCommitted: []int{2, 6},
+		},
+		{
+			name:         "atomic batch with an invalid item commits nothing",
+			items:        []int{2, 3, 6},
+			atomic:       true,
+			failOdd:      true,
+			failCommit:   -1,
+			failUndo:     -1,
+			wantStatuses: []Status{StatusSkipped, StatusFailed, StatusSkipped},
+		},
+		{
+			name:          "atomic batch undoes committed items and rolls back when a commit fails",
+			items:         []int{2, 4, 6, 8},
+			atomic:        true,
+			failCommit:    2,
+			failUndo:      -1,
+			wantStatuses:  []Status{StatusSkipped, StatusSkipped, StatusFailed, StatusSkipped},
+			wantCommitted: []int{2, 4},
+			wantUndone:    []int{4, 2},
+			wantTx:        []bool{false},
+		},
+		{
+			name:          "atomic batch reports items that can't be undone",
+			items:         []int{2, 4, 6},
+			atomic:        true,
+			failCommit:    2,
+			failUndo:      0,
+			wantStatuses:  []Status{StatusSucceeded, StatusSkipped, StatusFailed},
+			wantCommitted: []int{2, 4},
+			wantUndone:    []int{4},
+			wantTx:        []bool{false},
+		},
+		{
+			name:          "atomic batch without failures",
+			items:         []int{2, 4},
+			atomic:        true,
+			failCommit:    -1,
+			failUndo:      -1,
+			wantStatuses:  []Status{StatusSucceeded, StatusSucceeded},
+			wantCommitted: []int{2, 4},
+			wantTx:        []bool{true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &ledger{failUndo: tt.failUndo}
+			results := Run(context.Background(), l, tt.items, tt.atomic, l.prepare(tt.failOdd, tt.failCommit))
+
+			if got := statuses(results); !reflect.DeepEqual(got, tt.wantStatuses) {
+				t.Errorf("Run() statuses = %v, want %v", got, tt.wantStatuses)
+			}
+			if !reflect.DeepEqual(l.committed, tt.wantCommitted) {
+				t.Errorf("Run() committed = %v, want %v", l.committed, tt.wantCommitted)
+			}
+			if !reflect.DeepEqual(l.undone, tt.wantUndone) {
+				t.Errorf("Run() undone = %v, want %v", l.undone, tt.wantUndone)
+			}
+			if !reflect.DeepEqual(l.transactions, tt.wantTx) {
+				t.Errorf("Run() transactions committed = %v, want %v", l.transactions, tt.wantTx)
+			}
+
+			for i, r := range results {
+				switch r.Status {
+				case StatusSucceeded:
+					if r.Value != tt.items[i]*10 {
+						t.Errorf("Run() results[%d].Value = %d, want %d", i, r.Value, tt.items[i]*10)
+					}
+				case StatusFailed:
+					if !errors.Is(r.Err, errOdd) {
+						t.Errorf("Run() results[%d].Err = %v, want %v", i, r.Err, errOdd)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	results := []Result[int]{{Status: StatusSucceeded}, {Status: StatusFailed}, {Status: StatusSucceeded}}
+
+	if got := Count(results, StatusSucceeded); got != 2 {
+		t.Errorf("Count(succeeded) = %d, want 2", got)
+	}
+	if got := Count(results, StatusSkipped); got != 0 {
+		t.Errorf("Count(skipped) = %d, want 0", got)
+	}
+}
@@ -2,10 +2,17 @@ package client
 
 import (
 	"context"
+	"log/slog"
 
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
 )
 
 // Service provides client use cases
@@ -13,6 +20,9 @@ type Service struct {
 	clientRepo outbound.ClientRepository
 	labRepo    outbound.LaboratoryRepository
 	idGen      IDGenerator
+	auditor    auditapp.Recorder
+	events     outbound.EventPublisher
+	tx         outbound.Transactor
 }
 
 // IDGenerator generates unique IDs
@@ -21,11 +31,14 @@ type IDGenerator interface {
 }
 
 // NewService creates a new client service
-func NewService(clientRepo outbound.ClientRepository, labRepo outbound.LaboratoryRepository, idGen IDGenerator) *Service {
+func NewService(clientRepo outbound.ClientRepository, labRepo outbound.LaboratoryRepository, idGen IDGenerator, auditor auditapp.Recorder, events outbound.EventPublisher, tx outbound.Transactor) *Service {
 	return &Service{
 		clientRepo: clientRepo,
 		labRepo:    labRepo,
 		idGen:      idGen,
+		auditor:    auditor,
+		events:     events,
+		tx:         tx,
 	}
 }
 
@@ -39,47 +52,127 @@ type CreateInput struct {
 }
 
 // CreateClient creates a new client
-func (s *Service) CreateClient(ctx context.Context, input CreateInput) (*client.Client, error) {
+func (s *Service) CreateClient(ctx context.Context, input CreateInput) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.CreateClient", tracing.LaboratoryID(input.LaboratoryID))
+	defer tracing.End(span, &err)
+
+	// Validate laboratory exists
+	if err := s.checkLaboratory(ctx, input.LaboratoryID); err != nil {
+		return nil, err
+	}
+
+	step, err := s.creation(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return step.Commit(ctx)
+}
+
+// ImportClients creates several clients of a laboratory. An email may appear
+// only once per batch.
+func (s *Service) ImportClients(ctx context.Context, laboratoryID string, items []CreateInput, atomic bool) (_ []bulk.Result[*client.Client], err error) {
+	ctx, span := tracing.Start(ctx, "client.ImportClients", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
 	// Validate laboratory exists
-	_, err := s.labRepo.GetByID(ctx, input.LaboratoryID)
+	if err := s.checkLaboratory(ctx, laboratoryID); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(items))
+	return bulk.Run(ctx, s.tx, items, atomic, func(ctx context.Context, i int, input CreateInput) (bulk.Step[*client.Client], error) {
+		if seen[input.Email] {
+			return bulk.Step[*client.Client]{}, errors.Validation(errors.DuplicateInBatch("email"))
+		}
+		seen[input.Email] = true
+
+		input.LaboratoryID = laboratoryID
+		return s.creation(ctx, input)
+	}), nil
+}
+
+// checkLaboratory checks that a laboratory exists
+func (s *Service) checkLaboratory(ctx context.Context, laboratoryID string) error {
+	_, err := s.labRepo.GetByID(ctx, laboratoryID)
 	if err != nil {
 		if err == errors.ErrNotFound {
-			return nil, errors.ErrNotFound
+			return errors.ErrNotFound
 		}
-		return nil, errors.ErrInternal
+		slog.ErrorContext(ctx, "client: failed to load a laboratory", "laboratory_id", laboratoryID, "error", err)
+		return errors.ErrInternal
 	}
+	return nil
+}
 
-	// Check if email already exists within the laboratory
-	existing, err := s.clientRepo.GetByEmail(ctx, input.LaboratoryID, input.Email)
-	if err != nil && err != errors.ErrNotFound {
-		return nil, errors.ErrInternal
-	}
-	if existing != nil {
-		return nil, errors.ErrDuplicateEmail
+// creation validates a new client of an existing laboratory
+func (s *Service) creation(ctx context.Context, input CreateInput) (bulk.Step[*client.Client], error) {
+	if err := s.checkEmailAvailable(ctx, input.LaboratoryID, input.Email); err != nil {
+		return bulk.Step[*client.Client]{}, err
 	}
 
 	// Create new client
 	id := s.idGen.Generate()
 	c, err := client.NewClient(id, input.LaboratoryID, input.Name, input.Email, input.Phone, input.Address)
 	if err != nil {
-		return nil, err
+		return bulk.Step[*client.Client]{}, err
 	}
 
-	// Persist
-	if err := s.clientRepo.Create(ctx, c); err != nil {
-		return nil, errors.ErrInternal
-	}
+	return bulk.Step[*client.Client]{
+		Commit: func(ctx context.Context) (*client.Client, error) {
+			// The email may have been taken since the validation
+			if err := s.checkEmailAvailable(ctx, c.LaboratoryID, c.Email); err != nil {
+				return nil, err
+			}
 
-	return c, nil
+			// Persist
+			e := event.ClientCreated{Metadata: event.NewMetadata(c.LaboratoryID), Client: *c}
+			if err := s.persist(ctx, e, func(ctx context.Context) error {
+				return s.clientRepo.Create(ctx, c)
+			}); err != nil {
+				slog.ErrorContext(ctx, "client: failed to store a change", "event", e.Name(), "error", err)
+				return nil, errors.ErrInternal
+			}
+
+			s.auditor.Record(ctx, auditapp.RecordInput{
+				LaboratoryID: c.LaboratoryID,
+				EntityType:   audit.EntityClient,
+				EntityID:     c.ID,
+				Action:       audit.ActionCreate,
+				After:        c,
+			})
+
+			return c, nil
+		},
+		Undo: func(ctx context.Context) error {
+			return s.clientRepo.Delete(ctx, c.ID)
+		},
+	}, nil
+}
+
+// checkEmailAvailable checks that no client of the laboratory has the email
+func (s *Service) checkEmailAvailable(ctx context.Context, laboratoryID, email string) error {
+	existing, err := s.clientRepo.GetByEmail(ctx, laboratoryID, email)
+	if err != nil && err != errors.ErrNotFound {
+		slog.ErrorContext(ctx, "client: failed to look up a client by email", "error", err)
+		return errors.ErrInternal
+	}
+	if existing != nil {
+		return errors.ErrDuplicateEmail
+	}
+	return nil
 }
 
 // GetClient retrieves a client by ID (laboratory-scoped)
-func (s *Service) GetClient(ctx context.Context, id, laboratoryID string) (*client.Client, error) {
+func (s *Service) GetClient(ctx context.Context, id, laboratoryID string) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.GetClient", tracing.LaboratoryID(laboratoryID), tracing.EntityID("client", id))
+	defer tracing.End(span, &err)
+
 	c, err := s.clientRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "client: failed to load a client", "client_id", id, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -91,6 +184,28 @@ func (s *Service) GetClient(ctx context.Context, id, laboratoryID string) (*clie
 	return c, nil
 }
 
+// GetClients retrieves the clients with the given IDs in one lookup
+// (laboratory-scoped); IDs without a match are skipped
+func (s *Service) GetClients(ctx context.Context, ids []string, laboratoryID string) (_ []*client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.GetClients", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	found, err := s.clientRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "client: failed to load clients", "ids", ids, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	clients := make([]*client.Client, 0, len(found))
+	for _, c := range found {
+		if c.LaboratoryID == laboratoryID {
+			clients = append(clients, c)
+		}
+	}
+
+	return clients, nil
+}
+
 // UpdateInput represents the input for updating a client
 type UpdateInput struct {
 	ID           string
@@ -102,13 +217,17 @@ type UpdateInput struct {
 }
 
 // UpdateClient updates an existing client
-func (s *Service) UpdateClient(ctx context.Context, input UpdateInput) (*client.Client, error) {
+func (s *Service) UpdateClient(ctx context.Context, input UpdateInput) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.UpdateClient", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("client", input.ID))
+	defer tracing.End(span, &err)
+
 	// Get existing client
 	c, err := s.clientRepo.GetByID(ctx, input.ID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "client: failed to load a client", "client_id", input.ID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -117,10 +236,35 @@ func (s *Service) UpdateClient(ctx context.Context, input UpdateInput) (*client.
 		return nil, errors.ErrNotFound // Security: don't reveal existence
 	}
 
+	return s.update(ctx, c, input)
+}
+
+// PatchClient updates a client with the input patch derives from its current
+// state, e.g. by applying a JSON merge patch
+func (s *Service) PatchClient(ctx context.Context, id, laboratoryID string, patch func(current client.Client) (UpdateInput, error)) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.PatchClient", tracing.LaboratoryID(laboratoryID), tracing.EntityID("client", id))
+	defer tracing.End(span, &err)
+
+	c, err := s.GetClient(ctx, id, laboratoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := patch(*c)
+	if err != nil {
+		return nil, err
+	}
+	input.ID, input.LaboratoryID = c.ID, c.LaboratoryID
+	return s.update(ctx, c, input)
+}
+
+// update applies the input to a client loaded for the update and stores it
+func (s *Service) update(ctx context.Context, c *client.Client, input UpdateInput) (*client.Client, error) {
 	// Check if email changed and already exists
 	if c.Email != input.Email {
 		existing, err := s.clientRepo.GetByEmail(ctx, input.LaboratoryID, input.Email)
 		if err != nil && err != errors.ErrNotFound {
+			slog.ErrorContext(ctx, "client: failed to look up a client by email", "error", err)
 			return nil, errors.ErrInternal
 		}
 		if existing != nil && existing.ID != c.ID {
@@ -128,37 +272,210 @@ func (s *Service) UpdateClient(ctx context.Context, input UpdateInput) (*client.
 		}
 	}
 
+	before := *c
+
 	// Update client
 	if err := c.Update(input.Name, input.Email, input.Phone, input.Address); err != nil {
 		return nil, err
 	}
 
 	// Persist
-	if err := s.clientRepo.Update(ctx, c); err != nil {
+	e := event.ClientUpdated{Metadata: event.NewMetadata(c.LaboratoryID), Client: *c}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.clientRepo.Update(ctx, c)
+	}); err != nil {
+		slog.ErrorContext(ctx, "client: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: c.LaboratoryID,
+		EntityType:   audit.EntityClient,
+		EntityID:     c.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        c,
+	})
+
 	return c, nil
 }
 
-// ListClients retrieves all active clients for a laboratory
-func (s *Service) ListClients(ctx context.Context, laboratoryID string) ([]*client.Client, error) {
-	clients, err := s.clientRepo.List(ctx, laboratoryID)
+// LinkPortalUser binds a portal identity to a client (laboratory-scoped)
+func (s *Service) LinkPortalUser(ctx context.Context, id, laboratoryID, userID string) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.LinkPortalUser", tracing.LaboratoryID(laboratoryID), tracing.EntityID("client", id), tracing.UserIDKey.String(userID))
+	defer tracing.End(span, &err)
+
+	// Get existing client
+	c, err := s.clientRepo.GetByID(ctx, id)
 	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "client: failed to load a client", "client_id", id, "error", err)
 		return nil, errors.ErrInternal
 	}
 
-	return clients, nil
+	// Check laboratory scope
+	if c.LaboratoryID != laboratoryID {
+		return nil, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	// A portal identity can only act on behalf of a single client
+	existing, err := s.clientRepo.GetByPortalUserID(ctx, userID)
+	if err != nil && err != errors.ErrNotFound {
+		slog.ErrorContext(ctx, "client: failed to look up the client of a portal user", "portal_user_id", userID, "error", err)
+		return nil, errors.ErrInternal
+	}
+	if existing != nil && existing.ID != c.ID {
+		return nil, errors.ErrPortalUserAlreadyLinked
+	}
+
+	before := *c
+
+	if err := c.LinkPortalUser(userID); err != nil {
+		return nil, err
+	}
+
+	// Persist
+	e := event.ClientUpdated{Metadata: event.NewMetadata(c.LaboratoryID), Client: *c}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.clientRepo.Update(ctx, c)
+	}); err != nil {
+		slog.ErrorContext(ctx, "client: failed to store a change", "event", e.Name(), "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: c.LaboratoryID,
+		EntityType:   audit.EntityClient,
+		EntityID:     c.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        c,
+	})
+
+	return c, nil
+}
+
+// UnlinkPortalUser removes the portal identity from a client (laboratory-scoped)
+func (s *Service) UnlinkPortalUser(ctx context.Context, id, laboratoryID string) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.UnlinkPortalUser", tracing.LaboratoryID(laboratoryID), tracing.EntityID("client", id))
+	defer tracing.End(span, &err)
+
+	// Get existing client
+	c, err := s.clientRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "client: failed to load a client", "client_id", id, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	// Check laboratory scope
+	if c.LaboratoryID != laboratoryID {
+		return nil, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	before := *c
+	c.UnlinkPortalUser()
+
+	// Persist
+	e := event.ClientUpdated{Metadata: event.NewMetadata(c.LaboratoryID), Client: *c}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.clientRepo.Update(ctx, c)
+	}); err != nil {
+		slog.ErrorContext(ctx, "client: failed to store a change", "event", e.Name(), "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: c.LaboratoryID,
+		EntityType:   audit.EntityClient,
+		EntityID:     c.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        c,
+	})
+
+	return c, nil
+}
+
+// SetEmailNotifications opts a client in or out of notification emails (laboratory-scoped)
+func (s *Service) SetEmailNotifications(ctx context.Context, id, laboratoryID string, enabled bool) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "client.SetEmailNotifications", tracing.LaboratoryID(laboratoryID), tracing.EntityID("client", id))
+	defer tracing.End(span, &err)
+
+	// Get existing client
+	c, err := s.clientRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "client: failed to load a client", "client_id", id, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	// Check laboratory scope
+	if c.LaboratoryID != laboratoryID {
+		return nil, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	before := *c
+	c.SetEmailNotifications(enabled)
+
+	// Persist
+	e := event.ClientUpdated{Metadata: event.NewMetadata(c.LaboratoryID), Client: *c}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.clientRepo.Update(ctx, c)
+	}); err != nil {
+		slog.ErrorContext(ctx, "client: failed to store a change", "event", e.Name(), "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: c.LaboratoryID,
+		EntityType:   audit.EntityClient,
+		EntityID:     c.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        c,
+	})
+
+	return c, nil
+}
+
+// ListClients retrieves a page of active clients for a laboratory
+func (s *Service) ListClients(ctx context.Context, laboratoryID string, q listing.Query) (_ listing.Page[*client.Client], err error) {
+	ctx, span := tracing.Start(ctx, "client.ListClients", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	q, err = q.Normalize(client.ListSpec)
+	if err != nil {
+		return listing.Page[*client.Client]{}, err
+	}
+
+	page, err := s.clientRepo.List(ctx, laboratoryID, q)
+	if err != nil {
+		slog.ErrorContext(ctx, "client: failed to list clients", "error", err)
+		return listing.Page[*client.Client]{}, errors.ErrInternal
+	}
+
+	return page, nil
 }
 
 // DeleteClient performs a soft delete on a client (laboratory-scoped)
-func (s *Service) DeleteClient(ctx context.Context, id, laboratoryID string) error {
+func (s *Service) DeleteClient(ctx context.Context, id, laboratoryID string) (err error) {
+	ctx, span := tracing.Start(ctx, "client.DeleteClient", tracing.LaboratoryID(laboratoryID), tracing.EntityID("client", id))
+	defer tracing.End(span, &err)
+
 	// Check if client exists and belongs to the laboratory
 	c, err := s.clientRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "client: failed to load a client", "client_id", id, "error", err)
 		return errors.ErrInternal
 	}
 
@@ -168,9 +485,31 @@ func (s *Service) DeleteClient(ctx context.Context, id, laboratoryID string) err
 	}
 
 	// Delete
-	if err := s.clientRepo.Delete(ctx, id); err != nil {
+	e := event.ClientDeleted{Metadata: event.NewMetadata(c.LaboratoryID), Client: *c}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.clientRepo.Delete(ctx, id)
+	}); err != nil {
+		slog.ErrorContext(ctx, "client: failed to store a change", "event", e.Name(), "error", err)
 		return errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: c.LaboratoryID,
+		EntityType:   audit.EntityClient,
+		EntityID:     c.ID,
+		Action:       audit.ActionDelete,
+		Before:       c,
+	})
+
 	return nil
 }
+
+// persist runs a write and publishes its event in a single transaction
+func (s *Service) persist(ctx context.Context, e event.Event, write func(ctx context.Context) error) error {
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, e)
+	})
+}
@@ -3,11 +3,18 @@ package client
 import (
 	"context"
 	stderrors "errors"
+	"fmt"
+	"reflect"
 	"testing"
 
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 // mockIDGenerator is a mock ID generator for testing
@@ -19,6 +26,23 @@ func (m *mockIDGenerator) Generate() string {
 	return m.id
 }
 
+// mockEventPublisher is a mock event publisher for testing that records the published events
+type mockEventPublisher struct {
+	events []event.Event
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// mockTransactor is a mock transactor for testing that runs units of work without a transaction
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // mockClientRepository is a mock client repository for testing
 type mockClientRepository struct {
 	clients        map[string]*client.Client
@@ -55,6 +79,16 @@ func (m *mockClientRepository) GetByID(ctx context.Context, id string) (*client.
 	return c, nil
 }
 
+func (m *mockClientRepository) GetByIDs(ctx context.Context, ids []string) ([]*client.Client, error) {
+	var result []*client.Client
+	for _, id := range ids {
+		if v, exists := m.clients[id]; exists && !v.IsDeleted() {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
 func (m *mockClientRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*client.Client, error) {
 	if m.getByEmailErr != nil {
 		return nil, m.getByEmailErr
@@ -67,6 +101,15 @@ func (m *mockClientRepository) GetByEmail(ctx context.Context, laboratoryID, ema
 	return nil, errors.ErrNotFound
 }
 
+func (m *mockClientRepository) GetByPortalUserID(ctx context.Context, userID string) (*client.Client, error) {
+	for _, c := range m.clients {
+		if c.PortalUserID != "" && c.PortalUserID == userID && !c.IsDeleted() {
+			return c, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
 func (m *mockClientRepository) Update(ctx context.Context, c *client.Client) error {
 	if m.updateErr != nil {
 		return m.updateErr
@@ -90,9 +133,9 @@ func (m *mockClientRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (m *mockClientRepository) List(ctx context.Context, laboratoryID string) ([]*client.Client, error) {
+func (m *mockClientRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*client.Client], error) {
 	if m.listErr != nil {
-		return nil, m.listErr
+		return listing.Page[*client.Client]{}, m.listErr
 	}
 	var clients []*client.Client
 	for _, c := range m.clients {
@@ -100,7 +143,7 @@ func (m *mockClientRepository) List(ctx context.Context, laboratoryID string) ([
 			clients = append(clients, c)
 		}
 	}
-	return clients, nil
+	return listing.Page[*client.Client]{Items: clients, Total: len(clients)}, nil
 }
 
 // mockLaboratoryRepository is a mock laboratory repository for testing
@@ -143,8 +186,8 @@ func (m *mockLaboratoryRepository) Delete(ctx context.Context, id string) error
 	return nil
 }
 
-func (m *mockLaboratoryRepository) List(ctx context.Context) ([]*laboratory.Laboratory, error) {
-	return nil, nil
+func (m *mockLaboratoryRepository) List(ctx context.Context, q listing.Query) (listing.Page[*laboratory.Laboratory], error) {
+	return listing.Page[*laboratory.Laboratory]{}, nil
 }
 
 func TestService_CreateClient(t *testing.T) {
@@ -289,7 +332,7 @@ func TestService_CreateClient(t *testing.T) {
 			labRepo := newMockLaboratoryRepository()
 			tt.setupRepo(clientRepo, labRepo)
 			idGen := &mockIDGenerator{id: tt.mockID}
-			svc := NewService(clientRepo, labRepo, idGen)
+			svc := NewService(clientRepo, labRepo, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			c, err := svc.CreateClient(context.Background(), tt.input)
 
@@ -371,7 +414,7 @@ func TestService_GetClient(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			clientRepo := newMockClientRepository()
 			tt.setupRepo(clientRepo)
-			svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{})
+			svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			c, err := svc.GetClient(context.Background(), tt.id, tt.laboratoryID)
 
@@ -398,6 +441,27 @@ func TestService_GetClient(t *testing.T) {
 	}
 }
 
+func TestService_GetClients(t *testing.T) {
+	clientRepo := newMockClientRepository()
+	clientRepo.clients["client-1"] = &client.Client{ID: "client-1", LaboratoryID: "lab-123"}
+	clientRepo.clients["client-2"] = &client.Client{ID: "client-2", LaboratoryID: "lab-456"}
+	clientRepo.clients["client-3"] = &client.Client{ID: "client-3", LaboratoryID: "lab-123"}
+	svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+	clients, err := svc.GetClients(context.Background(), []string{"client-3", "client-2", "missing", "client-1"}, "lab-123")
+	if err != nil {
+		t.Fatalf("GetClients() unexpected error = %v", err)
+	}
+
+	var ids []string
+	for _, c := range clients {
+		ids = append(ids, c.ID)
+	}
+	if want := []string{"client-3", "client-1"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetClients() IDs = %v, want %v", ids, want)
+	}
+}
+
 func TestService_UpdateClient(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -529,7 +593,7 @@ func TestService_UpdateClient(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			clientRepo := newMockClientRepository()
 			tt.setupRepo(clientRepo)
-			svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{})
+			svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			c, err := svc.UpdateClient(context.Background(), tt.input)
 
@@ -556,6 +620,123 @@ func TestService_UpdateClient(t *testing.T) {
 	}
 }
 
+// recordingAuditor is a mock audit recorder capturing recorded inputs
+type recordingAuditor struct {
+	inputs []auditapp.RecordInput
+}
+
+func (r *recordingAuditor) Record(ctx context.Context, input auditapp.RecordInput) {
+	r.inputs = append(r.inputs, input)
+}
+
+func TestService_UpdateClient_RecordsAudit(t *testing.T) {
+	repo := newMockClientRepository()
+	repo.clients["client-123"] = &client.Client{
+		ID:           "client-123",
+		LaboratoryID: "lab-123",
+		Name:         "Test Client",
+		Email:        "test@example.com",
+		Phone:        "+5511999999999",
+		Address:      client.Address{Street: "Test Street", City: "Test City", State: "SP", PostalCode: "01234-567", Country: "Brazil"},
+	}
+	auditor := &recordingAuditor{}
+	svc := NewService(repo, newMockLaboratoryRepository(), &mockIDGenerator{id: "client-123"}, auditor, &mockEventPublisher{}, mockTransactor{})
+
+	_, err := svc.UpdateClient(context.Background(), UpdateInput{
+		ID:           "client-123",
+		LaboratoryID: "lab-123",
+		Name:         "Test Client",
+		Email:        "test@example.com",
+		Phone:        "+5511999999999",
+		Address:      client.Address{Street: "New Street", City: "Test City", State: "SP", PostalCode: "01234-567", Country: "Brazil"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateClient() unexpected error = %v", err)
+	}
+
+	if len(auditor.inputs) != 1 {
+		t.Fatalf("UpdateClient() recorded %d audit entries, want 1", len(auditor.inputs))
+	}
+	changes := audit.Diff(auditor.inputs[0].Before, auditor.inputs[0].After)
+	want := audit.FieldChange{Field: "address.street", Before: "Test Street", After: "New Street"}
+	if auditor.inputs[0].Action != audit.ActionUpdate || len(changes) != 1 || changes[0] != want {
+		t.Errorf("UpdateClient() audit action/changes = %v/%v, want update/%v", auditor.inputs[0].Action, changes, want)
+	}
+}
+
+func TestService_PatchClient(t *testing.T) {
+	errPatch := stderrors.New("malformed patch")
+
+	tests := []struct {
+		name         string
+		laboratoryID string
+		patchErr     error
+		wantErr      error
+		wantPatched  bool
+		wantPhone    string
+	}{
+		{
+			name:         "applies the patch to the current client",
+			laboratoryID: "lab-123",
+			wantPatched:  true,
+			wantPhone:    "+5511777777777",
+		},
+		{
+			name:         "patch fails",
+			laboratoryID: "lab-123",
+			patchErr:     errPatch,
+			wantErr:      errPatch,
+			wantPatched:  true,
+			wantPhone:    "+5511999999999",
+		},
+		{
+			name:         "client of another laboratory",
+			laboratoryID: "lab-456",
+			wantErr:      errors.ErrNotFound,
+			wantPhone:    "+5511999999999",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockClientRepository()
+			repo.clients["client-123"] = &client.Client{
+				ID:           "client-123",
+				LaboratoryID: "lab-123",
+				Name:         "Test Client",
+				Email:        "test@example.com",
+				Phone:        "+5511999999999",
+				Address:      client.Address{Street: "Test Street", City: "Test City", State: "SP", PostalCode: "01234-567", Country: "Brazil"},
+			}
+			svc := NewService(repo, newMockLaboratoryRepository(), &mockIDGenerator{id: "client-123"}, &recordingAuditor{}, &mockEventPublisher{}, mockTransactor{})
+
+			patched := false
+			_, err := svc.PatchClient(context.Background(), "client-123", tt.laboratoryID, func(current client.Client) (UpdateInput, error) {
+				patched = true
+				if tt.patchErr != nil {
+					return UpdateInput{}, tt.patchErr
+				}
+				return UpdateInput{
+					Name:    current.Name,
+					Email:   current.Email,
+					Phone:   "+5511777777777",
+					Address: current.Address,
+				}, nil
+			})
+
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("PatchClient() error = %v, want %v", err, tt.wantErr)
+			}
+			if patched != tt.wantPatched {
+				t.Errorf("PatchClient() called the patch = %v, want %v", patched, tt.wantPatched)
+			}
+			if got := repo.clients["client-123"].Phone; got != tt.wantPhone {
+				t.Errorf("PatchClient() stored phone = %q, want %q", got, tt.wantPhone)
+			}
+		})
+	}
+}
+
 func TestService_ListClients(t *testing.T) {
 	clientRepo := newMockClientRepository()
 	clientRepo.clients["client-1"] = &client.Client{
@@ -574,16 +755,40 @@ func TestService_ListClients(t *testing.T) {
 		Name:         "Client 3",
 	}
 
-	svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{})
+	svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
-	clients, err := svc.ListClients(context.Background(), "lab-123")
+	page, err := svc.ListClients(context.Background(), "lab-123", listing.Query{})
 	if err != nil {
 		t.Errorf("ListClients() unexpected error = %v", err)
 		return
 	}
 
-	if len(clients) != 2 {
-		t.Errorf("ListClients() got %d clients, want 2", len(clients))
+	if len(page.Items) != 2 {
+		t.Errorf("ListClients() got %d clients, want 2", len(page.Items))
+	}
+}
+
+func TestService_ListClients_InvalidQuery(t *testing.T) {
+	svc := NewService(newMockClientRepository(), newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+	tests := []struct {
+		name  string
+		query listing.Query
+	}{
+		{"unknown sort field", listing.Query{SortBy: "phone"}},
+		{"unknown filter field", listing.Query{Filters: map[string]string{"phone": "123"}}},
+		{"invalid direction", listing.Query{Direction: "sideways"}},
+		{"limit too large", listing.Query{Limit: listing.MaxLimit + 1}},
+		{"malformed cursor", listing.Query{Cursor: "not-a-cursor"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ListClients(context.Background(), "lab-123", tt.query)
+			if !stderrors.Is(err, errors.ErrInvalidInput) {
+				t.Errorf("ListClients() error = %v, want %v", err, errors.ErrInvalidInput)
+			}
+		})
 	}
 }
 
@@ -634,7 +839,7 @@ func TestService_DeleteClient(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			clientRepo := newMockClientRepository()
 			tt.setupRepo(clientRepo)
-			svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{})
+			svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			err := svc.DeleteClient(context.Background(), tt.id, tt.laboratoryID)
 
@@ -655,3 +860,265 @@ func TestService_DeleteClient(t *testing.T) {
 		})
 	}
 }
+
+func TestService_LinkPortalUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		laboratoryID string
+		userID       string
+		setupRepo    func(*mockClientRepository)
+		wantErr      error
+	}{
+		{
+			name:         "successfully link portal user",
+			id:           "client-123",
+			laboratoryID: "lab-123",
+			userID:       "user_abc",
+			setupRepo: func(r *mockClientRepository) {
+				r.clients["client-123"] = &client.Client{
+					ID:           "client-123",
+					LaboratoryID: "lab-123",
+					Name:         "Test Client",
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "relinking the same client is allowed",
+			id:           "client-123",
+			laboratoryID: "lab-123",
+			userID:       "user_abc",
+			setupRepo: func(r *mockClientRepository) {
+				r.clients["client-123"] = &client.Client{
+					ID:           "client-123",
+					LaboratoryID: "lab-123",
+					Name:         "Test Client",
+					PortalUserID: "user_abc",
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "portal user linked to another client",
+			id:           "client-123",
+			laboratoryID: "lab-123",
+			userID:       "user_abc",
+			setupRepo: func(r *mockClientRepository) {
+				r.clients["client-123"] = &client.Client{
+					ID:           "client-123",
+					LaboratoryID: "lab-123",
+					Name:         "Test Client",
+				}
+				r.clients["client-456"] = &client.Client{
+					ID:           "client-456",
+					LaboratoryID: "lab-123",
+					Name:         "Other Client",
+					PortalUserID: "user_abc",
+				}
+			},
+			wantErr: errors.ErrPortalUserAlreadyLinked,
+		},
+		{
+			name:         "client belongs to different laboratory",
+			id:           "client-123",
+			laboratoryID: "lab-456",
+			userID:       "user_abc",
+			setupRepo: func(r *mockClientRepository) {
+				r.clients["client-123"] = &client.Client{
+					ID:           "client-123",
+					LaboratoryID: "lab-123",
+					Name:         "Test Client",
+				}
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name:         "empty user id",
+			id:           "client-123",
+			laboratoryID: "lab-123",
+			userID:       "",
+			setupRepo: func(r *mockClientRepository) {
+				r.clients["client-123"] = &client.Client{
+					ID:           "client-123",
+					LaboratoryID: "lab-123",
+					Name:         "Test Client",
+				}
+			},
+			wantErr: errors.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientRepo := newMockClientRepository()
+			tt.setupRepo(clientRepo)
+			svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+			c, err := svc.LinkPortalUser(context.Background(), tt.id, tt.laboratoryID, tt.userID)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Errorf("LinkPortalUser() expected error %v, got nil", tt.wantErr)
+					return
+				}
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Errorf("LinkPortalUser() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("LinkPortalUser() unexpected error = %v", err)
+				return
+			}
+			if c.PortalUserID != tt.userID {
+				t.Errorf("LinkPortalUser() PortalUserID = %v, want %v", c.PortalUserID, tt.userID)
+			}
+		})
+	}
+}
+
+func TestService_UnlinkPortalUser(t *testing.T) {
+	clientRepo := newMockClientRepository()
+	clientRepo.clients["client-123"] = &client.Client{
+		ID:           "client-123",
+		LaboratoryID: "lab-123",
+		Name:         "Test Client",
+		PortalUserID: "user_abc",
+	}
+	svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+	if _, err := svc.UnlinkPortalUser(context.Background(), "client-123", "lab-456"); !stderrors.Is(err, errors.ErrNotFound) {
+		t.Errorf("UnlinkPortalUser() other laboratory error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	c, err := svc.UnlinkPortalUser(context.Background(), "client-123", "lab-123")
+	if err != nil {
+		t.Fatalf("UnlinkPortalUser() unexpected error = %v", err)
+	}
+	if c.PortalUserID != "" {
+		t.Errorf("UnlinkPortalUser() PortalUserID = %v, want empty", c.PortalUserID)
+	}
+}
+
+func TestService_SetEmailNotifications(t *testing.T) {
+	clientRepo := newMockClientRepository()
+	clientRepo.clients["client-123"] = &client.Client{
+		ID:           "client-123",
+		LaboratoryID: "lab-123",
+		Name:         "Test Client",
+	}
+	events := &mockEventPublisher{}
+	svc := NewService(clientRepo, newMockLaboratoryRepository(), &mockIDGenerator{}, auditapp.NopRecorder{}, events, mockTransactor{})
+
+	if _, err := svc.SetEmailNotifications(context.Background(), "client-123", "lab-456", false); !stderrors.Is(err, errors.ErrNotFound) {
+		t.Errorf("SetEmailNotifications() other laboratory error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	c, err := svc.SetEmailNotifications(context.Background(), "client-123", "lab-123", false)
+	if err != nil {
+		t.Fatalf("SetEmailNotifications() unexpected error = %v", err)
+	}
+	if !c.EmailOptOut || !clientRepo.clients["client-123"].EmailOptOut {
+		t.Errorf("SetEmailNotifications(false) did not store the opt-out")
+	}
+	if len(events.events) != 1 || events.events[0].Name() != event.NameClientUpdated {
+		t.Errorf("SetEmailNotifications() published %v, want client.updated", events.events)
+	}
+}
+
+// sequenceIDGenerator is a mock ID generator returning client-1, client-2, ...
+type sequenceIDGenerator struct {
+	n int
+}
+
+func (m *sequenceIDGenerator) Generate() string {
+	m.n++
+	return fmt.Sprintf("client-%d", m.n)
+}
+
+func TestService_ImportClients(t *testing.T) {
+	newInput := func(name, email string) CreateInput {
+		return CreateInput{
+			Name:  name,
+			Email: email,
+			Phone: "+5511999999999",
+			Address: client.Address{
+				Street:     "Test Street",
+				City:       "Test City",
+				State:      "SP",
+				PostalCode: "01234-567",
+				Country:    "Brazil",
+			},
+		}
+	}
+	items := []CreateInput{
+		newInput("First Client", "first@example.com"),
+		newInput("Existing Client", "existing@example.com"),
+		newInput("", "nameless@example.com"),
+		newInput("Second Client", "first@example.com"),
+		newInput("Third Client", "third@example.com"),
+	}
+
+	tests := []struct {
+		name         string
+		atomic       bool
+		wantStatuses []bulk.Status
+		wantErrs     []error
+		wantCreated  int
+	}{
+		{
+			name:         "creates the valid clients",
+			wantStatuses: []bulk.Status{bulk.StatusSucceeded, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusSucceeded},
+			wantErrs:     []error{nil, errors.ErrDuplicateEmail, errors.ErrInvalidInput, errors.ErrInvalidInput, nil},
+			wantCreated:  2,
+		},
+		{
+			name:         "atomic import creates nothing when an item fails",
+			atomic:       true,
+			wantStatuses: []bulk.Status{bulk.StatusSkipped, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusSkipped},
+			wantErrs:     []error{nil, errors.ErrDuplicateEmail, errors.ErrInvalidInput, errors.ErrInvalidInput, nil},
+			wantCreated:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientRepo := newMockClientRepository()
+			clientRepo.clients["existing"] = &client.Client{ID: "existing", LaboratoryID: "lab-123", Email: "existing@example.com"}
+			labRepo := newMockLaboratoryRepository()
+			labRepo.labs["lab-123"] = &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"}
+			svc := NewService(clientRepo, labRepo, &sequenceIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+			results, err := svc.ImportClients(context.Background(), "lab-123", items, tt.atomic)
+			if err != nil {
+				t.Fatalf("ImportClients() unexpected error = %v", err)
+			}
+
+			for i, r := range results {
+				if r.Status != tt.wantStatuses[i] {
+					t.Errorf("ImportClients() results[%d].Status = %v, want %v", i, r.Status, tt.wantStatuses[i])
+				}
+				if tt.wantErrs[i] != nil && !stderrors.Is(r.Err, tt.wantErrs[i]) {
+					t.Errorf("ImportClients() results[%d].Err = %v, want %v", i, r.Err, tt.wantErrs[i])
+				}
+				if r.Status == bulk.StatusSucceeded && r.Value.LaboratoryID != "lab-123" {
+					t.Errorf("ImportClients() results[%d].LaboratoryID = %v, want lab-123", i, r.Value.LaboratoryID)
+				}
+			}
+			if created := len(clientRepo.clients) - 1; created != tt.wantCreated {
+				t.Errorf("ImportClients() created %d clients, want %d", created, tt.wantCreated)
+			}
+		})
+	}
+}
+
+func TestService_ImportClients_LaboratoryNotFound(t *testing.T) {
+	svc := NewService(newMockClientRepository(), newMockLaboratoryRepository(), &sequenceIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+	_, err := svc.ImportClients(context.Background(), "non-existent", []CreateInput{{Name: "Test Client"}}, false)
+	if !stderrors.Is(err, errors.ErrNotFound) {
+		t.Errorf("ImportClients() error = %v, want %v", err, errors.ErrNotFound)
+	}
+}
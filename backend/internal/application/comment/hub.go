@@ -0,0 +1,259 @@
+package comment
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+// SignalType identifies a kind of signal sent to the participants of a thread
+type SignalType string
+
+const (
+	SignalCommentCreated SignalType = "comment.created"
+	SignalCommentDeleted SignalType = "comment.deleted"
+	SignalTyping         SignalType = "typing"
+	SignalRead           SignalType = "read"
+)
+
+// AllSignalTypes returns all signal types
+func AllSignalTypes() []SignalType {
+	return []SignalType{SignalCommentCreated, SignalCommentDeleted, SignalTyping, SignalRead}
+}
+
+// Signal is sent to the participants connected to a thread. Comment signals
+// carry the comment; typing and read signals carry the participant, and read
+// signals the last comment read.
+type Signal struct {
+	Type      SignalType
+	OrderID   string
+	Author    comment.Author
+	Comment   *comment.Comment
+	CommentID string
+	At        time.Time
+}
+
+// HubConfig holds the connection hub settings
+type HubConfig struct {
+	Buffer         int           // Signals queued per connection before it is dropped as too slow
+	TypingInterval time.Duration // Typing signals of a connection relayed at most this often
+}
+
+// DefaultHubConfig returns the default connection hub settings
+func DefaultHubConfig() HubConfig {
+	return HubConfig{Buffer: 32, TypingInterval: 2 * time.Second}
+}
+
+// Hubs relays comment threads to their connected participants, with a hub of
+// connections per laboratory so that laboratories don't contend with each
+// other. New and deleted comments come from the event bus through Handle;
+// typing and read signals come from the connections themselves.
+type Hubs struct {
+	cfg HubConfig
+
+	mu     sync.Mutex
+	labs   map[string]*hub
+	closed bool
+}
+
+// hub holds the connections of a laboratory by order
+type hub struct {
+	mu    sync.Mutex
+	rooms map[string]map[*Conn]struct{}
+}
+
+// NewHubs creates the connection hubs
+func NewHubs(cfg HubConfig) *Hubs {
+	return &Hubs{cfg: cfg, labs: make(map[string]*hub)}
+}
+
+// Conn is a participant connected to a thread
+type Conn struct {
+	Thread
+
+	hubs       *Hubs
+	ch         chan Signal
+	once       sync.Once
+	dropped    bool // Set before ch is closed
+	goingAway  bool // Set before ch is closed
+	lastTyping time.Time
+}
+
+// Join connects a participant to a thread
+func (h *Hubs) Join(t Thread) *Conn {
+	c := &Conn{Thread: t, hubs: h, ch: make(chan Signal, h.cfg.Buffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		c.goAway()
+		return c
+	}
+
+	lab, ok := h.labs[t.LaboratoryID]
+	if !ok {
+		lab = &hub{rooms: make(map[string]map[*Conn]struct{})}
+		h.labs[t.LaboratoryID] = lab
+	}
+
+	lab.mu.Lock()
+	defer lab.mu.Unlock()
+
+	room, ok := lab.rooms[t.OrderID]
+	if !ok {
+		room = make(map[*Conn]struct{})
+		lab.rooms[t.OrderID] = room
+	}
+	room[c] = struct{}{}
+	return c
+}
+
+// C returns the channel of signals for the participant. It is closed when
+// the connection falls too far behind or leaves.
+func (c *Conn) C() <-chan Signal {
+	return c.ch
+}
+
+// Dropped reports whether the hub disconnected the participant for falling
+// behind. It is meaningful once C is closed.
+func (c *Conn) Dropped() bool {
+	return c.dropped
+}
+
+// GoingAway reports whether the hubs disconnected the participant as they
+// closed. It is meaningful once C is closed.
+func (c *Conn) GoingAway() bool {
+	return c.goingAway
+}
+
+// goAway disconnects the participant as the hubs close
+func (c *Conn) goAway() {
+	c.once.Do(func() {
+		c.goingAway = true
+		close(c.ch)
+	})
+}
+
+// Leave disconnects the participant
+func (c *Conn) Leave() {
+	h := c.hubs
+	h.mu.Lock()
+	if lab, ok := h.labs[c.LaboratoryID]; ok {
+		lab.mu.Lock()
+		lab.drop(c)
+		if len(lab.rooms) == 0 {
+			delete(h.labs, c.LaboratoryID)
+		}
+		lab.mu.Unlock()
+	}
+	h.mu.Unlock()
+
+	c.once.Do(func() { close(c.ch) })
+}
+
+// Typing tells the other participants that this one is typing. Signals
+// sent within the typing interval of the previous one are skipped.
+func (c *Conn) Typing() {
+	now := time.Now().UTC()
+	if now.Sub(c.lastTyping) < c.hubs.cfg.TypingInterval {
+		return
+	}
+	c.lastTyping = now
+
+	c.hubs.broadcast(c.LaboratoryID, Signal{Type: SignalTyping, OrderID: c.OrderID, Author: c.Author, At: now}, c)
+}
+
+// Read tells the other participants that this one read the thread up to a comment
+func (c *Conn) Read(commentID string) {
+	c.hubs.broadcast(c.LaboratoryID, Signal{
+		Type:      SignalRead,
+		OrderID:   c.OrderID,
+		Author:    c.Author,
+		CommentID: commentID,
+		At:        time.Now().UTC(),
+	}, c)
+}
+
+// Close disconnects every participant and refuses new ones, e.g. on
+// shutdown; they reconnect to another instance and reload their threads
+func (h *Hubs) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for laboratoryID, lab := range h.labs {
+		lab.mu.Lock()
+		for _, room := range lab.rooms {
+			for c := range room {
+				c.goAway()
+			}
+		}
+		lab.rooms = make(map[string]map[*Conn]struct{})
+		lab.mu.Unlock()
+		delete(h.labs, laboratoryID)
+	}
+}
+
+// Handle sends the comment events of the bus to the participants of their
+// thread. It never fails: a connection missing a signal reloads the thread
+// when it reconnects, and must not cause the event to be delivered again.
+func (h *Hubs) Handle(ctx context.Context, e event.Event) error {
+	switch e := e.(type) {
+	case event.CommentCreated:
+		c := e.Comment
+		h.broadcast(c.LaboratoryID, Signal{Type: SignalCommentCreated, OrderID: c.OrderID, Author: c.Author, Comment: &c, At: c.CreatedAt}, nil)
+	case event.CommentDeleted:
+		c := e.Comment
+		h.broadcast(c.LaboratoryID, Signal{Type: SignalCommentDeleted, OrderID: c.OrderID, Author: c.Author, CommentID: c.ID, At: e.OccurredAt}, nil)
+	}
+	return nil
+}
+
+// broadcast sends a signal to the connections of its thread except from.
+// Connections whose queue is full are dropped rather than waited for, so a
+// slow participant never holds back the others.
+func (h *Hubs) broadcast(laboratoryID string, s Signal, from *Conn) {
+	lab := h.lab(laboratoryID)
+	if lab == nil {
+		return
+	}
+
+	lab.mu.Lock()
+	defer lab.mu.Unlock()
+
+	for c := range lab.rooms[s.OrderID] {
+		if c == from {
+			continue
+		}
+		select {
+		case c.ch <- s:
+		default:
+			lab.drop(c)
+			c.once.Do(func() {
+				c.dropped = true
+				close(c.ch)
+			})
+		}
+	}
+}
+
+// lab returns the hub of a laboratory, nil when nobody is connected
+func (h *Hubs) lab(laboratoryID string) *hub {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.labs[laboratoryID]
+}
+
+// drop removes a connection from its room. The caller must hold the lock of
+// the hub; Hubs locks come first when both are held.
+func (lab *hub) drop(c *Conn) {
+	room := lab.rooms[c.OrderID]
+	delete(room, c)
+	if len(room) == 0 {
+		delete(lab.rooms, c.OrderID)
+	}
+}
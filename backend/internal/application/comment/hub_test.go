@@ -0,0 +1,120 @@
+package comment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+func staffThread(laboratoryID, orderID, userID string) Thread {
+	return Thread{LaboratoryID: laboratoryID, OrderID: orderID, Author: comment.Author{ID: userID, Kind: comment.AuthorStaff}}
+}
+
+// pending returns the signals queued for a connection
+func pending(c *Conn) []Signal {
+	var signals []Signal
+	for {
+		select {
+		case s, ok := <-c.C():
+			if !ok {
+				return signals
+			}
+			signals = append(signals, s)
+		default:
+			return signals
+		}
+	}
+}
+
+func TestHubs_Handle_SendsToTheThreadOnly(t *testing.T) {
+	hubs := NewHubs(DefaultHubConfig())
+	same := hubs.Join(staffThread("lab-123", "order-1", "user-1"))
+	otherOrder := hubs.Join(staffThread("lab-123", "order-2", "user-2"))
+	otherLab := hubs.Join(staffThread("lab-456", "order-1", "user-3"))
+
+	c := comment.Comment{ID: "comment-1", LaboratoryID: "lab-123", OrderID: "order-1", Body: "Hello"}
+	_ = hubs.Handle(context.Background(), event.CommentCreated{Metadata: event.NewMetadata("lab-123"), Comment: c})
+	_ = hubs.Handle(context.Background(), event.CommentDeleted{Metadata: event.NewMetadata("lab-123"), Comment: c})
+
+	got := pending(same)
+	if len(got) != 2 || got[0].Type != SignalCommentCreated || got[0].Comment.ID != "comment-1" || got[1].Type != SignalCommentDeleted || got[1].CommentID != "comment-1" {
+		t.Errorf("signals of the thread = %+v, want the comment created then deleted", got)
+	}
+	if got := pending(otherOrder); len(got) != 0 {
+		t.Errorf("signals of another order = %+v, want none", got)
+	}
+	if got := pending(otherLab); len(got) != 0 {
+		t.Errorf("signals of another laboratory = %+v, want none", got)
+	}
+}
+
+func TestConn_TypingAndRead(t *testing.T) {
+	hubs := NewHubs(HubConfig{Buffer: 8, TypingInterval: time.Hour})
+	typist := hubs.Join(staffThread("lab-123", "order-1", "user-1"))
+	reader := hubs.Join(staffThread("lab-123", "order-1", "user-2"))
+
+	typist.Typing()
+	typist.Typing() // Within the typing interval
+	typist.Read("comment-1")
+
+	got := pending(reader)
+	if len(got) != 2 || got[0].Type != SignalTyping || got[0].Author.ID != "user-1" || got[1].Type != SignalRead || got[1].CommentID != "comment-1" {
+		t.Errorf("signals of the other participant = %+v, want one typing and one read", got)
+	}
+	if got := pending(typist); len(got) != 0 {
+		t.Errorf("signals of the sender = %+v, want none", got)
+	}
+}
+
+func TestHubs_DropsSlowConnections(t *testing.T) {
+	hubs := NewHubs(HubConfig{Buffer: 1, TypingInterval: time.Second})
+	slow := hubs.Join(staffThread("lab-123", "order-1", "user-1"))
+	fast := hubs.Join(staffThread("lab-123", "order-1", "user-2"))
+
+	for _, id := range []string{"comment-1", "comment-2"} {
+		c := comment.Comment{ID: id, LaboratoryID: "lab-123", OrderID: "order-1"}
+		_ = hubs.Handle(context.Background(), event.CommentCreated{Metadata: event.NewMetadata("lab-123"), Comment: c})
+		<-fast.C()
+	}
+
+	if got := pending(slow); len(got) != 1 {
+		t.Errorf("signals of the slow connection = %+v, want the one queued before it was dropped", got)
+	}
+	if _, ok := <-slow.C(); ok || !slow.Dropped() {
+		t.Error("slow connection not dropped")
+	}
+
+	// Leaving after being dropped is safe
+	slow.Leave()
+	fast.Leave()
+	if _, ok := <-fast.C(); ok || fast.Dropped() {
+		t.Error("Leave() did not close the connection normally")
+	}
+	if hubs.lab("lab-123") != nil {
+		t.Error("hub of the laboratory kept after everyone left")
+	}
+}
+
+func TestHubs_Close(t *testing.T) {
+	hubs := NewHubs(DefaultHubConfig())
+	conns := []*Conn{
+		hubs.Join(staffThread("lab-123", "order-1", "user-1")),
+		hubs.Join(staffThread("lab-456", "order-2", "user-2")),
+	}
+
+	hubs.Close()
+	conns = append(conns, hubs.Join(staffThread("lab-123", "order-1", "user-3"))) // After closing
+
+	for i, c := range conns {
+		if _, ok := <-c.C(); ok || !c.GoingAway() || c.Dropped() {
+			t.Errorf("connection %d not closed as going away", i)
+		}
+		c.Leave() // Leaving after the close is safe
+	}
+	if hubs.lab("lab-123") != nil || hubs.lab("lab-456") != nil {
+		t.Error("hubs of the laboratories kept after closing")
+	}
+}
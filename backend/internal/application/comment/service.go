@@ -0,0 +1,236 @@
+// Package comment provides the use cases of order comment threads, shared by
+// the laboratory staff and the client who placed the order
+package comment
+
+import (
+	"context"
+	"log/slog"
+
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
+)
+
+// Service provides order comment use cases
+type Service struct {
+	commentRepo outbound.CommentRepository
+	orderRepo   outbound.OrderRepository
+	clientRepo  outbound.ClientRepository
+	idGen       IDGenerator
+	auditor     auditapp.Recorder
+	events      outbound.EventPublisher
+	tx          outbound.Transactor
+}
+
+// IDGenerator generates unique IDs
+type IDGenerator interface {
+	Generate() string
+}
+
+// NewService creates a new comment service
+func NewService(commentRepo outbound.CommentRepository, orderRepo outbound.OrderRepository, clientRepo outbound.ClientRepository, idGen IDGenerator, auditor auditapp.Recorder, events outbound.EventPublisher, tx outbound.Transactor) *Service {
+	return &Service{
+		commentRepo: commentRepo,
+		orderRepo:   orderRepo,
+		clientRepo:  clientRepo,
+		idGen:       idGen,
+		auditor:     auditor,
+		events:      events,
+		tx:          tx,
+	}
+}
+
+// Thread is the comment thread of an order as seen by one participant. It is
+// only obtained through StaffThread or ClientThread, which check access.
+type Thread struct {
+	LaboratoryID string
+	OrderID      string
+	Author       comment.Author
+}
+
+// StaffThread opens the thread of an order of the laboratory for a staff user
+func (s *Service) StaffThread(ctx context.Context, laboratoryID, orderID, userID string) (_ Thread, err error) {
+	ctx, span := tracing.Start(ctx, "comment.StaffThread", tracing.LaboratoryID(laboratoryID), tracing.EntityID("order", orderID))
+	defer tracing.End(span, &err)
+
+	o, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return Thread{}, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "comment: failed to load an order", "order_id", orderID, "error", err)
+		return Thread{}, errors.ErrInternal
+	}
+
+	// Check laboratory scope
+	if o.LaboratoryID != laboratoryID {
+		return Thread{}, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	return Thread{
+		LaboratoryID: o.LaboratoryID,
+		OrderID:      o.ID,
+		Author:       comment.Author{ID: userID, Kind: comment.AuthorStaff},
+	}, nil
+}
+
+// ClientThread opens the thread of an order for the client bound to the
+// portal identity, who must have placed the order
+func (s *Service) ClientThread(ctx context.Context, userID, orderID string) (_ Thread, err error) {
+	ctx, span := tracing.Start(ctx, "comment.ClientThread", tracing.EntityID("order", orderID), tracing.UserIDKey.String(userID))
+	defer tracing.End(span, &err)
+
+	if userID == "" {
+		return Thread{}, errors.ErrUnauthorized
+	}
+
+	c, err := s.clientRepo.GetByPortalUserID(ctx, userID)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return Thread{}, errors.ErrForbidden // Identity is not bound to any client
+		}
+		slog.ErrorContext(ctx, "comment: failed to look up the client of a portal user", "portal_user_id", userID, "error", err)
+		return Thread{}, errors.ErrInternal
+	}
+
+	o, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return Thread{}, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "comment: failed to load an order", "order_id", orderID, "error", err)
+		return Thread{}, errors.ErrInternal
+	}
+
+	// Check client scope
+	if o.ClientID != c.ID || o.LaboratoryID != c.LaboratoryID {
+		return Thread{}, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	return Thread{
+		LaboratoryID: o.LaboratoryID,
+		OrderID:      o.ID,
+		Author:       comment.Author{ID: c.ID, Kind: comment.AuthorClient},
+	}, nil
+}
+
+// ListComments retrieves the comments of a thread, oldest first
+func (s *Service) ListComments(ctx context.Context, t Thread) (_ []*comment.Comment, err error) {
+	ctx, span := tracing.Start(ctx, "comment.ListComments", tracing.LaboratoryID(t.LaboratoryID), tracing.EntityID("order", t.OrderID))
+	defer tracing.End(span, &err)
+
+	comments, err := s.commentRepo.ListByOrder(ctx, t.OrderID)
+	if err != nil {
+		slog.ErrorContext(ctx, "comment: failed to list comments", "error", err)
+		return nil, errors.ErrInternal
+	}
+	return comments, nil
+}
+
+// GetComment retrieves a comment of a thread
+func (s *Service) GetComment(ctx context.Context, t Thread, id string) (_ *comment.Comment, err error) {
+	ctx, span := tracing.Start(ctx, "comment.GetComment", tracing.LaboratoryID(t.LaboratoryID), tracing.EntityID("order", t.OrderID), tracing.EntityID("comment", id))
+	defer tracing.End(span, &err)
+
+	c, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "comment: failed to load a comment", "comment_id", id, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	// Check thread scope
+	if c.OrderID != t.OrderID || c.LaboratoryID != t.LaboratoryID {
+		return nil, errors.ErrNotFound // Security: don't reveal existence
+	}
+	return c, nil
+}
+
+// CreateComment adds a comment of the participant to a thread
+func (s *Service) CreateComment(ctx context.Context, t Thread, body string) (_ *comment.Comment, err error) {
+	ctx, span := tracing.Start(ctx, "comment.CreateComment", tracing.LaboratoryID(t.LaboratoryID), tracing.EntityID("order", t.OrderID))
+	defer tracing.End(span, &err)
+
+	c, err := comment.NewComment(s.idGen.Generate(), t.LaboratoryID, t.OrderID, t.Author, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist
+	e := event.CommentCreated{Metadata: event.NewMetadata(c.LaboratoryID), Comment: *c}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.commentRepo.Create(ctx, c)
+	}); err != nil {
+		slog.ErrorContext(ctx, "comment: failed to store a change", "event", e.Name(), "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: c.LaboratoryID,
+		EntityType:   audit.EntityComment,
+		EntityID:     c.ID,
+		Action:       audit.ActionCreate,
+		After:        c,
+	})
+
+	return c, nil
+}
+
+// DeleteComment performs a soft delete on a comment of a thread. Participants
+// may only delete their own comments.
+func (s *Service) DeleteComment(ctx context.Context, t Thread, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "comment.DeleteComment", tracing.LaboratoryID(t.LaboratoryID), tracing.EntityID("order", t.OrderID), tracing.EntityID("comment", id))
+	defer tracing.End(span, &err)
+
+	c, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "comment: failed to load a comment", "comment_id", id, "error", err)
+		return errors.ErrInternal
+	}
+
+	// Check thread scope
+	if c.OrderID != t.OrderID || c.LaboratoryID != t.LaboratoryID {
+		return errors.ErrNotFound // Security: don't reveal existence
+	}
+	if !c.WrittenBy(t.Author) {
+		return errors.ErrForbidden
+	}
+
+	// Delete
+	e := event.CommentDeleted{Metadata: event.NewMetadata(c.LaboratoryID), Comment: *c}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.commentRepo.Delete(ctx, id)
+	}); err != nil {
+		slog.ErrorContext(ctx, "comment: failed to store a change", "event", e.Name(), "error", err)
+		return errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: c.LaboratoryID,
+		EntityType:   audit.EntityComment,
+		EntityID:     c.ID,
+		Action:       audit.ActionDelete,
+		Before:       c,
+	})
+
+	return nil
+}
+
+// persist runs a write and publishes its event in a single transaction
+func (s *Service) persist(ctx context.Context, e event.Event, write func(ctx context.Context) error) error {
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, e)
+	})
+}
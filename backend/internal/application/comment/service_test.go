@@ -0,0 +1,175 @@
+package comment
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// sequenceIDGenerator is a mock ID generator returning distinct IDs
+type sequenceIDGenerator struct {
+	n int
+}
+
+func (g *sequenceIDGenerator) Generate() string {
+	g.n++
+	return "comment-" + strconv.Itoa(g.n)
+}
+
+// recordedEvents collects the events delivered by the bus
+type recordedEvents struct {
+	events []event.Event
+}
+
+func (r *recordedEvents) Handle(ctx context.Context, e event.Event) error {
+	r.events = append(r.events, e)
+	return nil
+}
+
+func setupCommentService(t *testing.T) (*Service, *recordedEvents) {
+	t.Helper()
+	ctx := context.Background()
+
+	clientRepo := memory.NewClientRepository()
+	orderRepo := memory.NewOrderRepository()
+	for _, c := range []*client.Client{
+		{ID: "client-1", LaboratoryID: "lab-123", Name: "Dr. One", PortalUserID: "user_one"},
+		{ID: "client-2", LaboratoryID: "lab-123", Name: "Dr. Two", PortalUserID: "user_two"},
+	} {
+		c.CreatedAt = time.Now().UTC()
+		c.UpdatedAt = c.CreatedAt
+		_ = clientRepo.Create(ctx, c)
+	}
+	for _, o := range []*order.Order{
+		{ID: "order-1", ClientID: "client-1", LaboratoryID: "lab-123", Status: order.StatusReceived},
+		{ID: "order-2", ClientID: "client-2", LaboratoryID: "lab-123", Status: order.StatusReceived},
+	} {
+		_ = orderRepo.Create(ctx, o)
+	}
+
+	bus := eventbus.NewSyncBus()
+	recorded := &recordedEvents{}
+	bus.SubscribeAll("recorder", recorded.Handle)
+
+	svc := NewService(memory.NewCommentRepository(), orderRepo, clientRepo, &sequenceIDGenerator{}, auditapp.NopRecorder{}, bus, memory.NewTransactor())
+	return svc, recorded
+}
+
+func TestService_StaffThread(t *testing.T) {
+	svc, _ := setupCommentService(t)
+
+	tests := []struct {
+		name         string
+		laboratoryID string
+		orderID      string
+		wantErr      error
+	}{
+		{"order of the laboratory", "lab-123", "order-1", nil},
+		{"order of another laboratory", "lab-456", "order-1", errors.ErrNotFound},
+		{"unknown order", "lab-123", "order-999", errors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th, err := svc.StaffThread(context.Background(), tt.laboratoryID, tt.orderID, "user_staff")
+			if err != tt.wantErr {
+				t.Fatalf("StaffThread() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && th.Author != (comment.Author{ID: "user_staff", Kind: comment.AuthorStaff}) {
+				t.Errorf("StaffThread() author = %+v, want the staff user", th.Author)
+			}
+		})
+	}
+}
+
+func TestService_ClientThread(t *testing.T) {
+	svc, _ := setupCommentService(t)
+
+	tests := []struct {
+		name    string
+		userID  string
+		orderID string
+		wantErr error
+	}{
+		{"own order", "user_one", "order-1", nil},
+		{"order of another client", "user_one", "order-2", errors.ErrNotFound},
+		{"identity without client", "user_stranger", "order-1", errors.ErrForbidden},
+		{"missing identity", "", "order-1", errors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th, err := svc.ClientThread(context.Background(), tt.userID, tt.orderID)
+			if err != tt.wantErr {
+				t.Fatalf("ClientThread() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && th.Author != (comment.Author{ID: "client-1", Kind: comment.AuthorClient}) {
+				t.Errorf("ClientThread() author = %+v, want the client", th.Author)
+			}
+		})
+	}
+}
+
+func TestService_CreateAndDeleteComment(t *testing.T) {
+	svc, recorded := setupCommentService(t)
+	ctx := context.Background()
+
+	staff, _ := svc.StaffThread(ctx, "lab-123", "order-1", "user_staff")
+	dentist, _ := svc.ClientThread(ctx, "user_one", "order-1")
+
+	if _, err := svc.CreateComment(ctx, staff, "  "); !stderrors.Is(err, errors.ErrInvalidInput) {
+		t.Fatalf("CreateComment() with a blank body error = %v, want a validation error", err)
+	}
+
+	question, err := svc.CreateComment(ctx, staff, "Shade A2 or A3?")
+	if err != nil {
+		t.Fatalf("CreateComment() unexpected error = %v", err)
+	}
+	if _, err := svc.CreateComment(ctx, dentist, "A2, thanks"); err != nil {
+		t.Fatalf("CreateComment() unexpected error = %v", err)
+	}
+
+	comments, _ := svc.ListComments(ctx, dentist)
+	if len(comments) != 2 || comments[0].ID != question.ID {
+		t.Fatalf("ListComments() = %v, want both comments oldest first", comments)
+	}
+	if len(recorded.events) != 2 || recorded.events[0].Name() != event.NameCommentCreated {
+		t.Fatalf("published events = %v, want two comment.created", recorded.events)
+	}
+
+	if got, err := svc.GetComment(ctx, dentist, question.ID); err != nil || got.ID != question.ID {
+		t.Errorf("GetComment() = %v, %v, want the comment of the thread", got, err)
+	}
+
+	if err := svc.DeleteComment(ctx, dentist, question.ID); err != errors.ErrForbidden {
+		t.Errorf("DeleteComment() of another participant's comment error = %v, want %v", err, errors.ErrForbidden)
+	}
+	other, _ := svc.StaffThread(ctx, "lab-123", "order-2", "user_staff")
+	if _, err := svc.GetComment(ctx, other, question.ID); err != errors.ErrNotFound {
+		t.Errorf("GetComment() from another thread error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if err := svc.DeleteComment(ctx, other, question.ID); err != errors.ErrNotFound {
+		t.Errorf("DeleteComment() from another thread error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if err := svc.DeleteComment(ctx, staff, question.ID); err != nil {
+		t.Fatalf("DeleteComment() unexpected error = %v", err)
+	}
+
+	if comments, _ := svc.ListComments(ctx, staff); len(comments) != 1 {
+		t.Errorf("ListComments() after delete = %v, want one comment", comments)
+	}
+	if last := recorded.events[len(recorded.events)-1]; last.Name() != event.NameCommentDeleted {
+		t.Errorf("last published event = %v, want comment.deleted", last.Name())
+	}
+}
@@ -0,0 +1,200 @@
+// Package expand resolves the related resources embedded in order and client
+// responses on request (the expand query parameter)
+package expand
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
+)
+
+// Field is a related resource that can be embedded in a response
+type Field string
+
+const (
+	FieldClient      Field = "client"
+	FieldLaboratory  Field = "laboratory"
+	FieldTechnician  Field = "technician"
+	FieldCatalogItem Field = "catalog_item" // The catalog prosthesis of each order item
+)
+
+// OrderFields returns the fields that can be expanded on orders
+func OrderFields() []Field {
+	return []Field{FieldClient, FieldLaboratory, FieldTechnician, FieldCatalogItem}
+}
+
+// ClientFields returns the fields that can be expanded on clients
+func ClientFields() []Field {
+	return []Field{FieldLaboratory}
+}
+
+// Set is the set of fields to expand
+type Set map[Field]bool
+
+// Parse parses a comma-separated list of fields, each of which must be allowed
+func Parse(raw string, allowed []Field) (Set, error) {
+	set := Set{}
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !contains(allowed, Field(name)) {
+			return nil, errors.Validation(errors.InvalidChoice("expand", allowed))
+		}
+		set[Field(name)] = true
+	}
+	return set, nil
+}
+
+// Related holds the resources related to a batch of orders or clients of a
+// laboratory. Resources that were not requested, or no longer exist, are absent.
+type Related struct {
+	Laboratory   *laboratory.Laboratory
+	Clients      map[string]*client.Client
+	Technicians  map[string]*technician.Technician
+	CatalogItems map[string]*prosthesis.Prosthesis
+}
+
+// Service resolves related resources with one batched lookup per kind of resource
+type Service struct {
+	labRepo    outbound.LaboratoryRepository
+	clientRepo outbound.ClientRepository
+	techRepo   outbound.TechnicianRepository
+	catalog    outbound.ProsthesisRepository
+}
+
+// NewService creates a new expansion service
+func NewService(labRepo outbound.LaboratoryRepository, clientRepo outbound.ClientRepository, techRepo outbound.TechnicianRepository, catalog outbound.ProsthesisRepository) *Service {
+	return &Service{
+		labRepo:    labRepo,
+		clientRepo: clientRepo,
+		techRepo:   techRepo,
+		catalog:    catalog,
+	}
+}
+
+// Orders resolves the requested resources related to orders of a laboratory
+func (s *Service) Orders(ctx context.Context, laboratoryID string, orders []*order.Order, fields Set) (_ Related, err error) {
+	ctx, span := tracing.Start(ctx, "expand.Orders", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	var related Related
+
+	if fields[FieldLaboratory] {
+		if related.Laboratory, err = s.laboratory(ctx, laboratoryID); err != nil {
+			return Related{}, err
+		}
+	}
+
+	if fields[FieldClient] {
+		ids := uniqueIDs(orders, func(o *order.Order) []string { return []string{o.ClientID} })
+		found, err := s.clientRepo.GetByIDs(ctx, ids)
+		if err != nil {
+			slog.ErrorContext(ctx, "expand: failed to load clients", "ids", ids, "error", err)
+			return Related{}, errors.ErrInternal
+		}
+		related.Clients = byID(found, laboratoryID, func(c *client.Client) (string, string) { return c.ID, c.LaboratoryID })
+	}
+
+	if fields[FieldTechnician] {
+		ids := uniqueIDs(orders, func(o *order.Order) []string { return []string{o.TechnicianID} })
+		found, err := s.techRepo.GetByIDs(ctx, ids)
+		if err != nil {
+			slog.ErrorContext(ctx, "expand: failed to load technicians", "ids", ids, "error", err)
+			return Related{}, errors.ErrInternal
+		}
+		related.Technicians = byID(found, laboratoryID, func(t *technician.Technician) (string, string) { return t.ID, t.LaboratoryID })
+	}
+
+	if fields[FieldCatalogItem] {
+		ids := uniqueIDs(orders, func(o *order.Order) []string {
+			ids := make([]string, len(o.Prosthesis))
+			for i, item := range o.Prosthesis {
+				ids[i] = item.CatalogItemID
+			}
+			return ids
+		})
+		found, err := s.catalog.GetByIDs(ctx, ids)
+		if err != nil {
+			slog.ErrorContext(ctx, "expand: failed to load prostheses", "ids", ids, "error", err)
+			return Related{}, errors.ErrInternal
+		}
+		related.CatalogItems = byID(found, laboratoryID, func(p *prosthesis.Prosthesis) (string, string) { return p.ID, p.LaboratoryID })
+	}
+
+	return related, nil
+}
+
+// Clients resolves the requested resources related to clients of a laboratory
+func (s *Service) Clients(ctx context.Context, laboratoryID string, fields Set) (_ Related, err error) {
+	ctx, span := tracing.Start(ctx, "expand.Clients", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	var related Related
+	if fields[FieldLaboratory] {
+		lab, err := s.laboratory(ctx, laboratoryID)
+		if err != nil {
+			return Related{}, err
+		}
+		related.Laboratory = lab
+	}
+	return related, nil
+}
+
+// laboratory retrieves the laboratory the request is scoped to
+func (s *Service) laboratory(ctx context.Context, laboratoryID string) (*laboratory.Laboratory, error) {
+	lab, err := s.labRepo.GetByID(ctx, laboratoryID)
+	if err == errors.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "expand: failed to load a laboratory", "laboratory_id", laboratoryID, "error", err)
+		return nil, errors.ErrInternal
+	}
+	return lab, nil
+}
+
+// uniqueIDs collects the distinct non-empty IDs referenced by orders
+func uniqueIDs(orders []*order.Order, refs func(*order.Order) []string) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, o := range orders {
+		for _, id := range refs(o) {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// byID indexes resources by ID, keeping only those of the laboratory
+func byID[T any](items []T, laboratoryID string, key func(T) (id, laboratoryID string)) map[string]T {
+	m := make(map[string]T, len(items))
+	for _, item := range items {
+		if id, lab := key(item); lab == laboratoryID {
+			m[id] = item
+		}
+	}
+	return m
+}
+
+func contains(fields []Field, field Field) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package expand
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Set
+		wantErr error
+	}{
+		{"empty", "", Set{}, nil},
+		{"single field", "client", Set{FieldClient: true}, nil},
+		{"several fields with spaces", "client, technician,,catalog_item", Set{FieldClient: true, FieldTechnician: true, FieldCatalogItem: true}, nil},
+		{"unknown field", "client,patient", nil, errors.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw, OrderFields())
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Parse("client", ClientFields()); !stderrors.Is(err, errors.ErrInvalidInput) {
+		t.Errorf("Parse(client) on clients error = %v, want %v", err, errors.ErrInvalidInput)
+	}
+}
+
+// countingClientRepository counts client lookups
+type countingClientRepository struct {
+	*memory.ClientRepository
+	lookups int
+}
+
+func (r *countingClientRepository) GetByID(ctx context.Context, id string) (*client.Client, error) {
+	r.lookups++
+	return r.ClientRepository.GetByID(ctx, id)
+}
+
+func (r *countingClientRepository) GetByIDs(ctx context.Context, ids []string) ([]*client.Client, error) {
+	r.lookups++
+	return r.ClientRepository.GetByIDs(ctx, ids)
+}
+
+func TestService_Orders(t *testing.T) {
+	ctx := context.Background()
+	labRepo := memory.NewLaboratoryRepository()
+	clientRepo := &countingClientRepository{ClientRepository: memory.NewClientRepository()}
+	techRepo := memory.NewTechnicianRepository()
+	catalog := memory.NewProsthesisRepository()
+
+	_ = labRepo.Create(ctx, &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"})
+	_ = clientRepo.Create(ctx, &client.Client{ID: "client-1", LaboratoryID: "lab-123"})
+	_ = clientRepo.Create(ctx, &client.Client{ID: "client-2", LaboratoryID: "lab-123"})
+	_ = techRepo.Create(ctx, &technician.Technician{ID: "tech-1", LaboratoryID: "lab-123"})
+	_ = catalog.Create(ctx, &prosthesis.Prosthesis{ID: "crown-1", LaboratoryID: "lab-123", Type: prosthesis.ProsthesisTypeCrown})
+	_ = catalog.Create(ctx, &prosthesis.Prosthesis{ID: "crown-2", LaboratoryID: "lab-456", Type: prosthesis.ProsthesisTypeCrown})
+
+	orders := []*order.Order{
+		{ID: "order-1", ClientID: "client-1", TechnicianID: "tech-1", Prosthesis: []order.ProsthesisItem{{CatalogItemID: "crown-1"}}},
+		{ID: "order-2", ClientID: "client-1", Prosthesis: []order.ProsthesisItem{{CatalogItemID: "crown-2"}, {}}},
+		{ID: "order-3", ClientID: "client-2", TechnicianID: "deleted-tech"},
+	}
+
+	svc := NewService(labRepo, clientRepo, techRepo, catalog)
+	related, err := svc.Orders(ctx, "lab-123", orders, Set{FieldClient: true, FieldLaboratory: true, FieldTechnician: true, FieldCatalogItem: true})
+	if err != nil {
+		t.Fatalf("Orders() unexpected error = %v", err)
+	}
+
+	if clientRepo.lookups != 1 {
+		t.Errorf("Orders() made %d client lookups, want 1", clientRepo.lookups)
+	}
+	if related.Laboratory == nil || related.Laboratory.ID != "lab-123" {
+		t.Errorf("Orders() Laboratory = %v, want lab-123", related.Laboratory)
+	}
+	if len(related.Clients) != 2 {
+		t.Errorf("Orders() got %d clients, want 2", len(related.Clients))
+	}
+	if _, ok := related.Technicians["tech-1"]; !ok || len(related.Technicians) != 1 {
+		t.Errorf("Orders() Technicians = %v, want only tech-1", related.Technicians)
+	}
+	if _, ok := related.CatalogItems["crown-1"]; !ok || len(related.CatalogItems) != 1 {
+		t.Errorf("Orders() CatalogItems = %v, want only crown-1 (crown-2 belongs to another laboratory)", related.CatalogItems)
+	}
+}
+
+func TestService_Orders_OnlyRequestedFields(t *testing.T) {
+	clientRepo := &countingClientRepository{ClientRepository: memory.NewClientRepository()}
+	svc := NewService(memory.NewLaboratoryRepository(), clientRepo, memory.NewTechnicianRepository(), memory.NewProsthesisRepository())
+
+	related, err := svc.Orders(context.Background(), "lab-123", []*order.Order{{ID: "order-1", ClientID: "client-1"}}, Set{FieldTechnician: true})
+	if err != nil {
+		t.Fatalf("Orders() unexpected error = %v", err)
+	}
+	if clientRepo.lookups != 0 || related.Clients != nil || related.Laboratory != nil {
+		t.Errorf("Orders() resolved fields that were not requested: %+v", related)
+	}
+}
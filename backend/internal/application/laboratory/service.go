@@ -2,16 +2,25 @@ package laboratory
 
 import (
 	"context"
+	"log/slog"
 
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
 )
 
 // Service provides laboratory use cases
 type Service struct {
-	repo      outbound.LaboratoryRepository
-	idGen     IDGenerator
+	repo    outbound.LaboratoryRepository
+	idGen   IDGenerator
+	auditor auditapp.Recorder
+	events  outbound.EventPublisher
+	tx      outbound.Transactor
 }
 
 // IDGenerator generates unique IDs
@@ -20,26 +29,34 @@ type IDGenerator interface {
 }
 
 // NewService creates a new laboratory service
-func NewService(repo outbound.LaboratoryRepository, idGen IDGenerator) *Service {
+func NewService(repo outbound.LaboratoryRepository, idGen IDGenerator, auditor auditapp.Recorder, events outbound.EventPublisher, tx outbound.Transactor) *Service {
 	return &Service{
-		repo:  repo,
-		idGen: idGen,
+		repo:    repo,
+		idGen:   idGen,
+		auditor: auditor,
+		events:  events,
+		tx:      tx,
 	}
 }
 
 // CreateInput represents the input for creating a laboratory
 type CreateInput struct {
-	Name    string
-	Email   string
-	Phone   string
-	Address laboratory.Address
+	Name     string
+	Email    string
+	Phone    string
+	Address  laboratory.Address
+	Language string // Empty uses the default language
 }
 
 // CreateLaboratory creates a new laboratory
-func (s *Service) CreateLaboratory(ctx context.Context, input CreateInput) (*laboratory.Laboratory, error) {
+func (s *Service) CreateLaboratory(ctx context.Context, input CreateInput) (_ *laboratory.Laboratory, err error) {
+	ctx, span := tracing.Start(ctx, "laboratory.CreateLaboratory")
+	defer tracing.End(span, &err)
+
 	// Check if email already exists
 	existing, err := s.repo.GetByEmail(ctx, input.Email)
 	if err != nil && err != errors.ErrNotFound {
+		slog.ErrorContext(ctx, "laboratory: failed to look up a laboratory by email", "error", err)
 		return nil, errors.ErrInternal
 	}
 	if existing != nil {
@@ -52,22 +69,43 @@ func (s *Service) CreateLaboratory(ctx context.Context, input CreateInput) (*lab
 	if err != nil {
 		return nil, err
 	}
+	if input.Language != "" {
+		if err := lab.SetLanguage(input.Language); err != nil {
+			return nil, err
+		}
+	}
 
 	// Persist
-	if err := s.repo.Create(ctx, lab); err != nil {
+	e := event.LaboratoryCreated{Metadata: event.NewMetadata(lab.ID), Laboratory: *lab}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.repo.Create(ctx, lab)
+	}); err != nil {
+		slog.ErrorContext(ctx, "laboratory: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: lab.ID,
+		EntityType:   audit.EntityLaboratory,
+		EntityID:     lab.ID,
+		Action:       audit.ActionCreate,
+		After:        lab,
+	})
+
 	return lab, nil
 }
 
 // GetLaboratory retrieves a laboratory by ID
-func (s *Service) GetLaboratory(ctx context.Context, id string) (*laboratory.Laboratory, error) {
+func (s *Service) GetLaboratory(ctx context.Context, id string) (_ *laboratory.Laboratory, err error) {
+	ctx, span := tracing.Start(ctx, "laboratory.GetLaboratory", tracing.LaboratoryID(id))
+	defer tracing.End(span, &err)
+
 	lab, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "laboratory: failed to load a laboratory", "laboratory_id", id, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -76,28 +114,58 @@ func (s *Service) GetLaboratory(ctx context.Context, id string) (*laboratory.Lab
 
 // UpdateInput represents the input for updating a laboratory
 type UpdateInput struct {
-	ID      string
-	Name    string
-	Email   string
-	Phone   string
-	Address laboratory.Address
+	ID       string
+	Name     string
+	Email    string
+	Phone    string
+	Address  laboratory.Address
+	Language string // Empty keeps the current language
 }
 
 // UpdateLaboratory updates an existing laboratory
-func (s *Service) UpdateLaboratory(ctx context.Context, input UpdateInput) (*laboratory.Laboratory, error) {
+func (s *Service) UpdateLaboratory(ctx context.Context, input UpdateInput) (_ *laboratory.Laboratory, err error) {
+	ctx, span := tracing.Start(ctx, "laboratory.UpdateLaboratory", tracing.LaboratoryID(input.ID))
+	defer tracing.End(span, &err)
+
 	// Get existing laboratory
 	lab, err := s.repo.GetByID(ctx, input.ID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "laboratory: failed to load a laboratory", "laboratory_id", input.ID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	return s.update(ctx, lab, input)
+}
+
+// PatchLaboratory updates a laboratory with the input patch derives from its current
+// state, e.g. by applying a JSON merge patch
+func (s *Service) PatchLaboratory(ctx context.Context, id string, patch func(current laboratory.Laboratory) (UpdateInput, error)) (_ *laboratory.Laboratory, err error) {
+	ctx, span := tracing.Start(ctx, "laboratory.PatchLaboratory", tracing.LaboratoryID(id))
+	defer tracing.End(span, &err)
+
+	lab, err := s.GetLaboratory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := patch(*lab)
+	if err != nil {
+		return nil, err
+	}
+	input.ID = lab.ID
+	return s.update(ctx, lab, input)
+}
+
+// update applies the input to a laboratory loaded for the update and stores it
+func (s *Service) update(ctx context.Context, lab *laboratory.Laboratory, input UpdateInput) (*laboratory.Laboratory, error) {
 	// Check if email changed and already exists
 	if lab.Email != input.Email {
 		existing, err := s.repo.GetByEmail(ctx, input.Email)
 		if err != nil && err != errors.ErrNotFound {
+			slog.ErrorContext(ctx, "laboratory: failed to look up a laboratory by email", "error", err)
 			return nil, errors.ErrInternal
 		}
 		if existing != nil && existing.ID != lab.ID {
@@ -105,45 +173,99 @@ func (s *Service) UpdateLaboratory(ctx context.Context, input UpdateInput) (*lab
 		}
 	}
 
+	before := *lab
+
 	// Update laboratory
 	if err := lab.Update(input.Name, input.Email, input.Phone, input.Address); err != nil {
 		return nil, err
 	}
+	if input.Language != "" {
+		if err := lab.SetLanguage(input.Language); err != nil {
+			return nil, err
+		}
+	}
 
 	// Persist
-	if err := s.repo.Update(ctx, lab); err != nil {
+	e := event.LaboratoryUpdated{Metadata: event.NewMetadata(lab.ID), Laboratory: *lab}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.repo.Update(ctx, lab)
+	}); err != nil {
+		slog.ErrorContext(ctx, "laboratory: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: lab.ID,
+		EntityType:   audit.EntityLaboratory,
+		EntityID:     lab.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        lab,
+	})
+
 	return lab, nil
 }
 
-// ListLaboratories retrieves all active laboratories
-func (s *Service) ListLaboratories(ctx context.Context) ([]*laboratory.Laboratory, error) {
-	labs, err := s.repo.List(ctx)
+// ListLaboratories retrieves a page of active laboratories
+func (s *Service) ListLaboratories(ctx context.Context, q listing.Query) (_ listing.Page[*laboratory.Laboratory], err error) {
+	ctx, span := tracing.Start(ctx, "laboratory.ListLaboratories")
+	defer tracing.End(span, &err)
+
+	q, err = q.Normalize(laboratory.ListSpec)
 	if err != nil {
-		return nil, errors.ErrInternal
+		return listing.Page[*laboratory.Laboratory]{}, err
 	}
 
-	return labs, nil
+	page, err := s.repo.List(ctx, q)
+	if err != nil {
+		slog.ErrorContext(ctx, "laboratory: failed to list laboratories", "error", err)
+		return listing.Page[*laboratory.Laboratory]{}, errors.ErrInternal
+	}
+
+	return page, nil
 }
 
 // DeleteLaboratory performs a soft delete on a laboratory
-func (s *Service) DeleteLaboratory(ctx context.Context, id string) error {
+func (s *Service) DeleteLaboratory(ctx context.Context, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "laboratory.DeleteLaboratory", tracing.LaboratoryID(id))
+	defer tracing.End(span, &err)
+
 	// Check if laboratory exists
-	_, err := s.repo.GetByID(ctx, id)
+	lab, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "laboratory: failed to load a laboratory", "laboratory_id", id, "error", err)
 		return errors.ErrInternal
 	}
 
 	// Delete
-	if err := s.repo.Delete(ctx, id); err != nil {
+	e := event.LaboratoryDeleted{Metadata: event.NewMetadata(lab.ID), Laboratory: *lab}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.repo.Delete(ctx, id)
+	}); err != nil {
+		slog.ErrorContext(ctx, "laboratory: failed to store a change", "event", e.Name(), "error", err)
 		return errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: lab.ID,
+		EntityType:   audit.EntityLaboratory,
+		EntityID:     lab.ID,
+		Action:       audit.ActionDelete,
+		Before:       lab,
+	})
+
 	return nil
 }
 
+// persist runs a write and publishes its event in a single transaction
+func (s *Service) persist(ctx context.Context, e event.Event, write func(ctx context.Context) error) error {
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, e)
+	})
+}
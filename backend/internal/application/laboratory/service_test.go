@@ -5,8 +5,11 @@ import (
 	stderrors "errors"
 	"testing"
 
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 // mockIDGenerator is a mock ID generator for testing
@@ -18,6 +21,23 @@ func (m *mockIDGenerator) Generate() string {
 	return m.id
 }
 
+// mockEventPublisher is a mock event publisher for testing that records the published events
+type mockEventPublisher struct {
+	events []event.Event
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// mockTransactor is a mock transactor for testing that runs units of work without a transaction
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // mockRepository is a mock repository for testing
 type mockRepository struct {
 	labs          map[string]*laboratory.Laboratory
@@ -89,9 +109,9 @@ func (m *mockRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (m *mockRepository) List(ctx context.Context) ([]*laboratory.Laboratory, error) {
+func (m *mockRepository) List(ctx context.Context, q listing.Query) (listing.Page[*laboratory.Laboratory], error) {
 	if m.listErr != nil {
-		return nil, m.listErr
+		return listing.Page[*laboratory.Laboratory]{}, m.listErr
 	}
 	var labs []*laboratory.Laboratory
 	for _, lab := range m.labs {
@@ -99,7 +119,7 @@ func (m *mockRepository) List(ctx context.Context) ([]*laboratory.Laboratory, er
 			labs = append(labs, lab)
 		}
 	}
-	return labs, nil
+	return listing.Page[*laboratory.Laboratory]{Items: labs, Total: len(labs)}, nil
 }
 
 func TestService_CreateLaboratory(t *testing.T) {
@@ -151,6 +171,25 @@ func TestService_CreateLaboratory(t *testing.T) {
 			},
 			wantErr: errors.ErrDuplicateEmail,
 		},
+		{
+			name: "unsupported language",
+			input: CreateInput{
+				Name:  "Test Lab",
+				Email: "test@lab.com",
+				Phone: "+5511999999999",
+				Address: laboratory.Address{
+					Street:     "Test Street",
+					City:       "Test City",
+					State:      "SP",
+					PostalCode: "01234-567",
+					Country:    "Brazil",
+				},
+				Language: "fr",
+			},
+			mockID:    "lab-123",
+			setupRepo: func(r *mockRepository) {},
+			wantErr:   errors.ErrInvalidInput,
+		},
 		{
 			name: "invalid input - empty name",
 			input: CreateInput{
@@ -176,7 +215,7 @@ func TestService_CreateLaboratory(t *testing.T) {
 			repo := newMockRepository()
 			tt.setupRepo(repo)
 			idGen := &mockIDGenerator{id: tt.mockID}
-			svc := NewService(repo, idGen)
+			svc := NewService(repo, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			lab, err := svc.CreateLaboratory(context.Background(), tt.input)
 
@@ -241,7 +280,7 @@ func TestService_GetLaboratory(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := newMockRepository()
 			tt.setupRepo(repo)
-			svc := NewService(repo, &mockIDGenerator{})
+			svc := NewService(repo, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			lab, err := svc.GetLaboratory(context.Background(), tt.id)
 
@@ -331,7 +370,7 @@ func TestService_UpdateLaboratory(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := newMockRepository()
 			tt.setupRepo(repo)
-			svc := NewService(repo, &mockIDGenerator{})
+			svc := NewService(repo, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			lab, err := svc.UpdateLaboratory(context.Background(), tt.input)
 
@@ -363,16 +402,16 @@ func TestService_ListLaboratories(t *testing.T) {
 	repo.labs["lab-1"] = &laboratory.Laboratory{ID: "lab-1", Name: "Lab 1"}
 	repo.labs["lab-2"] = &laboratory.Laboratory{ID: "lab-2", Name: "Lab 2"}
 
-	svc := NewService(repo, &mockIDGenerator{})
+	svc := NewService(repo, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
-	labs, err := svc.ListLaboratories(context.Background())
+	page, err := svc.ListLaboratories(context.Background(), listing.Query{})
 	if err != nil {
 		t.Errorf("ListLaboratories() unexpected error = %v", err)
 		return
 	}
 
-	if len(labs) != 2 {
-		t.Errorf("ListLaboratories() got %d labs, want 2", len(labs))
+	if len(page.Items) != 2 {
+		t.Errorf("ListLaboratories() got %d labs, want 2", len(page.Items))
 	}
 }
 
@@ -406,7 +445,7 @@ func TestService_DeleteLaboratory(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := newMockRepository()
 			tt.setupRepo(repo)
-			svc := NewService(repo, &mockIDGenerator{})
+			svc := NewService(repo, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			err := svc.DeleteLaboratory(context.Background(), tt.id)
 
@@ -0,0 +1,185 @@
+package notification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/mail"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+)
+
+// SenderConfig holds the platform sender of the notification emails
+type SenderConfig struct {
+	From           string   // Platform address, used unless the laboratory's own is allowed
+	AllowedDomains []string // Domains laboratories may send from, see notification.SenderAllowed
+}
+
+// Notifier emails clients when their order is ready or delivered and
+// technicians when they are assigned an order. Handle is subscribed to the
+// event bus and only queues the emails; the Worker sends them.
+type Notifier struct {
+	labRepo      outbound.LaboratoryRepository
+	clientRepo   outbound.ClientRepository
+	techRepo     outbound.TechnicianRepository
+	settingsRepo outbound.NotificationSettingsRepository
+	queue        outbound.EmailQueue
+	templates    *Templates
+	sender       SenderConfig
+	now          func() time.Time
+}
+
+// NewNotifier creates a new notifier
+func NewNotifier(labRepo outbound.LaboratoryRepository, clientRepo outbound.ClientRepository, techRepo outbound.TechnicianRepository, settingsRepo outbound.NotificationSettingsRepository, queue outbound.EmailQueue, templates *Templates, sender SenderConfig) *Notifier {
+	return &Notifier{
+		labRepo:      labRepo,
+		clientRepo:   clientRepo,
+		techRepo:     techRepo,
+		settingsRepo: settingsRepo,
+		queue:        queue,
+		templates:    templates,
+		sender:       sender,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Handle queues the emails of an event. An email whose recipient no longer
+// exists is dropped; other failures are returned for the event to be
+// delivered again, which queues no email twice.
+func (n *Notifier) Handle(ctx context.Context, e event.Event) error {
+	var err error
+	switch e := e.(type) {
+	case event.OrderStatusChanged:
+		switch e.To {
+		case order.StatusReady:
+			err = n.notifyClient(ctx, e.ID, notification.KindOrderReady, e.Order)
+		case order.StatusDelivered:
+			err = n.notifyClient(ctx, e.ID, notification.KindOrderDelivered, e.Order)
+		}
+	case event.OrderUpdated:
+		if e.TechnicianAssigned() {
+			err = n.notifyTechnician(ctx, e.ID, e.Order)
+		}
+	}
+	if err == errors.ErrNotFound {
+		slog.WarnContext(logging.WithLaboratoryID(ctx, e.Meta().LaboratoryID), "notification: recipient not found, email dropped", "event", e.Name())
+		return nil
+	}
+	return err
+}
+
+// notifyClient emails the client of an order unless they opted out
+func (n *Notifier) notifyClient(ctx context.Context, eventID string, kind notification.Kind, o order.Order) error {
+	c, err := n.clientRepo.GetByID(ctx, o.ClientID)
+	if err != nil {
+		return err
+	}
+	if c.EmailOptOut {
+		return nil
+	}
+
+	return n.send(ctx, eventID, kind, o, mail.Address{Name: c.Name, Address: c.Email}, Data{
+		RecipientName: c.Name,
+		ClientName:    c.Name,
+	})
+}
+
+// notifyTechnician emails the technician assigned an order
+func (n *Notifier) notifyTechnician(ctx context.Context, eventID string, o order.Order) error {
+	tech, err := n.techRepo.GetByID(ctx, o.TechnicianID)
+	if err != nil {
+		return err
+	}
+	c, err := n.clientRepo.GetByID(ctx, o.ClientID)
+	if err != nil {
+		return err
+	}
+
+	return n.send(ctx, eventID, notification.KindTechnicianAssigned, o, mail.Address{Name: tech.Name, Address: tech.Email}, Data{
+		RecipientName: tech.Name,
+		ClientName:    c.Name,
+	})
+}
+
+// send renders the email of an order in the language of its laboratory and
+// queues it
+func (n *Notifier) send(ctx context.Context, eventID string, kind notification.Kind, o order.Order, to mail.Address, data Data) error {
+	lab, err := n.labRepo.GetByID(ctx, o.LaboratoryID)
+	if err != nil {
+		return err
+	}
+	from, replyTo, err := n.addresses(ctx, lab)
+	if err != nil {
+		return err
+	}
+
+	data.Language = lab.Language
+	data.LaboratoryName = lab.Name
+	data.OrderID = o.ID
+	data.Items = o.Prosthesis
+	rendered, err := n.templates.Render(kind, data)
+	if err != nil {
+		return err
+	}
+
+	now := n.now()
+	return n.queue.Enqueue(ctx, &notification.Email{
+		ID:            emailID(eventID, kind, to),
+		LaboratoryID:  lab.ID,
+		Kind:          kind,
+		From:          from,
+		ReplyTo:       replyTo,
+		To:            to,
+		Subject:       rendered.Subject,
+		Text:          rendered.Text,
+		HTML:          rendered.HTML,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	})
+}
+
+// emailID identifies the email of a kind an event sends to a recipient, alike
+// every time the event is handled and without revealing the address
+func emailID(eventID string, kind notification.Kind, to mail.Address) string {
+	sum := sha256.Sum256([]byte(eventID + "\x00" + string(kind) + "\x00" + to.Address))
+	return hex.EncodeToString(sum[:16])
+}
+
+// addresses returns the sender and reply-to addresses of a laboratory, its
+// settings overriding the defaults. The laboratory's sender address is used
+// only at an allowed domain; otherwise the email is sent from the platform
+// address and the laboratory's address receives the replies.
+func (n *Notifier) addresses(ctx context.Context, lab *laboratory.Laboratory) (mail.Address, *mail.Address, error) {
+	from := mail.Address{Name: lab.Name, Address: n.sender.From}
+	replyTo := &mail.Address{Name: lab.Name, Address: lab.Email}
+
+	s, err := n.settingsRepo.Get(ctx, lab.ID)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return from, replyTo, nil
+		}
+		return mail.Address{}, nil, err
+	}
+	if s.SenderName != "" {
+		from.Name, replyTo.Name = s.SenderName, s.SenderName
+	}
+	if s.SenderEmail != "" {
+		if notification.SenderAllowed(s.SenderEmail, n.sender.AllowedDomains) {
+			from.Address = s.SenderEmail
+		} else {
+			replyTo.Address = s.SenderEmail
+		}
+	}
+	if s.ReplyTo != "" {
+		replyTo.Address = s.ReplyTo
+	}
+	return from, replyTo, nil
+}
@@ -0,0 +1,218 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// recordingMailer records the emails sent, failing when err is set
+type recordingMailer struct {
+	sent []outbound.Email
+	err  error
+}
+
+func (m *recordingMailer) Send(ctx context.Context, email outbound.Email) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, email)
+	return nil
+}
+
+type notifierTestSetup struct {
+	notifier   *Notifier
+	worker     *Worker
+	queue      *memory.EmailQueue
+	mailer     *recordingMailer
+	clientRepo *memory.ClientRepository
+	settings   *memory.NotificationSettingsRepository
+}
+
+func setupNotifier(t *testing.T) notifierTestSetup {
+	t.Helper()
+	ctx := context.Background()
+
+	labRepo := memory.NewLaboratoryRepository()
+	_ = labRepo.Create(ctx, &laboratory.Laboratory{ID: "lab-123", Name: "Smile Lab", Email: "desk@smilelab.com", Language: i18n.En})
+	clientRepo := memory.NewClientRepository()
+	_ = clientRepo.Create(ctx, &client.Client{ID: "client-1", LaboratoryID: "lab-123", Name: "Dr. Ana", Email: "ana@clinic.com"})
+	techRepo := memory.NewTechnicianRepository()
+	_ = techRepo.Create(ctx, &technician.Technician{ID: "tech-1", LaboratoryID: "lab-123", Name: "Bruno", Email: "bruno@smilelab.com"})
+	settings := memory.NewNotificationSettingsRepository()
+
+	templates, err := LoadTemplates()
+	if err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+	queue := memory.NewEmailQueue()
+	mailer := &recordingMailer{}
+	sender := SenderConfig{From: "notifications@example.com", AllowedDomains: []string{"smilelab.com"}}
+	return notifierTestSetup{
+		notifier:   NewNotifier(labRepo, clientRepo, techRepo, settings, queue, templates, sender),
+		worker:     NewWorker(queue, mailer, DefaultWorkerConfig()),
+		queue:      queue,
+		mailer:     mailer,
+		clientRepo: clientRepo,
+		settings:   settings,
+	}
+}
+
+func testOrder() order.Order {
+	return order.Order{
+		ID:           "order-123",
+		ClientID:     "client-1",
+		LaboratoryID: "lab-123",
+		Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+	}
+}
+
+func statusChanged(to order.Status) event.Event {
+	o := testOrder()
+	o.Status = to
+	return event.OrderStatusChanged{Metadata: event.NewMetadata("lab-123"), Order: o, To: to}
+}
+
+func TestNotifier_Handle(t *testing.T) {
+	assigned := testOrder()
+	assigned.TechnicianID = "tech-1"
+
+	tests := []struct {
+		name        string
+		event       event.Event
+		wantTo      string
+		wantSubject string
+	}{
+		{"order ready", statusChanged(order.StatusReady), "ana@clinic.com", "Order order-123 is ready"},
+		{"order delivered", statusChanged(order.StatusDelivered), "ana@clinic.com", "Order order-123 was delivered"},
+		{"other status", statusChanged(order.StatusInProduction), "", ""},
+		{"technician assigned", event.OrderUpdated{Metadata: event.NewMetadata("lab-123"), Order: assigned}, "bruno@smilelab.com", "You were assigned order order-123"},
+		{"technician kept", event.OrderUpdated{Metadata: event.NewMetadata("lab-123"), Order: assigned, PreviousTechnicianID: "tech-1"}, "", ""},
+		{"other event", event.OrderCreated{Metadata: event.NewMetadata("lab-123"), Order: testOrder()}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := setupNotifier(t)
+
+			if err := setup.notifier.Handle(context.Background(), tt.event); err != nil {
+				t.Fatalf("Handle() error = %v", err)
+			}
+			if _, err := setup.worker.SendDue(context.Background()); err != nil {
+				t.Fatalf("SendDue() error = %v", err)
+			}
+
+			if tt.wantTo == "" {
+				if len(setup.mailer.sent) != 0 {
+					t.Errorf("Handle() sent %+v, want no email", setup.mailer.sent)
+				}
+				return
+			}
+			if len(setup.mailer.sent) != 1 {
+				t.Fatalf("Handle() sent %d emails, want 1", len(setup.mailer.sent))
+			}
+			got := setup.mailer.sent[0]
+			if got.To.Address != tt.wantTo || got.Subject != tt.wantSubject {
+				t.Errorf("email to %s with subject %q, want %s and %q", got.To.Address, got.Subject, tt.wantTo, tt.wantSubject)
+			}
+			if got.From.String() != `"Smile Lab" <notifications@example.com>` || got.ReplyTo.Address != "desk@smilelab.com" {
+				t.Errorf("email from %s replying to %s, want the default sender of the laboratory", got.From.String(), got.ReplyTo)
+			}
+		})
+	}
+}
+
+// handleAndSend handles an event and sends the emails it queued
+func handleAndSend(t *testing.T, setup notifierTestSetup, e event.Event) {
+	t.Helper()
+	if err := setup.notifier.Handle(context.Background(), e); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if _, err := setup.worker.SendDue(context.Background()); err != nil {
+		t.Fatalf("SendDue() error = %v", err)
+	}
+}
+
+func TestNotifier_Handle_LaboratorySender(t *testing.T) {
+	tests := []struct {
+		name        string
+		senderEmail string
+		wantFrom    string
+		wantReplyTo string
+	}{
+		{"allowed domain", "orders@smilelab.com", "orders@smilelab.com", "desk@smilelab.com"},
+		{"other domain", "orders@bank.com", "notifications@example.com", "orders@bank.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := setupNotifier(t)
+			_ = setup.settings.Save(context.Background(), &notification.Settings{LaboratoryID: "lab-123", SenderName: "Smile Lab Orders", SenderEmail: tt.senderEmail})
+
+			handleAndSend(t, setup, statusChanged(order.StatusReady))
+
+			if len(setup.mailer.sent) != 1 {
+				t.Fatalf("Handle() sent %d emails, want 1", len(setup.mailer.sent))
+			}
+			got := setup.mailer.sent[0]
+			if got.From.Name != "Smile Lab Orders" || got.From.Address != tt.wantFrom || got.ReplyTo.Address != tt.wantReplyTo {
+				t.Errorf("email from %s replying to %s, want from %s replying to %s", got.From.String(), got.ReplyTo, tt.wantFrom, tt.wantReplyTo)
+			}
+		})
+	}
+}
+
+func TestNotifier_Handle_Redelivered(t *testing.T) {
+	setup := setupNotifier(t)
+	ctx := context.Background()
+	e := event.WithID(statusChanged(order.StatusReady), "event-1")
+
+	for i := 0; i < 2; i++ {
+		if err := setup.notifier.Handle(ctx, e); err != nil {
+			t.Fatalf("Handle() error = %v", err)
+		}
+	}
+	handleAndSend(t, setup, e) // Once more after the email was sent
+
+	if len(setup.mailer.sent) != 1 {
+		t.Errorf("Handle() of the same event three times sent %d emails, want 1", len(setup.mailer.sent))
+	}
+
+	handleAndSend(t, setup, event.WithID(statusChanged(order.StatusReady), "event-2"))
+	if len(setup.mailer.sent) != 2 {
+		t.Errorf("Handle() of another event sent %d emails in total, want 2", len(setup.mailer.sent))
+	}
+}
+
+func TestNotifier_Handle_OptOut(t *testing.T) {
+	setup := setupNotifier(t)
+	c, _ := setup.clientRepo.GetByID(context.Background(), "client-1")
+	c.SetEmailNotifications(false)
+	_ = setup.clientRepo.Update(context.Background(), c)
+
+	handleAndSend(t, setup, statusChanged(order.StatusReady))
+
+	if len(setup.mailer.sent) != 0 {
+		t.Errorf("Handle() sent %+v to a client who opted out", setup.mailer.sent)
+	}
+}
+
+func TestNotifier_Handle_RecipientDeleted(t *testing.T) {
+	setup := setupNotifier(t)
+	_ = setup.clientRepo.Delete(context.Background(), "client-1")
+
+	handleAndSend(t, setup, statusChanged(order.StatusReady))
+
+	if len(setup.mailer.sent) != 0 {
+		t.Errorf("Handle() sent %+v to a deleted client", setup.mailer.sent)
+	}
+}
@@ -0,0 +1,105 @@
+// Package notification emails clients and technicians about their orders,
+// from the sender each laboratory configures
+package notification
+
+import (
+	"context"
+	"log/slog"
+
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
+)
+
+// Service provides notification settings use cases
+type Service struct {
+	settingsRepo  outbound.NotificationSettingsRepository
+	labRepo       outbound.LaboratoryRepository
+	auditor       auditapp.Recorder
+	senderDomains []string
+}
+
+// NewService creates a new notification service accepting sender addresses
+// at the given domains only
+func NewService(settingsRepo outbound.NotificationSettingsRepository, labRepo outbound.LaboratoryRepository, auditor auditapp.Recorder, senderDomains []string) *Service {
+	return &Service{
+		settingsRepo:  settingsRepo,
+		labRepo:       labRepo,
+		auditor:       auditor,
+		senderDomains: senderDomains,
+	}
+}
+
+// GetSettings retrieves the notification settings of a laboratory, empty
+// when it uses the defaults
+func (s *Service) GetSettings(ctx context.Context, laboratoryID string) (_ *notification.Settings, err error) {
+	ctx, span := tracing.Start(ctx, "notification.GetSettings", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	// Validate laboratory exists
+	if _, err := s.labRepo.GetByID(ctx, laboratoryID); err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "notification: failed to load a laboratory", "laboratory_id", laboratoryID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	settings, err := s.settingsRepo.Get(ctx, laboratoryID)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return &notification.Settings{LaboratoryID: laboratoryID}, nil
+		}
+		slog.ErrorContext(ctx, "notification: failed to load the settings", "laboratory_id", laboratoryID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	return settings, nil
+}
+
+// UpdateSettingsInput represents the input for updating the notification settings
+type UpdateSettingsInput struct {
+	LaboratoryID string
+	SenderName   string // Empty uses the laboratory's name
+	SenderEmail  string // Empty uses the configured sender address; must be at an allowed domain
+	ReplyTo      string // Empty uses the laboratory's email
+}
+
+// UpdateSettings replaces the notification settings of a laboratory
+func (s *Service) UpdateSettings(ctx context.Context, input UpdateSettingsInput) (_ *notification.Settings, err error) {
+	ctx, span := tracing.Start(ctx, "notification.UpdateSettings", tracing.LaboratoryID(input.LaboratoryID))
+	defer tracing.End(span, &err)
+
+	before, err := s.GetSettings(ctx, input.LaboratoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := notification.NewSettings(input.LaboratoryID, input.SenderName, input.SenderEmail, input.ReplyTo)
+	if err != nil {
+		return nil, err
+	}
+	if settings.SenderEmail != "" && !notification.SenderAllowed(settings.SenderEmail, s.senderDomains) {
+		return nil, errors.Validation(errors.Invalid("sender_email"))
+	}
+
+	// Persist
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		slog.ErrorContext(ctx, "notification: failed to store the settings", "laboratory_id", settings.LaboratoryID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: settings.LaboratoryID,
+		EntityType:   audit.EntityNotificationSettings,
+		EntityID:     settings.LaboratoryID,
+		Action:       audit.ActionUpdate,
+		Before:       before,
+		After:        settings,
+	})
+
+	return settings, nil
+}
@@ -0,0 +1,86 @@
+package notification
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+)
+
+func setupService(t *testing.T) *Service {
+	t.Helper()
+
+	labRepo := memory.NewLaboratoryRepository()
+	_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: "lab-123", Name: "Smile Lab"})
+	return NewService(memory.NewNotificationSettingsRepository(), labRepo, auditapp.NopRecorder{}, []string{"smilelab.com"})
+}
+
+func TestService_GetSettings(t *testing.T) {
+	svc := setupService(t)
+
+	s, err := svc.GetSettings(context.Background(), "lab-123")
+	if err != nil || s.LaboratoryID != "lab-123" || s.SenderName != "" {
+		t.Errorf("GetSettings() = %+v, %v, want empty settings using the defaults", s, err)
+	}
+	if _, err := svc.GetSettings(context.Background(), "lab-999"); err != errors.ErrNotFound {
+		t.Errorf("GetSettings() of an unknown laboratory error = %v, want %v", err, errors.ErrNotFound)
+	}
+}
+
+func TestService_UpdateSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   UpdateSettingsInput
+		wantErr error
+	}{
+		{
+			name:  "valid settings",
+			input: UpdateSettingsInput{LaboratoryID: "lab-123", SenderName: "Smile Lab Orders", SenderEmail: "orders@smilelab.com", ReplyTo: "desk@smilelab.com"},
+		},
+		{
+			name:  "back to the defaults",
+			input: UpdateSettingsInput{LaboratoryID: "lab-123"},
+		},
+		{
+			name:    "invalid sender email",
+			input:   UpdateSettingsInput{LaboratoryID: "lab-123", SenderEmail: "orders"},
+			wantErr: errors.ErrInvalidInput,
+		},
+		{
+			name:    "sender email at another domain",
+			input:   UpdateSettingsInput{LaboratoryID: "lab-123", SenderEmail: "orders@bank.com"},
+			wantErr: errors.ErrInvalidInput,
+		},
+		{
+			name:    "unknown laboratory",
+			input:   UpdateSettingsInput{LaboratoryID: "lab-999"},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := setupService(t)
+
+			s, err := svc.UpdateSettings(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("UpdateSettings() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateSettings() unexpected error = %v", err)
+			}
+
+			got, _ := svc.GetSettings(context.Background(), tt.input.LaboratoryID)
+			if *got != *s || got.SenderEmail != tt.input.SenderEmail {
+				t.Errorf("GetSettings() after update = %+v, want %+v", got, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+)
+
+// templateFS holds, for every language, a text template per kind defining
+// the subject and the plain text body, and an HTML template defining the
+// content of the shared HTML layout
+//
+//go:embed templates
+var templateFS embed.FS
+
+// Data is the data available to the templates
+type Data struct {
+	Language       i18n.Language
+	LaboratoryName string
+	RecipientName  string
+	ClientName     string // Client of the order
+	OrderID        string
+	Items          []order.ProsthesisItem
+}
+
+// Rendered is a rendered notification email
+type Rendered struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// templateKey identifies the templates of a kind in a language
+type templateKey struct {
+	kind notification.Kind
+	lang i18n.Language
+}
+
+// Templates renders the notification emails of every kind and language
+type Templates struct {
+	text map[templateKey]*texttemplate.Template
+	html map[templateKey]*htmltemplate.Template
+}
+
+// LoadTemplates parses the embedded templates, failing when a kind lacks a
+// template in a supported language
+func LoadTemplates() (*Templates, error) {
+	layout, err := htmltemplate.ParseFS(templateFS, "templates/layout.html")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Templates{
+		text: make(map[templateKey]*texttemplate.Template),
+		html: make(map[templateKey]*htmltemplate.Template),
+	}
+	for _, lang := range i18n.Supported() {
+		for _, kind := range notification.AllKinds() {
+			name := "templates/" + string(lang) + "/" + string(kind)
+			key := templateKey{kind, lang}
+
+			if t.text[key], err = texttemplate.ParseFS(templateFS, name+".txt"); err != nil {
+				return nil, err
+			}
+			if t.text[key].Lookup("subject") == nil {
+				return nil, fmt.Errorf("notification: %s.txt defines no subject", name)
+			}
+
+			html, err := layout.Clone()
+			if err != nil {
+				return nil, err
+			}
+			if t.html[key], err = html.ParseFS(templateFS, name+".html"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return t, nil
+}
+
+// Render renders the email of a kind in the language of the data, the
+// default language when it is not supported
+func (t *Templates) Render(kind notification.Kind, data Data) (Rendered, error) {
+	if _, ok := t.text[templateKey{kind, data.Language}]; !ok {
+		data.Language = i18n.Default
+	}
+	key := templateKey{kind, data.Language}
+	text, ok := t.text[key]
+	if !ok {
+		return Rendered{}, fmt.Errorf("notification: no templates for %s", kind)
+	}
+
+	var subject, body, html bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Rendered{}, err
+	}
+	if err := text.Execute(&body, data); err != nil {
+		return Rendered{}, err
+	}
+	if err := t.html[key].ExecuteTemplate(&html, "layout", data); err != nil {
+		return Rendered{}, err
+	}
+
+	return Rendered{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    body.String(),
+		HTML:    html.String(),
+	}, nil
+}
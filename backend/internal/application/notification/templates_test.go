@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
+)
+
+func testData(lang i18n.Language) Data {
+	return Data{
+		Language:       lang,
+		LaboratoryName: "Smile Lab",
+		RecipientName:  "Dr. Ana",
+		ClientName:     "Dr. Ana",
+		OrderID:        "order-123",
+		Items:          []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Shade: "A2", Quantity: 2}},
+	}
+}
+
+func TestTemplates_RenderEveryKindAndLanguage(t *testing.T) {
+	templates, err := LoadTemplates()
+	if err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+
+	for _, lang := range i18n.Supported() {
+		for _, kind := range notification.AllKinds() {
+			t.Run(string(lang)+"/"+string(kind), func(t *testing.T) {
+				r, err := templates.Render(kind, testData(lang))
+				if err != nil {
+					t.Fatalf("Render() error = %v", err)
+				}
+				if !strings.Contains(r.Subject, "order-123") || strings.Contains(r.Subject, "\n") {
+					t.Errorf("Subject = %q, want a single line naming the order", r.Subject)
+				}
+				for name, body := range map[string]string{"Text": r.Text, "HTML": r.HTML} {
+					if !strings.Contains(body, "Dr. Ana") || !strings.Contains(body, "2 × crown (zirconia") || !strings.Contains(body, "Smile Lab") {
+						t.Errorf("%s = %q, want the recipient, the items and the laboratory", name, body)
+					}
+				}
+				if !strings.Contains(r.HTML, `<html lang="`+string(lang)+`">`) {
+					t.Errorf("HTML = %q, want the layout in %s", r.HTML, lang)
+				}
+			})
+		}
+	}
+}
+
+func TestTemplates_Render(t *testing.T) {
+	templates, _ := LoadTemplates()
+
+	// Unsupported languages fall back to the default one
+	r, err := templates.Render(notification.KindOrderReady, testData(""))
+	if err != nil || r.Subject != "O pedido order-123 está pronto" {
+		t.Errorf("Render() without language = %q, %v, want the default language", r.Subject, err)
+	}
+
+	// Values are escaped in HTML only
+	data := testData(i18n.En)
+	data.RecipientName = "<b>Ana</b>"
+	r, _ = templates.Render(notification.KindOrderReady, data)
+	if strings.Contains(r.HTML, "<b>Ana</b>") || !strings.Contains(r.HTML, "&lt;b&gt;Ana&lt;/b&gt;") {
+		t.Errorf("HTML = %q, want the recipient escaped", r.HTML)
+	}
+	if !strings.Contains(r.Text, "Hello, <b>Ana</b>.") {
+		t.Errorf("Text = %q, want the recipient as is", r.Text)
+	}
+
+	if _, err := templates.Render("order_lost", testData(i18n.En)); err == nil {
+		t.Error("Render() of an unknown kind error = nil, want an error")
+	}
+}
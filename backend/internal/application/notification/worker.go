@@ -0,0 +1,142 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+)
+
+// WorkerConfig holds the worker settings
+type WorkerConfig struct {
+	PollInterval time.Duration      // How often due emails are looked for
+	BatchSize    int                // Emails claimed at a time
+	Lease        time.Duration      // How long a claimed email is held back from other workers
+	Retry        outbox.RetryPolicy // Backoff between attempts and attempts before giving up
+	Retention    time.Duration      // How long sent and dead emails are kept; zero keeps them
+}
+
+// DefaultWorkerConfig returns the default worker settings
+func DefaultWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		PollInterval: 5 * time.Second,
+		BatchSize:    50,
+		Lease:        5 * time.Minute,
+		Retry: outbox.RetryPolicy{
+			MaxAttempts: 8,
+			Backoff:     outbox.Backoff{Initial: 30 * time.Second, Max: time.Hour},
+		},
+		Retention: 24 * time.Hour,
+	}
+}
+
+// Worker sends the queued emails through the mailer, so that a slow or
+// unreachable SMTP server holds back neither the event bus nor the other
+// subscribers. A failed email is retried with exponential backoff and given
+// up on once the retry policy's attempts are used up.
+type Worker struct {
+	queue     outbound.EmailQueue
+	mailer    outbound.Mailer
+	cfg       WorkerConfig
+	now       func() time.Time
+	heartbeat health.Heartbeat
+}
+
+// NewWorker creates a new email worker
+func NewWorker(queue outbound.EmailQueue, mailer outbound.Mailer, cfg WorkerConfig) *Worker {
+	return &Worker{
+		queue:  queue,
+		mailer: mailer,
+		cfg:    cfg,
+		now:    func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run sends due emails every poll interval until ctx is done
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+	defer w.heartbeat.Stop()
+
+	for {
+		w.heartbeat.Beat()
+		if _, err := w.SendDue(ctx); err != nil {
+			slog.ErrorContext(ctx, "notification: failed to send emails", "error", err)
+		}
+		if w.cfg.Retention > 0 {
+			if _, err := w.queue.Purge(ctx, w.now().Add(-w.cfg.Retention)); err != nil {
+				slog.ErrorContext(ctx, "notification: failed to purge finished emails", "error", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check reports an error unless the worker is running and polled the queue
+// within the last three poll intervals
+func (w *Worker) Check(ctx context.Context) error {
+	return w.heartbeat.Err(3 * w.cfg.PollInterval)
+}
+
+// SendDue attempts every email due now and returns how many were sent
+func (w *Worker) SendDue(ctx context.Context) (int, error) {
+	sent := 0
+	for ctx.Err() == nil {
+		emails, err := w.queue.Claim(ctx, w.now(), w.cfg.BatchSize, w.cfg.Lease)
+		if err != nil {
+			return sent, err
+		}
+
+		w.heartbeat.Beat()
+		for _, e := range emails {
+			if w.attempt(ctx, e) {
+				sent++
+			}
+		}
+		if len(emails) < w.cfg.BatchSize {
+			break
+		}
+	}
+	return sent, nil
+}
+
+// attempt sends a claimed email and stores the outcome
+func (w *Worker) attempt(ctx context.Context, e *notification.Email) bool {
+	ctx = logging.WithLaboratoryID(ctx, e.LaboratoryID)
+
+	err := w.mailer.Send(ctx, outbound.Email{
+		From:    e.From,
+		ReplyTo: e.ReplyTo,
+		To:      e.To,
+		Subject: e.Subject,
+		Text:    e.Text,
+		HTML:    e.HTML,
+	})
+	if err != nil {
+		e.MarkFailed(err, w.now(), w.cfg.Retry)
+		if e.IsDead() {
+			slog.ErrorContext(ctx, "notification: email failed, giving up", "email_id", e.ID, "kind", e.Kind, "attempts", e.Attempts, "error", err)
+		} else {
+			slog.WarnContext(ctx, "notification: email failed, retrying", "email_id", e.ID, "kind", e.Kind, "attempts", e.Attempts, "next_attempt_at", e.NextAttemptAt, "error", err)
+		}
+	} else {
+		e.MarkSent(w.now())
+	}
+
+	// The email stays claimed until its lease ends if the outcome can't be
+	// stored, and is then sent again
+	if err := w.queue.Update(ctx, e); err != nil {
+		slog.ErrorContext(ctx, "notification: failed to store the attempt of an email", "email_id", e.ID, "error", err)
+	}
+	return e.IsSent()
+}
@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"context"
+	stderrors "errors"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+func TestWorker_SendDue_RetriesThenGivesUp(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	queue := memory.NewEmailQueue()
+	_ = queue.Enqueue(ctx, &notification.Email{
+		ID:            "email-1",
+		LaboratoryID:  "lab-123",
+		Kind:          notification.KindOrderReady,
+		From:          mail.Address{Address: "notifications@example.com"},
+		To:            mail.Address{Address: "ana@clinic.com"},
+		Subject:       "Order order-123 is ready",
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	})
+
+	mailer := &recordingMailer{err: stderrors.New("connection refused")}
+	cfg := DefaultWorkerConfig()
+	cfg.Retry = outbox.RetryPolicy{MaxAttempts: 2, Backoff: outbox.Backoff{Initial: time.Minute, Max: time.Minute}}
+	worker := NewWorker(queue, mailer, cfg)
+	worker.now = func() time.Time { return now }
+
+	if sent, err := worker.SendDue(ctx); sent != 0 || err != nil {
+		t.Fatalf("SendDue() = %d, %v, want 0 sent", sent, err)
+	}
+	// Not due again before its backoff
+	if emails, _ := queue.Claim(ctx, now.Add(30*time.Second), 10, time.Minute); len(emails) != 0 {
+		t.Fatalf("Claim() during the backoff = %d emails, want none", len(emails))
+	}
+
+	now = now.Add(time.Minute)
+	_, _ = worker.SendDue(ctx)
+	now = now.Add(time.Hour)
+	if emails, _ := queue.Claim(ctx, now, 10, time.Minute); len(emails) != 0 {
+		t.Errorf("Claim() after the last attempt = %d emails, want the email given up on", len(emails))
+	}
+
+	// Sent once the server accepts it
+	_ = queue.Enqueue(ctx, &notification.Email{ID: "email-2", NextAttemptAt: now, CreatedAt: now})
+	mailer.err = nil
+	if sent, err := worker.SendDue(ctx); sent != 1 || err != nil || len(mailer.sent) != 1 {
+		t.Errorf("SendDue() = %d, %v, want 1 sent", sent, err)
+	}
+}
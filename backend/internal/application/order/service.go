@@ -2,17 +2,33 @@ package order
 
 import (
 	"context"
-
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
 )
 
 // Service provides order use cases
 type Service struct {
 	orderRepo  outbound.OrderRepository
 	clientRepo outbound.ClientRepository
+	techRepo   outbound.TechnicianRepository
+	catalog    outbound.ProsthesisRepository
+	storage    outbound.AttachmentStorage
 	idGen      IDGenerator
+	auditor    auditapp.Recorder
+	events     outbound.EventPublisher
+	tx         outbound.Transactor
 }
 
 // IDGenerator generates unique IDs
@@ -21,11 +37,17 @@ type IDGenerator interface {
 }
 
 // NewService creates a new order service
-func NewService(orderRepo outbound.OrderRepository, clientRepo outbound.ClientRepository, idGen IDGenerator) *Service {
+func NewService(orderRepo outbound.OrderRepository, clientRepo outbound.ClientRepository, techRepo outbound.TechnicianRepository, catalog outbound.ProsthesisRepository, storage outbound.AttachmentStorage, idGen IDGenerator, auditor auditapp.Recorder, events outbound.EventPublisher, tx outbound.Transactor) *Service {
 	return &Service{
 		orderRepo:  orderRepo,
 		clientRepo: clientRepo,
+		techRepo:   techRepo,
+		catalog:    catalog,
+		storage:    storage,
 		idGen:      idGen,
+		auditor:    auditor,
+		events:     events,
+		tx:         tx,
 	}
 }
 
@@ -37,13 +59,17 @@ type CreateInput struct {
 }
 
 // CreateOrder creates a new order
-func (s *Service) CreateOrder(ctx context.Context, input CreateInput) (*order.Order, error) {
+func (s *Service) CreateOrder(ctx context.Context, input CreateInput) (_ *order.Order, err error) {
+	ctx, span := tracing.Start(ctx, "order.CreateOrder", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("client", input.ClientID))
+	defer tracing.End(span, &err)
+
 	// Validate client exists and belongs to the laboratory
 	client, err := s.clientRepo.GetByID(ctx, input.ClientID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "order: failed to load a client", "client_id", input.ClientID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -58,22 +84,41 @@ func (s *Service) CreateOrder(ctx context.Context, input CreateInput) (*order.Or
 	if err != nil {
 		return nil, err
 	}
+	if err := s.validateCatalogItems(ctx, o.Prosthesis, o.LaboratoryID); err != nil {
+		return nil, err
+	}
 
 	// Persist
-	if err := s.orderRepo.Create(ctx, o); err != nil {
+	e := event.OrderCreated{Metadata: event.NewMetadata(o.LaboratoryID), Order: *o}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.orderRepo.Create(ctx, o)
+	}); err != nil {
+		slog.ErrorContext(ctx, "order: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: o.LaboratoryID,
+		EntityType:   audit.EntityOrder,
+		EntityID:     o.ID,
+		Action:       audit.ActionCreate,
+		After:        o,
+	})
+
 	return o, nil
 }
 
 // GetOrder retrieves an order by ID (laboratory-scoped)
-func (s *Service) GetOrder(ctx context.Context, id, laboratoryID string) (*order.Order, error) {
+func (s *Service) GetOrder(ctx context.Context, id, laboratoryID string) (_ *order.Order, err error) {
+	ctx, span := tracing.Start(ctx, "order.GetOrder", tracing.LaboratoryID(laboratoryID), tracing.EntityID("order", id))
+	defer tracing.End(span, &err)
+
 	o, err := s.orderRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "order: failed to load an order", "order_id", id, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -85,21 +130,127 @@ func (s *Service) GetOrder(ctx context.Context, id, laboratoryID string) (*order
 	return o, nil
 }
 
+// DownloadAttachment retrieves an attachment of an order of the laboratory
+func (s *Service) DownloadAttachment(ctx context.Context, id, laboratoryID, attachmentID string) (_ *order.Attachment, _ []byte, err error) {
+	ctx, span := tracing.Start(ctx, "order.DownloadAttachment", tracing.LaboratoryID(laboratoryID), tracing.EntityID("order", id), tracing.EntityID("attachment", attachmentID))
+	defer tracing.End(span, &err)
+
+	o, err := s.GetOrder(ctx, id, laboratoryID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, a := range o.Attachments {
+		if a.ID != attachmentID {
+			continue
+		}
+
+		data, _, err := s.storage.Get(ctx, AttachmentKey(o.ID, a.ID))
+		if err != nil {
+			if err == errors.ErrNotFound {
+				return nil, nil, errors.ErrNotFound
+			}
+			slog.ErrorContext(ctx, "order: failed to load an attachment", "order_id", o.ID, "attachment_id", a.ID, "error", err)
+			return nil, nil, errors.ErrInternal
+		}
+
+		attachment := a
+		return &attachment, data, nil
+	}
+
+	return nil, nil, errors.ErrNotFound
+}
+
+// AddAttachmentInput represents the input for attaching a file to an order
+type AddAttachmentInput struct {
+	ID           string
+	LaboratoryID string
+	FileName     string
+	ContentType  string
+	Data         []byte
+	UploadedBy   string // User ID of the uploader
+}
+
+// AddAttachment stores a file and attaches it to an order of the laboratory.
+// Only the attachment is written, so changes made to the order meanwhile are
+// kept.
+func (s *Service) AddAttachment(ctx context.Context, input AddAttachmentInput) (_ *order.Attachment, err error) {
+	ctx, span := tracing.Start(ctx, "order.AddAttachment", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("order", input.ID))
+	defer tracing.End(span, &err)
+
+	o, err := s.GetOrder(ctx, input.ID, input.LaboratoryID)
+	if err != nil {
+		return nil, err
+	}
+	before := *o
+
+	attachment := order.Attachment{
+		ID:          s.idGen.Generate(),
+		FileName:    input.FileName,
+		ContentType: input.ContentType,
+		Size:        int64(len(input.Data)),
+		UploadedBy:  input.UploadedBy,
+		UploadedAt:  time.Now().UTC(),
+	}
+	if err := o.AddAttachment(attachment); err != nil {
+		return nil, err
+	}
+
+	// Store contents before metadata so a listed attachment is always downloadable
+	if err := s.storage.Save(ctx, AttachmentKey(o.ID, attachment.ID), attachment.ContentType, input.Data); err != nil {
+		slog.ErrorContext(ctx, "order: failed to store an attachment", "order_id", o.ID, "attachment_id", attachment.ID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	// Persist
+	e := event.OrderUpdated{Metadata: event.NewMetadata(o.LaboratoryID), Order: *o, PreviousTechnicianID: o.TechnicianID}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.orderRepo.AddAttachment(ctx, o.ID, attachment)
+	}); err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "order: failed to store a change", "event", e.Name(), "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: o.LaboratoryID,
+		EntityType:   audit.EntityOrder,
+		EntityID:     o.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        o,
+	})
+
+	return &attachment, nil
+}
+
+// AttachmentKey returns the storage key of an order attachment
+func AttachmentKey(orderID, attachmentID string) string {
+	return orderID + "/" + attachmentID
+}
+
 // UpdateInput represents the input for updating an order
 type UpdateInput struct {
 	ID           string
 	LaboratoryID string
 	Prosthesis   []order.ProsthesisItem
+	TechnicianID *string // Nil keeps the current assignment, empty unassigns
 }
 
 // UpdateOrder updates an existing order (excluding status)
-func (s *Service) UpdateOrder(ctx context.Context, input UpdateInput) (*order.Order, error) {
+func (s *Service) UpdateOrder(ctx context.Context, input UpdateInput) (_ *order.Order, err error) {
+	ctx, span := tracing.Start(ctx, "order.UpdateOrder", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("order", input.ID))
+	defer tracing.End(span, &err)
+
 	// Get existing order
 	o, err := s.orderRepo.GetByID(ctx, input.ID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "order: failed to load an order", "order_id", input.ID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -108,16 +259,42 @@ func (s *Service) UpdateOrder(ctx context.Context, input UpdateInput) (*order.Or
 		return nil, errors.ErrNotFound // Security: don't reveal existence
 	}
 
+	before := *o
+
 	// Update order
 	if err := o.Update(input.Prosthesis); err != nil {
 		return nil, err
 	}
+	if err := s.validateCatalogItems(ctx, o.Prosthesis, o.LaboratoryID); err != nil {
+		return nil, err
+	}
+
+	// Assign technician, who must belong to the same laboratory
+	if input.TechnicianID != nil {
+		if err := s.validateTechnician(ctx, *input.TechnicianID, o.LaboratoryID); err != nil {
+			return nil, err
+		}
+		o.AssignTechnician(*input.TechnicianID)
+	}
 
 	// Persist
-	if err := s.orderRepo.Update(ctx, o); err != nil {
+	e := event.OrderUpdated{Metadata: event.NewMetadata(o.LaboratoryID), Order: *o, PreviousTechnicianID: before.TechnicianID}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.orderRepo.Update(ctx, o)
+	}); err != nil {
+		slog.ErrorContext(ctx, "order: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: o.LaboratoryID,
+		EntityType:   audit.EntityOrder,
+		EntityID:     o.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        o,
+	})
+
 	return o, nil
 }
 
@@ -129,76 +306,251 @@ type UpdateStatusInput struct {
 }
 
 // UpdateOrderStatus updates an order's status with workflow validation
-func (s *Service) UpdateOrderStatus(ctx context.Context, input UpdateStatusInput) (*order.Order, error) {
+func (s *Service) UpdateOrderStatus(ctx context.Context, input UpdateStatusInput) (_ *order.Order, err error) {
+	ctx, span := tracing.Start(ctx, "order.UpdateOrderStatus", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("order", input.ID))
+	defer tracing.End(span, &err)
+
+	before, o, err := s.loadStatusChange(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.commitStatusChange(ctx, before, o)
+}
+
+// BulkUpdateOrderStatus changes the status of several orders of a laboratory.
+// An order may appear only once per batch.
+func (s *Service) BulkUpdateOrderStatus(ctx context.Context, laboratoryID string, items []UpdateStatusInput, atomic bool) []bulk.Result[*order.Order] {
+	ctx, span := tracing.Start(ctx, "order.BulkUpdateOrderStatus", tracing.LaboratoryID(laboratoryID))
+	defer span.End()
+
+	seen := make(map[string]bool, len(items))
+	return bulk.Run(ctx, s.tx, items, atomic, func(ctx context.Context, i int, input UpdateStatusInput) (bulk.Step[*order.Order], error) {
+		if seen[input.ID] {
+			return bulk.Step[*order.Order]{}, errors.Validation(errors.DuplicateInBatch("id"))
+		}
+		seen[input.ID] = true
+
+		if !order.IsValidStatus(string(input.Status)) {
+			return bulk.Step[*order.Order]{}, errors.Validation(errors.InvalidChoice("status", order.AllStatuses()))
+		}
+
+		input.LaboratoryID = laboratoryID
+		return s.statusChange(ctx, input)
+	})
+}
+
+// statusChange validates a status change against the order's workflow. The
+// commit validates it again against the order as it is by then.
+func (s *Service) statusChange(ctx context.Context, input UpdateStatusInput) (bulk.Step[*order.Order], error) {
+	if _, _, err := s.loadStatusChange(ctx, input); err != nil {
+		return bulk.Step[*order.Order]{}, err
+	}
+
+	var before, changed *order.Order
+	return bulk.Step[*order.Order]{
+		Commit: func(ctx context.Context) (_ *order.Order, err error) {
+			before, changed, err = s.loadStatusChange(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+			return s.commitStatusChange(ctx, before, changed)
+		},
+		Undo: func(ctx context.Context) error {
+			return s.restore(ctx, before, changed)
+		},
+	}, nil
+}
+
+// loadStatusChange loads an order of a laboratory and applies a status change
+// to a copy of it, so that nothing changes before the commit
+func (s *Service) loadStatusChange(ctx context.Context, input UpdateStatusInput) (before, changed *order.Order, err error) {
 	// Get existing order
 	o, err := s.orderRepo.GetByID(ctx, input.ID)
 	if err != nil {
 		if err == errors.ErrNotFound {
-			return nil, errors.ErrNotFound
+			return nil, nil, errors.ErrNotFound
 		}
-		return nil, errors.ErrInternal
+		slog.ErrorContext(ctx, "order: failed to load an order", "order_id", input.ID, "error", err)
+		return nil, nil, errors.ErrInternal
 	}
 
 	// Check laboratory scope
 	if o.LaboratoryID != input.LaboratoryID {
-		return nil, errors.ErrNotFound // Security: don't reveal existence
+		return nil, nil, errors.ErrNotFound // Security: don't reveal existence
 	}
 
 	// Update status with workflow validation
-	if err := o.UpdateStatus(input.Status); err != nil {
-		return nil, err
+	c := *o
+	c.History = append([]order.StatusChange(nil), o.History...)
+	if err := c.UpdateStatus(input.Status); err != nil {
+		return nil, nil, err
 	}
+	return o, &c, nil
+}
 
+// commitStatusChange stores a status change and publishes its event
+func (s *Service) commitStatusChange(ctx context.Context, before, o *order.Order) (*order.Order, error) {
 	// Persist
-	if err := s.orderRepo.Update(ctx, o); err != nil {
+	e := statusChanged(before, o)
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.orderRepo.Update(ctx, o)
+	}); err != nil {
+		slog.ErrorContext(ctx, "order: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: o.LaboratoryID,
+		EntityType:   audit.EntityOrder,
+		EntityID:     o.ID,
+		Action:       audit.ActionStatusChange,
+		Before:       before,
+		After:        o,
+	})
+
 	return o, nil
 }
 
-// ListOrders retrieves all active orders for a laboratory
-func (s *Service) ListOrders(ctx context.Context, laboratoryID string) ([]*order.Order, error) {
-	orders, err := s.orderRepo.List(ctx, laboratoryID)
+// restore puts back an order changed by an aborted batch, unless it changed
+// again since. It publishes no event nor audit entry: those of the change are
+// dropped with the transaction of the batch.
+func (s *Service) restore(ctx context.Context, before, changed *order.Order) error {
+	current, err := s.orderRepo.GetByID(ctx, before.ID)
 	if err != nil {
-		return nil, errors.ErrInternal
+		return fmt.Errorf("failed to load order %s: %w", before.ID, err)
+	}
+	if !current.UpdatedAt.Equal(changed.UpdatedAt) {
+		return fmt.Errorf("order %s changed since the batch updated it", before.ID)
+	}
+	return s.orderRepo.Update(ctx, before)
+}
+
+// statusChanged creates the event of an order moving from one status to another
+func statusChanged(before, after *order.Order) event.OrderStatusChanged {
+	return event.OrderStatusChanged{
+		Metadata: event.NewMetadata(after.LaboratoryID),
+		Order:    *after,
+		From:     before.Status,
+		To:       after.Status,
+	}
+}
+
+// validateTechnician checks that a technician exists in the laboratory; empty means unassigned
+func (s *Service) validateTechnician(ctx context.Context, technicianID, laboratoryID string) error {
+	if technicianID == "" {
+		return nil
+	}
+
+	t, err := s.techRepo.GetByID(ctx, technicianID)
+	if err != nil && err != errors.ErrNotFound {
+		slog.ErrorContext(ctx, "order: failed to load a technician", "technician_id", technicianID, "error", err)
+		return errors.ErrInternal
+	}
+	if err == errors.ErrNotFound || t.LaboratoryID != laboratoryID {
+		return errors.Validation(errors.ReferenceNotFound("technician_id"))
+	}
+
+	return nil
+}
+
+// validateCatalogItems checks that the catalog items referenced by order items
+// exist in the laboratory's catalog, with a single lookup
+func (s *Service) validateCatalogItems(ctx context.Context, items []order.ProsthesisItem, laboratoryID string) error {
+	var ids []string
+	for _, item := range items {
+		if item.CatalogItemID != "" {
+			ids = append(ids, item.CatalogItemID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	found, err := s.catalog.GetByIDs(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "order: failed to load prostheses", "ids", ids, "error", err)
+		return errors.ErrInternal
+	}
+	inLab := make(map[string]bool, len(found))
+	for _, p := range found {
+		if p.LaboratoryID == laboratoryID {
+			inLab[p.ID] = true
+		}
+	}
+
+	var validationErrors errors.ValidationErrors
+	for i, item := range items {
+		if item.CatalogItemID != "" && !inLab[item.CatalogItemID] {
+			validationErrors = append(validationErrors, errors.ReferenceNotFound("prosthesis["+strconv.Itoa(i)+"].catalog_item_id"))
+		}
+	}
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+	return nil
+}
+
+// ListOrders retrieves a page of active orders of a laboratory matching the search criteria
+func (s *Service) ListOrders(ctx context.Context, laboratoryID string, criteria order.SearchCriteria, q listing.Query) (_ listing.Page[*order.Order], err error) {
+	ctx, span := tracing.Start(ctx, "order.ListOrders", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	if err := criteria.Validate(); err != nil {
+		return listing.Page[*order.Order]{}, err
+	}
+
+	q, err = q.Normalize(order.ListSpec)
+	if err != nil {
+		return listing.Page[*order.Order]{}, err
+	}
+
+	page, err := s.orderRepo.Search(ctx, laboratoryID, criteria, q)
+	if err != nil {
+		slog.ErrorContext(ctx, "order: failed to list orders", "error", err)
+		return listing.Page[*order.Order]{}, errors.ErrInternal
 	}
 
-	return orders, nil
+	return page, nil
 }
 
-// ListOrdersByClient retrieves all active orders for a specific client (laboratory-scoped)
-func (s *Service) ListOrdersByClient(ctx context.Context, clientID, laboratoryID string) ([]*order.Order, error) {
+// ListOrdersByClient retrieves a page of active orders for a specific client (laboratory-scoped)
+func (s *Service) ListOrdersByClient(ctx context.Context, clientID, laboratoryID string, criteria order.SearchCriteria, q listing.Query) (_ listing.Page[*order.Order], err error) {
+	ctx, span := tracing.Start(ctx, "order.ListOrdersByClient", tracing.LaboratoryID(laboratoryID), tracing.EntityID("client", clientID))
+	defer tracing.End(span, &err)
+
 	// Validate client exists and belongs to the laboratory
 	client, err := s.clientRepo.GetByID(ctx, clientID)
 	if err != nil {
 		if err == errors.ErrNotFound {
-			return nil, errors.ErrNotFound
+			return listing.Page[*order.Order]{}, errors.ErrNotFound
 		}
-		return nil, errors.ErrInternal
+		slog.ErrorContext(ctx, "order: failed to load a client", "client_id", clientID, "error", err)
+		return listing.Page[*order.Order]{}, errors.ErrInternal
 	}
 
 	// Check laboratory scope
 	if client.LaboratoryID != laboratoryID {
-		return nil, errors.ErrNotFound // Security: don't reveal existence
+		return listing.Page[*order.Order]{}, errors.ErrNotFound // Security: don't reveal existence
 	}
 
-	orders, err := s.orderRepo.ListByClientID(ctx, clientID)
-	if err != nil {
-		return nil, errors.ErrInternal
-	}
+	// Scope to the client, overriding any client criterion from the caller
+	criteria.ClientID = clientID
 
-	return orders, nil
+	return s.ListOrders(ctx, laboratoryID, criteria, q)
 }
 
 // DeleteOrder performs a soft delete on an order (laboratory-scoped)
-func (s *Service) DeleteOrder(ctx context.Context, id, laboratoryID string) error {
+func (s *Service) DeleteOrder(ctx context.Context, id, laboratoryID string) (err error) {
+	ctx, span := tracing.Start(ctx, "order.DeleteOrder", tracing.LaboratoryID(laboratoryID), tracing.EntityID("order", id))
+	defer tracing.End(span, &err)
+
 	// Check if order exists and belongs to the laboratory
 	o, err := s.orderRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "order: failed to load an order", "order_id", id, "error", err)
 		return errors.ErrInternal
 	}
 
@@ -208,9 +560,31 @@ func (s *Service) DeleteOrder(ctx context.Context, id, laboratoryID string) erro
 	}
 
 	// Delete
-	if err := s.orderRepo.Delete(ctx, id); err != nil {
+	e := event.OrderDeleted{Metadata: event.NewMetadata(o.LaboratoryID), Order: *o}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.orderRepo.Delete(ctx, id)
+	}); err != nil {
+		slog.ErrorContext(ctx, "order: failed to store a change", "event", e.Name(), "error", err)
 		return errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: o.LaboratoryID,
+		EntityType:   audit.EntityOrder,
+		EntityID:     o.ID,
+		Action:       audit.ActionDelete,
+		Before:       o,
+	})
+
 	return nil
 }
+
+// persist runs a write and publishes its event in a single transaction
+func (s *Service) persist(ctx context.Context, e event.Event, write func(ctx context.Context) error) error {
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, e)
+	})
+}
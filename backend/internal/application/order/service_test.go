@@ -3,11 +3,25 @@ package order
 import (
 	"context"
 	stderrors "errors"
+	"reflect"
 	"testing"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
 )
 
 // mockIDGenerator is a mock ID generator for testing
@@ -19,6 +33,23 @@ func (m *mockIDGenerator) Generate() string {
 	return m.id
 }
 
+// mockEventPublisher is a mock event publisher for testing that records the published events
+type mockEventPublisher struct {
+	events []event.Event
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// mockTransactor is a mock transactor for testing that runs units of work without a transaction
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // mockOrderRepository is a mock order repository for testing
 type mockOrderRepository struct {
 	orders        map[string]*order.Order
@@ -78,6 +109,18 @@ func (m *mockOrderRepository) UpdateStatus(ctx context.Context, id string, statu
 	return nil
 }
 
+func (m *mockOrderRepository) AddAttachment(ctx context.Context, id string, a order.Attachment) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	o, exists := m.orders[id]
+	if !exists {
+		return errors.ErrNotFound
+	}
+	o.Attachments = append(o.Attachments, a)
+	return nil
+}
+
 func (m *mockOrderRepository) Delete(ctx context.Context, id string) error {
 	if m.deleteErr != nil {
 		return m.deleteErr
@@ -90,17 +133,17 @@ func (m *mockOrderRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (m *mockOrderRepository) List(ctx context.Context, laboratoryID string) ([]*order.Order, error) {
+func (m *mockOrderRepository) Search(ctx context.Context, laboratoryID string, criteria order.SearchCriteria, q listing.Query) (listing.Page[*order.Order], error) {
 	if m.listErr != nil {
-		return nil, m.listErr
+		return listing.Page[*order.Order]{}, m.listErr
 	}
 	var orders []*order.Order
 	for _, o := range m.orders {
-		if o.LaboratoryID == laboratoryID && !o.IsDeleted() {
+		if o.LaboratoryID == laboratoryID && !o.IsDeleted() && criteria.Matches(o) {
 			orders = append(orders, o)
 		}
 	}
-	return orders, nil
+	return listing.Page[*order.Order]{Items: orders, Total: len(orders)}, nil
 }
 
 func (m *mockOrderRepository) ListByClientID(ctx context.Context, clientID string) ([]*order.Order, error) {
@@ -144,10 +187,24 @@ func (m *mockClientRepository) GetByID(ctx context.Context, id string) (*client.
 	return c, nil
 }
 
+func (m *mockClientRepository) GetByIDs(ctx context.Context, ids []string) ([]*client.Client, error) {
+	var result []*client.Client
+	for _, id := range ids {
+		if v, exists := m.clients[id]; exists && !v.IsDeleted() {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
 func (m *mockClientRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*client.Client, error) {
 	return nil, errors.ErrNotFound
 }
 
+func (m *mockClientRepository) GetByPortalUserID(ctx context.Context, userID string) (*client.Client, error) {
+	return nil, errors.ErrNotFound
+}
+
 func (m *mockClientRepository) Update(ctx context.Context, c *client.Client) error {
 	return nil
 }
@@ -156,7 +213,69 @@ func (m *mockClientRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (m *mockClientRepository) List(ctx context.Context, laboratoryID string) ([]*client.Client, error) {
+func (m *mockClientRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*client.Client], error) {
+	return listing.Page[*client.Client]{}, nil
+}
+
+// mockTechnicianRepository is a mock technician repository for testing
+type mockTechnicianRepository struct {
+	technicians map[string]*technician.Technician
+	getByIDErr  error
+}
+
+func newMockTechnicianRepository() *mockTechnicianRepository {
+	return &mockTechnicianRepository{
+		technicians: make(map[string]*technician.Technician),
+	}
+}
+
+func (m *mockTechnicianRepository) Create(ctx context.Context, tech *technician.Technician) error {
+	m.technicians[tech.ID] = tech
+	return nil
+}
+
+func (m *mockTechnicianRepository) GetByID(ctx context.Context, id string) (*technician.Technician, error) {
+	if m.getByIDErr != nil {
+		return nil, m.getByIDErr
+	}
+	tech, exists := m.technicians[id]
+	if !exists {
+		return nil, errors.ErrNotFound
+	}
+	return tech, nil
+}
+
+func (m *mockTechnicianRepository) GetByIDs(ctx context.Context, ids []string) ([]*technician.Technician, error) {
+	var result []*technician.Technician
+	for _, id := range ids {
+		if v, exists := m.technicians[id]; exists && !v.IsDeleted() {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTechnicianRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*technician.Technician, error) {
+	return nil, errors.ErrNotFound
+}
+
+func (m *mockTechnicianRepository) Update(ctx context.Context, tech *technician.Technician) error {
+	return nil
+}
+
+func (m *mockTechnicianRepository) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockTechnicianRepository) Restore(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockTechnicianRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*technician.Technician], error) {
+	return listing.Page[*technician.Technician]{}, nil
+}
+
+func (m *mockTechnicianRepository) ListByRole(ctx context.Context, laboratoryID string, role technician.Role) ([]*technician.Technician, error) {
 	return nil, nil
 }
 
@@ -257,7 +376,7 @@ func TestService_CreateOrder(t *testing.T) {
 			clientRepo := newMockClientRepository()
 			tt.setupRepo(orderRepo, clientRepo)
 			idGen := &mockIDGenerator{id: tt.mockID}
-			svc := NewService(orderRepo, clientRepo, idGen)
+			svc := NewService(orderRepo, clientRepo, newMockTechnicianRepository(), nil, nil, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			o, err := svc.CreateOrder(context.Background(), tt.input)
 
@@ -292,6 +411,121 @@ func TestService_CreateOrder(t *testing.T) {
 	}
 }
 
+// txKey marks the context of a unit of work run by recordingTransactor
+type txKey struct{}
+
+// recordingTransactor runs units of work with a marked context
+type recordingTransactor struct{}
+
+func (recordingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// txPublisher records the events published within a unit of work
+type txPublisher struct {
+	events []event.Event
+}
+
+func (p *txPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	if ctx.Value(txKey{}) != true {
+		return stderrors.New("published outside of the transaction")
+	}
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func TestService_CreateOrder_PublishesWithinTransaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		createErr  error
+		wantErr    error
+		wantEvents int
+	}{
+		{name: "event stored with the order", wantEvents: 1},
+		{name: "no event when the order is not stored", createErr: stderrors.New("disk full"), wantErr: errors.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderRepo := newMockOrderRepository()
+			orderRepo.createErr = tt.createErr
+			clientRepo := newMockClientRepository()
+			clientRepo.clients["client-123"] = &client.Client{ID: "client-123", LaboratoryID: "lab-123"}
+			events := &txPublisher{}
+			svc := NewService(orderRepo, clientRepo, newMockTechnicianRepository(), nil, nil, &mockIDGenerator{id: "order-123"}, auditapp.NopRecorder{}, events, recordingTransactor{})
+
+			_, err := svc.CreateOrder(context.Background(), CreateInput{
+				ClientID:     "client-123",
+				LaboratoryID: "lab-123",
+				Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+			})
+			if !stderrors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateOrder() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(events.events) != tt.wantEvents {
+				t.Fatalf("CreateOrder() published %d events, want %d", len(events.events), tt.wantEvents)
+			}
+			if tt.wantEvents > 0 {
+				if created, ok := events.events[0].(event.OrderCreated); !ok || created.Order.ID != "order-123" {
+					t.Errorf("CreateOrder() published %+v, want OrderCreated of order-123", events.events[0])
+				}
+			}
+		})
+	}
+}
+
+func TestService_CreateOrder_CatalogItems(t *testing.T) {
+	ctx := context.Background()
+	catalog := memory.NewProsthesisRepository()
+	_ = catalog.Create(ctx, &prosthesis.Prosthesis{ID: "crown-1", LaboratoryID: "lab-123", Type: prosthesis.ProsthesisTypeCrown})
+	_ = catalog.Create(ctx, &prosthesis.Prosthesis{ID: "crown-2", LaboratoryID: "lab-456", Type: prosthesis.ProsthesisTypeCrown})
+
+	tests := []struct {
+		name        string
+		catalogIDs  []string
+		wantInvalid []string // Fields reported as references not found
+	}{
+		{"catalog item of the laboratory", []string{"crown-1", ""}, nil},
+		{"unknown and foreign catalog items", []string{"crown-1", "non-existent", "crown-2"}, []string{"prosthesis[1].catalog_item_id", "prosthesis[2].catalog_item_id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientRepo := newMockClientRepository()
+			clientRepo.clients["client-123"] = &client.Client{ID: "client-123", LaboratoryID: "lab-123", Name: "Test Client"}
+			svc := NewService(newMockOrderRepository(), clientRepo, newMockTechnicianRepository(), catalog, nil, &mockIDGenerator{id: "order-123"}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+			items := make([]order.ProsthesisItem, len(tt.catalogIDs))
+			for i, id := range tt.catalogIDs {
+				items[i] = order.ProsthesisItem{Type: "crown", Material: "zirconia", Quantity: 1, CatalogItemID: id}
+			}
+			o, err := svc.CreateOrder(ctx, CreateInput{ClientID: "client-123", LaboratoryID: "lab-123", Prosthesis: items})
+
+			if tt.wantInvalid == nil {
+				if err != nil {
+					t.Fatalf("CreateOrder() unexpected error = %v", err)
+				}
+				if o.Prosthesis[0].CatalogItemID != "crown-1" {
+					t.Errorf("CreateOrder() CatalogItemID = %q, want crown-1", o.Prosthesis[0].CatalogItemID)
+				}
+				return
+			}
+
+			var ve errors.ValidationErrors
+			if !stderrors.As(err, &ve) {
+				t.Fatalf("CreateOrder() error = %v, want validation errors", err)
+			}
+			var fields []string
+			for _, e := range ve {
+				fields = append(fields, e.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantInvalid) {
+				t.Errorf("CreateOrder() invalid fields = %v, want %v", fields, tt.wantInvalid)
+			}
+		})
+	}
+}
+
 func TestService_GetOrder(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -355,7 +589,7 @@ func TestService_GetOrder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orderRepo := newMockOrderRepository()
 			tt.setupRepo(orderRepo)
-			svc := NewService(orderRepo, newMockClientRepository(), &mockIDGenerator{})
+			svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			o, err := svc.GetOrder(context.Background(), tt.id, tt.laboratoryID)
 
@@ -382,6 +616,46 @@ func TestService_GetOrder(t *testing.T) {
 	}
 }
 
+func TestService_GetOrder_Traced(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+
+	orderRepo := newMockOrderRepository()
+	orderRepo.orders["order-123"] = &order.Order{ID: "order-123", LaboratoryID: "lab-123", Status: order.StatusReceived}
+	svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+	_, _ = svc.GetOrder(context.Background(), "order-123", "lab-123")
+	_, _ = svc.GetOrder(context.Background(), "order-123", "lab-456")
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("ended %d spans, want 2", len(ended))
+	}
+	for i, want := range []struct {
+		laboratoryID string
+		status       codes.Code
+	}{
+		{"lab-123", codes.Unset},
+		{"lab-456", codes.Error},
+	} {
+		span := ended[i]
+		if span.Name() != "order.GetOrder" {
+			t.Errorf("span %d name = %q, want order.GetOrder", i, span.Name())
+		}
+		attrs := attribute.NewSet(span.Attributes()...)
+		if v, _ := attrs.Value("laboratory_id"); v.AsString() != want.laboratoryID {
+			t.Errorf("span %d laboratory_id = %q, want %q", i, v.AsString(), want.laboratoryID)
+		}
+		if v, _ := attrs.Value("order_id"); v.AsString() != "order-123" {
+			t.Errorf("span %d order_id = %q, want order-123", i, v.AsString())
+		}
+		if span.Status().Code != want.status {
+			t.Errorf("span %d status = %v, want %v", i, span.Status().Code, want.status)
+		}
+	}
+}
+
 func TestService_UpdateOrder(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -442,7 +716,7 @@ func TestService_UpdateOrder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orderRepo := newMockOrderRepository()
 			tt.setupRepo(orderRepo)
-			svc := NewService(orderRepo, newMockClientRepository(), &mockIDGenerator{})
+			svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			o, err := svc.UpdateOrder(context.Background(), tt.input)
 
@@ -564,7 +838,8 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orderRepo := newMockOrderRepository()
 			tt.setupRepo(orderRepo)
-			svc := NewService(orderRepo, newMockClientRepository(), &mockIDGenerator{})
+			events := &mockEventPublisher{}
+			svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, events, mockTransactor{})
 
 			o, err := svc.UpdateOrderStatus(context.Background(), tt.input)
 
@@ -576,6 +851,9 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 				if !stderrors.Is(err, tt.wantErr) {
 					t.Errorf("UpdateOrderStatus() error = %v, want %v", err, tt.wantErr)
 				}
+				if len(events.events) != 0 {
+					t.Errorf("UpdateOrderStatus() published %v, want no events", events.events)
+				}
 				return
 			}
 
@@ -587,10 +865,67 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 			if o.Status != tt.input.Status {
 				t.Errorf("UpdateOrderStatus() Status = %v, want %v", o.Status, tt.input.Status)
 			}
+
+			if len(events.events) != 1 {
+				t.Fatalf("UpdateOrderStatus() published %d events, want 1", len(events.events))
+			}
+			changed, ok := events.events[0].(event.OrderStatusChanged)
+			if !ok || changed.From != order.StatusReceived || changed.To != tt.input.Status || changed.LaboratoryID != "lab-123" {
+				t.Errorf("UpdateOrderStatus() published %+v, want the change from received to %v", events.events[0], tt.input.Status)
+			}
 		})
 	}
 }
 
+// racingOrderRepository changes the status of an order right after it is
+// read, as a concurrent request would
+type racingOrderRepository struct {
+	*memory.OrderRepository
+}
+
+func (r racingOrderRepository) GetByID(ctx context.Context, id string) (*order.Order, error) {
+	o, err := r.OrderRepository.GetByID(ctx, id)
+	if err == nil {
+		_ = r.OrderRepository.UpdateStatus(ctx, id, order.StatusInProduction)
+	}
+	return o, err
+}
+
+func TestService_AddAttachment(t *testing.T) {
+	ctx := context.Background()
+	orderRepo := memory.NewOrderRepository()
+	_ = orderRepo.Create(ctx, &order.Order{ID: "order-123", ClientID: "client-123", LaboratoryID: "lab-123", Status: order.StatusReceived})
+	storage := memory.NewAttachmentStorage()
+	events := &mockEventPublisher{}
+	svc := NewService(racingOrderRepository{orderRepo}, newMockClientRepository(), newMockTechnicianRepository(), nil, storage, &mockIDGenerator{id: "att-1"}, auditapp.NopRecorder{}, events, mockTransactor{})
+
+	if _, err := svc.AddAttachment(ctx, AddAttachmentInput{ID: "order-123", LaboratoryID: "lab-456", FileName: "scan.stl", Data: []byte("solid")}); err != errors.ErrNotFound {
+		t.Errorf("AddAttachment() to another laboratory's order error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	attachment, err := svc.AddAttachment(ctx, AddAttachmentInput{ID: "order-123", LaboratoryID: "lab-123", FileName: "scan.stl", ContentType: "model/stl", Data: []byte("solid"), UploadedBy: "user_dentist"})
+	if err != nil {
+		t.Fatalf("AddAttachment() unexpected error = %v", err)
+	}
+
+	stored, _ := orderRepo.GetByID(ctx, "order-123")
+	if len(stored.Attachments) != 1 || stored.Attachments[0].ID != attachment.ID {
+		t.Errorf("AddAttachment() stored attachments = %v, want %v", stored.Attachments, attachment.ID)
+	}
+	if stored.Status != order.StatusInProduction {
+		t.Errorf("AddAttachment() overwrote the concurrent status change, status = %v", stored.Status)
+	}
+	if data, _, err := storage.Get(ctx, AttachmentKey("order-123", attachment.ID)); err != nil || string(data) != "solid" {
+		t.Errorf("AddAttachment() stored contents %q, %v", data, err)
+	}
+	if len(events.events) != 1 {
+		t.Fatalf("AddAttachment() published %d events, want 1", len(events.events))
+	}
+	if updated, ok := events.events[0].(event.OrderUpdated); !ok || updated.TechnicianAssigned() {
+		t.Errorf("AddAttachment() published %+v, want OrderUpdated without an assignment", events.events[0])
+	}
+}
+
 func TestService_ListOrders(t *testing.T) {
 	orderRepo := newMockOrderRepository()
 	orderRepo.orders["order-1"] = &order.Order{
@@ -633,16 +968,84 @@ func TestService_ListOrders(t *testing.T) {
 		},
 	}
 
-	svc := NewService(orderRepo, newMockClientRepository(), &mockIDGenerator{})
+	svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
-	orders, err := svc.ListOrders(context.Background(), "lab-123")
+	page, err := svc.ListOrders(context.Background(), "lab-123", order.SearchCriteria{}, listing.Query{})
 	if err != nil {
 		t.Errorf("ListOrders() unexpected error = %v", err)
 		return
 	}
 
-	if len(orders) != 2 {
-		t.Errorf("ListOrders() got %d orders, want 2", len(orders))
+	if len(page.Items) != 2 || page.Total != 2 {
+		t.Errorf("ListOrders() got %d orders (total %d), want 2", len(page.Items), page.Total)
+	}
+}
+
+func TestService_ListOrders_InvalidCriteria(t *testing.T) {
+	svc := NewService(newMockOrderRepository(), newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+	criteria := order.SearchCriteria{Statuses: []order.Status{"shipped"}}
+	_, err := svc.ListOrders(context.Background(), "lab-123", criteria, listing.Query{})
+	if !stderrors.Is(err, errors.ErrInvalidInput) {
+		t.Errorf("ListOrders() error = %v, want validation error", err)
+	}
+}
+
+func TestService_UpdateOrder_AssignTechnician(t *testing.T) {
+	assigned := "tech-1"
+	otherLab := "tech-2"
+	missing := "tech-404"
+	unassigned := ""
+
+	tests := []struct {
+		name         string
+		current      string
+		technicianID *string
+		want         string
+		wantErr      bool
+	}{
+		{name: "assign technician", technicianID: &assigned, want: "tech-1"},
+		{name: "keep assignment when omitted", current: "tech-1", want: "tech-1"},
+		{name: "unassign with empty id", current: "tech-1", technicianID: &unassigned, want: ""},
+		{name: "technician of another laboratory", technicianID: &otherLab, wantErr: true},
+		{name: "technician not found", technicianID: &missing, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderRepo := newMockOrderRepository()
+			orderRepo.orders["order-123"] = &order.Order{
+				ID:           "order-123",
+				ClientID:     "client-123",
+				LaboratoryID: "lab-123",
+				TechnicianID: tt.current,
+				Status:       order.StatusReceived,
+			}
+			techRepo := newMockTechnicianRepository()
+			techRepo.technicians["tech-1"] = &technician.Technician{ID: "tech-1", LaboratoryID: "lab-123"}
+			techRepo.technicians["tech-2"] = &technician.Technician{ID: "tech-2", LaboratoryID: "lab-456"}
+
+			svc := NewService(orderRepo, newMockClientRepository(), techRepo, nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+			o, err := svc.UpdateOrder(context.Background(), UpdateInput{
+				ID:           "order-123",
+				LaboratoryID: "lab-123",
+				Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+				TechnicianID: tt.technicianID,
+			})
+			if tt.wantErr {
+				if !stderrors.Is(err, errors.ErrInvalidInput) {
+					t.Errorf("UpdateOrder() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateOrder() unexpected error = %v", err)
+			}
+			if o.TechnicianID != tt.want {
+				t.Errorf("UpdateOrder() TechnicianID = %q, want %q", o.TechnicianID, tt.want)
+			}
+		})
 	}
 }
 
@@ -724,9 +1127,9 @@ func TestService_ListOrdersByClient(t *testing.T) {
 			orderRepo := newMockOrderRepository()
 			clientRepo := newMockClientRepository()
 			tt.setupRepo(orderRepo, clientRepo)
-			svc := NewService(orderRepo, clientRepo, &mockIDGenerator{})
+			svc := NewService(orderRepo, clientRepo, newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
-			orders, err := svc.ListOrdersByClient(context.Background(), tt.clientID, tt.laboratoryID)
+			page, err := svc.ListOrdersByClient(context.Background(), tt.clientID, tt.laboratoryID, order.SearchCriteria{}, listing.Query{})
 
 			if tt.wantErr != nil {
 				if err == nil {
@@ -744,8 +1147,8 @@ func TestService_ListOrdersByClient(t *testing.T) {
 				return
 			}
 
-			if len(orders) != tt.wantCount {
-				t.Errorf("ListOrdersByClient() got %d orders, want %d", len(orders), tt.wantCount)
+			if len(page.Items) != tt.wantCount {
+				t.Errorf("ListOrdersByClient() got %d orders, want %d", len(page.Items), tt.wantCount)
 			}
 		})
 	}
@@ -814,7 +1217,7 @@ func TestService_DeleteOrder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orderRepo := newMockOrderRepository()
 			tt.setupRepo(orderRepo)
-			svc := NewService(orderRepo, newMockClientRepository(), &mockIDGenerator{})
+			svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			err := svc.DeleteOrder(context.Background(), tt.id, tt.laboratoryID)
 
@@ -835,3 +1238,155 @@ func TestService_DeleteOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestService_BulkUpdateOrderStatus(t *testing.T) {
+	items := []UpdateStatusInput{
+		{ID: "order-1", Status: order.StatusInProduction},
+		{ID: "order-2", Status: order.StatusReady},
+		{ID: "order-1", Status: order.StatusQualityCheck},
+		{ID: "order-4", Status: "shipped"},
+		{ID: "non-existent", Status: order.StatusInProduction},
+		{ID: "order-3", Status: order.StatusInProduction},
+	}
+
+	tests := []struct {
+		name         string
+		atomic       bool
+		wantStatuses []bulk.Status
+		wantErrs     []error
+		wantOrders   map[string]order.Status
+		wantEvents   int
+	}{
+		{
+			name:         "applies the valid transitions",
+			wantStatuses: []bulk.Status{bulk.StatusSucceeded, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusSucceeded},
+			wantErrs:     []error{nil, errors.ErrInvalidStatusTransition, errors.ErrInvalidInput, errors.ErrInvalidInput, errors.ErrNotFound, nil},
+			wantOrders:   map[string]order.Status{"order-1": order.StatusInProduction, "order-2": order.StatusReceived, "order-3": order.StatusInProduction},
+			wantEvents:   2,
+		},
+		{
+			name:         "atomic batch applies nothing when an item fails",
+			atomic:       true,
+			wantStatuses: []bulk.Status{bulk.StatusSkipped, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusSkipped},
+			wantErrs:     []error{nil, errors.ErrInvalidStatusTransition, errors.ErrInvalidInput, errors.ErrInvalidInput, errors.ErrNotFound, nil},
+			wantOrders:   map[string]order.Status{"order-1": order.StatusReceived, "order-2": order.StatusReceived, "order-3": order.StatusReceived},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderRepo := newMockOrderRepository()
+			for _, id := range []string{"order-1", "order-2", "order-3"} {
+				orderRepo.orders[id] = &order.Order{
+					ID:           id,
+					ClientID:     "client-123",
+					LaboratoryID: "lab-123",
+					Status:       order.StatusReceived,
+					Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+				}
+			}
+			events := &mockEventPublisher{}
+			svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, events, mockTransactor{})
+
+			results := svc.BulkUpdateOrderStatus(context.Background(), "lab-123", items, tt.atomic)
+
+			for i, r := range results {
+				if r.Status != tt.wantStatuses[i] {
+					t.Errorf("BulkUpdateOrderStatus() results[%d].Status = %v, want %v", i, r.Status, tt.wantStatuses[i])
+				}
+				if tt.wantErrs[i] != nil && !stderrors.Is(r.Err, tt.wantErrs[i]) {
+					t.Errorf("BulkUpdateOrderStatus() results[%d].Err = %v, want %v", i, r.Err, tt.wantErrs[i])
+				}
+			}
+			for id, want := range tt.wantOrders {
+				if got := orderRepo.orders[id].Status; got != want {
+					t.Errorf("order %s Status = %v, want %v", id, got, want)
+				}
+			}
+			if len(events.events) != tt.wantEvents {
+				t.Errorf("BulkUpdateOrderStatus() published %d events, want %d", len(events.events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+// failingOrderRepository fails to update one order
+type failingOrderRepository struct {
+	*memory.OrderRepository
+	failID string
+}
+
+func (r *failingOrderRepository) Update(ctx context.Context, o *order.Order) error {
+	if o.ID == r.failID {
+		return stderrors.New("storage failure")
+	}
+	return r.OrderRepository.Update(ctx, o)
+}
+
+func TestService_BulkUpdateOrderStatus_AtomicRollback(t *testing.T) {
+	ctx := context.Background()
+	orderRepo := memory.NewOrderRepository()
+	for _, id := range []string{"order-1", "order-2", "order-3"} {
+		_ = orderRepo.Create(ctx, &order.Order{
+			ID:           id,
+			ClientID:     "client-123",
+			LaboratoryID: "lab-123",
+			Status:       order.StatusQualityCheck,
+			Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+		})
+	}
+	events := &mockEventPublisher{}
+	svc := NewService(&failingOrderRepository{orderRepo, "order-3"}, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, memory.NewCommitPublisher(events), memory.NewTransactor())
+
+	items := []UpdateStatusInput{
+		{ID: "order-1", Status: order.StatusReady},
+		{ID: "order-2", Status: order.StatusReady},
+		{ID: "order-3", Status: order.StatusReady},
+	}
+	results := svc.BulkUpdateOrderStatus(ctx, "lab-123", items, true)
+
+	want := []bulk.Status{bulk.StatusSkipped, bulk.StatusSkipped, bulk.StatusFailed}
+	for i, r := range results {
+		if r.Status != want[i] {
+			t.Errorf("BulkUpdateOrderStatus() results[%d].Status = %v, want %v", i, r.Status, want[i])
+		}
+	}
+	for _, id := range []string{"order-1", "order-2"} {
+		if o, _ := orderRepo.GetByID(ctx, id); o.Status != order.StatusQualityCheck || len(o.History) != 0 {
+			t.Errorf("order %s = %v with %d changes, want it restored", id, o.Status, len(o.History))
+		}
+	}
+	if len(events.events) != 0 {
+		t.Errorf("BulkUpdateOrderStatus() published %d events, want none for an aborted batch", len(events.events))
+	}
+}
+
+func TestService_StatusChange_CommitChecksCurrentOrder(t *testing.T) {
+	ctx := context.Background()
+	orderRepo := memory.NewOrderRepository()
+	_ = orderRepo.Create(ctx, &order.Order{
+		ID:           "order-1",
+		ClientID:     "client-123",
+		LaboratoryID: "lab-123",
+		Status:       order.StatusQualityCheck,
+		Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+	})
+	svc := NewService(orderRepo, newMockClientRepository(), newMockTechnicianRepository(), nil, nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+
+	step, err := svc.statusChange(ctx, UpdateStatusInput{ID: "order-1", LaboratoryID: "lab-123", Status: order.StatusReady})
+	if err != nil {
+		t.Fatalf("statusChange() unexpected error = %v", err)
+	}
+
+	// The order moves on between the validation and the commit
+	if _, err := svc.UpdateOrderStatus(ctx, UpdateStatusInput{ID: "order-1", LaboratoryID: "lab-123", Status: order.StatusRevision}); err != nil {
+		t.Fatalf("UpdateOrderStatus() unexpected error = %v", err)
+	}
+
+	if _, err := step.Commit(ctx); err != errors.ErrInvalidStatusTransition {
+		t.Errorf("Commit() error = %v, want %v", err, errors.ErrInvalidStatusTransition)
+	}
+	if o, _ := orderRepo.GetByID(ctx, "order-1"); o.Status != order.StatusRevision {
+		t.Errorf("order Status = %v, want the concurrent change kept", o.Status)
+	}
+}
@@ -0,0 +1,227 @@
+// Package orderstream streams the order changes of a laboratory to live
+// clients such as the production floor board
+package orderstream
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// Config holds the broker settings
+type Config struct {
+	Replay int // Messages kept per laboratory for clients resuming a stream
+	Buffer int // Messages queued per client before it is dropped as too slow
+}
+
+// DefaultConfig returns the default broker settings
+func DefaultConfig() Config {
+	return Config{Replay: 256, Buffer: 64}
+}
+
+// Message is an order change sent to the clients of its laboratory
+type Message struct {
+	ID         string     // Resume token, sent as the SSE event ID
+	Type       event.Name // order.created, order.updated, order.status_changed or order.deleted
+	Order      order.Order
+	From       order.Status // Status changes only
+	To         order.Status // Status changes only
+	OccurredAt time.Time
+}
+
+// Broker fans the order events of the bus out to the clients streaming
+// their laboratory. Message IDs are a sequence number per laboratory
+// prefixed with the broker's epoch, so IDs from before a restart are
+// recognised as unknown rather than mistaken for recent ones.
+type Broker struct {
+	labRepo outbound.LaboratoryRepository
+	cfg     Config
+	epoch   string
+
+	mu      sync.Mutex
+	streams map[string]*stream
+}
+
+// stream holds the recent messages and the clients of a laboratory
+type stream struct {
+	seq     uint64
+	replay  []Message // Oldest first, at most Config.Replay
+	clients map[*Subscription]struct{}
+}
+
+// NewBroker creates a new order stream broker
+func NewBroker(labRepo outbound.LaboratoryRepository, cfg Config) *Broker {
+	return &Broker{
+		labRepo: labRepo,
+		cfg:     cfg,
+		epoch:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		streams: make(map[string]*stream),
+	}
+}
+
+// Subscription is a client of a laboratory stream
+type Subscription struct {
+	// Replay holds the messages the client missed since its last event ID
+	Replay []Message
+	// Reset reports that the messages since the last event ID are no longer
+	// kept, so the client must reload the orders instead of resuming
+	Reset bool
+	// Cursor is the ID of the latest message at subscription time
+	Cursor string
+
+	ch     chan Message
+	broker *Broker
+	labID  string
+	once   sync.Once
+}
+
+// C returns the channel of new messages. It is closed when the client falls
+// too far behind or the subscription is closed; the client then resumes with
+// its last event ID.
+func (s *Subscription) C() <-chan Message {
+	return s.ch
+}
+
+// Close unsubscribes the client
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.drop(s.labID, s)
+}
+
+// Close ends every subscription, e.g. on shutdown; their clients resume
+// with their last event ID on another instance
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for labID, st := range b.streams {
+		for sub := range st.clients {
+			b.drop(labID, sub)
+		}
+	}
+}
+
+// Subscribe subscribes to the order changes of a laboratory. A non-empty
+// lastEventID resumes the stream after that message.
+func (b *Broker) Subscribe(ctx context.Context, laboratoryID, lastEventID string) (*Subscription, error) {
+	if _, err := b.labRepo.GetByID(ctx, laboratoryID); err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "orderstream: failed to load a laboratory", "laboratory_id", laboratoryID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	st := b.stream(laboratoryID)
+	sub := &Subscription{
+		Cursor: b.id(st.seq),
+		ch:     make(chan Message, b.cfg.Buffer),
+		broker: b,
+		labID:  laboratoryID,
+	}
+	if lastEventID != "" {
+		sub.Replay, sub.Reset = b.since(st, lastEventID)
+	}
+	st.clients[sub] = struct{}{}
+	return sub, nil
+}
+
+// Handle sends the order events of the bus to the clients of their
+// laboratory. It never fails: a stream is best effort and must not cause the
+// event to be delivered again.
+func (b *Broker) Handle(ctx context.Context, e event.Event) error {
+	m := Message{Type: e.Name(), OccurredAt: e.Meta().OccurredAt}
+	switch e := e.(type) {
+	case event.OrderCreated:
+		m.Order = e.Order
+	case event.OrderUpdated:
+		m.Order = e.Order
+	case event.OrderStatusChanged:
+		m.Order, m.From, m.To = e.Order, e.From, e.To
+	case event.OrderDeleted:
+		m.Order = e.Order
+	default:
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	laboratoryID := e.Meta().LaboratoryID
+	st := b.stream(laboratoryID)
+	st.seq++
+	m.ID = b.id(st.seq)
+
+	st.replay = append(st.replay, m)
+	if over := len(st.replay) - b.cfg.Replay; over > 0 {
+		st.replay = append([]Message(nil), st.replay[over:]...)
+	}
+
+	for sub := range st.clients {
+		select {
+		case sub.ch <- m:
+		default:
+			// Too slow: the client reconnects and catches up from the replay
+			b.drop(laboratoryID, sub)
+		}
+	}
+	return nil
+}
+
+// since returns the kept messages after lastEventID, reporting true when
+// some of them may be missing
+func (b *Broker) since(st *stream, lastEventID string) ([]Message, bool) {
+	epoch, raw, ok := strings.Cut(lastEventID, "-")
+	if !ok || epoch != b.epoch {
+		return nil, true
+	}
+	seq, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil || seq > st.seq {
+		return nil, true
+	}
+	if seq == st.seq {
+		return nil, false
+	}
+
+	// The next message must still be kept
+	first := st.seq - uint64(len(st.replay)) + 1
+	if len(st.replay) == 0 || seq+1 < first {
+		return nil, true
+	}
+	return append([]Message(nil), st.replay[seq+1-first:]...), false
+}
+
+// stream returns the stream of a laboratory, creating it when missing.
+// The caller must hold the lock.
+func (b *Broker) stream(laboratoryID string) *stream {
+	st, ok := b.streams[laboratoryID]
+	if !ok {
+		st = &stream{clients: make(map[*Subscription]struct{})}
+		b.streams[laboratoryID] = st
+	}
+	return st
+}
+
+// drop removes a client and closes its channel. The caller must hold the lock.
+func (b *Broker) drop(laboratoryID string, sub *Subscription) {
+	if st, ok := b.streams[laboratoryID]; ok {
+		delete(st.clients, sub)
+	}
+	sub.once.Do(func() { close(sub.ch) })
+}
+
+// id formats the message ID of a sequence number
+func (b *Broker) id(seq uint64) string {
+	return b.epoch + "-" + strconv.FormatUint(seq, 10)
+}
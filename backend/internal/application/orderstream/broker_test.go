@@ -0,0 +1,164 @@
+package orderstream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+func newTestBroker(cfg Config) *Broker {
+	labRepo := memory.NewLaboratoryRepository()
+	_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"})
+	_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: "lab-456", Name: "Other Lab"})
+	return NewBroker(labRepo, cfg)
+}
+
+func orderCreated(laboratoryID, orderID string) event.Event {
+	return event.OrderCreated{
+		Metadata: event.NewMetadata(laboratoryID),
+		Order:    order.Order{ID: orderID, LaboratoryID: laboratoryID, Status: order.StatusReceived},
+	}
+}
+
+func TestBroker_SendsOrderEventsOfTheLaboratory(t *testing.T) {
+	b := newTestBroker(DefaultConfig())
+	ctx := context.Background()
+
+	if _, err := b.Subscribe(ctx, "lab-999", ""); err != errors.ErrNotFound {
+		t.Fatalf("Subscribe() to a missing laboratory error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	sub, err := b.Subscribe(ctx, "lab-123", "")
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error = %v", err)
+	}
+	defer sub.Close()
+
+	_ = b.Handle(ctx, orderCreated("lab-456", "order-other"))
+	_ = b.Handle(ctx, event.ClientCreated{Metadata: event.NewMetadata("lab-123")})
+	_ = b.Handle(ctx, event.OrderStatusChanged{
+		Metadata: event.NewMetadata("lab-123"),
+		Order:    order.Order{ID: "order-1", LaboratoryID: "lab-123", Status: order.StatusReady},
+		From:     order.StatusQualityCheck,
+		To:       order.StatusReady,
+	})
+
+	select {
+	case m := <-sub.C():
+		if m.Type != event.NameOrderStatusChanged || m.Order.ID != "order-1" || m.From != order.StatusQualityCheck || m.To != order.StatusReady {
+			t.Errorf("message = %+v, want the status change of order-1", m)
+		}
+	default:
+		t.Fatal("no message received")
+	}
+	if len(sub.C()) != 0 {
+		t.Errorf("%d more messages queued, want only the order event of lab-123", len(sub.C()))
+	}
+}
+
+func TestBroker_Resume(t *testing.T) {
+	b := newTestBroker(Config{Replay: 3, Buffer: 8})
+	ctx := context.Background()
+
+	var ids []string
+	for _, id := range []string{"order-1", "order-2", "order-3", "order-4", "order-5"} {
+		_ = b.Handle(ctx, orderCreated("lab-123", id))
+	}
+	sub, _ := b.Subscribe(ctx, "lab-123", "")
+	for _, m := range b.streams["lab-123"].replay {
+		ids = append(ids, m.ID)
+	}
+	sub.Close()
+
+	tests := []struct {
+		name        string
+		lastEventID string
+		wantOrders  []string
+		wantReset   bool
+	}{
+		{name: "up to date", lastEventID: ids[2]},
+		{name: "within the replay", lastEventID: ids[0], wantOrders: []string{"order-4", "order-5"}},
+		{name: "just before the replay", lastEventID: b.id(2), wantOrders: []string{"order-3", "order-4", "order-5"}},
+		{name: "beyond the replay", lastEventID: b.id(1), wantReset: true},
+		{name: "from before a restart", lastEventID: "epoch-4", wantReset: true},
+		{name: "from the future", lastEventID: b.id(9), wantReset: true},
+		{name: "malformed", lastEventID: "garbage", wantReset: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, err := b.Subscribe(ctx, "lab-123", tt.lastEventID)
+			if err != nil {
+				t.Fatalf("Subscribe() unexpected error = %v", err)
+			}
+			defer sub.Close()
+
+			if sub.Reset != tt.wantReset {
+				t.Errorf("Reset = %v, want %v", sub.Reset, tt.wantReset)
+			}
+			if sub.Cursor != ids[2] {
+				t.Errorf("Cursor = %s, want the latest ID %s", sub.Cursor, ids[2])
+			}
+			var got []string
+			for _, m := range sub.Replay {
+				got = append(got, m.Order.ID)
+			}
+			if len(got) != len(tt.wantOrders) {
+				t.Fatalf("replayed %v, want %v", got, tt.wantOrders)
+			}
+			for i := range got {
+				if got[i] != tt.wantOrders[i] {
+					t.Errorf("replayed %v, want %v", got, tt.wantOrders)
+				}
+			}
+		})
+	}
+}
+
+func TestBroker_DropsSlowClients(t *testing.T) {
+	b := newTestBroker(Config{Replay: 10, Buffer: 2})
+	ctx := context.Background()
+
+	slow, _ := b.Subscribe(ctx, "lab-123", "")
+	for _, id := range []string{"order-1", "order-2", "order-3"} {
+		_ = b.Handle(ctx, orderCreated("lab-123", id))
+	}
+
+	var last string
+	for m := range slow.C() {
+		last = m.ID
+	}
+	if last != b.id(2) {
+		t.Fatalf("last message before the drop = %s, want %s", last, b.id(2))
+	}
+
+	// The dropped client catches up from its last event
+	resumed, _ := b.Subscribe(ctx, "lab-123", last)
+	defer resumed.Close()
+	if resumed.Reset || len(resumed.Replay) != 1 || resumed.Replay[0].Order.ID != "order-3" {
+		t.Errorf("resumed subscription = %+v, want order-3 replayed", resumed)
+	}
+
+	slow.Close() // Closing a dropped client is harmless
+}
+
+func TestBroker_Close(t *testing.T) {
+	b := newTestBroker(DefaultConfig())
+	ctx := context.Background()
+
+	first, _ := b.Subscribe(ctx, "lab-123", "")
+	second, _ := b.Subscribe(ctx, "lab-456", "")
+	b.Close()
+
+	for _, sub := range []*Subscription{first, second} {
+		if _, ok := <-sub.C(); ok {
+			t.Error("subscription still open after Close()")
+		}
+		sub.Close() // Closing an ended subscription is harmless
+	}
+}
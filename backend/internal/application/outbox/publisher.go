@@ -0,0 +1,47 @@
+// Package outbox provides the reliable delivery of domain events: services
+// publish them to the outbox within the transaction of their change, and a
+// relay delivers them to their subscribers at least once
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// IDGenerator generates unique IDs
+type IDGenerator interface {
+	Generate() string
+}
+
+// Publisher publishes events by appending them to the outbox. Within a
+// transaction, they are stored only if the transaction commits.
+type Publisher struct {
+	store outbound.OutboxStore
+	idGen IDGenerator
+	now   func() time.Time
+}
+
+// NewPublisher creates a new outbox publisher
+func NewPublisher(store outbound.OutboxStore, idGen IDGenerator) *Publisher {
+	return &Publisher{
+		store: store,
+		idGen: idGen,
+		now:   func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Publish appends events to the outbox, identifying each with the ID of its
+// message so that subscribers recognize it when it is delivered again
+func (p *Publisher) Publish(ctx context.Context, events ...event.Event) error {
+	now := p.now()
+	msgs := make([]*outbox.Message, len(events))
+	for i, e := range events {
+		id := p.idGen.Generate()
+		msgs[i] = outbox.NewMessage(id, event.WithID(e, id), now)
+	}
+	return p.store.Append(ctx, msgs...)
+}
@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+)
+
+// Deliverer delivers an event to its subscribers except those in done. It
+// returns the subscribers that handled the event, and an error when any of
+// the others failed so that the delivery to them is retried.
+type Deliverer interface {
+	Deliver(ctx context.Context, e event.Event, done []string) ([]string, error)
+}
+
+// RelayConfig holds the relay settings
+type RelayConfig struct {
+	PollInterval time.Duration      // How often due messages are looked for
+	BatchSize    int                // Messages claimed at a time
+	Lease        time.Duration      // How long a claimed message is held back from other relays
+	Retry        outbox.RetryPolicy // Attempts and delay between them of a failing message
+	Retention    time.Duration      // How long delivered and dead messages are kept; zero keeps them
+}
+
+// DefaultRelayConfig returns the default relay settings
+func DefaultRelayConfig() RelayConfig {
+	return RelayConfig{
+		PollInterval: time.Second,
+		BatchSize:    100,
+		Lease:        time.Minute,
+		Retry: outbox.RetryPolicy{
+			MaxAttempts: 20,
+			Backoff:     outbox.Backoff{Initial: time.Second, Max: 5 * time.Minute},
+		},
+		Retention: 24 * time.Hour,
+	}
+}
+
+// Relay delivers the messages of the outbox to their subscribers. A message
+// is marked delivered only after every subscriber handled it, and a retry
+// skips the subscribers that already did. A subscriber may still get an event
+// twice when the outcome of an attempt is lost (at-least-once delivery), so
+// subscribers must tolerate duplicates. Messages are attempted oldest first,
+// but a failing message doesn't hold back the ones after it, and is given up
+// on (dead) once the retry policy's attempts run out.
+type Relay struct {
+	store     outbound.OutboxStore
+	deliverer Deliverer
+	cfg       RelayConfig
+	now       func() time.Time
+	heartbeat health.Heartbeat
+}
+
+// NewRelay creates a new outbox relay
+func NewRelay(store outbound.OutboxStore, deliverer Deliverer, cfg RelayConfig) *Relay {
+	return &Relay{
+		store:     store,
+		deliverer: deliverer,
+		cfg:       cfg,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run relays due messages every poll interval until ctx is done
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	defer r.heartbeat.Stop()
+
+	for {
+		r.heartbeat.Beat()
+		if _, err := r.RelayDue(ctx); err != nil {
+			slog.ErrorContext(ctx, "outbox: failed to relay messages", "error", err)
+		}
+		if r.cfg.Retention > 0 {
+			if _, err := r.store.Purge(ctx, r.now().Add(-r.cfg.Retention)); err != nil {
+				slog.ErrorContext(ctx, "outbox: failed to purge finished messages", "error", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check reports an error unless the relay is running and polled the outbox
+// within the last three poll intervals
+func (r *Relay) Check(ctx context.Context) error {
+	return r.heartbeat.Err(3 * r.cfg.PollInterval)
+}
+
+// RelayDue attempts the delivery of every message due now and returns how
+// many were delivered
+func (r *Relay) RelayDue(ctx context.Context) (int, error) {
+	delivered := 0
+	for ctx.Err() == nil {
+		msgs, err := r.store.Claim(ctx, r.now(), r.cfg.BatchSize, r.cfg.Lease)
+		if err != nil {
+			return delivered, err
+		}
+
+		r.heartbeat.Beat()
+		for _, m := range msgs {
+			if r.attempt(ctx, m) {
+				delivered++
+			}
+		}
+		if len(msgs) < r.cfg.BatchSize {
+			break
+		}
+	}
+	return delivered, nil
+}
+
+// attempt delivers a claimed message and stores the outcome
+func (r *Relay) attempt(ctx context.Context, m *outbox.Message) bool {
+	ctx = logging.WithLaboratoryID(ctx, m.Event.Meta().LaboratoryID)
+
+	handled, err := r.deliverer.Deliver(ctx, m.Event, m.DeliveredTo)
+	m.MarkHandled(handled)
+	if err != nil {
+		m.MarkFailed(err, r.now(), r.cfg.Retry)
+		if m.IsDead() {
+			slog.ErrorContext(ctx, "outbox: delivery failed, giving up", "message_id", m.ID, "event", m.Event.Name(), "attempts", m.Attempts, "delivered_to", m.DeliveredTo, "error", err)
+		} else {
+			slog.WarnContext(ctx, "outbox: delivery failed, retrying", "message_id", m.ID, "event", m.Event.Name(), "attempts", m.Attempts, "next_attempt_at", m.NextAttemptAt, "error", err)
+		}
+	} else {
+		m.MarkDelivered(r.now())
+	}
+
+	// The message stays claimed until its lease ends if the outcome can't be
+	// stored, and is then delivered again
+	if updateErr := r.store.Update(ctx, m); updateErr != nil {
+		slog.ErrorContext(ctx, "outbox: failed to store the delivery of a message", "message_id", m.ID, "error", updateErr)
+	}
+	return err == nil
+}
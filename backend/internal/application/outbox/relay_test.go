@@ -0,0 +1,210 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+type sequenceIDGenerator struct {
+	n int
+}
+
+func (g *sequenceIDGenerator) Generate() string {
+	g.n++
+	return "msg-" + strconv.Itoa(g.n)
+}
+
+// mockDeliverer records deliveries and fails those of the laboratories in failing
+type mockDeliverer struct {
+	mu        sync.Mutex
+	delivered []string
+	failing   map[string]bool
+}
+
+func (d *mockDeliverer) Deliver(ctx context.Context, e event.Event, done []string) ([]string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.failing[e.Meta().LaboratoryID] {
+		return nil, errors.New("subscriber unavailable")
+	}
+	d.delivered = append(d.delivered, e.Meta().LaboratoryID)
+	return []string{"mock"}, nil
+}
+
+func (d *mockDeliverer) count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.delivered)
+}
+
+func created(laboratoryID string) event.Event {
+	return event.OrderCreated{Metadata: event.NewMetadata(laboratoryID)}
+}
+
+func TestRelay_RelayDue(t *testing.T) {
+	store := memory.NewOutboxStore()
+	publisher := NewPublisher(store, &sequenceIDGenerator{})
+	deliverer := &mockDeliverer{failing: map[string]bool{"lab-2": true}}
+
+	cfg := DefaultRelayConfig()
+	cfg.BatchSize = 2
+	cfg.Retry = outbox.RetryPolicy{MaxAttempts: 3, Backoff: outbox.Backoff{Initial: time.Minute, Max: time.Hour}}
+	relay := NewRelay(store, deliverer, cfg)
+	now := time.Now().UTC()
+	relay.now = func() time.Time { return now }
+	publisher.now = relay.now
+
+	ctx := context.Background()
+	if err := publisher.Publish(ctx, created("lab-1"), created("lab-2"), created("lab-3")); err != nil {
+		t.Fatalf("Publish() unexpected error = %v", err)
+	}
+
+	delivered, err := relay.RelayDue(ctx)
+	if err != nil || delivered != 2 {
+		t.Fatalf("RelayDue() = %d, %v, want 2 delivered across batches", delivered, err)
+	}
+
+	// The failed message waits for its backoff
+	if delivered, _ := relay.RelayDue(ctx); delivered != 0 {
+		t.Errorf("RelayDue() before the backoff = %d, want 0", delivered)
+	}
+
+	deliverer.failing = nil
+	now = now.Add(time.Minute)
+	if delivered, _ := relay.RelayDue(ctx); delivered != 1 {
+		t.Errorf("RelayDue() after the backoff = %d, want the retried message", delivered)
+	}
+	if got := deliverer.delivered; len(got) != 3 || got[2] != "lab-2" {
+		t.Errorf("delivered %v, want every event once", got)
+	}
+
+	// Delivered messages are never relayed again
+	now = now.Add(time.Hour)
+	if delivered, _ := relay.RelayDue(ctx); delivered != 0 {
+		t.Errorf("RelayDue() once delivered = %d, want 0", delivered)
+	}
+}
+
+func TestRelay_RetriesFailedSubscribersOnly(t *testing.T) {
+	store := memory.NewOutboxStore()
+	bus := eventbus.NewSyncBus()
+	calls := map[string]int{}
+	var flakyIDs []string
+	failing := true
+	bus.SubscribeAll("steady", func(ctx context.Context, e event.Event) error {
+		calls["steady"]++
+		return nil
+	})
+	bus.SubscribeAll("flaky", func(ctx context.Context, e event.Event) error {
+		calls["flaky"]++
+		flakyIDs = append(flakyIDs, e.Meta().ID)
+		if failing {
+			return errors.New("subscriber unavailable")
+		}
+		return nil
+	})
+
+	relay := NewRelay(store, bus, DefaultRelayConfig())
+	now := time.Now().UTC()
+	relay.now = func() time.Time { return now }
+
+	publisher := NewPublisher(store, &sequenceIDGenerator{})
+	publisher.now = relay.now
+
+	ctx := context.Background()
+	if err := publisher.Publish(ctx, created("lab-1")); err != nil {
+		t.Fatalf("Publish() unexpected error = %v", err)
+	}
+	if delivered, _ := relay.RelayDue(ctx); delivered != 0 {
+		t.Fatalf("RelayDue() with a failing subscriber = %d, want 0", delivered)
+	}
+
+	failing = false
+	now = now.Add(time.Hour)
+	if delivered, _ := relay.RelayDue(ctx); delivered != 1 {
+		t.Fatalf("RelayDue() after the backoff = %d, want 1", delivered)
+	}
+	if calls["steady"] != 1 || calls["flaky"] != 2 {
+		t.Errorf("handler calls = %v, want steady once and flaky twice", calls)
+	}
+	if len(flakyIDs) != 2 || flakyIDs[0] == "" || flakyIDs[0] != flakyIDs[1] {
+		t.Errorf("event IDs seen by the retried subscriber = %v, want the same ID twice", flakyIDs)
+	}
+}
+
+func TestRelay_GivesUpAfterMaxAttempts(t *testing.T) {
+	store := memory.NewOutboxStore()
+	deliverer := &mockDeliverer{failing: map[string]bool{"lab-1": true}}
+
+	cfg := DefaultRelayConfig()
+	cfg.Retry = outbox.RetryPolicy{MaxAttempts: 2, Backoff: outbox.Backoff{Initial: time.Minute, Max: time.Minute}}
+	relay := NewRelay(store, deliverer, cfg)
+	now := time.Now().UTC()
+	relay.now = func() time.Time { return now }
+
+	publisher := NewPublisher(store, &sequenceIDGenerator{})
+	publisher.now = relay.now
+
+	ctx := context.Background()
+	if err := publisher.Publish(ctx, created("lab-1")); err != nil {
+		t.Fatalf("Publish() unexpected error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := relay.RelayDue(ctx); err != nil {
+			t.Fatalf("RelayDue() unexpected error = %v", err)
+		}
+		now = now.Add(time.Hour)
+	}
+
+	msgs, _ := store.Claim(ctx, now, 10, time.Minute)
+	if len(msgs) != 0 {
+		t.Errorf("Claim() after the attempts ran out = %d messages, want the message dead", len(msgs))
+	}
+}
+
+func TestRelay_Run(t *testing.T) {
+	store := memory.NewOutboxStore()
+	deliverer := &mockDeliverer{}
+
+	cfg := DefaultRelayConfig()
+	cfg.PollInterval = 5 * time.Millisecond
+	relay := NewRelay(store, deliverer, cfg)
+	if err := relay.Check(context.Background()); err == nil {
+		t.Error("Check() before Run() = nil, want an error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+
+	_ = NewPublisher(store, &sequenceIDGenerator{}).Publish(context.Background(), created("lab-1"))
+
+	deadline := time.Now().Add(time.Second)
+	for deliverer.count() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if err := relay.Check(context.Background()); err != nil {
+		t.Errorf("Check() while running = %v, want nil", err)
+	}
+	cancel()
+	<-done
+
+	if deliverer.count() != 1 {
+		t.Errorf("Run() delivered %d events, want 1", deliverer.count())
+	}
+	if err := relay.Check(context.Background()); err == nil {
+		t.Error("Check() after Run() returned = nil, want an error")
+	}
+}
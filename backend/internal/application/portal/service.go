@@ -0,0 +1,162 @@
+package portal
+
+import (
+	"context"
+	"log/slog"
+
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
+)
+
+// Service provides client portal use cases for dentists bound to a client
+type Service struct {
+	clientRepo outbound.ClientRepository
+	orders     *orderapp.Service
+}
+
+// NewService creates a new client portal service
+func NewService(clientRepo outbound.ClientRepository, orders *orderapp.Service) *Service {
+	return &Service{
+		clientRepo: clientRepo,
+		orders:     orders,
+	}
+}
+
+// CurrentClient resolves the client bound to the portal identity
+func (s *Service) CurrentClient(ctx context.Context, userID string) (_ *client.Client, err error) {
+	ctx, span := tracing.Start(ctx, "portal.CurrentClient", tracing.UserIDKey.String(userID))
+	defer tracing.End(span, &err)
+
+	if userID == "" {
+		return nil, errors.ErrUnauthorized
+	}
+
+	c, err := s.clientRepo.GetByPortalUserID(ctx, userID)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrForbidden // Identity is not bound to any client
+		}
+		slog.ErrorContext(ctx, "portal: failed to look up the client of a portal user", "portal_user_id", userID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	return c, nil
+}
+
+// IsPortalUser reports whether an identity is bound to a client, and so may
+// only use the portal
+func (s *Service) IsPortalUser(ctx context.Context, userID string) (bool, error) {
+	if userID == "" {
+		return false, nil
+	}
+
+	_, err := s.clientRepo.GetByPortalUserID(ctx, userID)
+	switch {
+	case err == errors.ErrNotFound:
+		return false, nil
+	case err != nil:
+		slog.ErrorContext(ctx, "portal: failed to look up the client of a portal user", "portal_user_id", userID, "error", err)
+		return false, errors.ErrInternal
+	}
+	return true, nil
+}
+
+// CreateOrder creates an order on behalf of the client bound to the portal identity
+func (s *Service) CreateOrder(ctx context.Context, userID string, items []order.ProsthesisItem) (_ *order.Order, err error) {
+	ctx, span := tracing.Start(ctx, "portal.CreateOrder", tracing.UserIDKey.String(userID))
+	defer tracing.End(span, &err)
+
+	c, err := s.CurrentClient(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.orders.CreateOrder(ctx, orderapp.CreateInput{
+		ClientID:     c.ID,
+		LaboratoryID: c.LaboratoryID,
+		Prosthesis:   items,
+	})
+}
+
+// ListOrders retrieves a page of active orders of the client bound to the portal identity matching the search criteria
+func (s *Service) ListOrders(ctx context.Context, userID string, criteria order.SearchCriteria, q listing.Query) (_ listing.Page[*order.Order], err error) {
+	ctx, span := tracing.Start(ctx, "portal.ListOrders", tracing.UserIDKey.String(userID))
+	defer tracing.End(span, &err)
+
+	c, err := s.CurrentClient(ctx, userID)
+	if err != nil {
+		return listing.Page[*order.Order]{}, err
+	}
+
+	return s.orders.ListOrdersByClient(ctx, c.ID, c.LaboratoryID, criteria, q)
+}
+
+// GetOrder retrieves an order owned by the client bound to the portal identity
+func (s *Service) GetOrder(ctx context.Context, userID, orderID string) (_ *order.Order, err error) {
+	ctx, span := tracing.Start(ctx, "portal.GetOrder", tracing.UserIDKey.String(userID), tracing.EntityID("order", orderID))
+	defer tracing.End(span, &err)
+
+	c, err := s.CurrentClient(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.orders.GetOrder(ctx, orderID, c.LaboratoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check client scope
+	if o.ClientID != c.ID {
+		return nil, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	return o, nil
+}
+
+// UploadAttachmentInput represents the input for uploading an order attachment
+type UploadAttachmentInput struct {
+	UserID      string
+	OrderID     string
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// UploadAttachment stores a file and attaches it to an order owned by the portal client
+func (s *Service) UploadAttachment(ctx context.Context, input UploadAttachmentInput) (_ *order.Attachment, err error) {
+	ctx, span := tracing.Start(ctx, "portal.UploadAttachment", tracing.UserIDKey.String(input.UserID), tracing.EntityID("order", input.OrderID))
+	defer tracing.End(span, &err)
+
+	o, err := s.GetOrder(ctx, input.UserID, input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.orders.AddAttachment(ctx, orderapp.AddAttachmentInput{
+		ID:           o.ID,
+		LaboratoryID: o.LaboratoryID,
+		FileName:     input.FileName,
+		ContentType:  input.ContentType,
+		Data:         input.Data,
+		UploadedBy:   input.UserID,
+	})
+}
+
+// DownloadAttachment retrieves an attachment of an order owned by the portal client
+func (s *Service) DownloadAttachment(ctx context.Context, userID, orderID, attachmentID string) (_ *order.Attachment, _ []byte, err error) {
+	ctx, span := tracing.Start(ctx, "portal.DownloadAttachment", tracing.UserIDKey.String(userID), tracing.EntityID("order", orderID), tracing.EntityID("attachment", attachmentID))
+	defer tracing.End(span, &err)
+
+	o, err := s.GetOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.orders.DownloadAttachment(ctx, o.ID, o.LaboratoryID, attachmentID)
+}
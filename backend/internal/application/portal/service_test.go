@@ -0,0 +1,206 @@
+package portal
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/eventbus"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	orderapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+// sequenceIDGenerator is a mock ID generator returning predictable IDs
+type sequenceIDGenerator struct {
+	ids []string
+	n   int
+}
+
+func (g *sequenceIDGenerator) Generate() string {
+	id := g.ids[g.n%len(g.ids)]
+	g.n++
+	return id
+}
+
+func setupPortalService(t *testing.T) (*Service, *memory.OrderRepository) {
+	t.Helper()
+
+	clientRepo := memory.NewClientRepository()
+	orderRepo := memory.NewOrderRepository()
+	idGen := &sequenceIDGenerator{ids: []string{"order-new", "att-1"}}
+	storage := memory.NewAttachmentStorage()
+	orders := orderapp.NewService(orderRepo, clientRepo, memory.NewTechnicianRepository(), memory.NewProsthesisRepository(), storage, idGen, auditapp.NopRecorder{}, eventbus.NewSyncBus(), memory.NewTransactor())
+	svc := NewService(clientRepo, orders)
+
+	ctx := context.Background()
+	for _, c := range []*client.Client{
+		{ID: "client-1", LaboratoryID: "lab-123", Name: "Dr. One", PortalUserID: "user_one"},
+		{ID: "client-2", LaboratoryID: "lab-123", Name: "Dr. Two", PortalUserID: "user_two"},
+		{ID: "client-3", LaboratoryID: "lab-123", Name: "Dr. Three"},
+	} {
+		c.CreatedAt = time.Now().UTC()
+		c.UpdatedAt = c.CreatedAt
+		_ = clientRepo.Create(ctx, c)
+	}
+
+	for _, o := range []*order.Order{
+		{ID: "order-1", ClientID: "client-1", LaboratoryID: "lab-123", Status: order.StatusReceived},
+		{ID: "order-2", ClientID: "client-2", LaboratoryID: "lab-123", Status: order.StatusReceived},
+	} {
+		o.Prosthesis = []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}}
+		_ = orderRepo.Create(ctx, o)
+	}
+
+	return svc, orderRepo
+}
+
+func TestService_CurrentClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     string
+		wantClient string
+		wantErr    error
+	}{
+		{"linked identity", "user_one", "client-1", nil},
+		{"anonymous identity", "", "", errors.ErrUnauthorized},
+		{"identity not linked to any client", "user_unknown", "", errors.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := setupPortalService(t)
+
+			c, err := svc.CurrentClient(context.Background(), tt.userID)
+
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Errorf("CurrentClient() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CurrentClient() unexpected error = %v", err)
+			}
+			if c.ID != tt.wantClient {
+				t.Errorf("CurrentClient() ID = %v, want %v", c.ID, tt.wantClient)
+			}
+		})
+	}
+}
+
+func TestService_ListOrders_OnlyOwnOrders(t *testing.T) {
+	svc, _ := setupPortalService(t)
+
+	page, err := svc.ListOrders(context.Background(), "user_one", order.SearchCriteria{}, listing.Query{})
+	if err != nil {
+		t.Fatalf("ListOrders() unexpected error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != "order-1" {
+		t.Errorf("ListOrders() = %v, want only order-1", page.Items)
+	}
+}
+
+func TestService_GetOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  string
+		orderID string
+		wantErr error
+	}{
+		{"own order", "user_one", "order-1", nil},
+		{"order of another client", "user_one", "order-2", errors.ErrNotFound},
+		{"non-existent order", "user_one", "missing", errors.ErrNotFound},
+		{"identity not linked", "user_unknown", "order-1", errors.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := setupPortalService(t)
+
+			o, err := svc.GetOrder(context.Background(), tt.userID, tt.orderID)
+
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Errorf("GetOrder() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetOrder() unexpected error = %v", err)
+			}
+			if o.ID != tt.orderID {
+				t.Errorf("GetOrder() ID = %v, want %v", o.ID, tt.orderID)
+			}
+		})
+	}
+}
+
+func TestService_CreateOrder_ForBoundClient(t *testing.T) {
+	svc, _ := setupPortalService(t)
+
+	o, err := svc.CreateOrder(context.Background(), "user_two", []order.ProsthesisItem{
+		{Type: "bridge", Material: "porcelain", Quantity: 1},
+	})
+	if err != nil {
+		t.Fatalf("CreateOrder() unexpected error = %v", err)
+	}
+	if o.ClientID != "client-2" || o.LaboratoryID != "lab-123" {
+		t.Errorf("CreateOrder() ClientID/LaboratoryID = %v/%v, want client-2/lab-123", o.ClientID, o.LaboratoryID)
+	}
+}
+
+func TestService_UploadAndDownloadAttachment(t *testing.T) {
+	svc, orderRepo := setupPortalService(t)
+	ctx := context.Background()
+
+	_, err := svc.UploadAttachment(ctx, UploadAttachmentInput{
+		UserID:      "user_two",
+		OrderID:     "order-1",
+		FileName:    "scan.stl",
+		ContentType: "model/stl",
+		Data:        []byte("solid scan"),
+	})
+	if !stderrors.Is(err, errors.ErrNotFound) {
+		t.Errorf("UploadAttachment() to another client's order error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	attachment, err := svc.UploadAttachment(ctx, UploadAttachmentInput{
+		UserID:      "user_one",
+		OrderID:     "order-1",
+		FileName:    "scan.stl",
+		ContentType: "model/stl",
+		Data:        []byte("solid scan"),
+	})
+	if err != nil {
+		t.Fatalf("UploadAttachment() unexpected error = %v", err)
+	}
+
+	stored, _ := orderRepo.GetByID(ctx, "order-1")
+	if len(stored.Attachments) != 1 || stored.Attachments[0].ID != attachment.ID {
+		t.Errorf("UploadAttachment() stored attachments = %v, want %v", stored.Attachments, attachment.ID)
+	}
+
+	got, data, err := svc.DownloadAttachment(ctx, "user_one", "order-1", attachment.ID)
+	if err != nil {
+		t.Fatalf("DownloadAttachment() unexpected error = %v", err)
+	}
+	if got.FileName != "scan.stl" || string(data) != "solid scan" {
+		t.Errorf("DownloadAttachment() = %v %q, want scan.stl %q", got.FileName, data, "solid scan")
+	}
+
+	_, err = svc.UploadAttachment(ctx, UploadAttachmentInput{
+		UserID:      "user_one",
+		OrderID:     "order-1",
+		FileName:    "empty.stl",
+		ContentType: "model/stl",
+	})
+	if !stderrors.Is(err, errors.ErrInvalidInput) {
+		t.Errorf("UploadAttachment() empty file error = %v, want %v", err, errors.ErrInvalidInput)
+	}
+}
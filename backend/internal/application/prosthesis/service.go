@@ -2,10 +2,16 @@ package prosthesis
 
 import (
 	"context"
+	"log/slog"
 
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
 )
 
 // Service provides prosthesis use cases
@@ -13,6 +19,9 @@ type Service struct {
 	prosthesisRepo outbound.ProsthesisRepository
 	labRepo        outbound.LaboratoryRepository
 	idGen          IDGenerator
+	auditor        auditapp.Recorder
+	events         outbound.EventPublisher
+	tx             outbound.Transactor
 }
 
 // IDGenerator generates unique IDs
@@ -21,11 +30,14 @@ type IDGenerator interface {
 }
 
 // NewService creates a new prosthesis service
-func NewService(prosthesisRepo outbound.ProsthesisRepository, labRepo outbound.LaboratoryRepository, idGen IDGenerator) *Service {
+func NewService(prosthesisRepo outbound.ProsthesisRepository, labRepo outbound.LaboratoryRepository, idGen IDGenerator, auditor auditapp.Recorder, events outbound.EventPublisher, tx outbound.Transactor) *Service {
 	return &Service{
 		prosthesisRepo: prosthesisRepo,
 		labRepo:        labRepo,
 		idGen:          idGen,
+		auditor:        auditor,
+		events:         events,
+		tx:             tx,
 	}
 }
 
@@ -40,13 +52,17 @@ type CreateInput struct {
 }
 
 // CreateProsthesis creates a new prosthesis
-func (s *Service) CreateProsthesis(ctx context.Context, input CreateInput) (*prosthesis.Prosthesis, error) {
+func (s *Service) CreateProsthesis(ctx context.Context, input CreateInput) (_ *prosthesis.Prosthesis, err error) {
+	ctx, span := tracing.Start(ctx, "prosthesis.CreateProsthesis", tracing.LaboratoryID(input.LaboratoryID))
+	defer tracing.End(span, &err)
+
 	// Validate laboratory exists
-	_, err := s.labRepo.GetByID(ctx, input.LaboratoryID)
+	_, err = s.labRepo.GetByID(ctx, input.LaboratoryID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "prosthesis: failed to load a laboratory", "laboratory_id", input.LaboratoryID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -58,20 +74,36 @@ func (s *Service) CreateProsthesis(ctx context.Context, input CreateInput) (*pro
 	}
 
 	// Persist
-	if err := s.prosthesisRepo.Create(ctx, p); err != nil {
+	e := event.ProsthesisCreated{Metadata: event.NewMetadata(p.LaboratoryID), Prosthesis: *p}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.prosthesisRepo.Create(ctx, p)
+	}); err != nil {
+		slog.ErrorContext(ctx, "prosthesis: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: p.LaboratoryID,
+		EntityType:   audit.EntityProsthesis,
+		EntityID:     p.ID,
+		Action:       audit.ActionCreate,
+		After:        p,
+	})
+
 	return p, nil
 }
 
 // GetProsthesis retrieves a prosthesis by ID (laboratory-scoped)
-func (s *Service) GetProsthesis(ctx context.Context, id, laboratoryID string) (*prosthesis.Prosthesis, error) {
+func (s *Service) GetProsthesis(ctx context.Context, id, laboratoryID string) (_ *prosthesis.Prosthesis, err error) {
+	ctx, span := tracing.Start(ctx, "prosthesis.GetProsthesis", tracing.LaboratoryID(laboratoryID), tracing.EntityID("prosthesis", id))
+	defer tracing.End(span, &err)
+
 	p, err := s.prosthesisRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "prosthesis: failed to load a prosthesis", "prosthesis_id", id, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -83,6 +115,28 @@ func (s *Service) GetProsthesis(ctx context.Context, id, laboratoryID string) (*
 	return p, nil
 }
 
+// GetProstheses retrieves the prostheses with the given IDs in one lookup
+// (laboratory-scoped); IDs without a match are skipped
+func (s *Service) GetProstheses(ctx context.Context, ids []string, laboratoryID string) (_ []*prosthesis.Prosthesis, err error) {
+	ctx, span := tracing.Start(ctx, "prosthesis.GetProstheses", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	found, err := s.prosthesisRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "prosthesis: failed to load prostheses", "ids", ids, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	prostheses := make([]*prosthesis.Prosthesis, 0, len(found))
+	for _, p := range found {
+		if p.LaboratoryID == laboratoryID {
+			prostheses = append(prostheses, p)
+		}
+	}
+
+	return prostheses, nil
+}
+
 // UpdateInput represents the input for updating a prosthesis
 type UpdateInput struct {
 	ID             string
@@ -95,13 +149,17 @@ type UpdateInput struct {
 }
 
 // UpdateProsthesis updates an existing prosthesis
-func (s *Service) UpdateProsthesis(ctx context.Context, input UpdateInput) (*prosthesis.Prosthesis, error) {
+func (s *Service) UpdateProsthesis(ctx context.Context, input UpdateInput) (_ *prosthesis.Prosthesis, err error) {
+	ctx, span := tracing.Start(ctx, "prosthesis.UpdateProsthesis", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("prosthesis", input.ID))
+	defer tracing.End(span, &err)
+
 	// Get existing prosthesis
 	p, err := s.prosthesisRepo.GetByID(ctx, input.ID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "prosthesis: failed to load a prosthesis", "prosthesis_id", input.ID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -110,47 +168,89 @@ func (s *Service) UpdateProsthesis(ctx context.Context, input UpdateInput) (*pro
 		return nil, errors.ErrNotFound // Security: don't reveal existence
 	}
 
+	return s.update(ctx, p, input)
+}
+
+// PatchProsthesis updates a prosthesis with the input patch derives from its current
+// state, e.g. by applying a JSON merge patch
+func (s *Service) PatchProsthesis(ctx context.Context, id, laboratoryID string, patch func(current prosthesis.Prosthesis) (UpdateInput, error)) (_ *prosthesis.Prosthesis, err error) {
+	ctx, span := tracing.Start(ctx, "prosthesis.PatchProsthesis", tracing.LaboratoryID(laboratoryID), tracing.EntityID("prosthesis", id))
+	defer tracing.End(span, &err)
+
+	p, err := s.GetProsthesis(ctx, id, laboratoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := patch(*p)
+	if err != nil {
+		return nil, err
+	}
+	input.ID, input.LaboratoryID = p.ID, p.LaboratoryID
+	return s.update(ctx, p, input)
+}
+
+// update applies the input to a prosthesis loaded for the update and stores it
+func (s *Service) update(ctx context.Context, p *prosthesis.Prosthesis, input UpdateInput) (*prosthesis.Prosthesis, error) {
+	before := *p
+
 	// Update prosthesis
 	if err := p.Update(input.Type, input.Material, input.Shade, input.Specifications, input.Notes); err != nil {
 		return nil, err
 	}
 
 	// Persist
-	if err := s.prosthesisRepo.Update(ctx, p); err != nil {
+	e := event.ProsthesisUpdated{Metadata: event.NewMetadata(p.LaboratoryID), Prosthesis: *p}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.prosthesisRepo.Update(ctx, p)
+	}); err != nil {
+		slog.ErrorContext(ctx, "prosthesis: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: p.LaboratoryID,
+		EntityType:   audit.EntityProsthesis,
+		EntityID:     p.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        p,
+	})
+
 	return p, nil
 }
 
-// ListProstheses retrieves all active prostheses for a laboratory, optionally filtered by type or material
-func (s *Service) ListProstheses(ctx context.Context, laboratoryID string, prosthesisType *prosthesis.ProsthesisType, material *string) ([]*prosthesis.Prosthesis, error) {
-	var prostheses []*prosthesis.Prosthesis
-	var err error
+// ListProstheses retrieves a page of active prostheses for a laboratory, optionally filtered by type, material or shade
+func (s *Service) ListProstheses(ctx context.Context, laboratoryID string, q listing.Query) (_ listing.Page[*prosthesis.Prosthesis], err error) {
+	ctx, span := tracing.Start(ctx, "prosthesis.ListProstheses", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
 
-	if prosthesisType != nil {
-		prostheses, err = s.prosthesisRepo.FindByType(ctx, laboratoryID, *prosthesisType)
-	} else if material != nil {
-		prostheses, err = s.prosthesisRepo.FindByMaterial(ctx, laboratoryID, *material)
-	} else {
-		prostheses, err = s.prosthesisRepo.List(ctx, laboratoryID)
+	q, err = q.Normalize(prosthesis.ListSpec)
+	if err != nil {
+		return listing.Page[*prosthesis.Prosthesis]{}, err
 	}
 
+	page, err := s.prosthesisRepo.List(ctx, laboratoryID, q)
 	if err != nil {
-		return nil, errors.ErrInternal
+		slog.ErrorContext(ctx, "prosthesis: failed to list prostheses", "error", err)
+		return listing.Page[*prosthesis.Prosthesis]{}, errors.ErrInternal
 	}
 
-	return prostheses, nil
+	return page, nil
 }
 
 // DeleteProsthesis performs a soft delete on a prosthesis (laboratory-scoped)
-func (s *Service) DeleteProsthesis(ctx context.Context, id, laboratoryID string) error {
+func (s *Service) DeleteProsthesis(ctx context.Context, id, laboratoryID string) (err error) {
+	ctx, span := tracing.Start(ctx, "prosthesis.DeleteProsthesis", tracing.LaboratoryID(laboratoryID), tracing.EntityID("prosthesis", id))
+	defer tracing.End(span, &err)
+
 	// Check if prosthesis exists and belongs to the laboratory
 	p, err := s.prosthesisRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "prosthesis: failed to load a prosthesis", "prosthesis_id", id, "error", err)
 		return errors.ErrInternal
 	}
 
@@ -160,9 +260,31 @@ func (s *Service) DeleteProsthesis(ctx context.Context, id, laboratoryID string)
 	}
 
 	// Delete
-	if err := s.prosthesisRepo.Delete(ctx, id); err != nil {
+	e := event.ProsthesisDeleted{Metadata: event.NewMetadata(p.LaboratoryID), Prosthesis: *p}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.prosthesisRepo.Delete(ctx, id)
+	}); err != nil {
+		slog.ErrorContext(ctx, "prosthesis: failed to store a change", "event", e.Name(), "error", err)
 		return errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: p.LaboratoryID,
+		EntityType:   audit.EntityProsthesis,
+		EntityID:     p.ID,
+		Action:       audit.ActionDelete,
+		Before:       p,
+	})
+
 	return nil
 }
+
+// persist runs a write and publishes its event in a single transaction
+func (s *Service) persist(ctx context.Context, e event.Event, write func(ctx context.Context) error) error {
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, e)
+	})
+}
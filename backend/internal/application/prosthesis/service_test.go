@@ -3,10 +3,14 @@ package prosthesis
 import (
 	"context"
 	stderrors "errors"
+	"strings"
 	"testing"
 
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
 )
 
@@ -19,6 +23,23 @@ func (m *mockIDGenerator) Generate() string {
 	return m.id
 }
 
+// mockEventPublisher is a mock event publisher for testing that records the published events
+type mockEventPublisher struct {
+	events []event.Event
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// mockTransactor is a mock transactor for testing that runs units of work without a transaction
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // mockProsthesisRepository is a mock prosthesis repository for testing
 type mockProsthesisRepository struct {
 	prostheses map[string]*prosthesis.Prosthesis
@@ -56,6 +77,16 @@ func (m *mockProsthesisRepository) GetByID(ctx context.Context, id string) (*pro
 	return p, nil
 }
 
+func (m *mockProsthesisRepository) GetByIDs(ctx context.Context, ids []string) ([]*prosthesis.Prosthesis, error) {
+	var result []*prosthesis.Prosthesis
+	for _, id := range ids {
+		if v, exists := m.prostheses[id]; exists && !v.IsDeleted() {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
 func (m *mockProsthesisRepository) Update(ctx context.Context, p *prosthesis.Prosthesis) error {
 	if m.updateErr != nil {
 		return m.updateErr
@@ -79,17 +110,24 @@ func (m *mockProsthesisRepository) Delete(ctx context.Context, id string) error
 	return nil
 }
 
-func (m *mockProsthesisRepository) List(ctx context.Context, laboratoryID string) ([]*prosthesis.Prosthesis, error) {
+func (m *mockProsthesisRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*prosthesis.Prosthesis], error) {
 	if m.listErr != nil {
-		return nil, m.listErr
+		return listing.Page[*prosthesis.Prosthesis]{}, m.listErr
 	}
 	var prostheses []*prosthesis.Prosthesis
 	for _, p := range m.prostheses {
-		if p.LaboratoryID == laboratoryID && !p.IsDeleted() {
-			prostheses = append(prostheses, p)
+		if p.LaboratoryID != laboratoryID || p.IsDeleted() {
+			continue
+		}
+		if pt, ok := q.Filters["type"]; ok && string(p.Type) != pt {
+			continue
 		}
+		if material, ok := q.Filters["material"]; ok && !strings.EqualFold(p.Material, material) {
+			continue
+		}
+		prostheses = append(prostheses, p)
 	}
-	return prostheses, nil
+	return listing.Page[*prosthesis.Prosthesis]{Items: prostheses, Total: len(prostheses)}, nil
 }
 
 func (m *mockProsthesisRepository) FindByType(ctx context.Context, laboratoryID string, prosthesisType prosthesis.ProsthesisType) ([]*prosthesis.Prosthesis, error) {
@@ -158,8 +196,8 @@ func (m *mockLaboratoryRepository) Delete(ctx context.Context, id string) error
 	return nil
 }
 
-func (m *mockLaboratoryRepository) List(ctx context.Context) ([]*laboratory.Laboratory, error) {
-	return nil, nil
+func (m *mockLaboratoryRepository) List(ctx context.Context, q listing.Query) (listing.Page[*laboratory.Laboratory], error) {
+	return listing.Page[*laboratory.Laboratory]{}, nil
 }
 
 func TestService_CreateProsthesis(t *testing.T) {
@@ -225,7 +263,7 @@ func TestService_CreateProsthesis(t *testing.T) {
 			tt.setupRepo(pr, lr)
 
 			idGen := &mockIDGenerator{id: tt.mockID}
-			svc := NewService(pr, lr, idGen)
+			svc := NewService(pr, lr, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			ctx := context.Background()
 			p, err := svc.CreateProsthesis(ctx, tt.input)
@@ -300,7 +338,7 @@ func TestService_GetProsthesis(t *testing.T) {
 			tt.setupRepo(pr)
 
 			idGen := &mockIDGenerator{}
-			svc := NewService(pr, lr, idGen)
+			svc := NewService(pr, lr, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			ctx := context.Background()
 			p, err := svc.GetProsthesis(ctx, tt.id, tt.labID)
@@ -380,7 +418,7 @@ func TestService_UpdateProsthesis(t *testing.T) {
 			tt.setupRepo(pr)
 
 			idGen := &mockIDGenerator{}
-			svc := NewService(pr, lr, idGen)
+			svc := NewService(pr, lr, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			ctx := context.Background()
 			p, err := svc.UpdateProsthesis(ctx, tt.input)
@@ -472,18 +510,25 @@ func TestService_ListProstheses(t *testing.T) {
 			tt.setupRepo(pr)
 
 			idGen := &mockIDGenerator{}
-			svc := NewService(pr, lr, idGen)
+			svc := NewService(pr, lr, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			ctx := context.Background()
-			prostheses, err := svc.ListProstheses(ctx, tt.laboratoryID, tt.prosthesisType, tt.material)
+			q := listing.Query{Filters: map[string]string{}}
+			if tt.prosthesisType != nil {
+				q.Filters["type"] = string(*tt.prosthesisType)
+			}
+			if tt.material != nil {
+				q.Filters["material"] = *tt.material
+			}
+			page, err := svc.ListProstheses(ctx, tt.laboratoryID, q)
 
 			if !stderrors.Is(err, tt.wantErr) {
 				t.Errorf("ListProstheses() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
-			if len(prostheses) != tt.wantCount {
-				t.Errorf("ListProstheses() count = %v, want %v", len(prostheses), tt.wantCount)
+			if len(page.Items) != tt.wantCount {
+				t.Errorf("ListProstheses() count = %v, want %v", len(page.Items), tt.wantCount)
 			}
 		})
 	}
@@ -533,7 +578,7 @@ func TestService_DeleteProsthesis(t *testing.T) {
 			tt.setupRepo(pr)
 
 			idGen := &mockIDGenerator{}
-			svc := NewService(pr, lr, idGen)
+			svc := NewService(pr, lr, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			ctx := context.Background()
 			err := svc.DeleteProsthesis(ctx, tt.id, tt.labID)
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// PurgeDeletedJob is the name of the job purging old soft-deleted records
+const PurgeDeletedJob = "purge-deleted"
+
+// PurgeDeleted returns a task permanently removing the records soft-deleted
+// more than retention ago. Purgers are keyed by the name of their records,
+// e.g. "orders", as reported in the run summary.
+func PurgeDeleted(purgers map[string]outbound.DeletedPurger, retention time.Duration) Task {
+	names := make([]string, 0, len(purgers))
+	for name := range purgers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return func(ctx context.Context) (string, error) {
+		before := time.Now().UTC().Add(-retention)
+
+		var purged []string
+		for _, name := range names {
+			n, err := purgers[name].PurgeDeleted(ctx, before)
+			if err != nil {
+				return "", fmt.Errorf("purging %s: %w", name, err)
+			}
+			if n > 0 {
+				purged = append(purged, fmt.Sprintf("%d %s", n, name))
+			}
+		}
+
+		if len(purged) == 0 {
+			return "nothing to purge", nil
+		}
+		return "purged " + strings.Join(purged, ", "), nil
+	}
+}
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// mockPurger purges n records or fails with err
+type mockPurger struct {
+	n      int
+	err    error
+	before time.Time
+}
+
+func (p *mockPurger) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	p.before = deletedBefore
+	return p.n, p.err
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	tests := []struct {
+		name       string
+		purgers    map[string]*mockPurger
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name:       "purged",
+			purgers:    map[string]*mockPurger{"orders": {n: 3}, "clients": {n: 1}, "comments": {}},
+			wantResult: "purged 1 clients, 3 orders",
+		},
+		{
+			name:       "nothing to purge",
+			purgers:    map[string]*mockPurger{"orders": {}},
+			wantResult: "nothing to purge",
+		},
+		{
+			name:    "failed",
+			purgers: map[string]*mockPurger{"orders": {err: stderrors.New("store unavailable")}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purgers := make(map[string]outbound.DeletedPurger)
+			for name, p := range tt.purgers {
+				purgers[name] = p
+			}
+
+			result, err := PurgeDeleted(purgers, 30*24*time.Hour)(context.Background())
+			if (err != nil) != tt.wantErr || result != tt.wantResult {
+				t.Fatalf("PurgeDeleted() = %q, %v, want %q", result, err, tt.wantResult)
+			}
+
+			cutoff := time.Now().UTC().Add(-30 * 24 * time.Hour)
+			for name, p := range tt.purgers {
+				if p.before.Sub(cutoff).Abs() > time.Minute {
+					t.Errorf("%s purged before %v, want the retention cutoff %v", name, p.before, cutoff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+// Package scheduler runs recurring background jobs, such as purging old
+// soft-deleted records, on cron schedules
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/cron"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+)
+
+// DefaultRunsLimit is the number of runs returned when no limit is given
+const DefaultRunsLimit = 20
+
+// idleCheck is how often the schedules are checked when no job will activate again
+const idleCheck = time.Hour
+
+// Task is the work of a job. It returns a short summary of what it did, kept
+// in the run history.
+type Task func(ctx context.Context) (string, error)
+
+// Job is a task run on a cron schedule
+type Job struct {
+	Name     string
+	Schedule string        // Cron expression, evaluated in UTC, e.g. "0 3 * * *"
+	Timeout  time.Duration // Longest a run may take before its context is cancelled
+	Task     Task
+}
+
+// IDGenerator generates unique IDs
+type IDGenerator interface {
+	Generate() string
+}
+
+// Scheduler runs the registered jobs on their schedules. A job runs on a
+// single instance at a time: an instance first acquires the lock of the job,
+// then skips the activation if the shared run history shows another instance
+// already ran it. Activations due while the previous run of the job is still
+// going are skipped as well.
+type Scheduler struct {
+	runs     outbound.JobRunRepository
+	locker   outbound.JobLocker
+	idGen    IDGenerator
+	instance string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	jobs    []*entry // In registration order
+	running sync.WaitGroup
+
+	heartbeat health.Heartbeat
+}
+
+// entry is a registered job with its schedule state
+type entry struct {
+	Job
+	schedule *cron.Schedule
+	next     time.Time // Next activation, zero when there is none
+	running  bool      // Running on this instance
+}
+
+// NewScheduler creates a new scheduler. The instance identifies it as the
+// owner of job locks and in the run history.
+func NewScheduler(runs outbound.JobRunRepository, locker outbound.JobLocker, idGen IDGenerator, instance string) *Scheduler {
+	return &Scheduler{
+		runs:     runs,
+		locker:   locker,
+		idGen:    idGen,
+		instance: instance,
+		now:      func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Register adds a job, failing when it is incomplete, its schedule is invalid
+// or its name is taken. Jobs are registered before Run.
+func (s *Scheduler) Register(j Job) error {
+	if j.Name == "" || j.Task == nil || j.Timeout <= 0 {
+		return fmt.Errorf("scheduler: job %q needs a name, a task and a positive timeout", j.Name)
+	}
+	schedule, err := cron.Parse(j.Schedule)
+	if err != nil {
+		return fmt.Errorf("scheduler: job %s: %w", j.Name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.jobs {
+		if e.Name == j.Name {
+			return fmt.Errorf("scheduler: job %s is already registered", j.Name)
+		}
+	}
+	s.jobs = append(s.jobs, &entry{Job: j, schedule: schedule, next: schedule.Next(s.now())})
+	return nil
+}
+
+// Run starts the jobs as they come due until ctx is done, then waits for the
+// running ones to finish. Runs are not cancelled with ctx, only by their
+// timeout, so that a shutdown doesn't leave a job half done.
+func (s *Scheduler) Run(ctx context.Context) {
+	defer s.heartbeat.Stop()
+	for {
+		s.heartbeat.Beat()
+		timer := time.NewTimer(s.startDue(ctx))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.running.Wait()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// startDue starts the due jobs in the background and returns the time until
+// the next activation
+func (s *Scheduler) startDue(ctx context.Context) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var next time.Time
+	for _, e := range s.jobs {
+		if !e.next.IsZero() && !now.Before(e.next) {
+			scheduledAt := e.next
+			e.next = e.schedule.Next(now)
+
+			if e.running {
+				slog.WarnContext(ctx, "scheduler: skipped an activation, the previous run is still going", "job", e.Name, "scheduled_at", scheduledAt)
+			} else {
+				e.running = true
+				s.running.Add(1)
+				go func(e *entry) {
+					defer s.running.Done()
+					s.execute(ctx, e, scheduledAt)
+
+					s.mu.Lock()
+					e.running = false
+					s.mu.Unlock()
+				}(e)
+			}
+		}
+
+		if !e.next.IsZero() && (next.IsZero() || e.next.Before(next)) {
+			next = e.next
+		}
+	}
+
+	if next.IsZero() {
+		return idleCheck
+	}
+	return next.Sub(now)
+}
+
+// execute runs a job for an activation of its schedule unless another
+// instance holds its lock or already ran the activation, and records the run.
+// It returns nil when the job was skipped.
+func (s *Scheduler) execute(ctx context.Context, e *entry, scheduledAt time.Time) *job.Run {
+	ctx = context.WithoutCancel(ctx)
+
+	locked, err := s.locker.TryLock(ctx, e.Name, s.instance, e.Timeout)
+	if err != nil {
+		slog.ErrorContext(ctx, "scheduler: failed to lock a job", "job", e.Name, "error", err)
+		return nil
+	}
+	if !locked {
+		return nil // Running on another instance
+	}
+	defer func() {
+		if err := s.locker.Unlock(ctx, e.Name, s.instance); err != nil {
+			slog.ErrorContext(ctx, "scheduler: failed to unlock a job", "job", e.Name, "error", err)
+		}
+	}()
+
+	last, err := s.runs.ListByJob(ctx, e.Name, 1)
+	if err != nil {
+		slog.ErrorContext(ctx, "scheduler: failed to load the last run of a job", "job", e.Name, "error", err)
+	} else if len(last) == 1 && !last[0].ScheduledAt.Before(scheduledAt) {
+		return nil // Another instance ran this activation
+	}
+
+	run := job.NewRun(s.idGen.Generate(), e.Name, s.instance, scheduledAt, s.now())
+	if err := s.runs.Create(ctx, run); err != nil {
+		slog.ErrorContext(ctx, "scheduler: failed to store a run", "job", e.Name, "run_id", run.ID, "error", err)
+	}
+
+	taskCtx, cancel := context.WithTimeout(ctx, e.Timeout)
+	result, err := runTask(taskCtx, e.Task)
+	cancel()
+
+	if err != nil {
+		run.Fail(err, s.now())
+		slog.ErrorContext(ctx, "scheduler: job failed", "job", e.Name, "run_id", run.ID, "duration_ms", run.Duration().Milliseconds(), "error", err)
+	} else {
+		run.Succeed(result, s.now())
+		slog.InfoContext(ctx, "scheduler: job succeeded", "job", e.Name, "run_id", run.ID, "duration_ms", run.Duration().Milliseconds(), "result", result)
+	}
+
+	if err := s.runs.Update(ctx, run); err != nil {
+		slog.ErrorContext(ctx, "scheduler: failed to store the outcome of a run", "job", e.Name, "run_id", run.ID, "error", err)
+	}
+	return run
+}
+
+// runTask runs a task, turning a panic into an error
+func runTask(ctx context.Context, task Task) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return task(ctx)
+}
+
+// JobStatus describes a registered job and its last run
+type JobStatus struct {
+	Name      string
+	Schedule  string
+	Timeout   time.Duration
+	NextRunAt *time.Time // Nil when the schedule never activates again
+	Running   bool       // Running on this instance
+	LastRun   *job.Run   // Nil when the job never ran
+}
+
+// Check reports an error unless the scheduler is running. It waits for the
+// next activation between beats, so only its being stopped is reported.
+func (s *Scheduler) Check(ctx context.Context) error {
+	return s.heartbeat.Err(0)
+}
+
+// Jobs returns the registered jobs with their last run, sorted by name
+func (s *Scheduler) Jobs(ctx context.Context) ([]JobStatus, error) {
+	s.mu.Lock()
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, e := range s.jobs {
+		st := JobStatus{Name: e.Name, Schedule: e.Schedule, Timeout: e.Timeout, Running: e.running}
+		if !e.next.IsZero() {
+			next := e.next
+			st.NextRunAt = &next
+		}
+		statuses = append(statuses, st)
+	}
+	s.mu.Unlock()
+
+	for i := range statuses {
+		last, err := s.runs.ListByJob(ctx, statuses[i].Name, 1)
+		if err != nil {
+			slog.ErrorContext(ctx, "scheduler: failed to load the last run of a job", "job", statuses[i].Name, "error", err)
+			return nil, errors.ErrInternal
+		}
+		if len(last) == 1 {
+			statuses[i].LastRun = last[0]
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses, nil
+}
+
+// Runs returns up to limit of the most recent runs of a job, DefaultRunsLimit
+// when limit is zero
+func (s *Scheduler) Runs(ctx context.Context, name string, limit int) ([]*job.Run, error) {
+	if !s.registered(name) {
+		return nil, errors.ErrNotFound
+	}
+	if limit <= 0 {
+		limit = DefaultRunsLimit
+	}
+
+	runs, err := s.runs.ListByJob(ctx, name, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "scheduler: failed to list the runs of a job", "job", name, "error", err)
+		return nil, errors.ErrInternal
+	}
+	return runs, nil
+}
+
+// registered reports whether a job of the given name is registered
+func (s *Scheduler) registered(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.jobs {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
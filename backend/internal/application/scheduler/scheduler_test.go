@@ -0,0 +1,281 @@
+package scheduler
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+type sequenceIDGenerator struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (g *sequenceIDGenerator) Generate() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.n++
+	return "run-" + strconv.Itoa(g.n)
+}
+
+// testClock is a clock moved by the tests while jobs read it
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	return c.now
+}
+
+// newTestScheduler creates a scheduler whose clock reads *now
+func newTestScheduler(runs outbound.JobRunRepository, locker outbound.JobLocker, instance string, now *time.Time) *Scheduler {
+	s := NewScheduler(runs, locker, &sequenceIDGenerator{}, instance)
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func succeeding(result string) Task {
+	return func(ctx context.Context) (string, error) { return result, nil }
+}
+
+func TestScheduler_Register(t *testing.T) {
+	now := time.Date(2024, 1, 15, 2, 30, 0, 0, time.UTC)
+	s := newTestScheduler(memory.NewJobRunRepository(), memory.NewJobLocker(), "host-1", &now)
+
+	if err := s.Register(Job{Name: "purge", Schedule: "0 3 * * *", Timeout: time.Minute, Task: succeeding("ok")}); err != nil {
+		t.Fatalf("Register() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		job  Job
+	}{
+		{"taken name", Job{Name: "purge", Schedule: "0 3 * * *", Timeout: time.Minute, Task: succeeding("ok")}},
+		{"invalid schedule", Job{Name: "other", Schedule: "every night", Timeout: time.Minute, Task: succeeding("ok")}},
+		{"missing name", Job{Schedule: "0 3 * * *", Timeout: time.Minute, Task: succeeding("ok")}},
+		{"missing task", Job{Name: "other", Schedule: "0 3 * * *", Timeout: time.Minute}},
+		{"missing timeout", Job{Name: "other", Schedule: "0 3 * * *", Task: succeeding("ok")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.Register(tt.job); err == nil {
+				t.Error("Register() should fail")
+			}
+		})
+	}
+
+	jobs, _ := s.Jobs(context.Background())
+	if len(jobs) != 1 || jobs[0].NextRunAt == nil || !jobs[0].NextRunAt.Equal(time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("Jobs() = %+v, want the job due at 03:00", jobs)
+	}
+}
+
+func TestScheduler_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		task       Task
+		wantStatus job.RunStatus
+		wantResult string
+		wantError  string
+	}{
+		{"succeeded", succeeding("purged 2 orders"), job.RunStatusSucceeded, "purged 2 orders", ""},
+		{
+			name:       "failed",
+			task:       func(ctx context.Context) (string, error) { return "", stderrors.New("store unavailable") },
+			wantStatus: job.RunStatusFailed,
+			wantError:  "store unavailable",
+		},
+		{
+			name:       "panicked",
+			task:       func(ctx context.Context) (string, error) { panic("nil map") },
+			wantStatus: job.RunStatusFailed,
+			wantError:  "panic: nil map",
+		},
+		{
+			name: "timed out",
+			task: func(ctx context.Context) (string, error) {
+				<-ctx.Done()
+				return "", ctx.Err()
+			},
+			wantStatus: job.RunStatusFailed,
+			wantError:  context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+			runs := memory.NewJobRunRepository()
+			s := newTestScheduler(runs, memory.NewJobLocker(), "host-1", &now)
+			_ = s.Register(Job{Name: "purge", Schedule: "0 3 * * *", Timeout: 10 * time.Millisecond, Task: tt.task})
+
+			run := s.execute(context.Background(), s.jobs[0], now)
+			if run == nil {
+				t.Fatal("execute() skipped the job")
+			}
+
+			stored, _ := runs.ListByJob(context.Background(), "purge", 10)
+			if len(stored) != 1 || stored[0].Status != tt.wantStatus || stored[0].Result != tt.wantResult || stored[0].Error != tt.wantError {
+				t.Fatalf("run history = %+v, want one run %s with result %q and error %q", stored, tt.wantStatus, tt.wantResult, tt.wantError)
+			}
+			if stored[0].Instance != "host-1" || !stored[0].ScheduledAt.Equal(now) || stored[0].FinishedAt == nil {
+				t.Errorf("run = %+v, want the instance, activation and finish time recorded", stored[0])
+			}
+		})
+	}
+}
+
+func TestScheduler_SingleInstance(t *testing.T) {
+	now := time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+	runs := memory.NewJobRunRepository()
+	locker := memory.NewJobLocker()
+	ctx := context.Background()
+
+	calls := 0
+	task := func(ctx context.Context) (string, error) {
+		calls++
+		return "done", nil
+	}
+	instances := []*Scheduler{
+		newTestScheduler(runs, locker, "host-1", &now),
+		newTestScheduler(runs, locker, "host-2", &now),
+	}
+	for _, s := range instances {
+		_ = s.Register(Job{Name: "purge", Schedule: "0 3 * * *", Timeout: time.Minute, Task: task})
+	}
+
+	// Another instance holds the lock
+	_, _ = locker.TryLock(ctx, "purge", "host-3", time.Minute)
+	if run := instances[0].execute(ctx, instances[0].jobs[0], now); run != nil || calls != 0 {
+		t.Fatalf("execute() of a locked job = %+v, want it skipped", run)
+	}
+	_ = locker.Unlock(ctx, "purge", "host-3")
+
+	// The activation runs on the first instance only
+	if run := instances[0].execute(ctx, instances[0].jobs[0], now); run == nil {
+		t.Fatal("execute() skipped the job")
+	}
+	if run := instances[1].execute(ctx, instances[1].jobs[0], now); run != nil || calls != 1 {
+		t.Fatalf("execute() of an activation already run = %+v, want it skipped", run)
+	}
+
+	// The next activation runs on whichever instance gets it first
+	if run := instances[1].execute(ctx, instances[1].jobs[0], now.Add(24*time.Hour)); run == nil || run.Instance != "host-2" || calls != 2 {
+		t.Errorf("execute() of the next activation = %+v, want it run by host-2", run)
+	}
+}
+
+func TestScheduler_StartDue(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 1, 15, 2, 59, 30, 0, time.UTC)}
+	s := NewScheduler(memory.NewJobRunRepository(), memory.NewJobLocker(), &sequenceIDGenerator{}, "host-1")
+	s.now = clock.Now
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	_ = s.Register(Job{Name: "statements", Schedule: "* * * * *", Timeout: time.Minute, Task: func(ctx context.Context) (string, error) {
+		started <- struct{}{}
+		<-release
+		return "done", nil
+	}})
+	_ = s.Register(Job{Name: "purge", Schedule: "0 3 * * *", Timeout: time.Minute, Task: succeeding("done")})
+	ctx := context.Background()
+
+	if wait := s.startDue(ctx); wait != 30*time.Second {
+		t.Fatalf("startDue() before any activation = %v, want 30s", wait)
+	}
+
+	clock.Advance(30 * time.Second)
+	if wait := s.startDue(ctx); wait != time.Minute {
+		t.Errorf("startDue() = %v, want a minute until the next activation", wait)
+	}
+	<-started
+
+	// The next activation is skipped while the previous run is going
+	now := clock.Advance(time.Minute)
+	s.startDue(ctx)
+	jobs, _ := s.Jobs(ctx)
+	if !jobs[1].Running || !jobs[1].NextRunAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("Jobs() = %+v, want statements running and due in a minute", jobs)
+	}
+
+	close(release)
+	s.running.Wait()
+	select {
+	case <-started:
+		t.Error("an activation started while the previous run was going")
+	default:
+	}
+
+	history, _ := s.Runs(ctx, "statements", 0)
+	if len(history) != 1 || history[0].Status != job.RunStatusSucceeded {
+		t.Errorf("Runs() = %+v, want a single successful run", history)
+	}
+	if history, _ := s.Runs(ctx, "purge", 0); len(history) != 1 {
+		t.Errorf("Runs() of purge = %+v, want the 03:00 run", history)
+	}
+	if _, err := s.Runs(ctx, "unknown", 0); err != errors.ErrNotFound {
+		t.Errorf("Runs() of an unknown job error = %v, want %v", err, errors.ErrNotFound)
+	}
+}
+
+func TestScheduler_RunWaitsForRunningJobs(t *testing.T) {
+	now := time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+	s := newTestScheduler(memory.NewJobRunRepository(), memory.NewJobLocker(), "host-1", &now)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	_ = s.Register(Job{Name: "purge", Schedule: "0 3 * * *", Timeout: time.Minute, Task: func(ctx context.Context) (string, error) {
+		close(started)
+		select {
+		case <-release:
+			return "done", nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}})
+	s.jobs[0].next = now // Due now
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(stopped)
+	}()
+
+	<-started
+	cancel()
+	select {
+	case <-stopped:
+		t.Fatal("Run() returned before the running job finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Run() didn't return once the running job finished")
+	}
+
+	if history, _ := s.Runs(context.Background(), "purge", 0); len(history) != 1 || history[0].Status != job.RunStatusSucceeded {
+		t.Errorf("Runs() = %+v, want the run completed despite the shutdown", history)
+	}
+}
@@ -2,17 +2,31 @@ package technician
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
 
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
 )
 
 // Service provides technician use cases
 type Service struct {
-	techRepo outbound.TechnicianRepository
-	labRepo  outbound.LaboratoryRepository
-	idGen    IDGenerator
+	techRepo  outbound.TechnicianRepository
+	labRepo   outbound.LaboratoryRepository
+	orderRepo outbound.OrderRepository
+	idGen     IDGenerator
+	auditor   auditapp.Recorder
+	events    outbound.EventPublisher
+	tx        outbound.Transactor
 }
 
 // IDGenerator generates unique IDs
@@ -21,11 +35,15 @@ type IDGenerator interface {
 }
 
 // NewService creates a new technician service
-func NewService(techRepo outbound.TechnicianRepository, labRepo outbound.LaboratoryRepository, idGen IDGenerator) *Service {
+func NewService(techRepo outbound.TechnicianRepository, labRepo outbound.LaboratoryRepository, orderRepo outbound.OrderRepository, idGen IDGenerator, auditor auditapp.Recorder, events outbound.EventPublisher, tx outbound.Transactor) *Service {
 	return &Service{
-		techRepo: techRepo,
-		labRepo:  labRepo,
-		idGen:    idGen,
+		techRepo:  techRepo,
+		labRepo:   labRepo,
+		orderRepo: orderRepo,
+		idGen:     idGen,
+		auditor:   auditor,
+		events:    events,
+		tx:        tx,
 	}
 }
 
@@ -40,19 +58,24 @@ type CreateInput struct {
 }
 
 // CreateTechnician creates a new technician
-func (s *Service) CreateTechnician(ctx context.Context, input CreateInput) (*technician.Technician, error) {
+func (s *Service) CreateTechnician(ctx context.Context, input CreateInput) (_ *technician.Technician, err error) {
+	ctx, span := tracing.Start(ctx, "technician.CreateTechnician", tracing.LaboratoryID(input.LaboratoryID))
+	defer tracing.End(span, &err)
+
 	// Validate laboratory exists
-	_, err := s.labRepo.GetByID(ctx, input.LaboratoryID)
+	_, err = s.labRepo.GetByID(ctx, input.LaboratoryID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "technician: failed to load a laboratory", "laboratory_id", input.LaboratoryID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
 	// Check if email already exists within the laboratory
 	existing, err := s.techRepo.GetByEmail(ctx, input.LaboratoryID, input.Email)
 	if err != nil && err != errors.ErrNotFound {
+		slog.ErrorContext(ctx, "technician: failed to look up a technician by email", "error", err)
 		return nil, errors.ErrInternal
 	}
 	if existing != nil {
@@ -67,20 +90,36 @@ func (s *Service) CreateTechnician(ctx context.Context, input CreateInput) (*tec
 	}
 
 	// Persist
-	if err := s.techRepo.Create(ctx, tech); err != nil {
+	e := event.TechnicianCreated{Metadata: event.NewMetadata(tech.LaboratoryID), Technician: *tech}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.techRepo.Create(ctx, tech)
+	}); err != nil {
+		slog.ErrorContext(ctx, "technician: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: tech.LaboratoryID,
+		EntityType:   audit.EntityTechnician,
+		EntityID:     tech.ID,
+		Action:       audit.ActionCreate,
+		After:        tech,
+	})
+
 	return tech, nil
 }
 
 // GetTechnician retrieves a technician by ID (laboratory-scoped)
-func (s *Service) GetTechnician(ctx context.Context, id, laboratoryID string) (*technician.Technician, error) {
+func (s *Service) GetTechnician(ctx context.Context, id, laboratoryID string) (_ *technician.Technician, err error) {
+	ctx, span := tracing.Start(ctx, "technician.GetTechnician", tracing.LaboratoryID(laboratoryID), tracing.EntityID("technician", id))
+	defer tracing.End(span, &err)
+
 	tech, err := s.techRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "technician: failed to load a technician", "technician_id", id, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -92,6 +131,28 @@ func (s *Service) GetTechnician(ctx context.Context, id, laboratoryID string) (*
 	return tech, nil
 }
 
+// GetTechnicians retrieves the technicians with the given IDs in one lookup
+// (laboratory-scoped); IDs without a match are skipped
+func (s *Service) GetTechnicians(ctx context.Context, ids []string, laboratoryID string) (_ []*technician.Technician, err error) {
+	ctx, span := tracing.Start(ctx, "technician.GetTechnicians", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	found, err := s.techRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "technician: failed to load technicians", "ids", ids, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	technicians := make([]*technician.Technician, 0, len(found))
+	for _, tech := range found {
+		if tech.LaboratoryID == laboratoryID {
+			technicians = append(technicians, tech)
+		}
+	}
+
+	return technicians, nil
+}
+
 // UpdateInput represents the input for updating a technician
 type UpdateInput struct {
 	ID              string
@@ -104,13 +165,17 @@ type UpdateInput struct {
 }
 
 // UpdateTechnician updates an existing technician
-func (s *Service) UpdateTechnician(ctx context.Context, input UpdateInput) (*technician.Technician, error) {
+func (s *Service) UpdateTechnician(ctx context.Context, input UpdateInput) (_ *technician.Technician, err error) {
+	ctx, span := tracing.Start(ctx, "technician.UpdateTechnician", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("technician", input.ID))
+	defer tracing.End(span, &err)
+
 	// Get existing technician
 	tech, err := s.techRepo.GetByID(ctx, input.ID)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return nil, errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "technician: failed to load a technician", "technician_id", input.ID, "error", err)
 		return nil, errors.ErrInternal
 	}
 
@@ -119,10 +184,35 @@ func (s *Service) UpdateTechnician(ctx context.Context, input UpdateInput) (*tec
 		return nil, errors.ErrNotFound // Security: don't reveal existence
 	}
 
+	return s.update(ctx, tech, input)
+}
+
+// PatchTechnician updates a technician with the input patch derives from its current
+// state, e.g. by applying a JSON merge patch
+func (s *Service) PatchTechnician(ctx context.Context, id, laboratoryID string, patch func(current technician.Technician) (UpdateInput, error)) (_ *technician.Technician, err error) {
+	ctx, span := tracing.Start(ctx, "technician.PatchTechnician", tracing.LaboratoryID(laboratoryID), tracing.EntityID("technician", id))
+	defer tracing.End(span, &err)
+
+	tech, err := s.GetTechnician(ctx, id, laboratoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := patch(*tech)
+	if err != nil {
+		return nil, err
+	}
+	input.ID, input.LaboratoryID = tech.ID, tech.LaboratoryID
+	return s.update(ctx, tech, input)
+}
+
+// update applies the input to a technician loaded for the update and stores it
+func (s *Service) update(ctx context.Context, tech *technician.Technician, input UpdateInput) (*technician.Technician, error) {
 	// Check if email changed and already exists
 	if tech.Email != input.Email {
 		existing, err := s.techRepo.GetByEmail(ctx, input.LaboratoryID, input.Email)
 		if err != nil && err != errors.ErrNotFound {
+			slog.ErrorContext(ctx, "technician: failed to look up a technician by email", "error", err)
 			return nil, errors.ErrInternal
 		}
 		if existing != nil && existing.ID != tech.ID {
@@ -130,45 +220,65 @@ func (s *Service) UpdateTechnician(ctx context.Context, input UpdateInput) (*tec
 		}
 	}
 
+	before := *tech
+
 	// Update technician
 	if err := tech.Update(input.Name, input.Email, input.Phone, input.Role, input.Specializations); err != nil {
 		return nil, err
 	}
 
 	// Persist
-	if err := s.techRepo.Update(ctx, tech); err != nil {
+	e := event.TechnicianUpdated{Metadata: event.NewMetadata(tech.LaboratoryID), Technician: *tech}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.techRepo.Update(ctx, tech)
+	}); err != nil {
+		slog.ErrorContext(ctx, "technician: failed to store a change", "event", e.Name(), "error", err)
 		return nil, errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: tech.LaboratoryID,
+		EntityType:   audit.EntityTechnician,
+		EntityID:     tech.ID,
+		Action:       audit.ActionUpdate,
+		Before:       &before,
+		After:        tech,
+	})
+
 	return tech, nil
 }
 
-// ListTechnicians retrieves all active technicians for a laboratory, optionally filtered by role
-func (s *Service) ListTechnicians(ctx context.Context, laboratoryID string, role *technician.Role) ([]*technician.Technician, error) {
-	var techs []*technician.Technician
-	var err error
+// ListTechnicians retrieves a page of active technicians for a laboratory, optionally filtered by role
+func (s *Service) ListTechnicians(ctx context.Context, laboratoryID string, q listing.Query) (_ listing.Page[*technician.Technician], err error) {
+	ctx, span := tracing.Start(ctx, "technician.ListTechnicians", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
 
-	if role != nil {
-		techs, err = s.techRepo.ListByRole(ctx, laboratoryID, *role)
-	} else {
-		techs, err = s.techRepo.List(ctx, laboratoryID)
+	q, err = q.Normalize(technician.ListSpec)
+	if err != nil {
+		return listing.Page[*technician.Technician]{}, err
 	}
 
+	page, err := s.techRepo.List(ctx, laboratoryID, q)
 	if err != nil {
-		return nil, errors.ErrInternal
+		slog.ErrorContext(ctx, "technician: failed to list technicians", "error", err)
+		return listing.Page[*technician.Technician]{}, errors.ErrInternal
 	}
 
-	return techs, nil
+	return page, nil
 }
 
 // DeleteTechnician performs a soft delete on a technician (laboratory-scoped)
-func (s *Service) DeleteTechnician(ctx context.Context, id, laboratoryID string) error {
+func (s *Service) DeleteTechnician(ctx context.Context, id, laboratoryID string) (err error) {
+	ctx, span := tracing.Start(ctx, "technician.DeleteTechnician", tracing.LaboratoryID(laboratoryID), tracing.EntityID("technician", id))
+	defer tracing.End(span, &err)
+
 	// Check if technician exists and belongs to the laboratory
 	tech, err := s.techRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == errors.ErrNotFound {
 			return errors.ErrNotFound
 		}
+		slog.ErrorContext(ctx, "technician: failed to load a technician", "technician_id", id, "error", err)
 		return errors.ErrInternal
 	}
 
@@ -178,9 +288,210 @@ func (s *Service) DeleteTechnician(ctx context.Context, id, laboratoryID string)
 	}
 
 	// Delete
-	if err := s.techRepo.Delete(ctx, id); err != nil {
+	e := event.TechnicianDeleted{Metadata: event.NewMetadata(tech.LaboratoryID), Technician: *tech}
+	if err := s.persist(ctx, e, func(ctx context.Context) error {
+		return s.techRepo.Delete(ctx, id)
+	}); err != nil {
+		slog.ErrorContext(ctx, "technician: failed to store a change", "event", e.Name(), "error", err)
 		return errors.ErrInternal
 	}
 
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: tech.LaboratoryID,
+		EntityType:   audit.EntityTechnician,
+		EntityID:     tech.ID,
+		Action:       audit.ActionDelete,
+		Before:       tech,
+	})
+
 	return nil
 }
+
+// DeletionInput represents a technician to delete in a batch
+type DeletionInput struct {
+	ID         string
+	ReassignTo string // Technician taking over the open orders; empty leaves them unassigned
+}
+
+// Deletion is the outcome of deleting a technician in a batch
+type Deletion struct {
+	Technician       *technician.Technician
+	ReassignedOrders []string // IDs of the open orders handed over
+}
+
+// BulkDeleteTechnicians soft-deletes several technicians of a laboratory and
+// hands their open (not delivered) orders over to another technician. A
+// technician may appear only once per batch and can't take over orders while
+// being deleted in it.
+func (s *Service) BulkDeleteTechnicians(ctx context.Context, laboratoryID string, items []DeletionInput, atomic bool) []bulk.Result[Deletion] {
+	ctx, span := tracing.Start(ctx, "technician.BulkDeleteTechnicians", tracing.LaboratoryID(laboratoryID))
+	defer span.End()
+
+	deleting := make(map[string]bool, len(items))
+	for _, item := range items {
+		deleting[item.ID] = true
+	}
+
+	seen := make(map[string]bool, len(items))
+	return bulk.Run(ctx, s.tx, items, atomic, func(ctx context.Context, i int, input DeletionInput) (bulk.Step[Deletion], error) {
+		if seen[input.ID] {
+			return bulk.Step[Deletion]{}, errors.Validation(errors.DuplicateInBatch("id"))
+		}
+		seen[input.ID] = true
+
+		return s.deletion(ctx, laboratoryID, input, deleting)
+	})
+}
+
+// deletion validates the deletion of a technician. The orders to hand over
+// are collected when it is committed.
+func (s *Service) deletion(ctx context.Context, laboratoryID string, input DeletionInput, deleting map[string]bool) (bulk.Step[Deletion], error) {
+	tech, err := s.GetTechnician(ctx, input.ID, laboratoryID)
+	if err != nil {
+		return bulk.Step[Deletion]{}, err
+	}
+
+	// The successor must be an active technician of the same laboratory
+	if input.ReassignTo != "" {
+		if deleting[input.ReassignTo] {
+			return bulk.Step[Deletion]{}, errors.Validation(errors.Invalid("reassign_to"))
+		}
+		if _, err := s.GetTechnician(ctx, input.ReassignTo, laboratoryID); err != nil {
+			if err == errors.ErrNotFound {
+				return bulk.Step[Deletion]{}, errors.Validation(errors.ReferenceNotFound("reassign_to"))
+			}
+			return bulk.Step[Deletion]{}, err
+		}
+	}
+
+	var handedOver []handover
+	return bulk.Step[Deletion]{
+		Commit: func(ctx context.Context) (Deletion, error) {
+			handedOver = nil
+			var ids []string
+			// A failed commit restores the orders handed over and drops their events
+			err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+				// The open orders are looked up again, as they may have changed since the validation
+				orders, err := s.openOrders(ctx, laboratoryID, tech.ID)
+				if err != nil {
+					return err
+				}
+
+				for _, o := range orders {
+					before := *o
+					o.AssignTechnician(input.ReassignTo)
+					e := event.OrderUpdated{Metadata: event.NewMetadata(o.LaboratoryID), Order: *o, PreviousTechnicianID: before.TechnicianID}
+					if err := s.persist(ctx, e, func(ctx context.Context) error {
+						return s.orderRepo.Update(ctx, o)
+					}); err != nil {
+						slog.ErrorContext(ctx, "technician: failed to store a change", "event", e.Name(), "error", err)
+						return errors.ErrInternal
+					}
+					handedOver = append(handedOver, handover{before: &before, after: o})
+					ids = append(ids, o.ID)
+
+					s.auditor.Record(ctx, auditapp.RecordInput{
+						LaboratoryID: o.LaboratoryID,
+						EntityType:   audit.EntityOrder,
+						EntityID:     o.ID,
+						Action:       audit.ActionUpdate,
+						Before:       &before,
+						After:        o,
+					})
+				}
+
+				e := event.TechnicianDeleted{Metadata: event.NewMetadata(tech.LaboratoryID), Technician: *tech}
+				if err := s.persist(ctx, e, func(ctx context.Context) error {
+					return s.techRepo.Delete(ctx, tech.ID)
+				}); err != nil {
+					slog.ErrorContext(ctx, "technician: failed to store a change", "event", e.Name(), "error", err)
+					return errors.ErrInternal
+				}
+
+				s.auditor.Record(ctx, auditapp.RecordInput{
+					LaboratoryID: tech.LaboratoryID,
+					EntityType:   audit.EntityTechnician,
+					EntityID:     tech.ID,
+					Action:       audit.ActionDelete,
+					Before:       tech,
+				})
+				return nil
+			})
+			if err != nil {
+				if err := s.restoreOrders(ctx, handedOver); err != nil {
+					slog.ErrorContext(ctx, "technician: failed to restore the orders of a technician", "technician_id", tech.ID, "error", err)
+				}
+				return Deletion{}, err
+			}
+
+			return Deletion{Technician: tech, ReassignedOrders: ids}, nil
+		},
+		Undo: func(ctx context.Context) error {
+			if err := s.techRepo.Restore(ctx, tech.ID); err != nil {
+				return fmt.Errorf("failed to restore technician %s: %w", tech.ID, err)
+			}
+			return s.restoreOrders(ctx, handedOver)
+		},
+	}, nil
+}
+
+// handover is an order handed over to another technician
+type handover struct {
+	before, after *order.Order
+}
+
+// restoreOrders hands orders back as they were, unless they changed again
+// since. It publishes no events nor audit entries: those of the handover are
+// dropped with its transaction.
+func (s *Service) restoreOrders(ctx context.Context, handedOver []handover) error {
+	var errs []error
+	for i := len(handedOver) - 1; i >= 0; i-- {
+		h := handedOver[i]
+		current, err := s.orderRepo.GetByID(ctx, h.before.ID)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("failed to load order %s: %w", h.before.ID, err))
+		case !current.UpdatedAt.Equal(h.after.UpdatedAt):
+			errs = append(errs, fmt.Errorf("order %s changed since it was handed over", h.before.ID))
+		default:
+			if err := s.orderRepo.Update(ctx, h.before); err != nil {
+				errs = append(errs, fmt.Errorf("failed to restore order %s: %w", h.before.ID, err))
+			}
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// openOrders returns the active orders assigned to a technician that are not yet delivered
+func (s *Service) openOrders(ctx context.Context, laboratoryID, technicianID string) ([]*order.Order, error) {
+	criteria := order.SearchCriteria{TechnicianID: technicianID, Statuses: order.OpenStatuses()}
+	q, err := listing.Query{Limit: listing.MaxLimit}.Normalize(order.ListSpec)
+	if err != nil {
+		slog.ErrorContext(ctx, "technician: invalid query of open orders", "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	var orders []*order.Order
+	for {
+		page, err := s.orderRepo.Search(ctx, laboratoryID, criteria, q)
+		if err != nil {
+			slog.ErrorContext(ctx, "technician: failed to list orders", "error", err)
+			return nil, errors.ErrInternal
+		}
+		orders = append(orders, page.Items...)
+		if page.NextCursor == "" {
+			return orders, nil
+		}
+		q.Cursor = page.NextCursor
+	}
+}
+
+// persist runs a write and publishes its event in a single transaction
+func (s *Service) persist(ctx context.Context, e event.Event, write func(ctx context.Context) error) error {
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, e)
+	})
+}
@@ -5,8 +5,14 @@ import (
 	stderrors "errors"
 	"testing"
 
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/application/bulk"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
 )
 
@@ -19,6 +25,23 @@ func (m *mockIDGenerator) Generate() string {
 	return m.id
 }
 
+// mockEventPublisher is a mock event publisher for testing that records the published events
+type mockEventPublisher struct {
+	events []event.Event
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// mockTransactor is a mock transactor for testing that runs units of work without a transaction
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // mockTechnicianRepository is a mock repository for testing
 type mockTechnicianRepository struct {
 	techs          map[string]*technician.Technician
@@ -56,6 +79,16 @@ func (m *mockTechnicianRepository) GetByID(ctx context.Context, id string) (*tec
 	return tech, nil
 }
 
+func (m *mockTechnicianRepository) GetByIDs(ctx context.Context, ids []string) ([]*technician.Technician, error) {
+	var result []*technician.Technician
+	for _, id := range ids {
+		if v, exists := m.techs[id]; exists && !v.IsDeleted() {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
 func (m *mockTechnicianRepository) GetByEmail(ctx context.Context, laboratoryID, email string) (*technician.Technician, error) {
 	if m.getByEmailErr != nil {
 		return nil, m.getByEmailErr
@@ -91,17 +124,30 @@ func (m *mockTechnicianRepository) Delete(ctx context.Context, id string) error
 	return nil
 }
 
-func (m *mockTechnicianRepository) List(ctx context.Context, laboratoryID string) ([]*technician.Technician, error) {
+func (m *mockTechnicianRepository) Restore(ctx context.Context, id string) error {
+	tech, exists := m.techs[id]
+	if !exists || !tech.IsDeleted() {
+		return errors.ErrNotFound
+	}
+	tech.DeletedAt = nil
+	return nil
+}
+
+func (m *mockTechnicianRepository) List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*technician.Technician], error) {
 	if m.listErr != nil {
-		return nil, m.listErr
+		return listing.Page[*technician.Technician]{}, m.listErr
 	}
 	var techs []*technician.Technician
 	for _, tech := range m.techs {
-		if tech.LaboratoryID == laboratoryID && !tech.IsDeleted() {
-			techs = append(techs, tech)
+		if tech.LaboratoryID != laboratoryID || tech.IsDeleted() {
+			continue
+		}
+		if role, ok := q.Filters["role"]; ok && string(tech.Role) != role {
+			continue
 		}
+		techs = append(techs, tech)
 	}
-	return techs, nil
+	return listing.Page[*technician.Technician]{Items: techs, Total: len(techs)}, nil
 }
 
 func (m *mockTechnicianRepository) ListByRole(ctx context.Context, laboratoryID string, role technician.Role) ([]*technician.Technician, error) {
@@ -167,14 +213,14 @@ func (m *mockLaboratoryRepository) Delete(ctx context.Context, id string) error
 	return nil
 }
 
-func (m *mockLaboratoryRepository) List(ctx context.Context) ([]*laboratory.Laboratory, error) {
+func (m *mockLaboratoryRepository) List(ctx context.Context, q listing.Query) (listing.Page[*laboratory.Laboratory], error) {
 	var labs []*laboratory.Laboratory
 	for _, lab := range m.labs {
 		if !lab.IsDeleted() {
 			labs = append(labs, lab)
 		}
 	}
-	return labs, nil
+	return listing.Page[*laboratory.Laboratory]{Items: labs, Total: len(labs)}, nil
 }
 
 func TestService_CreateTechnician(t *testing.T) {
@@ -256,7 +302,7 @@ func TestService_CreateTechnician(t *testing.T) {
 			labRepo := newMockLaboratoryRepository()
 			tt.setupRepo(techRepo, labRepo)
 			idGen := &mockIDGenerator{id: tt.mockID}
-			svc := NewService(techRepo, labRepo, idGen)
+			svc := NewService(techRepo, labRepo, nil, idGen, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			tech, err := svc.CreateTechnician(context.Background(), tt.input)
 
@@ -338,7 +384,7 @@ func TestService_GetTechnician(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			techRepo := newMockTechnicianRepository()
 			tt.setupRepo(techRepo)
-			svc := NewService(techRepo, newMockLaboratoryRepository(), &mockIDGenerator{})
+			svc := NewService(techRepo, newMockLaboratoryRepository(), nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			tech, err := svc.GetTechnician(context.Background(), tt.id, tt.labID)
 
@@ -386,32 +432,33 @@ func TestService_ListTechnicians(t *testing.T) {
 		Role:         technician.RoleTechnician,
 	}
 
-	svc := NewService(techRepo, newMockLaboratoryRepository(), &mockIDGenerator{})
+	svc := NewService(techRepo, newMockLaboratoryRepository(), nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 	// List all technicians for lab-123
-	techs, err := svc.ListTechnicians(context.Background(), "lab-123", nil)
+	page, err := svc.ListTechnicians(context.Background(), "lab-123", listing.Query{})
 	if err != nil {
 		t.Errorf("ListTechnicians() unexpected error = %v", err)
 		return
 	}
 
-	if len(techs) != 2 {
-		t.Errorf("ListTechnicians() got %d techs, want 2", len(techs))
+	if len(page.Items) != 2 {
+		t.Errorf("ListTechnicians() got %d techs, want 2", len(page.Items))
 	}
 
 	// List technicians filtered by role
-	role := technician.RoleSeniorTechnician
-	techs, err = svc.ListTechnicians(context.Background(), "lab-123", &role)
+	page, err = svc.ListTechnicians(context.Background(), "lab-123", listing.Query{
+		Filters: map[string]string{"role": string(technician.RoleSeniorTechnician)},
+	})
 	if err != nil {
 		t.Errorf("ListTechnicians() unexpected error = %v", err)
 		return
 	}
 
-	if len(techs) != 1 {
-		t.Errorf("ListTechnicians() with role filter got %d techs, want 1", len(techs))
+	if len(page.Items) != 1 {
+		t.Errorf("ListTechnicians() with role filter got %d techs, want 1", len(page.Items))
 	}
-	if techs[0].Role != technician.RoleSeniorTechnician {
-		t.Errorf("ListTechnicians() Role = %v, want %v", techs[0].Role, technician.RoleSeniorTechnician)
+	if page.Items[0].Role != technician.RoleSeniorTechnician {
+		t.Errorf("ListTechnicians() Role = %v, want %v", page.Items[0].Role, technician.RoleSeniorTechnician)
 	}
 }
 
@@ -462,7 +509,7 @@ func TestService_DeleteTechnician(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			techRepo := newMockTechnicianRepository()
 			tt.setupRepo(techRepo)
-			svc := NewService(techRepo, newMockLaboratoryRepository(), &mockIDGenerator{})
+			svc := NewService(techRepo, newMockLaboratoryRepository(), nil, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
 
 			err := svc.DeleteTechnician(context.Background(), tt.id, tt.labID)
 
@@ -483,3 +530,157 @@ func TestService_DeleteTechnician(t *testing.T) {
 		})
 	}
 }
+
+// setupBulkDeletion stores technicians tech-1 to tech-3 of lab-123, with open
+// order order-1 and delivered order order-2 assigned to tech-1
+func setupBulkDeletion(t *testing.T) (*Service, *memory.TechnicianRepository, *memory.OrderRepository) {
+	t.Helper()
+	ctx := context.Background()
+	techRepo := memory.NewTechnicianRepository()
+	orderRepo := memory.NewOrderRepository()
+
+	for _, id := range []string{"tech-1", "tech-2", "tech-3"} {
+		tech, err := technician.NewTechnician(id, "lab-123", "John Doe", id+"@lab.com", "+5511999999999", technician.RoleTechnician, nil)
+		if err != nil {
+			t.Fatalf("NewTechnician() error = %v", err)
+		}
+		_ = techRepo.Create(ctx, tech)
+	}
+	for id, status := range map[string]order.Status{"order-1": order.StatusInProduction, "order-2": order.StatusDelivered} {
+		_ = orderRepo.Create(ctx, &order.Order{
+			ID:           id,
+			ClientID:     "client-123",
+			LaboratoryID: "lab-123",
+			TechnicianID: "tech-1",
+			Status:       status,
+			Prosthesis:   []order.ProsthesisItem{{Type: "crown", Material: "zirconia", Quantity: 1}},
+		})
+	}
+
+	svc := NewService(techRepo, newMockLaboratoryRepository(), orderRepo, &mockIDGenerator{}, auditapp.NopRecorder{}, &mockEventPublisher{}, mockTransactor{})
+	return svc, techRepo, orderRepo
+}
+
+func TestService_BulkDeleteTechnicians(t *testing.T) {
+	tests := []struct {
+		name         string
+		items        []DeletionInput
+		atomic       bool
+		wantStatuses []bulk.Status
+		wantErrs     []error
+		wantDeleted  []string
+		wantAssignee string // Technician of order-1 afterwards
+	}{
+		{
+			name:         "reassigns open orders",
+			items:        []DeletionInput{{ID: "tech-1", ReassignTo: "tech-2"}},
+			wantStatuses: []bulk.Status{bulk.StatusSucceeded},
+			wantErrs:     []error{nil},
+			wantDeleted:  []string{"tech-1"},
+			wantAssignee: "tech-2",
+		},
+		{
+			name:         "unassigns open orders without successor",
+			items:        []DeletionInput{{ID: "tech-1"}, {ID: "tech-3"}},
+			wantStatuses: []bulk.Status{bulk.StatusSucceeded, bulk.StatusSucceeded},
+			wantErrs:     []error{nil, nil},
+			wantDeleted:  []string{"tech-1", "tech-3"},
+			wantAssignee: "",
+		},
+		{
+			name: "invalid items fail on their own",
+			items: []DeletionInput{
+				{ID: "tech-1", ReassignTo: "tech-3"},
+				{ID: "tech-3"},
+				{ID: "tech-2", ReassignTo: "non-existent"},
+				{ID: "non-existent"},
+				{ID: "tech-1"},
+			},
+			wantStatuses: []bulk.Status{bulk.StatusFailed, bulk.StatusSucceeded, bulk.StatusFailed, bulk.StatusFailed, bulk.StatusFailed},
+			wantErrs:     []error{errors.ErrInvalidInput, nil, errors.ErrInvalidInput, errors.ErrNotFound, errors.ErrInvalidInput},
+			wantDeleted:  []string{"tech-3"},
+			wantAssignee: "tech-1",
+		},
+		{
+			name:         "atomic batch deletes nothing when an item fails",
+			items:        []DeletionInput{{ID: "tech-1", ReassignTo: "tech-2"}, {ID: "non-existent"}},
+			atomic:       true,
+			wantStatuses: []bulk.Status{bulk.StatusSkipped, bulk.StatusFailed},
+			wantErrs:     []error{nil, errors.ErrNotFound},
+			wantAssignee: "tech-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, techRepo, orderRepo := setupBulkDeletion(t)
+			ctx := context.Background()
+
+			results := svc.BulkDeleteTechnicians(ctx, "lab-123", tt.items, tt.atomic)
+
+			for i, r := range results {
+				if r.Status != tt.wantStatuses[i] {
+					t.Errorf("BulkDeleteTechnicians() results[%d].Status = %v, want %v", i, r.Status, tt.wantStatuses[i])
+				}
+				if tt.wantErrs[i] != nil && !stderrors.Is(r.Err, tt.wantErrs[i]) {
+					t.Errorf("BulkDeleteTechnicians() results[%d].Err = %v, want %v", i, r.Err, tt.wantErrs[i])
+				}
+			}
+
+			deleted := map[string]bool{}
+			for _, id := range tt.wantDeleted {
+				deleted[id] = true
+			}
+			for _, id := range []string{"tech-1", "tech-2", "tech-3"} {
+				_, err := techRepo.GetByID(ctx, id)
+				if gotDeleted := err == errors.ErrNotFound; gotDeleted != deleted[id] {
+					t.Errorf("technician %s deleted = %v, want %v", id, gotDeleted, deleted[id])
+				}
+			}
+
+			open, _ := orderRepo.GetByID(ctx, "order-1")
+			if open.TechnicianID != tt.wantAssignee {
+				t.Errorf("order-1 TechnicianID = %q, want %q", open.TechnicianID, tt.wantAssignee)
+			}
+			delivered, _ := orderRepo.GetByID(ctx, "order-2")
+			if delivered.TechnicianID != "tech-1" {
+				t.Errorf("order-2 TechnicianID = %q, want tech-1", delivered.TechnicianID)
+			}
+		})
+	}
+}
+
+// failingTechnicianRepository fails to delete one technician
+type failingTechnicianRepository struct {
+	*memory.TechnicianRepository
+	failID string
+}
+
+func (r *failingTechnicianRepository) Delete(ctx context.Context, id string) error {
+	if id == r.failID {
+		return stderrors.New("storage failure")
+	}
+	return r.TechnicianRepository.Delete(ctx, id)
+}
+
+func TestService_BulkDeleteTechnicians_AtomicUndo(t *testing.T) {
+	_, techRepo, orderRepo := setupBulkDeletion(t)
+	ctx := context.Background()
+	events := &mockEventPublisher{}
+	svc := NewService(&failingTechnicianRepository{techRepo, "tech-3"}, newMockLaboratoryRepository(), orderRepo, &mockIDGenerator{}, auditapp.NopRecorder{}, memory.NewCommitPublisher(events), memory.NewTransactor())
+
+	results := svc.BulkDeleteTechnicians(ctx, "lab-123", []DeletionInput{{ID: "tech-1", ReassignTo: "tech-2"}, {ID: "tech-3"}}, true)
+
+	if results[0].Status != bulk.StatusSkipped || results[1].Status != bulk.StatusFailed {
+		t.Fatalf("BulkDeleteTechnicians() statuses = %v, %v, want skipped, failed", results[0].Status, results[1].Status)
+	}
+	if _, err := techRepo.GetByID(ctx, "tech-1"); err != nil {
+		t.Errorf("tech-1 not restored: GetByID() error = %v", err)
+	}
+	if o, _ := orderRepo.GetByID(ctx, "order-1"); o.TechnicianID != "tech-1" {
+		t.Errorf("order-1 TechnicianID = %q, want tech-1", o.TechnicianID)
+	}
+	if len(events.events) != 0 {
+		t.Errorf("BulkDeleteTechnicians() published %d events, want none for an aborted batch", len(events.events))
+	}
+}
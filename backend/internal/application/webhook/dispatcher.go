@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+)
+
+// Dispatcher turns order events into deliveries to the subscriptions of their
+// laboratory. Handle is subscribed to the event bus; the deliveries are sent
+// by the Worker, so a slow receiver never holds back the bus.
+type Dispatcher struct {
+	subscriptionRepo outbound.WebhookSubscriptionRepository
+	deliveryRepo     outbound.WebhookDeliveryRepository
+	idGen            IDGenerator
+	now              func() time.Time
+}
+
+// NewDispatcher creates a new webhook dispatcher
+func NewDispatcher(subscriptionRepo outbound.WebhookSubscriptionRepository, deliveryRepo outbound.WebhookDeliveryRepository, idGen IDGenerator) *Dispatcher {
+	return &Dispatcher{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		idGen:            idGen,
+		now:              func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Handle creates a delivery of the event to every subscription wanting one of
+// its event types. Each event type is a separate webhook event, whose ID is
+// derived from the event's, so a redelivered event keeps it.
+func (d *Dispatcher) Handle(ctx context.Context, e event.Event) error {
+	types := webhook.EventTypesOf(e)
+	data, ok := newPayloadData(e)
+	if len(types) == 0 || !ok {
+		return nil
+	}
+
+	subs, err := d.subscriptionRepo.ListByLaboratory(ctx, e.Meta().LaboratoryID)
+	if err != nil {
+		return err
+	}
+
+	now := d.now()
+	var deliveries []*webhook.Delivery
+	for _, t := range types {
+		var eventID string
+		var payload []byte
+		for _, s := range subs {
+			if !s.Wants(t) {
+				continue
+			}
+			// The payload is built once per event type, for its first subscriber
+			if payload == nil {
+				eventID = webhookEventID(e, t)
+				payload, err = json.Marshal(Payload{
+					ID:           eventID,
+					Type:         t,
+					LaboratoryID: e.Meta().LaboratoryID,
+					OccurredAt:   e.Meta().OccurredAt,
+					Data:         data,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			deliveries = append(deliveries, webhook.NewDelivery(d.idGen.Generate(), s, eventID, t, payload, now))
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return d.deliveryRepo.Create(ctx, deliveries...)
+}
+
+// webhookEventID identifies the webhook event an event is delivered as
+func webhookEventID(e event.Event, t webhook.EventType) string {
+	return e.Meta().ID + ":" + string(t)
+}
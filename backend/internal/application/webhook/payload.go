@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+// Payload is the JSON body posted to subscriptions. Its shape is part of the
+// public API: fields may be added but not renamed or removed.
+type Payload struct {
+	ID           string            `json:"id"` // Event ID, the same for every delivery of the event
+	Type         webhook.EventType `json:"type"`
+	LaboratoryID string            `json:"laboratory_id"`
+	OccurredAt   time.Time         `json:"occurred_at"`
+	Data         PayloadData       `json:"data"`
+}
+
+// PayloadData is the subject of an order event
+type PayloadData struct {
+	Order OrderPayload  `json:"order"`
+	From  *order.Status `json:"from,omitempty"` // Status changes only
+	To    *order.Status `json:"to,omitempty"`   // Status changes only
+}
+
+// OrderPayload is the order an event is about
+type OrderPayload struct {
+	ID           string               `json:"id"`
+	ClientID     string               `json:"client_id"`
+	TechnicianID string               `json:"technician_id,omitempty"`
+	Status       order.Status         `json:"status"`
+	Prosthesis   []ProsthesisItemData `json:"prosthesis"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+// ProsthesisItemData is an item of the order an event is about
+type ProsthesisItemData struct {
+	Type          string `json:"type"`
+	Material      string `json:"material"`
+	Shade         string `json:"shade,omitempty"`
+	Quantity      int    `json:"quantity"`
+	Notes         string `json:"notes,omitempty"`
+	CatalogItemID string `json:"catalog_item_id,omitempty"`
+}
+
+// newPayloadData describes the subject of an order event, reporting false for
+// events of other entities
+func newPayloadData(e event.Event) (PayloadData, bool) {
+	switch e := e.(type) {
+	case event.OrderCreated:
+		return PayloadData{Order: toOrderPayload(e.Order)}, true
+	case event.OrderUpdated:
+		return PayloadData{Order: toOrderPayload(e.Order)}, true
+	case event.OrderDeleted:
+		return PayloadData{Order: toOrderPayload(e.Order)}, true
+	case event.OrderStatusChanged:
+		from, to := e.From, e.To
+		return PayloadData{Order: toOrderPayload(e.Order), From: &from, To: &to}, true
+	}
+	return PayloadData{}, false
+}
+
+// toOrderPayload converts a domain order to its payload
+func toOrderPayload(o order.Order) OrderPayload {
+	items := make([]ProsthesisItemData, len(o.Prosthesis))
+	for i, item := range o.Prosthesis {
+		items[i] = ProsthesisItemData{
+			Type:          item.Type,
+			Material:      item.Material,
+			Shade:         item.Shade,
+			Quantity:      item.Quantity,
+			Notes:         item.Notes,
+			CatalogItemID: item.CatalogItemID,
+		}
+	}
+
+	return OrderPayload{
+		ID:           o.ID,
+		ClientID:     o.ClientID,
+		TechnicianID: o.TechnicianID,
+		Status:       o.Status,
+		Prosthesis:   items,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
@@ -0,0 +1,294 @@
+// Package webhook provides outgoing webhooks: laboratories subscribe URLs to
+// order lifecycle events, which are posted to them as signed JSON payloads
+// and retried until accepted or dead-lettered
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/tracing"
+)
+
+// DefaultDeliveryLimit is the number of deliveries returned when no limit is given
+const DefaultDeliveryLimit = 50
+
+// MaxDeliveryLimit is the maximum number of deliveries returned by a single query
+const MaxDeliveryLimit = 500
+
+// IDGenerator generates unique IDs
+type IDGenerator interface {
+	Generate() string
+}
+
+// Service provides webhook subscription and delivery log use cases
+type Service struct {
+	subscriptionRepo outbound.WebhookSubscriptionRepository
+	deliveryRepo     outbound.WebhookDeliveryRepository
+	labRepo          outbound.LaboratoryRepository
+	idGen            IDGenerator
+	auditor          auditapp.Recorder
+	now              func() time.Time
+}
+
+// NewService creates a new webhook service
+func NewService(subscriptionRepo outbound.WebhookSubscriptionRepository, deliveryRepo outbound.WebhookDeliveryRepository, labRepo outbound.LaboratoryRepository, idGen IDGenerator, auditor auditapp.Recorder) *Service {
+	return &Service{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		labRepo:          labRepo,
+		idGen:            idGen,
+		auditor:          auditor,
+		now:              func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// CreateInput represents the input for creating a subscription
+type CreateInput struct {
+	LaboratoryID string
+	URL          string
+	EventTypes   []webhook.EventType
+	Secret       string
+}
+
+// CreateSubscription creates a new subscription
+func (s *Service) CreateSubscription(ctx context.Context, input CreateInput) (_ *webhook.Subscription, err error) {
+	ctx, span := tracing.Start(ctx, "webhook.CreateSubscription", tracing.LaboratoryID(input.LaboratoryID))
+	defer tracing.End(span, &err)
+
+	// Validate laboratory exists
+	_, err = s.labRepo.GetByID(ctx, input.LaboratoryID)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "webhooks: failed to load a laboratory", "laboratory_id", input.LaboratoryID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	// Create new subscription
+	id := s.idGen.Generate()
+	sub, err := webhook.NewSubscription(id, input.LaboratoryID, input.URL, input.EventTypes, input.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist
+	if err := s.subscriptionRepo.Create(ctx, sub); err != nil {
+		slog.ErrorContext(ctx, "webhooks: failed to store a subscription", "subscription_id", sub.ID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: sub.LaboratoryID,
+		EntityType:   audit.EntityWebhook,
+		EntityID:     sub.ID,
+		Action:       audit.ActionCreate,
+		After:        redacted(sub),
+	})
+
+	return sub, nil
+}
+
+// GetSubscription retrieves a subscription by ID (laboratory-scoped)
+func (s *Service) GetSubscription(ctx context.Context, id, laboratoryID string) (_ *webhook.Subscription, err error) {
+	ctx, span := tracing.Start(ctx, "webhook.GetSubscription", tracing.LaboratoryID(laboratoryID), tracing.EntityID("subscription", id))
+	defer tracing.End(span, &err)
+
+	sub, err := s.subscriptionRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "webhooks: failed to load a subscription", "subscription_id", id, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	// Check laboratory scope
+	if sub.LaboratoryID != laboratoryID {
+		return nil, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	return sub, nil
+}
+
+// ListSubscriptions retrieves the subscriptions of a laboratory, oldest first
+func (s *Service) ListSubscriptions(ctx context.Context, laboratoryID string) (_ []*webhook.Subscription, err error) {
+	ctx, span := tracing.Start(ctx, "webhook.ListSubscriptions", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	subs, err := s.subscriptionRepo.ListByLaboratory(ctx, laboratoryID)
+	if err != nil {
+		slog.ErrorContext(ctx, "webhooks: failed to list subscriptions", "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	return subs, nil
+}
+
+// UpdateInput represents the input for updating a subscription.
+// An empty Secret keeps the current one.
+type UpdateInput struct {
+	ID           string
+	LaboratoryID string
+	URL          string
+	EventTypes   []webhook.EventType
+	Secret       string
+}
+
+// UpdateSubscription updates an existing subscription. Pending deliveries are
+// sent to the new URL, signed with the new secret.
+func (s *Service) UpdateSubscription(ctx context.Context, input UpdateInput) (_ *webhook.Subscription, err error) {
+	ctx, span := tracing.Start(ctx, "webhook.UpdateSubscription", tracing.LaboratoryID(input.LaboratoryID), tracing.EntityID("subscription", input.ID))
+	defer tracing.End(span, &err)
+
+	sub, err := s.GetSubscription(ctx, input.ID, input.LaboratoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := redacted(sub)
+
+	// Update subscription
+	if err := sub.Update(input.URL, input.EventTypes, input.Secret); err != nil {
+		return nil, err
+	}
+
+	// Persist
+	if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+		slog.ErrorContext(ctx, "webhooks: failed to store a subscription", "subscription_id", sub.ID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: sub.LaboratoryID,
+		EntityType:   audit.EntityWebhook,
+		EntityID:     sub.ID,
+		Action:       audit.ActionUpdate,
+		Before:       before,
+		After:        redacted(sub),
+	})
+
+	return sub, nil
+}
+
+// DeleteSubscription performs a soft delete on a subscription (laboratory-scoped).
+// Its pending deliveries are dead-lettered when they come due.
+func (s *Service) DeleteSubscription(ctx context.Context, id, laboratoryID string) (err error) {
+	ctx, span := tracing.Start(ctx, "webhook.DeleteSubscription", tracing.LaboratoryID(laboratoryID), tracing.EntityID("subscription", id))
+	defer tracing.End(span, &err)
+
+	sub, err := s.GetSubscription(ctx, id, laboratoryID)
+	if err != nil {
+		return err
+	}
+
+	// Delete
+	if err := s.subscriptionRepo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "webhooks: failed to delete a subscription", "subscription_id", id, "error", err)
+		return errors.ErrInternal
+	}
+
+	s.auditor.Record(ctx, auditapp.RecordInput{
+		LaboratoryID: sub.LaboratoryID,
+		EntityType:   audit.EntityWebhook,
+		EntityID:     sub.ID,
+		Action:       audit.ActionDelete,
+		Before:       redacted(sub),
+	})
+
+	return nil
+}
+
+// ListDeliveries retrieves the delivery log of a subscription matching the
+// filter, most recent first (laboratory-scoped)
+func (s *Service) ListDeliveries(ctx context.Context, laboratoryID string, filter webhook.DeliveryFilter) (_ []*webhook.Delivery, err error) {
+	ctx, span := tracing.Start(ctx, "webhook.ListDeliveries", tracing.LaboratoryID(laboratoryID))
+	defer tracing.End(span, &err)
+
+	if _, err := s.GetSubscription(ctx, filter.SubscriptionID, laboratoryID); err != nil {
+		return nil, err
+	}
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, errors.Validation(errors.InvalidChoice("status", webhook.AllDeliveryStatuses()))
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultDeliveryLimit
+	} else if filter.Limit > MaxDeliveryLimit {
+		filter.Limit = MaxDeliveryLimit
+	}
+
+	deliveries, err := s.deliveryRepo.List(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "webhooks: failed to list deliveries", "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	return deliveries, nil
+}
+
+// GetDelivery retrieves a delivery of a subscription with its attempts (laboratory-scoped)
+func (s *Service) GetDelivery(ctx context.Context, id, subscriptionID, laboratoryID string) (_ *webhook.Delivery, err error) {
+	ctx, span := tracing.Start(ctx, "webhook.GetDelivery", tracing.LaboratoryID(laboratoryID), tracing.EntityID("subscription", subscriptionID), tracing.EntityID("delivery", id))
+	defer tracing.End(span, &err)
+
+	if _, err := s.GetSubscription(ctx, subscriptionID, laboratoryID); err != nil {
+		return nil, err
+	}
+
+	d, err := s.deliveryRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == errors.ErrNotFound {
+			return nil, errors.ErrNotFound
+		}
+		slog.ErrorContext(ctx, "webhooks: failed to load a delivery", "delivery_id", id, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	// Check subscription scope
+	if d.SubscriptionID != subscriptionID {
+		return nil, errors.ErrNotFound // Security: don't reveal existence
+	}
+
+	return d, nil
+}
+
+// Redeliver queues a new delivery of the payload of a delivery, whatever its
+// status, e.g. once the receiver of a dead delivery is fixed (laboratory-scoped).
+// The new delivery keeps the event ID, so receivers can drop it if they
+// already processed the event.
+func (s *Service) Redeliver(ctx context.Context, id, subscriptionID, laboratoryID string) (_ *webhook.Delivery, err error) {
+	ctx, span := tracing.Start(ctx, "webhook.Redeliver", tracing.LaboratoryID(laboratoryID), tracing.EntityID("subscription", subscriptionID), tracing.EntityID("delivery", id))
+	defer tracing.End(span, &err)
+
+	d, err := s.GetDelivery(ctx, id, subscriptionID, laboratoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	redelivery := d.Redeliver(s.idGen.Generate(), s.now())
+	if err := s.deliveryRepo.Create(ctx, redelivery); err != nil {
+		slog.ErrorContext(ctx, "webhooks: failed to store a delivery", "delivery_id", redelivery.ID, "error", err)
+		return nil, errors.ErrInternal
+	}
+
+	return redelivery, nil
+}
+
+// redacted returns a copy of a subscription safe for the audit log, with the
+// secret replaced by a fingerprint that only changes when the secret does
+func redacted(sub *webhook.Subscription) *webhook.Subscription {
+	clone := *sub
+	sum := sha256.Sum256([]byte(sub.Secret))
+	clone.Secret = "sha256:" + hex.EncodeToString(sum[:4])
+	return &clone
+}
@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	auditapp "github.com/JonatasP2A/dental-prosthesis/backend/internal/application/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+// mockAuditor records the audited operations
+type mockAuditor struct {
+	records []auditapp.RecordInput
+}
+
+func (m *mockAuditor) Record(ctx context.Context, input auditapp.RecordInput) {
+	m.records = append(m.records, input)
+}
+
+func newTestService(s *testSetup) (*Service, *mockAuditor) {
+	labRepo := memory.NewLaboratoryRepository()
+	_ = labRepo.Create(context.Background(), &laboratory.Laboratory{ID: "lab-123", Name: "Test Lab"})
+
+	auditor := &mockAuditor{}
+	svc := NewService(s.subs, s.deliveries, labRepo, s.idGen, auditor)
+	svc.now = func() time.Time { return s.now }
+	return svc, auditor
+}
+
+func TestService_Subscriptions(t *testing.T) {
+	s := newTestSetup(t)
+	svc, auditor := newTestService(s)
+	ctx := context.Background()
+
+	input := CreateInput{
+		LaboratoryID: "lab-123",
+		URL:          "https://clinic.example.com/hooks",
+		EventTypes:   []webhook.EventType{webhook.EventOrderReady},
+		Secret:       testSecret,
+	}
+
+	if _, err := svc.CreateSubscription(ctx, CreateInput{LaboratoryID: "missing", URL: input.URL, EventTypes: input.EventTypes, Secret: testSecret}); err != errors.ErrNotFound {
+		t.Errorf("CreateSubscription() for a missing laboratory error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if _, err := svc.CreateSubscription(ctx, CreateInput{LaboratoryID: "lab-123", URL: input.URL, Secret: testSecret}); err == nil || !strings.Contains(err.Error(), "event_types") {
+		t.Errorf("CreateSubscription() without event types error = %v, want a validation error", err)
+	}
+
+	sub, err := svc.CreateSubscription(ctx, input)
+	if err != nil {
+		t.Fatalf("CreateSubscription() unexpected error = %v", err)
+	}
+
+	if _, err := svc.GetSubscription(ctx, sub.ID, "lab-456"); err != errors.ErrNotFound {
+		t.Errorf("GetSubscription() of another laboratory error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	updated, err := svc.UpdateSubscription(ctx, UpdateInput{
+		ID:           sub.ID,
+		LaboratoryID: "lab-123",
+		URL:          "https://clinic.example.com/v2",
+		EventTypes:   []webhook.EventType{webhook.EventOrderReady, webhook.EventOrderDelivered},
+		Secret:       "fedcba9876543210",
+	})
+	if err != nil || updated.URL != "https://clinic.example.com/v2" || len(updated.EventTypes) != 2 {
+		t.Fatalf("UpdateSubscription() = %+v, %v, want the new URL and event types", updated, err)
+	}
+
+	if subs, _ := svc.ListSubscriptions(ctx, "lab-123"); len(subs) != 1 {
+		t.Errorf("ListSubscriptions() = %d subscriptions, want 1", len(subs))
+	}
+
+	if err := svc.DeleteSubscription(ctx, sub.ID, "lab-123"); err != nil {
+		t.Fatalf("DeleteSubscription() unexpected error = %v", err)
+	}
+	if _, err := svc.GetSubscription(ctx, sub.ID, "lab-123"); err != errors.ErrNotFound {
+		t.Errorf("GetSubscription() after delete error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	// Every write is audited without revealing the secret
+	if len(auditor.records) != 3 {
+		t.Fatalf("audited %d operations, want 3", len(auditor.records))
+	}
+	for _, r := range auditor.records {
+		if r.EntityType != audit.EntityWebhook {
+			t.Errorf("audited entity type = %s, want %s", r.EntityType, audit.EntityWebhook)
+		}
+		for _, change := range audit.Diff(r.Before, r.After) {
+			if strings.Contains(change.Before, testSecret) || strings.Contains(change.After, "fedcba9876543210") {
+				t.Errorf("audit change %+v reveals the secret", change)
+			}
+		}
+	}
+	if changes := audit.Diff(auditor.records[1].Before, auditor.records[1].After); !hasChange(changes, "secret") {
+		t.Errorf("audited update changes = %+v, want the secret rotation recorded", changes)
+	}
+}
+
+func hasChange(changes []audit.FieldChange, field string) bool {
+	for _, c := range changes {
+		if c.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func TestService_DeliveryLogAndRedeliver(t *testing.T) {
+	s := newTestSetup(t)
+	svc, _ := newTestService(s)
+	ctx := context.Background()
+	clinic := newReceiver(t)
+	clinic.respond(http.StatusInternalServerError)
+
+	sub, err := svc.CreateSubscription(ctx, CreateInput{
+		LaboratoryID: "lab-123",
+		URL:          clinic.URL,
+		EventTypes:   []webhook.EventType{webhook.EventOrderReady},
+		Secret:       testSecret,
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription() unexpected error = %v", err)
+	}
+
+	_ = s.dispatcher.Handle(ctx, statusChanged("lab-123", order.StatusQualityCheck, order.StatusReady))
+	_, _ = s.worker.SendDue(ctx)
+
+	log, err := svc.ListDeliveries(ctx, "lab-123", webhook.DeliveryFilter{SubscriptionID: sub.ID})
+	if err != nil || len(log) != 1 || log[0].Status != webhook.DeliveryPending || len(log[0].Attempts) != 1 {
+		t.Fatalf("ListDeliveries() = %+v, %v, want one failed delivery awaiting a retry", log, err)
+	}
+	failed := log[0]
+
+	if _, err := svc.ListDeliveries(ctx, "lab-123", webhook.DeliveryFilter{SubscriptionID: sub.ID, Status: "lost"}); err == nil {
+		t.Error("ListDeliveries() with an invalid status error = nil, want a validation error")
+	}
+	if _, err := svc.ListDeliveries(ctx, "lab-456", webhook.DeliveryFilter{SubscriptionID: sub.ID}); err != errors.ErrNotFound {
+		t.Errorf("ListDeliveries() of another laboratory error = %v, want %v", err, errors.ErrNotFound)
+	}
+	if _, err := svc.GetDelivery(ctx, failed.ID, "hook-other", "lab-123"); err != errors.ErrNotFound {
+		t.Errorf("GetDelivery() of another subscription error = %v, want %v", err, errors.ErrNotFound)
+	}
+
+	clinic.respond(http.StatusOK)
+	redelivery, err := svc.Redeliver(ctx, failed.ID, sub.ID, "lab-123")
+	if err != nil || redelivery.RedeliveryOf != failed.ID || redelivery.Status != webhook.DeliveryPending {
+		t.Fatalf("Redeliver() = %+v, %v, want a pending redelivery", redelivery, err)
+	}
+
+	// The redelivery is sent right away, the original keeps its own backoff
+	if delivered, _ := s.worker.SendDue(ctx); delivered != 1 {
+		t.Fatalf("SendDue() = %d, want the redelivery sent", delivered)
+	}
+	got, _ := svc.GetDelivery(ctx, redelivery.ID, sub.ID, "lab-123")
+	if got.Status != webhook.DeliveryDelivered {
+		t.Errorf("redelivery status = %s, want %s", got.Status, webhook.DeliveryDelivered)
+	}
+	if payloads := clinic.received(); len(payloads) != 1 || payloads[0].ID != failed.EventID {
+		t.Errorf("received %+v, want the payload of the original event", payloads)
+	}
+}
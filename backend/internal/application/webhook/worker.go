@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/ports/outbound"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/health"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+)
+
+// WorkerConfig holds the worker settings
+type WorkerConfig struct {
+	PollInterval time.Duration       // How often due deliveries are looked for
+	BatchSize    int                 // Deliveries claimed at a time
+	Lease        time.Duration       // How long a claimed delivery is held back from other workers
+	Retry        webhook.RetryPolicy // Backoff between attempts and attempts before dead-lettering
+}
+
+// DefaultWorkerConfig returns the default worker settings
+func DefaultWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		PollInterval: 5 * time.Second,
+		BatchSize:    50,
+		Lease:        5 * time.Minute,
+		Retry: webhook.RetryPolicy{
+			MaxAttempts: 8,
+			Backoff:     outbox.Backoff{Initial: 30 * time.Second, Max: time.Hour},
+		},
+	}
+}
+
+// Worker sends due deliveries to their subscriptions and logs every attempt.
+// A failed delivery is retried with exponential backoff and dead-lettered
+// once the retry policy's attempts are used up.
+type Worker struct {
+	deliveryRepo     outbound.WebhookDeliveryRepository
+	subscriptionRepo outbound.WebhookSubscriptionRepository
+	sender           outbound.WebhookSender
+	cfg              WorkerConfig
+	now              func() time.Time
+	heartbeat        health.Heartbeat
+}
+
+// NewWorker creates a new webhook worker
+func NewWorker(deliveryRepo outbound.WebhookDeliveryRepository, subscriptionRepo outbound.WebhookSubscriptionRepository, sender outbound.WebhookSender, cfg WorkerConfig) *Worker {
+	return &Worker{
+		deliveryRepo:     deliveryRepo,
+		subscriptionRepo: subscriptionRepo,
+		sender:           sender,
+		cfg:              cfg,
+		now:              func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run sends due deliveries every poll interval until ctx is done
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+	defer w.heartbeat.Stop()
+
+	for {
+		w.heartbeat.Beat()
+		if _, err := w.SendDue(ctx); err != nil {
+			slog.ErrorContext(ctx, "webhooks: failed to send deliveries", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check reports an error unless the worker is running and polled the
+// deliveries within the last three poll intervals
+func (w *Worker) Check(ctx context.Context) error {
+	return w.heartbeat.Err(3 * w.cfg.PollInterval)
+}
+
+// SendDue attempts every delivery due now and returns how many were delivered
+func (w *Worker) SendDue(ctx context.Context) (int, error) {
+	delivered := 0
+	for ctx.Err() == nil {
+		deliveries, err := w.deliveryRepo.Claim(ctx, w.now(), w.cfg.BatchSize, w.cfg.Lease)
+		if err != nil {
+			return delivered, err
+		}
+
+		w.heartbeat.Beat()
+		for _, d := range deliveries {
+			if w.attempt(ctx, d) {
+				delivered++
+			}
+		}
+		if len(deliveries) < w.cfg.BatchSize {
+			break
+		}
+	}
+	return delivered, nil
+}
+
+// attempt sends a claimed delivery and stores the outcome
+func (w *Worker) attempt(ctx context.Context, d *webhook.Delivery) bool {
+	ctx = logging.WithLaboratoryID(ctx, d.LaboratoryID)
+
+	sub, err := w.subscriptionRepo.GetByID(ctx, d.SubscriptionID)
+	switch {
+	case err == errors.ErrNotFound:
+		// Nowhere to send it anymore: dead-letter it without retries
+		d.Record(webhook.Attempt{At: w.now(), Error: "subscription deleted"}, webhook.RetryPolicy{})
+	case err != nil:
+		// Attempted again once the lease ends
+		slog.ErrorContext(ctx, "webhooks: failed to load the subscription of a delivery", "delivery_id", d.ID, "subscription_id", d.SubscriptionID, "error", err)
+		return false
+	default:
+		d.Record(w.send(ctx, sub, d), w.cfg.Retry)
+	}
+
+	switch d.Status {
+	case webhook.DeliveryDead:
+		slog.WarnContext(ctx, "webhooks: delivery dead", "delivery_id", d.ID, "event", d.EventType, "subscription_id", d.SubscriptionID, "attempts", len(d.Attempts), "error", d.Attempts[len(d.Attempts)-1].Error)
+	case webhook.DeliveryPending:
+		slog.WarnContext(ctx, "webhooks: delivery failed, retrying", "delivery_id", d.ID, "event", d.EventType, "subscription_id", d.SubscriptionID, "next_attempt_at", d.NextAttemptAt, "error", d.Attempts[len(d.Attempts)-1].Error)
+	}
+
+	// The delivery stays claimed until its lease ends if the outcome can't be
+	// stored, and is then sent again
+	if err := w.deliveryRepo.Update(ctx, d); err != nil {
+		slog.ErrorContext(ctx, "webhooks: failed to store the attempt of a delivery", "delivery_id", d.ID, "error", err)
+	}
+	return d.Status == webhook.DeliveryDelivered
+}
+
+// send posts the signed payload of a delivery to its subscription
+func (w *Worker) send(ctx context.Context, sub *webhook.Subscription, d *webhook.Delivery) webhook.Attempt {
+	at := w.now()
+	status, err := w.sender.Send(ctx, outbound.WebhookRequest{
+		URL:        sub.URL,
+		DeliveryID: d.ID,
+		EventType:  d.EventType,
+		Timestamp:  at,
+		Signature:  webhook.Sign(sub.Secret, at, d.Payload),
+		Payload:    d.Payload,
+	})
+
+	attempt := webhook.Attempt{At: at, StatusCode: status, Duration: w.now().Sub(at)}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	return attempt
+}
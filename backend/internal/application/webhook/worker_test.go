@@ -0,0 +1,280 @@
+package webhook
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/persistence/memory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/adapters/outbound/webhooksender"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+const testSecret = "0123456789abcdef"
+
+type sequenceIDGenerator struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (g *sequenceIDGenerator) Generate() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.n++
+	return "id-" + strconv.Itoa(g.n)
+}
+
+// receiver is an httptest server recording the payloads it accepts, and
+// responding with status while it is not 2xx
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	payloads []Payload
+	verified bool
+}
+
+// newTestSender returns a webhook sender trusting the certificate that
+// httptest TLS servers share and allowed to reach them on loopback
+func newTestSender() *webhooksender.HTTPSender {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	return webhooksender.NewHTTPSender(webhooksender.Config{Timeout: time.Second, AllowPrivateNetworks: true, RootCAs: pool})
+}
+
+func newReceiver(t *testing.T) *receiver {
+	r := &receiver{status: http.StatusOK, verified: true}
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		body, _ := io.ReadAll(req.Body)
+		unix, _ := strconv.ParseInt(req.Header.Get(webhooksender.HeaderTimestamp), 10, 64)
+		if !webhook.Verify(testSecret, req.Header.Get(webhooksender.HeaderSignature), time.Unix(unix, 0), body) {
+			r.verified = false
+		}
+
+		if r.status >= 200 && r.status < 300 {
+			var p Payload
+			if err := json.Unmarshal(body, &p); err != nil {
+				t.Errorf("receiver: invalid payload %s: %v", body, err)
+			}
+			r.payloads = append(r.payloads, p)
+		}
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) respond(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) received() []Payload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Payload(nil), r.payloads...)
+}
+
+// testSetup wires a dispatcher and a worker sharing memory repositories
+type testSetup struct {
+	subs       *memory.WebhookSubscriptionRepository
+	deliveries *memory.WebhookDeliveryRepository
+	dispatcher *Dispatcher
+	worker     *Worker
+	idGen      *sequenceIDGenerator
+	now        time.Time
+}
+
+func newTestSetup(t *testing.T) *testSetup {
+	s := &testSetup{
+		subs:       memory.NewWebhookSubscriptionRepository(),
+		deliveries: memory.NewWebhookDeliveryRepository(),
+		idGen:      &sequenceIDGenerator{},
+		now:        time.Now().UTC(),
+	}
+	s.dispatcher = NewDispatcher(s.subs, s.deliveries, s.idGen)
+
+	cfg := DefaultWorkerConfig()
+	cfg.Retry = webhook.RetryPolicy{MaxAttempts: 3, Backoff: outbox.Backoff{Initial: time.Minute, Max: time.Hour}}
+	s.worker = NewWorker(s.deliveries, s.subs, newTestSender(), cfg)
+
+	// Both follow a clock the tests move forward
+	s.dispatcher.now = func() time.Time { return s.now }
+	s.worker.now = func() time.Time { return s.now }
+	return s
+}
+
+func (s *testSetup) subscribe(t *testing.T, id, url string, types ...webhook.EventType) {
+	sub, err := webhook.NewSubscription(id, "lab-123", url, types, testSecret)
+	if err != nil {
+		t.Fatalf("NewSubscription() error = %v", err)
+	}
+	if err := s.subs.Create(context.Background(), sub); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+}
+
+func (s *testSetup) deliveriesOf(t *testing.T, subscriptionID string) []*webhook.Delivery {
+	deliveries, err := s.deliveries.List(context.Background(), webhook.DeliveryFilter{SubscriptionID: subscriptionID})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	return deliveries
+}
+
+func statusChanged(laboratoryID string, from, to order.Status) event.Event {
+	return event.OrderStatusChanged{
+		Metadata: event.Metadata{ID: "event-" + string(to), LaboratoryID: laboratoryID, OccurredAt: time.Now().UTC()},
+		Order:    order.Order{ID: "order-1", ClientID: "client-1", LaboratoryID: laboratoryID, Status: to},
+		From:     from,
+		To:       to,
+	}
+}
+
+func TestWorker_DeliversSubscribedEvents(t *testing.T) {
+	s := newTestSetup(t)
+	ctx := context.Background()
+	clinic := newReceiver(t)
+	other := newReceiver(t)
+
+	s.subscribe(t, "hook-ready", clinic.URL, webhook.EventOrderReady, webhook.EventOrderDelivered)
+	s.subscribe(t, "hook-created", other.URL, webhook.EventOrderCreated)
+
+	events := []event.Event{
+		statusChanged("lab-123", order.StatusReceived, order.StatusInProduction),
+		statusChanged("lab-123", order.StatusQualityCheck, order.StatusReady),
+		statusChanged("lab-456", order.StatusQualityCheck, order.StatusReady), // Another laboratory
+		event.ClientCreated{Metadata: event.NewMetadata("lab-123")},
+	}
+	for _, e := range events {
+		if err := s.dispatcher.Handle(ctx, e); err != nil {
+			t.Fatalf("Handle() unexpected error = %v", err)
+		}
+	}
+
+	delivered, err := s.worker.SendDue(ctx)
+	if err != nil || delivered != 1 {
+		t.Fatalf("SendDue() = %d, %v, want 1 delivery", delivered, err)
+	}
+
+	payloads := clinic.received()
+	if len(payloads) != 1 {
+		t.Fatalf("clinic received %d payloads, want 1", len(payloads))
+	}
+	p := payloads[0]
+	if p.Type != webhook.EventOrderReady || p.LaboratoryID != "lab-123" || p.ID == "" ||
+		p.Data.Order.ID != "order-1" || p.Data.To == nil || *p.Data.To != order.StatusReady {
+		t.Errorf("payload = %+v, want order.ready of order-1", p)
+	}
+	if !clinic.verified {
+		t.Error("clinic received a payload with an invalid signature")
+	}
+	if len(other.received()) != 0 {
+		t.Errorf("other receiver got %d payloads, want none", len(other.received()))
+	}
+
+	log := s.deliveriesOf(t, "hook-ready")
+	if len(log) != 1 || log[0].Status != webhook.DeliveryDelivered || len(log[0].Attempts) != 1 || log[0].Attempts[0].StatusCode != http.StatusOK {
+		t.Errorf("delivery log = %+v, want one delivered delivery with its attempt", log)
+	}
+}
+
+func TestDispatcher_KeepsEventIDOnRedelivery(t *testing.T) {
+	s := newTestSetup(t)
+	ctx := context.Background()
+	s.subscribe(t, "hook-ready", newReceiver(t).URL, webhook.EventOrderStatusChanged, webhook.EventOrderReady)
+
+	e := statusChanged("lab-123", order.StatusQualityCheck, order.StatusReady)
+	for i := 0; i < 2; i++ {
+		if err := s.dispatcher.Handle(ctx, e); err != nil {
+			t.Fatalf("Handle() unexpected error = %v", err)
+		}
+	}
+
+	ids := map[webhook.EventType][]string{}
+	for _, d := range s.deliveriesOf(t, "hook-ready") {
+		ids[d.EventType] = append(ids[d.EventType], d.EventID)
+	}
+	changed, ready := ids[webhook.EventOrderStatusChanged], ids[webhook.EventOrderReady]
+	if len(changed) != 2 || changed[0] != changed[1] || len(ready) != 2 || ready[0] != ready[1] {
+		t.Fatalf("event IDs = %v, want each event type's ID kept across deliveries", ids)
+	}
+	if changed[0] == ready[0] {
+		t.Errorf("event IDs = %v, want one per event type", ids)
+	}
+}
+
+func TestWorker_RetriesThenDeadLetters(t *testing.T) {
+	s := newTestSetup(t)
+	ctx := context.Background()
+	clinic := newReceiver(t)
+	clinic.respond(http.StatusServiceUnavailable)
+
+	s.subscribe(t, "hook-1", clinic.URL, webhook.EventOrderDelivered)
+	_ = s.dispatcher.Handle(ctx, statusChanged("lab-123", order.StatusReady, order.StatusDelivered))
+
+	if delivered, _ := s.worker.SendDue(ctx); delivered != 0 {
+		t.Fatalf("SendDue() = %d, want 0 while the receiver fails", delivered)
+	}
+	d := s.deliveriesOf(t, "hook-1")[0]
+	if d.Status != webhook.DeliveryPending || !d.NextAttemptAt.Equal(s.now.Add(time.Minute)) || d.Attempts[0].StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("after the first attempt delivery = %+v, want a retry in a minute", d)
+	}
+
+	// Not retried before its backoff
+	s.now = s.now.Add(30 * time.Second)
+	_, _ = s.worker.SendDue(ctx)
+	if d := s.deliveriesOf(t, "hook-1")[0]; len(d.Attempts) != 1 {
+		t.Fatalf("attempts before the backoff = %d, want 1", len(d.Attempts))
+	}
+
+	s.now = s.now.Add(30 * time.Second)
+	_, _ = s.worker.SendDue(ctx)
+	s.now = s.now.Add(2 * time.Minute)
+	_, _ = s.worker.SendDue(ctx)
+
+	d = s.deliveriesOf(t, "hook-1")[0]
+	if d.Status != webhook.DeliveryDead || len(d.Attempts) != 3 {
+		t.Fatalf("after 3 failed attempts delivery = %+v, want it dead", d)
+	}
+
+	// Dead deliveries are never attempted again
+	s.now = s.now.Add(24 * time.Hour)
+	clinic.respond(http.StatusOK)
+	if delivered, _ := s.worker.SendDue(ctx); delivered != 0 || len(clinic.received()) != 0 {
+		t.Errorf("SendDue() = %d after dead-lettering, want nothing sent", delivered)
+	}
+}
+
+func TestWorker_DeadLettersDeliveriesOfDeletedSubscriptions(t *testing.T) {
+	s := newTestSetup(t)
+	ctx := context.Background()
+	clinic := newReceiver(t)
+
+	s.subscribe(t, "hook-1", clinic.URL, webhook.EventOrderReady)
+	_ = s.dispatcher.Handle(ctx, statusChanged("lab-123", order.StatusQualityCheck, order.StatusReady))
+	_ = s.subs.Delete(ctx, "hook-1")
+
+	if delivered, _ := s.worker.SendDue(ctx); delivered != 0 || len(clinic.received()) != 0 {
+		t.Fatalf("SendDue() = %d, want nothing sent to a deleted subscription", delivered)
+	}
+	if d := s.deliveriesOf(t, "hook-1")[0]; d.Status != webhook.DeliveryDead || d.Attempts[0].Error != "subscription deleted" {
+		t.Errorf("delivery = %+v, want it dead-lettered", d)
+	}
+}
@@ -1,19 +1,80 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 // Config holds the application configuration
 type Config struct {
-	Server ServerConfig `mapstructure:"server"`
-	Clerk  ClerkConfig  `mapstructure:"clerk"`
+	Server        ServerConfig        `mapstructure:"server"`
+	Health        HealthConfig        `mapstructure:"health"`
+	Logging       LoggingConfig       `mapstructure:"logging"`
+	Metrics       MetricsConfig       `mapstructure:"metrics"`
+	Tracing       TracingConfig       `mapstructure:"tracing"`
+	Clerk         ClerkConfig         `mapstructure:"clerk"`
+	RateLimit     RateLimitConfig     `mapstructure:"rate_limit"`
+	Idempotency   IdempotencyConfig   `mapstructure:"idempotency"`
+	GRPC          GRPCConfig          `mapstructure:"grpc"`
+	Events        EventsConfig        `mapstructure:"events"`
+	Outbox        OutboxConfig        `mapstructure:"outbox"`
+	Webhooks      WebhooksConfig      `mapstructure:"webhooks"`
+	Stream        StreamConfig        `mapstructure:"stream"`
+	Comments      CommentsConfig      `mapstructure:"comments"`
+	Notifications NotificationsConfig `mapstructure:"notifications"`
+	Scheduler     SchedulerConfig     `mapstructure:"scheduler"`
+	Admin         AdminConfig         `mapstructure:"admin"`
 }
 
-// ServerConfig holds server configuration
+// ServerConfig holds server configuration.
+// WriteTimeout bounds whole responses; the order stream and comment WebSockets
+// lift it for their connections. On SIGTERM /readyz fails at once, and the
+// server keeps serving for DrainDelay, for load balancers to notice, before it
+// stops accepting connections and waits up to ShutdownTimeout for the
+// requests in flight.
 type ServerConfig struct {
-	Port string `mapstructure:"port"`
-	Host string `mapstructure:"host"`
+	Port              string        `mapstructure:"port"`
+	Host              string        `mapstructure:"host"`
+	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout"`
+	ReadTimeout       time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout      time.Duration `mapstructure:"write_timeout"`
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout"`
+	DrainDelay        time.Duration `mapstructure:"drain_delay"`
+	ShutdownTimeout   time.Duration `mapstructure:"shutdown_timeout"`
+}
+
+// HealthConfig holds readiness probe configuration.
+// Every check of /readyz must answer within Timeout; the reachability of the
+// Clerk JWKS is checked at most once per JWKSInterval.
+type HealthConfig struct {
+	Timeout      time.Duration `mapstructure:"timeout"`
+	JWKSInterval time.Duration `mapstructure:"jwks_interval"`
+}
+
+// LoggingConfig holds structured logging configuration.
+// Level is one of debug, info, warn or error; Format is json or text.
+type LoggingConfig struct {
+	Level  string `mapstructure:"level"`
+	Format string `mapstructure:"format"`
+}
+
+// MetricsConfig holds Prometheus metrics configuration.
+// Metrics are served on /metrics of the server when enabled.
+type MetricsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// TracingConfig holds OpenTelemetry tracing configuration.
+// Exporter is otlp, sending spans to the OTLP gRPC collector at Endpoint, or
+// stdout. SampleRatio is the share of the new traces sampled, from 0 to 1.
+type TracingConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	ServiceName string  `mapstructure:"service_name"`
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
 }
 
 // ClerkConfig holds Clerk authentication configuration
@@ -21,6 +82,138 @@ type ClerkConfig struct {
 	SecretKey string `mapstructure:"secret_key"`
 }
 
+// RateLimitConfig holds rate limiting configuration.
+// Limits apply per API identity within each route group.
+type RateLimitConfig struct {
+	Enabled bool                     `mapstructure:"enabled"`
+	Default RateLimitRule            `mapstructure:"default"`
+	Groups  map[string]RateLimitRule `mapstructure:"groups"` // Keyed by route group, e.g. "orders"
+}
+
+// RateLimitRule holds the token bucket settings of a route group
+type RateLimitRule struct {
+	RequestsPerMinute int `mapstructure:"requests_per_minute"`
+	Burst             int `mapstructure:"burst"`
+}
+
+// IdempotencyConfig holds Idempotency-Key configuration.
+// Responses to POST requests are kept for Window so retries can be replayed.
+type IdempotencyConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Window  time.Duration `mapstructure:"window"`
+}
+
+// GRPCConfig holds gRPC server configuration.
+// The gRPC API listens on Port of the server host, next to the REST API.
+type GRPCConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Port    string `mapstructure:"port"`
+}
+
+// EventsConfig holds domain event bus configuration.
+// The async bus delivers events in the background from a queue of Buffer
+// events; the sync bus delivers them before the request completes.
+type EventsConfig struct {
+	Async   bool `mapstructure:"async"`
+	Buffer  int  `mapstructure:"buffer"`
+	Workers int  `mapstructure:"workers"`
+}
+
+// OutboxConfig holds transactional outbox configuration.
+// Events stored with their change are relayed to the event bus every
+// PollInterval, retried for the failed subscribers with exponential backoff
+// up to MaxAttempts, and kept for Retention once delivered or given up on.
+type OutboxConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	PollInterval   time.Duration `mapstructure:"poll_interval"`
+	BatchSize      int           `mapstructure:"batch_size"`
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	Retention      time.Duration `mapstructure:"retention"`
+}
+
+// WebhooksConfig holds outgoing webhook configuration.
+// Due deliveries are sent every PollInterval with a request Timeout, and
+// failed ones retried with exponential backoff up to MaxAttempts. Receivers
+// on loopback, private or link-local addresses are refused unless
+// AllowPrivateNetworks is set.
+type WebhooksConfig struct {
+	Enabled              bool          `mapstructure:"enabled"`
+	PollInterval         time.Duration `mapstructure:"poll_interval"`
+	Timeout              time.Duration `mapstructure:"timeout"`
+	MaxAttempts          int           `mapstructure:"max_attempts"`
+	InitialBackoff       time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff           time.Duration `mapstructure:"max_backoff"`
+	AllowPrivateNetworks bool          `mapstructure:"allow_private_networks"`
+}
+
+// StreamConfig holds live order stream configuration.
+// The last Replay changes of each laboratory are kept for resuming clients,
+// a client more than Buffer changes behind is disconnected, and a heartbeat
+// is sent every Heartbeat while idle.
+type StreamConfig struct {
+	Replay    int           `mapstructure:"replay"`
+	Buffer    int           `mapstructure:"buffer"`
+	Heartbeat time.Duration `mapstructure:"heartbeat"`
+}
+
+// CommentsConfig holds order comment WebSocket configuration.
+// A connection more than Buffer signals behind is dropped, typing signals of
+// a connection are relayed at most every TypingInterval, and browsers may
+// connect from AllowedOrigins besides the API's own origin.
+type CommentsConfig struct {
+	Buffer         int           `mapstructure:"buffer"`
+	TypingInterval time.Duration `mapstructure:"typing_interval"`
+	AllowedOrigins []string      `mapstructure:"allowed_origins"`
+}
+
+// NotificationsConfig holds email notification configuration.
+// Emails are queued and sent From the given address, under the laboratory's
+// name, unless the laboratory configured its own sender at one of
+// SenderDomains. Failed emails are retried like webhook deliveries.
+type NotificationsConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	From           string        `mapstructure:"from"`
+	SenderDomains  []string      `mapstructure:"sender_domains"`
+	PollInterval   time.Duration `mapstructure:"poll_interval"`
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	SMTP           SMTPConfig    `mapstructure:"smtp"`
+}
+
+// SMTPConfig holds the SMTP server the notification emails are sent through
+type SMTPConfig struct {
+	Host     string        `mapstructure:"host"`
+	Port     int           `mapstructure:"port"`
+	Username string        `mapstructure:"username"`
+	Password string        `mapstructure:"password"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// SchedulerConfig holds background job configuration. Instance identifies
+// this process in job locks and run history, the host name and process ID
+// when empty.
+type SchedulerConfig struct {
+	Enabled      bool               `mapstructure:"enabled"`
+	Instance     string             `mapstructure:"instance"`
+	PurgeDeleted PurgeDeletedConfig `mapstructure:"purge_deleted"`
+}
+
+// PurgeDeletedConfig holds the job permanently removing the records
+// soft-deleted more than Retention ago
+type PurgeDeletedConfig struct {
+	Schedule  string        `mapstructure:"schedule"`
+	Retention time.Duration `mapstructure:"retention"`
+	Timeout   time.Duration `mapstructure:"timeout"`
+}
+
+// AdminConfig holds the users allowed on the admin endpoints
+type AdminConfig struct {
+	UserIDs []string `mapstructure:"user_ids"`
+}
+
 // Load loads the configuration from file and environment
 func Load() (*Config, error) {
 	viper.SetConfigName("config")
@@ -32,7 +225,72 @@ func Load() (*Config, error) {
 	// Set defaults
 	viper.SetDefault("server.port", "8080")
 	viper.SetDefault("server.host", "0.0.0.0")
+	viper.SetDefault("server.read_header_timeout", "10s")
+	viper.SetDefault("server.read_timeout", "1m")
+	viper.SetDefault("server.write_timeout", "2m")
+	viper.SetDefault("server.idle_timeout", "2m")
+	viper.SetDefault("server.drain_delay", "15s")
+	viper.SetDefault("server.shutdown_timeout", "30s")
+	viper.SetDefault("health.timeout", "2s")
+	viper.SetDefault("health.jwks_interval", "1m")
+	viper.SetDefault("logging.level", "info")
+	viper.SetDefault("logging.format", "json")
+	viper.SetDefault("metrics.enabled", true)
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.service_name", "dental-prosthesis-api")
+	viper.SetDefault("tracing.exporter", "otlp")
+	viper.SetDefault("tracing.endpoint", "localhost:4317")
+	viper.SetDefault("tracing.insecure", false)
+	viper.SetDefault("tracing.sample_ratio", 1.0)
 	viper.SetDefault("clerk.secret_key", "")
+	viper.SetDefault("rate_limit.enabled", true)
+	viper.SetDefault("rate_limit.default.requests_per_minute", 300)
+	viper.SetDefault("rate_limit.default.burst", 60)
+	viper.SetDefault("idempotency.enabled", true)
+	viper.SetDefault("idempotency.window", "24h")
+	viper.SetDefault("grpc.enabled", true)
+	viper.SetDefault("grpc.port", "9090")
+	viper.SetDefault("events.async", true)
+	viper.SetDefault("events.buffer", 1024)
+	viper.SetDefault("events.workers", 1)
+	viper.SetDefault("outbox.enabled", true)
+	viper.SetDefault("outbox.poll_interval", "1s")
+	viper.SetDefault("outbox.batch_size", 100)
+	viper.SetDefault("outbox.max_attempts", 20)
+	viper.SetDefault("outbox.initial_backoff", "1s")
+	viper.SetDefault("outbox.max_backoff", "5m")
+	viper.SetDefault("outbox.retention", "24h")
+	viper.SetDefault("webhooks.enabled", true)
+	viper.SetDefault("webhooks.poll_interval", "5s")
+	viper.SetDefault("webhooks.timeout", "10s")
+	viper.SetDefault("webhooks.max_attempts", 8)
+	viper.SetDefault("webhooks.initial_backoff", "30s")
+	viper.SetDefault("webhooks.max_backoff", "1h")
+	viper.SetDefault("webhooks.allow_private_networks", false)
+	viper.SetDefault("stream.replay", 256)
+	viper.SetDefault("stream.buffer", 64)
+	viper.SetDefault("stream.heartbeat", "15s")
+	viper.SetDefault("comments.buffer", 32)
+	viper.SetDefault("comments.typing_interval", "2s")
+	viper.SetDefault("comments.allowed_origins", []string{})
+	viper.SetDefault("notifications.enabled", false)
+	viper.SetDefault("notifications.from", "notifications@localhost")
+	viper.SetDefault("notifications.sender_domains", []string{})
+	viper.SetDefault("notifications.poll_interval", "5s")
+	viper.SetDefault("notifications.max_attempts", 8)
+	viper.SetDefault("notifications.initial_backoff", "30s")
+	viper.SetDefault("notifications.max_backoff", "1h")
+	viper.SetDefault("notifications.smtp.host", "localhost")
+	viper.SetDefault("notifications.smtp.port", 587)
+	viper.SetDefault("notifications.smtp.username", "")
+	viper.SetDefault("notifications.smtp.password", "")
+	viper.SetDefault("notifications.smtp.timeout", "10s")
+	viper.SetDefault("scheduler.enabled", true)
+	viper.SetDefault("scheduler.instance", "")
+	viper.SetDefault("scheduler.purge_deleted.schedule", "0 3 * * *")
+	viper.SetDefault("scheduler.purge_deleted.retention", "720h")
+	viper.SetDefault("scheduler.purge_deleted.timeout", "10m")
+	viper.SetDefault("admin.user_ids", []string{})
 
 	// Environment variables
 	viper.SetEnvPrefix("DENTAL")
@@ -41,7 +299,32 @@ func Load() (*Config, error) {
 	// Bind specific environment variables
 	_ = viper.BindEnv("server.port", "DENTAL_SERVER_PORT")
 	_ = viper.BindEnv("server.host", "DENTAL_SERVER_HOST")
+	_ = viper.BindEnv("server.read_header_timeout", "DENTAL_SERVER_READ_HEADER_TIMEOUT")
+	_ = viper.BindEnv("server.read_timeout", "DENTAL_SERVER_READ_TIMEOUT")
+	_ = viper.BindEnv("server.write_timeout", "DENTAL_SERVER_WRITE_TIMEOUT")
+	_ = viper.BindEnv("server.idle_timeout", "DENTAL_SERVER_IDLE_TIMEOUT")
+	_ = viper.BindEnv("server.drain_delay", "DENTAL_SERVER_DRAIN_DELAY")
+	_ = viper.BindEnv("server.shutdown_timeout", "DENTAL_SERVER_SHUTDOWN_TIMEOUT")
+	_ = viper.BindEnv("health.timeout", "DENTAL_HEALTH_TIMEOUT")
+	_ = viper.BindEnv("health.jwks_interval", "DENTAL_HEALTH_JWKS_INTERVAL")
+	_ = viper.BindEnv("logging.level", "DENTAL_LOGGING_LEVEL")
+	_ = viper.BindEnv("logging.format", "DENTAL_LOGGING_FORMAT")
+	_ = viper.BindEnv("metrics.enabled", "DENTAL_METRICS_ENABLED")
+	_ = viper.BindEnv("tracing.enabled", "DENTAL_TRACING_ENABLED")
+	_ = viper.BindEnv("tracing.service_name", "DENTAL_TRACING_SERVICE_NAME")
+	_ = viper.BindEnv("tracing.exporter", "DENTAL_TRACING_EXPORTER")
+	_ = viper.BindEnv("tracing.endpoint", "DENTAL_TRACING_ENDPOINT")
+	_ = viper.BindEnv("tracing.insecure", "DENTAL_TRACING_INSECURE")
+	_ = viper.BindEnv("tracing.sample_ratio", "DENTAL_TRACING_SAMPLE_RATIO")
 	_ = viper.BindEnv("clerk.secret_key", "CLERK_SECRET_KEY")
+	_ = viper.BindEnv("rate_limit.enabled", "DENTAL_RATE_LIMIT_ENABLED")
+	_ = viper.BindEnv("idempotency.enabled", "DENTAL_IDEMPOTENCY_ENABLED")
+	_ = viper.BindEnv("idempotency.window", "DENTAL_IDEMPOTENCY_WINDOW")
+	_ = viper.BindEnv("grpc.enabled", "DENTAL_GRPC_ENABLED")
+	_ = viper.BindEnv("grpc.port", "DENTAL_GRPC_PORT")
+	_ = viper.BindEnv("events.async", "DENTAL_EVENTS_ASYNC")
+	_ = viper.BindEnv("outbox.enabled", "DENTAL_OUTBOX_ENABLED")
+	_ = viper.BindEnv("webhooks.enabled", "DENTAL_WEBHOOKS_ENABLED")
 
 	// Try to read config file (optional)
 	if err := viper.ReadInConfig(); err != nil {
@@ -0,0 +1,216 @@
+package audit
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Action represents the kind of write operation that was audited
+type Action string
+
+const (
+	ActionCreate       Action = "create"
+	ActionUpdate       Action = "update"
+	ActionDelete       Action = "delete"
+	ActionStatusChange Action = "status_change"
+)
+
+// AllActions returns all audited actions
+func AllActions() []Action {
+	return []Action{ActionCreate, ActionUpdate, ActionDelete, ActionStatusChange}
+}
+
+// EntityType represents the kind of entity an audit entry refers to
+type EntityType string
+
+const (
+	EntityLaboratory           EntityType = "laboratory"
+	EntityClient               EntityType = "client"
+	EntityOrder                EntityType = "order"
+	EntityProsthesis           EntityType = "prosthesis"
+	EntityTechnician           EntityType = "technician"
+	EntityWebhook              EntityType = "webhook"
+	EntityComment              EntityType = "comment"
+	EntityNotificationSettings EntityType = "notification_settings"
+)
+
+// AllEntityTypes returns all audited entity types
+func AllEntityTypes() []EntityType {
+	return []EntityType{EntityLaboratory, EntityClient, EntityOrder, EntityProsthesis, EntityTechnician, EntityWebhook, EntityComment, EntityNotificationSettings}
+}
+
+// Entry represents an immutable record of a write operation
+type Entry struct {
+	ID           string
+	LaboratoryID string
+	ActorID      string
+	EntityType   EntityType
+	EntityID     string
+	Action       Action
+	Changes      []FieldChange
+	RequestID    string
+	OccurredAt   time.Time
+}
+
+// FieldChange represents the before and after values of a single field
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// Filter represents the criteria for querying audit entries
+type Filter struct {
+	LaboratoryID string
+	ActorID      string
+	EntityType   EntityType
+	EntityID     string
+	Action       Action
+	From         *time.Time
+	To           *time.Time
+	Limit        int
+}
+
+// Matches returns true if the entry satisfies every criterion of the filter
+func (f Filter) Matches(e *Entry) bool {
+	if f.LaboratoryID != "" && e.LaboratoryID != f.LaboratoryID {
+		return false
+	}
+	if f.ActorID != "" && e.ActorID != f.ActorID {
+		return false
+	}
+	if f.EntityType != "" && e.EntityType != f.EntityType {
+		return false
+	}
+	if f.EntityID != "" && e.EntityID != f.EntityID {
+		return false
+	}
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if f.From != nil && e.OccurredAt.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && e.OccurredAt.After(*f.To) {
+		return false
+	}
+	return true
+}
+
+// ignoredFields are bookkeeping fields that would only add noise to a diff
+var ignoredFields = map[string]bool{
+	"CreatedAt": true,
+	"UpdatedAt": true,
+	"DeletedAt": true,
+	"History":   true,
+}
+
+// Diff returns the changed fields between two snapshots of an entity.
+// Either side may be nil, e.g. before is nil for a create.
+func Diff(before, after interface{}) []FieldChange {
+	b := Snapshot(before)
+	a := Snapshot(after)
+
+	fields := make(map[string]bool, len(a)+len(b))
+	for f := range b {
+		fields[f] = true
+	}
+	for f := range a {
+		fields[f] = true
+	}
+
+	var changes []FieldChange
+	for f := range fields {
+		if b[f] != a[f] {
+			changes = append(changes, FieldChange{Field: f, Before: b[f], After: a[f]})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// Snapshot flattens an entity into snake_case field paths and string values
+func Snapshot(v interface{}) map[string]string {
+	out := make(map[string]string)
+	if v == nil {
+		return out
+	}
+	flatten(out, "", reflect.ValueOf(v))
+	return out
+}
+
+func flatten(out map[string]string, prefix string, v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			if prefix != "" {
+				out[prefix] = ""
+			}
+			return
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		out[prefix] = t.UTC().Format(time.RFC3339Nano)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || ignoredFields[field.Name] {
+				continue
+			}
+			flatten(out, join(prefix, snakeCase(field.Name)), v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.String {
+			values := make([]string, v.Len())
+			for i := range values {
+				values[i] = v.Index(i).String()
+			}
+			out[prefix] = strings.Join(values, ",")
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			flatten(out, prefix+"["+strconv.Itoa(i)+"]", v.Index(i))
+		}
+	default:
+		out[prefix] = fmt.Sprint(v.Interface())
+	}
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// snakeCase converts a Go identifier such as LaboratoryID to laboratory_id
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word on lower->Upper and on the last capital of an acronym (IDName -> id_name)
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
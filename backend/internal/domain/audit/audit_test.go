@@ -0,0 +1,136 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type address struct {
+	Street string
+	City   string
+}
+
+type sample struct {
+	ID           string
+	LaboratoryID string
+	Name         string
+	Tags         []string
+	Address      address
+	UpdatedAt    time.Time
+}
+
+func TestDiff(t *testing.T) {
+	before := &sample{ID: "1", LaboratoryID: "lab-1", Name: "Old", Tags: []string{"a"}, Address: address{Street: "Main", City: "SP"}}
+	after := &sample{ID: "1", LaboratoryID: "lab-1", Name: "New", Tags: []string{"a", "b"}, Address: address{Street: "Main", City: "RJ"}, UpdatedAt: time.Now()}
+
+	tests := []struct {
+		name   string
+		before interface{}
+		after  interface{}
+		want   []FieldChange
+	}{
+		{
+			name:   "update reports only changed fields",
+			before: before,
+			after:  after,
+			want: []FieldChange{
+				{Field: "address.city", Before: "SP", After: "RJ"},
+				{Field: "name", Before: "Old", After: "New"},
+				{Field: "tags", Before: "a", After: "a,b"},
+			},
+		},
+		{
+			name:   "no changes",
+			before: before,
+			after:  before,
+			want:   nil,
+		},
+		{
+			name:   "create reports populated fields",
+			before: nil,
+			after:  &sample{ID: "1", Name: "New"},
+			want: []FieldChange{
+				{Field: "id", Before: "", After: "1"},
+				{Field: "name", Before: "", After: "New"},
+			},
+		},
+		{
+			name:   "delete reports populated fields",
+			before: &sample{ID: "1", Name: "Old"},
+			after:  nil,
+			want: []FieldChange{
+				{Field: "id", Before: "1", After: ""},
+				{Field: "name", Before: "Old", After: ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.before, tt.after)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Name", "name"},
+		{"LaboratoryID", "laboratory_id"},
+		{"PortalUserID", "portal_user_id"},
+		{"IDName", "id_name"},
+		{"ZipCode", "zip_code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := snakeCase(tt.input); got != tt.want {
+				t.Errorf("snakeCase(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	now := time.Now().UTC()
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+
+	entry := &Entry{
+		LaboratoryID: "lab-1",
+		ActorID:      "user_1",
+		EntityType:   EntityClient,
+		EntityID:     "client-1",
+		Action:       ActionUpdate,
+		OccurredAt:   now,
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty filter", Filter{}, true},
+		{"all criteria match", Filter{LaboratoryID: "lab-1", ActorID: "user_1", EntityType: EntityClient, EntityID: "client-1", Action: ActionUpdate, From: &earlier, To: &later}, true},
+		{"other laboratory", Filter{LaboratoryID: "lab-2"}, false},
+		{"other actor", Filter{ActorID: "user_2"}, false},
+		{"other entity type", Filter{EntityType: EntityOrder}, false},
+		{"other action", Filter{Action: ActionDelete}, false},
+		{"before range", Filter{From: &later}, false},
+		{"after range", Filter{To: &earlier}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(entry); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ type Client struct {
 	Email        string
 	Phone        string
 	Address      Address
+	PortalUserID string // Identity allowed to use the client portal
+	EmailOptOut  bool   // Client asked not to receive notification emails
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    *time.Time
@@ -61,52 +63,31 @@ func (c *Client) Validate() error {
 
 	// Validate laboratory_id
 	if strings.TrimSpace(c.LaboratoryID) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "laboratory_id",
-			Message: "laboratory_id is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("laboratory_id"))
 	}
 
 	// Validate name
 	name := strings.TrimSpace(c.Name)
 	if name == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "name",
-			Message: "name is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("name"))
 	} else if len(name) > 200 {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "name",
-			Message: "name must be at most 200 characters",
-		})
+		validationErrors = append(validationErrors, errors.TooLong("name", 200))
 	}
 
 	// Validate email
 	email := strings.TrimSpace(c.Email)
 	if email == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "email",
-			Message: "email is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("email"))
 	} else if !emailRegex.MatchString(email) {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "email",
-			Message: "invalid email format",
-		})
+		validationErrors = append(validationErrors, errors.InvalidEmail("email"))
 	}
 
 	// Validate phone
 	phone := strings.TrimSpace(c.Phone)
 	if phone == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "phone",
-			Message: "phone is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("phone"))
 	} else if !phoneRegex.MatchString(strings.ReplaceAll(phone, " ", "")) {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "phone",
-			Message: "invalid phone format",
-		})
+		validationErrors = append(validationErrors, errors.InvalidPhone("phone"))
 	}
 
 	// Validate address
@@ -134,6 +115,29 @@ func (c *Client) Update(name, email, phone string, address Address) error {
 	return c.Validate()
 }
 
+// LinkPortalUser binds a portal identity to the client
+func (c *Client) LinkPortalUser(userID string) error {
+	if strings.TrimSpace(userID) == "" {
+		return errors.Validation(errors.Required("user_id"))
+	}
+
+	c.PortalUserID = userID
+	c.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// UnlinkPortalUser removes the portal identity from the client
+func (c *Client) UnlinkPortalUser() {
+	c.PortalUserID = ""
+	c.UpdatedAt = time.Now().UTC()
+}
+
+// SetEmailNotifications opts the client in or out of notification emails
+func (c *Client) SetEmailNotifications(enabled bool) {
+	c.EmailOptOut = !enabled
+	c.UpdatedAt = time.Now().UTC()
+}
+
 // Delete performs a soft delete by setting DeletedAt
 func (c *Client) Delete() {
 	now := time.Now().UTC()
@@ -150,38 +154,23 @@ func (a *Address) Validate() error {
 	var validationErrors errors.ValidationErrors
 
 	if strings.TrimSpace(a.Street) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.street",
-			Message: "street is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.street"))
 	}
 
 	if strings.TrimSpace(a.City) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.city",
-			Message: "city is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.city"))
 	}
 
 	if strings.TrimSpace(a.State) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.state",
-			Message: "state is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.state"))
 	}
 
 	if strings.TrimSpace(a.PostalCode) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.postal_code",
-			Message: "postal code is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.postal_code"))
 	}
 
 	if strings.TrimSpace(a.Country) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.country",
-			Message: "country is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.country"))
 	}
 
 	if len(validationErrors) > 0 {
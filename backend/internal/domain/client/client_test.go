@@ -218,7 +218,7 @@ func TestNewClient(t *testing.T) {
 				Country:    "Brazil",
 			},
 			wantErr:     true,
-			errContains: "postal code is required",
+			errContains: "postal_code is required",
 		},
 		{
 			name:         "missing country",
@@ -372,6 +372,52 @@ func TestClient_Delete(t *testing.T) {
 	}
 }
 
+func TestClient_LinkPortalUser(t *testing.T) {
+	client := &Client{
+		ID:           "client-123",
+		LaboratoryID: "lab-123",
+		Name:         "Test Client",
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+
+	if err := client.LinkPortalUser("  "); err == nil {
+		t.Errorf("LinkPortalUser() expected error for empty user ID, got nil")
+	}
+
+	if err := client.LinkPortalUser("user_abc"); err != nil {
+		t.Fatalf("LinkPortalUser() unexpected error = %v", err)
+	}
+	if client.PortalUserID != "user_abc" {
+		t.Errorf("LinkPortalUser() PortalUserID = %v, want %v", client.PortalUserID, "user_abc")
+	}
+
+	client.UnlinkPortalUser()
+	if client.PortalUserID != "" {
+		t.Errorf("UnlinkPortalUser() PortalUserID = %v, want empty", client.PortalUserID)
+	}
+}
+
+func TestClient_SetEmailNotifications(t *testing.T) {
+	client := &Client{
+		ID:           "client-123",
+		LaboratoryID: "lab-123",
+		Name:         "Test Client",
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+
+	client.SetEmailNotifications(false)
+	if !client.EmailOptOut {
+		t.Errorf("SetEmailNotifications(false) EmailOptOut = false, want true")
+	}
+
+	client.SetEmailNotifications(true)
+	if client.EmailOptOut {
+		t.Errorf("SetEmailNotifications(true) EmailOptOut = true, want false")
+	}
+}
+
 func TestAddress_Validate(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -436,7 +482,7 @@ func TestAddress_Validate(t *testing.T) {
 				Country:    "Brazil",
 			},
 			wantErr:     true,
-			errContains: "postal code is required",
+			errContains: "postal_code is required",
 		},
 		{
 			name: "missing country",
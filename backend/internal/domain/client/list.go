@@ -0,0 +1,10 @@
+package client
+
+import "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+
+// ListSpec describes the fields clients can be sorted and filtered by
+var ListSpec = listing.Spec{
+	SortFields:   []string{"name", "email", "created_at", "updated_at"},
+	DefaultSort:  "created_at",
+	FilterFields: []string{"email", "city", "state"},
+}
@@ -0,0 +1,111 @@
+// Package comment defines the discussion thread of an order between the
+// laboratory staff and the client who placed it
+package comment
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// MaxBodyLength is the maximum length of a comment, in characters
+const MaxBodyLength = 4000
+
+// AuthorKind tells whether a comment was written by the laboratory or its client
+type AuthorKind string
+
+const (
+	AuthorStaff  AuthorKind = "staff"
+	AuthorClient AuthorKind = "client"
+)
+
+// AllAuthorKinds returns all valid author kinds
+func AllAuthorKinds() []AuthorKind {
+	return []AuthorKind{AuthorStaff, AuthorClient}
+}
+
+// IsValid checks if the author kind is valid
+func (k AuthorKind) IsValid() bool {
+	return k == AuthorStaff || k == AuthorClient
+}
+
+// Author identifies who writes in a thread: a staff user, or a client
+// through its portal user
+type Author struct {
+	ID   string // Staff user ID, or client ID
+	Kind AuthorKind
+}
+
+// Comment represents a message of the thread of an order
+type Comment struct {
+	ID           string
+	LaboratoryID string
+	OrderID      string
+	Author       Author
+	Body         string
+	CreatedAt    time.Time
+	DeletedAt    *time.Time
+}
+
+// NewComment creates a new Comment with validation
+func NewComment(id, laboratoryID, orderID string, author Author, body string) (*Comment, error) {
+	c := &Comment{
+		ID:           id,
+		LaboratoryID: laboratoryID,
+		OrderID:      orderID,
+		Author:       author,
+		Body:         strings.TrimSpace(body),
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Validate validates the comment fields
+func (c *Comment) Validate() error {
+	var validationErrors errors.ValidationErrors
+
+	if strings.TrimSpace(c.LaboratoryID) == "" {
+		validationErrors = append(validationErrors, errors.Required("laboratory_id"))
+	}
+	if strings.TrimSpace(c.OrderID) == "" {
+		validationErrors = append(validationErrors, errors.Required("order_id"))
+	}
+	if !c.Author.Kind.IsValid() {
+		validationErrors = append(validationErrors, errors.InvalidChoice("author_kind", AllAuthorKinds()))
+	}
+
+	if c.Body == "" {
+		validationErrors = append(validationErrors, errors.Required("body"))
+	} else if utf8.RuneCountInString(c.Body) > MaxBodyLength {
+		validationErrors = append(validationErrors, errors.TooLong("body", MaxBodyLength))
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// WrittenBy reports whether the comment was written by the given author
+func (c *Comment) WrittenBy(a Author) bool {
+	return c.Author == a
+}
+
+// Delete performs a soft delete by setting DeletedAt
+func (c *Comment) Delete() {
+	now := time.Now().UTC()
+	c.DeletedAt = &now
+}
+
+// IsDeleted returns true if the comment has been soft-deleted
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
@@ -0,0 +1,115 @@
+package comment
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewComment(t *testing.T) {
+	staff := Author{ID: "user-1", Kind: AuthorStaff}
+
+	tests := []struct {
+		name         string
+		laboratoryID string
+		orderID      string
+		author       Author
+		body         string
+		wantBody     string
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:         "valid comment",
+			laboratoryID: "lab-123",
+			orderID:      "order-123",
+			author:       staff,
+			body:         "  Shade A2 confirmed?  ",
+			wantBody:     "Shade A2 confirmed?",
+		},
+		{
+			name:         "comment of the client",
+			laboratoryID: "lab-123",
+			orderID:      "order-123",
+			author:       Author{ID: "client-1", Kind: AuthorClient},
+			body:         "Yes, A2",
+			wantBody:     "Yes, A2",
+		},
+		{
+			name:         "blank body",
+			laboratoryID: "lab-123",
+			orderID:      "order-123",
+			author:       staff,
+			body:         "   ",
+			wantErr:      true,
+			errContains:  "body is required",
+		},
+		{
+			name:         "body too long",
+			laboratoryID: "lab-123",
+			orderID:      "order-123",
+			author:       staff,
+			body:         strings.Repeat("é", MaxBodyLength+1),
+			wantErr:      true,
+			errContains:  "body must be at most 4000 characters",
+		},
+		{
+			name:         "body at the limit in multibyte characters",
+			laboratoryID: "lab-123",
+			orderID:      "order-123",
+			author:       staff,
+			body:         strings.Repeat("é", MaxBodyLength),
+			wantBody:     strings.Repeat("é", MaxBodyLength),
+		},
+		{
+			name:         "missing order",
+			laboratoryID: "lab-123",
+			author:       staff,
+			body:         "Hello",
+			wantErr:      true,
+			errContains:  "order_id is required",
+		},
+		{
+			name:         "invalid author kind",
+			laboratoryID: "lab-123",
+			orderID:      "order-123",
+			author:       Author{ID: "user-1", Kind: "robot"},
+			body:         "Hello",
+			wantErr:      true,
+			errContains:  "author_kind must be one of",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewComment("comment-1", tt.laboratoryID, tt.orderID, tt.author, tt.body)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("NewComment() error = %v, want one containing %q", err, tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewComment() unexpected error = %v", err)
+			}
+			if c.Body != tt.wantBody || c.CreatedAt.IsZero() {
+				t.Errorf("NewComment() = %+v, want body %q", c, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestComment_WrittenByAndDelete(t *testing.T) {
+	c, _ := NewComment("comment-1", "lab-123", "order-123", Author{ID: "client-1", Kind: AuthorClient}, "Hello")
+
+	if !c.WrittenBy(Author{ID: "client-1", Kind: AuthorClient}) {
+		t.Error("WrittenBy() its author = false, want true")
+	}
+	if c.WrittenBy(Author{ID: "client-1", Kind: AuthorStaff}) {
+		t.Error("WrittenBy() a staff user with the same ID = true, want false")
+	}
+
+	c.Delete()
+	if !c.IsDeleted() {
+		t.Error("IsDeleted() after Delete() = false, want true")
+	}
+}
@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 // Domain errors - independent of HTTP or other adapters
 var (
@@ -24,27 +27,38 @@ var (
 
 	// ErrInvalidStatusTransition indicates an invalid order status transition
 	ErrInvalidStatusTransition = errors.New("invalid status transition")
+
+	// ErrPortalUserAlreadyLinked indicates the portal identity is bound to another client
+	ErrPortalUserAlreadyLinked = errors.New("portal user already linked to another client")
+
+	// ErrIdempotencyKeyReused indicates an idempotency key sent again with a different request
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+
+	// ErrIdempotencyRequestInProgress indicates a retry while the first request is still processed
+	ErrIdempotencyRequestInProgress = errors.New("request with this idempotency key is in progress")
 )
 
 // ValidationError represents a field validation error
 type ValidationError struct {
 	Field   string
-	Message string
+	Message string            // English message
+	Key     string            // Stable message key, see validation.go
+	Params  map[string]string // Values of the message placeholders
 }
 
 // ValidationErrors is a collection of validation errors
 type ValidationErrors []ValidationError
 
+// Error lists every field error, e.g. "name: name is required; email: invalid email format"
 func (v ValidationErrors) Error() string {
 	if len(v) == 0 {
 		return "validation failed"
 	}
-	return v[0].Field + ": " + v[0].Message
-}
-
-// NewValidationError creates a new validation error
-func NewValidationError(field, message string) ValidationErrors {
-	return ValidationErrors{{Field: field, Message: message}}
+	msgs := make([]string, len(v))
+	for i, ve := range v {
+		msgs[i] = ve.Field + ": " + ve.Message
+	}
+	return strings.Join(msgs, "; ")
 }
 
 // Is checks if the error matches the target
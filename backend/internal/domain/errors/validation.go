@@ -0,0 +1,191 @@
+package errors
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Message keys identify a validation failure independently of language.
+// Adapters translate them with the i18n catalog, filling placeholders such as
+// {field} from the error's Params; Message is the English rendering.
+const (
+	KeyRequired          = "required"            // {field}
+	KeyTooLong           = "too_long"            // {field}, {max}
+	KeyTooShort          = "too_short"           // {field}, {min}
+	KeyInvalidEmail      = "invalid_email"       // {field}
+	KeyInvalidPhone      = "invalid_phone"       // {field}
+	KeyInvalidChoice     = "invalid_choice"      // {field}, {allowed}
+	KeyAtLeastOne        = "at_least_one"        // {field}
+	KeyGreaterThan       = "greater_than"        // {field}, {min}
+	KeyAtLeast           = "at_least"            // {field}, {min}
+	KeyAtMost            = "at_most"             // {field}, {max}
+	KeyFileTooLarge      = "file_too_large"      // {field}, {max}
+	KeyFileEmpty         = "file_empty"          // {field}
+	KeyInvalidFile       = "invalid_file"        // {field}
+	KeyInvalidRange      = "invalid_range"       // {field}, {to}
+	KeyOutOfRange        = "out_of_range"        // {field}, {min}, {max}
+	KeyInvalidCursor     = "invalid_cursor"      // {field}
+	KeyUnsupportedSort   = "unsupported_sort"    // {field}, {value}
+	KeyUnsupportedFilter = "unsupported_filter"  // {field}
+	KeyPositiveInteger   = "positive_integer"    // {field}
+	KeyInvalidTimestamp  = "invalid_timestamp"   // {field}
+	KeyReferenceNotFound = "reference_not_found" // {field}
+	KeyInvalidType       = "invalid_type"        // {field}, {type}
+	KeyInvalid           = "invalid"             // {field}
+	KeyDuplicateInBatch  = "duplicate_in_batch"  // {field}
+)
+
+// Keys returns every validation message key
+func Keys() []string {
+	return []string{
+		KeyRequired, KeyTooLong, KeyTooShort, KeyInvalidEmail, KeyInvalidPhone, KeyInvalidChoice,
+		KeyAtLeastOne, KeyGreaterThan, KeyAtLeast, KeyAtMost, KeyFileTooLarge,
+		KeyFileEmpty, KeyInvalidFile, KeyInvalidRange, KeyOutOfRange, KeyInvalidCursor,
+		KeyUnsupportedSort, KeyUnsupportedFilter, KeyPositiveInteger, KeyInvalidTimestamp,
+		KeyReferenceNotFound, KeyInvalidType, KeyInvalid, KeyDuplicateInBatch,
+	}
+}
+
+// Validation collects field errors into a ValidationErrors error
+func Validation(errs ...ValidationError) ValidationErrors {
+	return ValidationErrors(errs)
+}
+
+func fieldError(field, key, message string, params map[string]string) ValidationError {
+	if params == nil {
+		params = make(map[string]string, 1)
+	}
+	params["field"] = field
+	return ValidationError{Field: field, Message: message, Key: key, Params: params}
+}
+
+// Required reports a missing field
+func Required(field string) ValidationError {
+	return fieldError(field, KeyRequired, field+" is required", nil)
+}
+
+// TooLong reports a field longer than max characters
+func TooLong(field string, max int) ValidationError {
+	return fieldError(field, KeyTooLong, field+" must be at most "+strconv.Itoa(max)+" characters",
+		map[string]string{"max": strconv.Itoa(max)})
+}
+
+// TooShort reports a field shorter than min characters
+func TooShort(field string, min int) ValidationError {
+	return fieldError(field, KeyTooShort, field+" must be at least "+strconv.Itoa(min)+" characters",
+		map[string]string{"min": strconv.Itoa(min)})
+}
+
+// InvalidEmail reports a malformed email address
+func InvalidEmail(field string) ValidationError {
+	return fieldError(field, KeyInvalidEmail, "invalid email format", nil)
+}
+
+// InvalidPhone reports a malformed phone number
+func InvalidPhone(field string) ValidationError {
+	return fieldError(field, KeyInvalidPhone, "invalid phone format", nil)
+}
+
+// InvalidChoice reports a value outside the allowed set
+func InvalidChoice[T ~string](field string, allowed []T) ValidationError {
+	values := make([]string, len(allowed))
+	for i, v := range allowed {
+		values[i] = string(v)
+	}
+	list := strings.Join(values, ", ")
+	return fieldError(field, KeyInvalidChoice, field+" must be one of: "+list,
+		map[string]string{"allowed": list})
+}
+
+// AtLeastOne reports an empty list that needs at least one element
+func AtLeastOne(field string) ValidationError {
+	return fieldError(field, KeyAtLeastOne, "at least one "+field+" item is required", nil)
+}
+
+// GreaterThan reports a number that must be greater than min
+func GreaterThan(field string, min int) ValidationError {
+	return fieldError(field, KeyGreaterThan, field+" must be greater than "+strconv.Itoa(min),
+		map[string]string{"min": strconv.Itoa(min)})
+}
+
+// AtLeast reports a value below its minimum
+func AtLeast(field, min string) ValidationError {
+	return fieldError(field, KeyAtLeast, field+" must be at least "+min, map[string]string{"min": min})
+}
+
+// AtMost reports a value above its maximum
+func AtMost(field, max string) ValidationError {
+	return fieldError(field, KeyAtMost, field+" must be at most "+max, map[string]string{"max": max})
+}
+
+// FileTooLarge reports a file above the size limit, given in megabytes
+func FileTooLarge(field string, maxMB int) ValidationError {
+	return fieldError(field, KeyFileTooLarge, field+" must be at most "+strconv.Itoa(maxMB)+" MB",
+		map[string]string{"max": strconv.Itoa(maxMB)})
+}
+
+// FileEmpty reports an empty file
+func FileEmpty(field string) ValidationError {
+	return fieldError(field, KeyFileEmpty, field+" must not be empty", nil)
+}
+
+// InvalidFile reports a file that can't be read
+func InvalidFile(field string) ValidationError {
+	return fieldError(field, KeyInvalidFile, "invalid file", nil)
+}
+
+// InvalidRange reports a range whose start (field) is after its end (to)
+func InvalidRange(field, to string) ValidationError {
+	return fieldError(field, KeyInvalidRange, field+" must not be after "+to, map[string]string{"to": to})
+}
+
+// OutOfRange reports a number outside [min, max]
+func OutOfRange(field string, min, max int) ValidationError {
+	return fieldError(field, KeyOutOfRange, field+" must be between "+strconv.Itoa(min)+" and "+strconv.Itoa(max),
+		map[string]string{"min": strconv.Itoa(min), "max": strconv.Itoa(max)})
+}
+
+// InvalidCursor reports a malformed or mismatched pagination cursor
+func InvalidCursor(field string) ValidationError {
+	return fieldError(field, KeyInvalidCursor, field+" is invalid or was issued for a different sort", nil)
+}
+
+// UnsupportedSort reports a sort field that is not allowed
+func UnsupportedSort(field, value string) ValidationError {
+	return fieldError(field, KeyUnsupportedSort, "cannot sort by "+value, map[string]string{"value": value})
+}
+
+// UnsupportedFilter reports a filter field that is not allowed
+func UnsupportedFilter(field string) ValidationError {
+	return fieldError(field, KeyUnsupportedFilter, "cannot filter by "+field, nil)
+}
+
+// PositiveInteger reports a value that must be a positive integer
+func PositiveInteger(field string) ValidationError {
+	return fieldError(field, KeyPositiveInteger, field+" must be a positive integer", nil)
+}
+
+// InvalidTimestamp reports a value that is not an RFC3339 timestamp
+func InvalidTimestamp(field string) ValidationError {
+	return fieldError(field, KeyInvalidTimestamp, field+" must be an RFC3339 timestamp", nil)
+}
+
+// ReferenceNotFound reports a field referencing a resource that doesn't exist
+func ReferenceNotFound(field string) ValidationError {
+	return fieldError(field, KeyReferenceNotFound, field+" references a resource that was not found", nil)
+}
+
+// InvalidType reports a value of the wrong JSON type
+func InvalidType(field, typ string) ValidationError {
+	return fieldError(field, KeyInvalidType, field+" must be of type "+typ, map[string]string{"type": typ})
+}
+
+// Invalid reports an invalid value without further detail
+func Invalid(field string) ValidationError {
+	return fieldError(field, KeyInvalid, field+" is invalid", nil)
+}
+
+// DuplicateInBatch reports a value repeated by another item of the same batch
+func DuplicateInBatch(field string) ValidationError {
+	return fieldError(field, KeyDuplicateInBatch, field+" appears in another item of the batch", nil)
+}
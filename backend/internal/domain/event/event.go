@@ -0,0 +1,280 @@
+// Package event defines the domain events raised by the application services
+// once a change is persisted, for other parts of the system to react to
+package event
+
+import (
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
+)
+
+// Name identifies a kind of event
+type Name string
+
+const (
+	NameLaboratoryCreated  Name = "laboratory.created"
+	NameLaboratoryUpdated  Name = "laboratory.updated"
+	NameLaboratoryDeleted  Name = "laboratory.deleted"
+	NameClientCreated      Name = "client.created"
+	NameClientUpdated      Name = "client.updated"
+	NameClientDeleted      Name = "client.deleted"
+	NameOrderCreated       Name = "order.created"
+	NameOrderUpdated       Name = "order.updated"
+	NameOrderStatusChanged Name = "order.status_changed"
+	NameOrderDeleted       Name = "order.deleted"
+	NameProsthesisCreated  Name = "prosthesis.created"
+	NameProsthesisUpdated  Name = "prosthesis.updated"
+	NameProsthesisDeleted  Name = "prosthesis.deleted"
+	NameTechnicianCreated  Name = "technician.created"
+	NameTechnicianUpdated  Name = "technician.updated"
+	NameTechnicianDeleted  Name = "technician.deleted"
+	NameCommentCreated     Name = "comment.created"
+	NameCommentDeleted     Name = "comment.deleted"
+)
+
+// AllNames returns the names of all events
+func AllNames() []Name {
+	return []Name{
+		NameLaboratoryCreated, NameLaboratoryUpdated, NameLaboratoryDeleted,
+		NameClientCreated, NameClientUpdated, NameClientDeleted,
+		NameOrderCreated, NameOrderUpdated, NameOrderStatusChanged, NameOrderDeleted,
+		NameProsthesisCreated, NameProsthesisUpdated, NameProsthesisDeleted,
+		NameTechnicianCreated, NameTechnicianUpdated, NameTechnicianDeleted,
+		NameCommentCreated, NameCommentDeleted,
+	}
+}
+
+// IsValidName checks if a name string is the name of an event
+func IsValidName(s string) bool {
+	for _, name := range AllNames() {
+		if string(name) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a change of the domain
+type Event interface {
+	// Name identifies the kind of event
+	Name() Name
+	// Meta returns the metadata every event carries
+	Meta() Metadata
+}
+
+// Metadata is embedded in every event
+type Metadata struct {
+	ID           string // Stable across deliveries; assigned when the event is recorded in the outbox
+	LaboratoryID string // Laboratory the changed entity belongs to
+	OccurredAt   time.Time
+}
+
+// NewMetadata creates the metadata of an event of a laboratory occurring now
+func NewMetadata(laboratoryID string) Metadata {
+	return Metadata{LaboratoryID: laboratoryID, OccurredAt: time.Now().UTC()}
+}
+
+// Meta returns the metadata
+func (m Metadata) Meta() Metadata { return m }
+
+// WithID returns a copy of the event identified by id
+func WithID(e Event, id string) Event {
+	switch e := e.(type) {
+	case LaboratoryCreated:
+		e.ID = id
+		return e
+	case LaboratoryUpdated:
+		e.ID = id
+		return e
+	case LaboratoryDeleted:
+		e.ID = id
+		return e
+	case ClientCreated:
+		e.ID = id
+		return e
+	case ClientUpdated:
+		e.ID = id
+		return e
+	case ClientDeleted:
+		e.ID = id
+		return e
+	case OrderCreated:
+		e.ID = id
+		return e
+	case OrderUpdated:
+		e.ID = id
+		return e
+	case OrderStatusChanged:
+		e.ID = id
+		return e
+	case OrderDeleted:
+		e.ID = id
+		return e
+	case ProsthesisCreated:
+		e.ID = id
+		return e
+	case ProsthesisUpdated:
+		e.ID = id
+		return e
+	case ProsthesisDeleted:
+		e.ID = id
+		return e
+	case TechnicianCreated:
+		e.ID = id
+		return e
+	case TechnicianUpdated:
+		e.ID = id
+		return e
+	case TechnicianDeleted:
+		e.ID = id
+		return e
+	case CommentCreated:
+		e.ID = id
+		return e
+	case CommentDeleted:
+		e.ID = id
+		return e
+	}
+	return e
+}
+
+// LaboratoryCreated is raised when a laboratory is registered
+type LaboratoryCreated struct {
+	Metadata
+	Laboratory laboratory.Laboratory
+}
+
+// LaboratoryUpdated is raised when the details of a laboratory change
+type LaboratoryUpdated struct {
+	Metadata
+	Laboratory laboratory.Laboratory
+}
+
+// LaboratoryDeleted is raised when a laboratory is soft deleted
+type LaboratoryDeleted struct {
+	Metadata
+	Laboratory laboratory.Laboratory
+}
+
+// ClientCreated is raised when a client is created
+type ClientCreated struct {
+	Metadata
+	Client client.Client
+}
+
+// ClientUpdated is raised when the details or the portal user of a client change
+type ClientUpdated struct {
+	Metadata
+	Client client.Client
+}
+
+// ClientDeleted is raised when a client is soft deleted
+type ClientDeleted struct {
+	Metadata
+	Client client.Client
+}
+
+// OrderCreated is raised when an order is received
+type OrderCreated struct {
+	Metadata
+	Order order.Order
+}
+
+// OrderUpdated is raised when the items or the technician of an order change
+type OrderUpdated struct {
+	Metadata
+	Order                order.Order
+	PreviousTechnicianID string // Technician assigned before the change, empty when unassigned
+}
+
+// TechnicianAssigned reports whether the change assigned the order to a
+// technician other than the previous one
+func (e OrderUpdated) TechnicianAssigned() bool {
+	return e.Order.TechnicianID != "" && e.Order.TechnicianID != e.PreviousTechnicianID
+}
+
+// OrderStatusChanged is raised when an order moves through its workflow
+type OrderStatusChanged struct {
+	Metadata
+	Order order.Order
+	From  order.Status
+	To    order.Status
+}
+
+// OrderDeleted is raised when an order is soft deleted
+type OrderDeleted struct {
+	Metadata
+	Order order.Order
+}
+
+// ProsthesisCreated is raised when a prosthesis is added to the catalog
+type ProsthesisCreated struct {
+	Metadata
+	Prosthesis prosthesis.Prosthesis
+}
+
+// ProsthesisUpdated is raised when a prosthesis of the catalog changes
+type ProsthesisUpdated struct {
+	Metadata
+	Prosthesis prosthesis.Prosthesis
+}
+
+// ProsthesisDeleted is raised when a prosthesis is soft deleted from the catalog
+type ProsthesisDeleted struct {
+	Metadata
+	Prosthesis prosthesis.Prosthesis
+}
+
+// TechnicianCreated is raised when a technician is created
+type TechnicianCreated struct {
+	Metadata
+	Technician technician.Technician
+}
+
+// TechnicianUpdated is raised when the details of a technician change
+type TechnicianUpdated struct {
+	Metadata
+	Technician technician.Technician
+}
+
+// TechnicianDeleted is raised when a technician is soft deleted
+type TechnicianDeleted struct {
+	Metadata
+	Technician technician.Technician
+}
+
+// CommentCreated is raised when a comment is added to the thread of an order
+type CommentCreated struct {
+	Metadata
+	Comment comment.Comment
+}
+
+// CommentDeleted is raised when a comment is soft deleted from the thread of an order
+type CommentDeleted struct {
+	Metadata
+	Comment comment.Comment
+}
+
+func (LaboratoryCreated) Name() Name  { return NameLaboratoryCreated }
+func (LaboratoryUpdated) Name() Name  { return NameLaboratoryUpdated }
+func (LaboratoryDeleted) Name() Name  { return NameLaboratoryDeleted }
+func (ClientCreated) Name() Name      { return NameClientCreated }
+func (ClientUpdated) Name() Name      { return NameClientUpdated }
+func (ClientDeleted) Name() Name      { return NameClientDeleted }
+func (OrderCreated) Name() Name       { return NameOrderCreated }
+func (OrderUpdated) Name() Name       { return NameOrderUpdated }
+func (OrderStatusChanged) Name() Name { return NameOrderStatusChanged }
+func (OrderDeleted) Name() Name       { return NameOrderDeleted }
+func (ProsthesisCreated) Name() Name  { return NameProsthesisCreated }
+func (ProsthesisUpdated) Name() Name  { return NameProsthesisUpdated }
+func (ProsthesisDeleted) Name() Name  { return NameProsthesisDeleted }
+func (TechnicianCreated) Name() Name  { return NameTechnicianCreated }
+func (TechnicianUpdated) Name() Name  { return NameTechnicianUpdated }
+func (TechnicianDeleted) Name() Name  { return NameTechnicianDeleted }
+func (CommentCreated) Name() Name     { return NameCommentCreated }
+func (CommentDeleted) Name() Name     { return NameCommentDeleted }
@@ -0,0 +1,102 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+)
+
+func TestEvents_Names(t *testing.T) {
+	events := []Event{
+		LaboratoryCreated{}, LaboratoryUpdated{}, LaboratoryDeleted{},
+		ClientCreated{}, ClientUpdated{}, ClientDeleted{},
+		OrderCreated{}, OrderUpdated{}, OrderStatusChanged{}, OrderDeleted{},
+		ProsthesisCreated{}, ProsthesisUpdated{}, ProsthesisDeleted{},
+		TechnicianCreated{}, TechnicianUpdated{}, TechnicianDeleted{},
+		CommentCreated{}, CommentDeleted{},
+	}
+
+	names := AllNames()
+	if len(events) != len(names) {
+		t.Fatalf("AllNames() has %d names, want one per event (%d)", len(names), len(events))
+	}
+	for i, e := range events {
+		if e.Name() != names[i] {
+			t.Errorf("%T.Name() = %s, want %s", e, e.Name(), names[i])
+		}
+	}
+}
+
+func TestWithID(t *testing.T) {
+	events := []Event{
+		LaboratoryCreated{}, LaboratoryUpdated{}, LaboratoryDeleted{},
+		ClientCreated{}, ClientUpdated{}, ClientDeleted{},
+		OrderCreated{}, OrderUpdated{}, OrderStatusChanged{}, OrderDeleted{},
+		ProsthesisCreated{}, ProsthesisUpdated{}, ProsthesisDeleted{},
+		TechnicianCreated{}, TechnicianUpdated{}, TechnicianDeleted{},
+		CommentCreated{}, CommentDeleted{},
+	}
+
+	for _, e := range events {
+		got := WithID(e, "event-1")
+		if got.Meta().ID != "event-1" {
+			t.Errorf("WithID(%T) ID = %q, want event-1", e, got.Meta().ID)
+		}
+		if got.Name() != e.Name() {
+			t.Errorf("WithID(%T) = %T, want the same event", e, got)
+		}
+	}
+}
+
+func TestIsValidName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"order.status_changed", true},
+		{"technician.deleted", true},
+		{"order.shipped", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidName(tt.name); got != tt.want {
+				t.Errorf("IsValidName(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMetadata(t *testing.T) {
+	m := NewMetadata("lab-123")
+	e := OrderCreated{Metadata: m}
+
+	if e.Meta().LaboratoryID != "lab-123" || e.Meta().OccurredAt.IsZero() || e.Meta().OccurredAt.Location().String() != "UTC" {
+		t.Errorf("Meta() = %+v, want the laboratory and the current UTC time", e.Meta())
+	}
+}
+
+func TestOrderUpdated_TechnicianAssigned(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		current  string
+		want     bool
+	}{
+		{"first assignment", "", "tech-1", true},
+		{"reassignment", "tech-1", "tech-2", true},
+		{"same technician", "tech-1", "tech-1", false},
+		{"unassignment", "tech-1", "", false},
+		{"still unassigned", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := OrderUpdated{Order: order.Order{TechnicianID: tt.current}, PreviousTechnicianID: tt.previous}
+			if got := e.TechnicianAssigned(); got != tt.want {
+				t.Errorf("TechnicianAssigned() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
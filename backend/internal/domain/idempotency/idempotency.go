@@ -0,0 +1,30 @@
+package idempotency
+
+import "time"
+
+// MaxKeyLength bounds client-supplied keys
+const MaxKeyLength = 255
+
+// Record is a request made with an Idempotency-Key and, once completed, the
+// response it produced. Retries with the same key replay the response.
+type Record struct {
+	Scope       string // Tenant the key belongs to, e.g. lab:<laboratory_id>
+	Key         string
+	Fingerprint string // Hash of the request method, URL and body
+	Completed   bool   // False while the first request is still being processed
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// Expired reports whether the record is past its retention window
+func (r *Record) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// Matches reports whether a retry carries the same request as the record
+func (r *Record) Matches(fingerprint string) bool {
+	return r.Fingerprint == fingerprint
+}
@@ -0,0 +1,40 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecord_Expired(t *testing.T) {
+	now := time.Now().UTC()
+	r := &Record{CreatedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"before window ends", now.Add(59 * time.Minute), false},
+		{"when window ends", now.Add(time.Hour), true},
+		{"after window ends", now.Add(2 * time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Expired(tt.at); got != tt.want {
+				t.Errorf("Expired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecord_Matches(t *testing.T) {
+	r := &Record{Fingerprint: "abc"}
+
+	if !r.Matches("abc") {
+		t.Error("Matches() = false for the same fingerprint")
+	}
+	if r.Matches("def") {
+		t.Error("Matches() = true for a different fingerprint")
+	}
+}
@@ -0,0 +1,62 @@
+// Package job defines the runs of the recurring background jobs of the
+// scheduler, such as purging old soft-deleted records
+package job
+
+import "time"
+
+// HistoryLimit is the number of most recent runs kept per job
+const HistoryLimit = 50
+
+// RunStatus represents the outcome of a job run
+type RunStatus string
+
+const (
+	RunStatusRunning   RunStatus = "running"
+	RunStatusSucceeded RunStatus = "succeeded"
+	RunStatusFailed    RunStatus = "failed"
+)
+
+// AllRunStatuses returns all valid run statuses
+func AllRunStatuses() []RunStatus {
+	return []RunStatus{RunStatusRunning, RunStatusSucceeded, RunStatusFailed}
+}
+
+// Run records one execution of a job
+type Run struct {
+	ID          string
+	Job         string
+	Instance    string // Scheduler instance that ran the job
+	Status      RunStatus
+	Result      string // Summary reported by the job, e.g. "purged 3 orders"
+	Error       string
+	ScheduledAt time.Time // Activation of the schedule the run is for
+	StartedAt   time.Time
+	FinishedAt  *time.Time // Nil while running
+}
+
+// NewRun creates a run of a job for an activation of its schedule, started now
+func NewRun(id, job, instance string, scheduledAt, now time.Time) *Run {
+	return &Run{ID: id, Job: job, Instance: instance, Status: RunStatusRunning, ScheduledAt: scheduledAt, StartedAt: now}
+}
+
+// Succeed records that the job completed with the given summary
+func (r *Run) Succeed(result string, now time.Time) {
+	r.Status = RunStatusSucceeded
+	r.Result = result
+	r.FinishedAt = &now
+}
+
+// Fail records that the job failed
+func (r *Run) Fail(err error, now time.Time) {
+	r.Status = RunStatusFailed
+	r.Error = err.Error()
+	r.FinishedAt = &now
+}
+
+// Duration returns how long the run took, zero while running
+func (r *Run) Duration() time.Duration {
+	if r.FinishedAt == nil {
+		return 0
+	}
+	return r.FinishedAt.Sub(r.StartedAt)
+}
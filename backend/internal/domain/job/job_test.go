@@ -0,0 +1,49 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRun_Outcome(t *testing.T) {
+	now := time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		finish     func(r *Run)
+		wantStatus RunStatus
+		wantResult string
+		wantError  string
+	}{
+		{
+			name:       "succeeded",
+			finish:     func(r *Run) { r.Succeed("purged 3 orders", now.Add(2*time.Second)) },
+			wantStatus: RunStatusSucceeded,
+			wantResult: "purged 3 orders",
+		},
+		{
+			name:       "failed",
+			finish:     func(r *Run) { r.Fail(errors.New("store unavailable"), now.Add(2*time.Second)) },
+			wantStatus: RunStatusFailed,
+			wantError:  "store unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRun("run-1", "purge-deleted", "host-1", now, now)
+			if r.Status != RunStatusRunning || r.FinishedAt != nil || r.Duration() != 0 {
+				t.Fatalf("NewRun() = %+v, want a running run", r)
+			}
+
+			tt.finish(r)
+			if r.Status != tt.wantStatus || r.Result != tt.wantResult || r.Error != tt.wantError {
+				t.Errorf("run = %+v, want status %s, result %q, error %q", r, tt.wantStatus, tt.wantResult, tt.wantError)
+			}
+			if r.Duration() != 2*time.Second {
+				t.Errorf("Duration() = %v, want 2s", r.Duration())
+			}
+		})
+	}
+}
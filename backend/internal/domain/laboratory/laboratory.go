@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
 )
 
 var (
@@ -20,6 +21,7 @@ type Laboratory struct {
 	Email     string
 	Phone     string
 	Address   Address
+	Language  i18n.Language // Default language for messages to the lab's users
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -42,6 +44,7 @@ func NewLaboratory(id, name, email, phone string, address Address) (*Laboratory,
 		Email:     email,
 		Phone:     phone,
 		Address:   address,
+		Language:  i18n.Default,
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
 	}
@@ -60,43 +63,25 @@ func (l *Laboratory) Validate() error {
 	// Validate name
 	name := strings.TrimSpace(l.Name)
 	if name == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "name",
-			Message: "name is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("name"))
 	} else if len(name) > 200 {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "name",
-			Message: "name must be at most 200 characters",
-		})
+		validationErrors = append(validationErrors, errors.TooLong("name", 200))
 	}
 
 	// Validate email
 	email := strings.TrimSpace(l.Email)
 	if email == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "email",
-			Message: "email is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("email"))
 	} else if !emailRegex.MatchString(email) {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "email",
-			Message: "invalid email format",
-		})
+		validationErrors = append(validationErrors, errors.InvalidEmail("email"))
 	}
 
 	// Validate phone
 	phone := strings.TrimSpace(l.Phone)
 	if phone == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "phone",
-			Message: "phone is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("phone"))
 	} else if !phoneRegex.MatchString(strings.ReplaceAll(phone, " ", "")) {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "phone",
-			Message: "invalid phone format",
-		})
+		validationErrors = append(validationErrors, errors.InvalidPhone("phone"))
 	}
 
 	// Validate address
@@ -106,6 +91,11 @@ func (l *Laboratory) Validate() error {
 		}
 	}
 
+	// Validate language; labs stored before it existed have none and use the default
+	if _, ok := i18n.Parse(string(l.Language)); !ok && l.Language != "" {
+		validationErrors = append(validationErrors, errors.InvalidChoice("language", i18n.Supported()))
+	}
+
 	if len(validationErrors) > 0 {
 		return validationErrors
 	}
@@ -113,6 +103,19 @@ func (l *Laboratory) Validate() error {
 	return nil
 }
 
+// SetLanguage changes the laboratory's default language and sets UpdatedAt.
+// Regional variants are accepted and mapped to the supported language.
+func (l *Laboratory) SetLanguage(tag string) error {
+	lang, ok := i18n.Parse(tag)
+	if !ok {
+		return errors.Validation(errors.InvalidChoice("language", i18n.Supported()))
+	}
+	l.Language = lang
+	l.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
 // Update updates the laboratory fields and sets UpdatedAt
 func (l *Laboratory) Update(name, email, phone string, address Address) error {
 	l.Name = name
@@ -140,38 +143,23 @@ func (a *Address) Validate() error {
 	var validationErrors errors.ValidationErrors
 
 	if strings.TrimSpace(a.Street) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.street",
-			Message: "street is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.street"))
 	}
 
 	if strings.TrimSpace(a.City) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.city",
-			Message: "city is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.city"))
 	}
 
 	if strings.TrimSpace(a.State) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.state",
-			Message: "state is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.state"))
 	}
 
 	if strings.TrimSpace(a.PostalCode) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.postal_code",
-			Message: "postal code is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.postal_code"))
 	}
 
 	if strings.TrimSpace(a.Country) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "address.country",
-			Message: "country is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("address.country"))
 	}
 
 	if len(validationErrors) > 0 {
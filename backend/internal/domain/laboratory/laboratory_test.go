@@ -3,6 +3,8 @@ package laboratory
 import (
 	"testing"
 	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/i18n"
 )
 
 func TestNewLaboratory(t *testing.T) {
@@ -306,7 +308,7 @@ func TestAddress_Validate(t *testing.T) {
 				Country:    "Brazil",
 			},
 			wantErr:     true,
-			errContains: "postal code is required",
+			errContains: "postal_code is required",
 		},
 		{
 			name: "missing country",
@@ -357,3 +359,36 @@ func containsStringHelper(s, substr string) bool {
 	return false
 }
 
+
+func TestLaboratory_SetLanguage(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    i18n.Language
+		wantErr bool
+	}{
+		{name: "portuguese", tag: "pt-BR", want: i18n.PtBR},
+		{name: "regional variant", tag: "es-AR", want: i18n.Es},
+		{name: "unsupported", tag: "fr", want: i18n.PtBR, wantErr: true},
+		{name: "empty", tag: "", want: i18n.PtBR, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lab, err := NewLaboratory("lab-123", "Test Lab", "lab@example.com", "+5511999999999", Address{
+				Street: "Rua A", City: "São Paulo", State: "SP", PostalCode: "01000-000", Country: "BR",
+			})
+			if err != nil {
+				t.Fatalf("NewLaboratory() error = %v", err)
+			}
+
+			err = lab.SetLanguage(tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetLanguage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if lab.Language != tt.want {
+				t.Errorf("Language = %q, want %q", lab.Language, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package laboratory
+
+import "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+
+// ListSpec describes the fields laboratories can be sorted and filtered by
+var ListSpec = listing.Spec{
+	SortFields:   []string{"name", "created_at", "updated_at"},
+	DefaultSort:  "created_at",
+	FilterFields: []string{"city", "state"},
+}
@@ -0,0 +1,130 @@
+package listing
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+const (
+	// DefaultLimit is the page size used when no limit is given
+	DefaultLimit = 50
+
+	// MaxLimit is the maximum page size
+	MaxLimit = 200
+)
+
+// Direction represents a sort direction
+type Direction string
+
+const (
+	Asc  Direction = "asc"
+	Desc Direction = "desc"
+)
+
+// IsValid checks if the sort direction is valid
+func (d Direction) IsValid() bool {
+	return d == Asc || d == Desc
+}
+
+// Query represents a paginated, sorted and filtered list request
+type Query struct {
+	Limit     int
+	Cursor    string
+	SortBy    string
+	Direction Direction
+	Filters   map[string]string
+}
+
+// Page represents one page of a list result
+type Page[T any] struct {
+	Items      []T
+	NextCursor string // Empty on the last page
+	Total      int    // Number of items matching the filters across all pages
+}
+
+// Spec describes the fields a list endpoint can be sorted and filtered by
+type Spec struct {
+	SortFields   []string
+	DefaultSort  string
+	FilterFields []string
+}
+
+// Normalize validates the query against the spec and applies defaults
+func (q Query) Normalize(spec Spec) (Query, error) {
+	var errs errors.ValidationErrors
+
+	if q.Limit == 0 {
+		q.Limit = DefaultLimit
+	} else if q.Limit < 0 || q.Limit > MaxLimit {
+		errs = append(errs, errors.OutOfRange("limit", 1, MaxLimit))
+	}
+
+	if q.SortBy == "" {
+		q.SortBy = spec.DefaultSort
+	} else if !contains(spec.SortFields, q.SortBy) {
+		errs = append(errs, errors.UnsupportedSort("sort", q.SortBy))
+	}
+
+	if q.Direction == "" {
+		q.Direction = Asc
+	} else if !q.Direction.IsValid() {
+		errs = append(errs, errors.InvalidChoice("order", []Direction{Asc, Desc}))
+	}
+
+	for field := range q.Filters {
+		if !contains(spec.FilterFields, field) {
+			errs = append(errs, errors.UnsupportedFilter(field))
+		}
+	}
+
+	if q.Cursor != "" && len(errs) == 0 {
+		c, err := DecodeCursor(q.Cursor)
+		if err != nil || c.SortBy != q.SortBy || c.Direction != q.Direction {
+			errs = append(errs, errors.InvalidCursor("cursor"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return q, errs
+	}
+	return q, nil
+}
+
+// Cursor identifies the last item of a page in a given sort order.
+// It is opaque to API clients.
+type Cursor struct {
+	SortBy    string    `json:"s"`
+	Direction Direction `json:"d"`
+	Key       string    `json:"k"`
+	ID        string    `json:"i"`
+}
+
+// Encode returns the opaque string form of the cursor
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses an opaque cursor
+func DecodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errors.ErrInvalidInput
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, errors.ErrInvalidInput
+	}
+	return c, nil
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package listing
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+var testSpec = Spec{
+	SortFields:   []string{"name", "created_at"},
+	DefaultSort:  "created_at",
+	FilterFields: []string{"city"},
+}
+
+func TestQuery_Normalize(t *testing.T) {
+	validCursor := Cursor{SortBy: "name", Direction: Desc, Key: "bravo", ID: "2"}.Encode()
+
+	tests := []struct {
+		name    string
+		query   Query
+		want    Query
+		wantErr error
+	}{
+		{
+			name:  "defaults",
+			query: Query{},
+			want:  Query{Limit: DefaultLimit, SortBy: "created_at", Direction: Asc},
+		},
+		{
+			name:  "valid query with cursor",
+			query: Query{Limit: 10, SortBy: "name", Direction: Desc, Cursor: validCursor, Filters: map[string]string{"city": "Santos"}},
+			want:  Query{Limit: 10, SortBy: "name", Direction: Desc, Cursor: validCursor, Filters: map[string]string{"city": "Santos"}},
+		},
+		{"limit too large", Query{Limit: MaxLimit + 1}, Query{}, errors.ErrInvalidInput},
+		{"negative limit", Query{Limit: -1}, Query{}, errors.ErrInvalidInput},
+		{"unknown sort field", Query{SortBy: "email"}, Query{}, errors.ErrInvalidInput},
+		{"invalid direction", Query{Direction: "up"}, Query{}, errors.ErrInvalidInput},
+		{"unknown filter", Query{Filters: map[string]string{"email": "x"}}, Query{}, errors.ErrInvalidInput},
+		{"malformed cursor", Query{Cursor: "%%%"}, Query{}, errors.ErrInvalidInput},
+		{"cursor from another sort", Query{SortBy: "name", Cursor: validCursor}, Query{}, errors.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Normalize(testSpec)
+
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Errorf("Normalize() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Normalize() unexpected error = %v", err)
+			}
+			if got.Limit != tt.want.Limit || got.SortBy != tt.want.SortBy || got.Direction != tt.want.Direction || got.Cursor != tt.want.Cursor {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	c := Cursor{SortBy: "name", Direction: Asc, Key: "alpha", ID: "client-1"}
+
+	got, err := DecodeCursor(c.Encode())
+	if err != nil {
+		t.Fatalf("DecodeCursor() unexpected error = %v", err)
+	}
+	if got != c {
+		t.Errorf("DecodeCursor() = %+v, want %+v", got, c)
+	}
+}
@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"net/mail"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+// Email is a rendered notification queued for sending
+type Email struct {
+	ID            string
+	LaboratoryID  string
+	Kind          Kind
+	From          mail.Address
+	ReplyTo       *mail.Address // Nil to receive replies at From
+	To            mail.Address
+	Subject       string
+	Text          string
+	HTML          string
+	Attempts      int        // Failed sends so far
+	NextAttemptAt time.Time  // Not sent before this time
+	SentAt        *time.Time // Nil until the server accepted it
+	DeadAt        *time.Time // Set when the attempts ran out
+	LastError     string
+	CreatedAt     time.Time
+}
+
+// IsSent reports whether the server accepted the email
+func (e *Email) IsSent() bool {
+	return e.SentAt != nil
+}
+
+// IsDead reports whether the email was given up on
+func (e *Email) IsDead() bool {
+	return e.DeadAt != nil
+}
+
+// IsDue reports whether the email awaits a send attempt at the given time
+func (e *Email) IsDue(now time.Time) bool {
+	return !e.IsSent() && !e.IsDead() && !now.Before(e.NextAttemptAt)
+}
+
+// MarkSent records a successful send
+func (e *Email) MarkSent(now time.Time) {
+	e.SentAt = &now
+	e.LastError = ""
+}
+
+// MarkFailed records a failed send and schedules the next attempt, or marks
+// the email dead once the policy's attempts ran out
+func (e *Email) MarkFailed(err error, now time.Time, policy outbox.RetryPolicy) {
+	e.Attempts++
+	e.LastError = err.Error()
+	if policy.MaxAttempts > 0 && e.Attempts >= policy.MaxAttempts {
+		e.DeadAt = &now
+		return
+	}
+	e.NextAttemptAt = now.Add(policy.Backoff.Delay(e.Attempts))
+}
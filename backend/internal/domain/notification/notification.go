@@ -0,0 +1,107 @@
+// Package notification defines the emails sent to clients and technicians
+// about their orders and the sender settings of each laboratory
+package notification
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// MaxSenderNameLength is the maximum length of a sender name
+const MaxSenderNameLength = 100
+
+// Kind identifies a notification email
+type Kind string
+
+const (
+	KindOrderReady         Kind = "order_ready"         // To the client, when the order is ready
+	KindOrderDelivered     Kind = "order_delivered"     // To the client, when the order is delivered
+	KindTechnicianAssigned Kind = "technician_assigned" // To the technician assigned an order
+)
+
+// AllKinds returns all notification kinds
+func AllKinds() []Kind {
+	return []Kind{KindOrderReady, KindOrderDelivered, KindTechnicianAssigned}
+}
+
+// Settings holds the sender of the notification emails of a laboratory.
+// Empty fields fall back to the defaults: the laboratory's name, the
+// configured sender address and the laboratory's email for replies.
+type Settings struct {
+	LaboratoryID string
+	SenderName   string
+	SenderEmail  string // Used as sender only at an allowed domain, see SenderAllowed
+	ReplyTo      string
+	UpdatedAt    time.Time
+}
+
+// NewSettings creates new sender Settings with validation
+func NewSettings(laboratoryID, senderName, senderEmail, replyTo string) (*Settings, error) {
+	s := &Settings{
+		LaboratoryID: laboratoryID,
+		SenderName:   strings.TrimSpace(senderName),
+		SenderEmail:  strings.TrimSpace(senderEmail),
+		ReplyTo:      strings.TrimSpace(replyTo),
+		UpdatedAt:    time.Now().UTC(),
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Validate validates the settings fields
+func (s *Settings) Validate() error {
+	var validationErrors errors.ValidationErrors
+
+	// Validate laboratory_id
+	if strings.TrimSpace(s.LaboratoryID) == "" {
+		validationErrors = append(validationErrors, errors.Required("laboratory_id"))
+	}
+
+	// Validate sender_name; line breaks would inject headers
+	if len(s.SenderName) > MaxSenderNameLength {
+		validationErrors = append(validationErrors, errors.TooLong("sender_name", MaxSenderNameLength))
+	} else if strings.ContainsAny(s.SenderName, "\r\n") {
+		validationErrors = append(validationErrors, errors.Invalid("sender_name"))
+	}
+
+	// Validate sender_email and reply_to
+	if s.SenderEmail != "" && !emailRegex.MatchString(s.SenderEmail) {
+		validationErrors = append(validationErrors, errors.InvalidEmail("sender_email"))
+	}
+	if s.ReplyTo != "" && !emailRegex.MatchString(s.ReplyTo) {
+		validationErrors = append(validationErrors, errors.InvalidEmail("reply_to"))
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// SenderAllowed reports whether an address may be the sender of notification
+// emails: only addresses at the given domains, which the platform is
+// authorized to send for, are. Other addresses would fail SPF and DKIM checks
+// or let a laboratory impersonate any domain.
+func SenderAllowed(address string, domains []string) bool {
+	at := strings.LastIndex(address, "@")
+	if at < 0 {
+		return false
+	}
+	domain := address[at+1:]
+	for _, allowed := range domains {
+		if strings.EqualFold(domain, allowed) {
+			return true
+		}
+	}
+	return false
+}
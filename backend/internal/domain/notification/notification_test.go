@@ -0,0 +1,89 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		senderName  string
+		senderEmail string
+		replyTo     string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:        "all fields",
+			senderName:  "Smile Lab",
+			senderEmail: "orders@smilelab.com",
+			replyTo:     "front-desk@smilelab.com",
+		},
+		{
+			name: "defaults only",
+		},
+		{
+			name:        "invalid sender email",
+			senderEmail: "orders",
+			wantErr:     true,
+			errContains: "sender_email",
+		},
+		{
+			name:        "invalid reply-to",
+			replyTo:     "front-desk@",
+			wantErr:     true,
+			errContains: "reply_to",
+		},
+		{
+			name:        "sender name too long",
+			senderName:  strings.Repeat("a", MaxSenderNameLength+1),
+			wantErr:     true,
+			errContains: "sender_name",
+		},
+		{
+			name:        "sender name with a line break",
+			senderName:  "Smile Lab\r\nBcc: everyone@example.com",
+			wantErr:     true,
+			errContains: "sender_name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSettings("lab-123", tt.senderName, tt.senderEmail, tt.replyTo)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("NewSettings() error = %v, want one about %s", err, tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewSettings() unexpected error = %v", err)
+			}
+			if s.SenderEmail != tt.senderEmail || s.UpdatedAt.IsZero() {
+				t.Errorf("NewSettings() = %+v", s)
+			}
+		})
+	}
+}
+
+func TestSenderAllowed(t *testing.T) {
+	domains := []string{"smilelab.com"}
+	tests := []struct {
+		address string
+		want    bool
+	}{
+		{"orders@smilelab.com", true},
+		{"orders@SmileLab.com", true},
+		{"orders@bank.com", false},
+		{"orders@mail.smilelab.com", false},
+		{"orders", false},
+	}
+
+	for _, tt := range tests {
+		if got := SenderAllowed(tt.address, domains); got != tt.want {
+			t.Errorf("SenderAllowed(%q) = %v, want %v", tt.address, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package order
+
+import "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+
+// ListSpec describes the fields orders can be sorted by.
+// Orders are filtered through SearchCriteria instead of list filters.
+var ListSpec = listing.Spec{
+	SortFields:  []string{"status", "created_at", "updated_at"},
+	DefaultSort: "created_at",
+}
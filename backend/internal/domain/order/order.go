@@ -1,6 +1,7 @@
 package order
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,13 +13,36 @@ type Order struct {
 	ID           string
 	ClientID     string
 	LaboratoryID string
+	TechnicianID string // Assigned technician, empty when unassigned
 	Status       Status
 	Prosthesis   []ProsthesisItem
+	History      []StatusChange
+	Attachments  []Attachment
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    *time.Time
 }
 
+// StatusChange records a single transition in the order workflow
+type StatusChange struct {
+	From      Status
+	To        Status
+	ChangedAt time.Time
+}
+
+// MaxAttachmentSize is the maximum size in bytes of a single attachment
+const MaxAttachmentSize = 10 << 20
+
+// Attachment represents a file (scan, photo, prescription) attached to an order
+type Attachment struct {
+	ID          string
+	FileName    string
+	ContentType string
+	Size        int64
+	UploadedBy  string
+	UploadedAt  time.Time
+}
+
 // Status represents the workflow state of an order
 type Status string
 
@@ -53,6 +77,17 @@ func AllStatuses() []Status {
 	}
 }
 
+// OpenStatuses returns the statuses of orders still in the workflow, i.e. not terminal
+func OpenStatuses() []Status {
+	var open []Status
+	for _, status := range AllStatuses() {
+		if len(validTransitions[status]) > 0 {
+			open = append(open, status)
+		}
+	}
+	return open
+}
+
 // IsValidStatus checks if a status string is valid
 func IsValidStatus(s string) bool {
 	for _, status := range AllStatuses() {
@@ -65,23 +100,26 @@ func IsValidStatus(s string) bool {
 
 // ProsthesisItem represents a prosthesis item in an order
 type ProsthesisItem struct {
-	Type     string
-	Material string
-	Shade    string
-	Quantity int
-	Notes    string
+	Type          string
+	Material      string
+	Shade         string
+	Quantity      int
+	Notes         string
+	CatalogItemID string // Prosthesis of the laboratory's catalog the item is based on, optional
 }
 
 // NewOrder creates a new Order with validation
 func NewOrder(id, clientID, laboratoryID string, items []ProsthesisItem) (*Order, error) {
+	now := time.Now().UTC()
 	order := &Order{
 		ID:           id,
 		ClientID:     clientID,
 		LaboratoryID: laboratoryID,
 		Status:       StatusReceived, // Initial status is always "received"
 		Prosthesis:   items,
-		CreatedAt:    time.Now().UTC(),
-		UpdatedAt:    time.Now().UTC(),
+		History:      []StatusChange{{To: StatusReceived, ChangedAt: now}},
+		CreatedAt:    now,
+		UpdatedAt:    now,
 	}
 
 	if err := order.Validate(); err != nil {
@@ -97,26 +135,17 @@ func (o *Order) Validate() error {
 
 	// Validate client_id
 	if strings.TrimSpace(o.ClientID) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "client_id",
-			Message: "client_id is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("client_id"))
 	}
 
 	// Validate laboratory_id
 	if strings.TrimSpace(o.LaboratoryID) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "laboratory_id",
-			Message: "laboratory_id is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("laboratory_id"))
 	}
 
 	// Validate prosthesis items
 	if len(o.Prosthesis) == 0 {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "prosthesis",
-			Message: "at least one prosthesis item is required",
-		})
+		validationErrors = append(validationErrors, errors.AtLeastOne("prosthesis"))
 	}
 
 	// Validate each prosthesis item
@@ -143,6 +172,12 @@ func (o *Order) Update(items []ProsthesisItem) error {
 	return o.Validate()
 }
 
+// AssignTechnician assigns the order to a technician, or unassigns it when technicianID is empty
+func (o *Order) AssignTechnician(technicianID string) {
+	o.TechnicianID = technicianID
+	o.UpdatedAt = time.Now().UTC()
+}
+
 // CanTransitionTo checks if a status transition is valid
 func (o *Order) CanTransitionTo(newStatus Status) bool {
 	allowedTransitions, exists := validTransitions[o.Status]
@@ -164,7 +199,24 @@ func (o *Order) UpdateStatus(newStatus Status) error {
 		return errors.ErrInvalidStatusTransition
 	}
 
+	now := time.Now().UTC()
+	o.History = append(o.History, StatusChange{
+		From:      o.Status,
+		To:        newStatus,
+		ChangedAt: now,
+	})
 	o.Status = newStatus
+	o.UpdatedAt = now
+	return nil
+}
+
+// AddAttachment validates and attaches a file to the order
+func (o *Order) AddAttachment(a Attachment) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+
+	o.Attachments = append(o.Attachments, a)
 	o.UpdatedAt = time.Now().UTC()
 	return nil
 }
@@ -183,26 +235,43 @@ func (o *Order) IsDeleted() bool {
 // Validate validates the prosthesis item fields
 func (p *ProsthesisItem) Validate(index int) error {
 	var validationErrors errors.ValidationErrors
+	prefix := "prosthesis[" + strconv.Itoa(index) + "]."
 
 	if strings.TrimSpace(p.Type) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "prosthesis[" + string(rune('0'+index)) + "].type",
-			Message: "type is required",
-		})
+		validationErrors = append(validationErrors, errors.Required(prefix+"type"))
 	}
 
 	if strings.TrimSpace(p.Material) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "prosthesis[" + string(rune('0'+index)) + "].material",
-			Message: "material is required",
-		})
+		validationErrors = append(validationErrors, errors.Required(prefix+"material"))
 	}
 
 	if p.Quantity <= 0 {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "prosthesis[" + string(rune('0'+index)) + "].quantity",
-			Message: "quantity must be greater than 0",
-		})
+		validationErrors = append(validationErrors, errors.GreaterThan(prefix+"quantity", 0))
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// Validate validates the attachment fields
+func (a *Attachment) Validate() error {
+	var validationErrors errors.ValidationErrors
+
+	if strings.TrimSpace(a.FileName) == "" {
+		validationErrors = append(validationErrors, errors.Required("file_name"))
+	}
+
+	if strings.TrimSpace(a.ContentType) == "" {
+		validationErrors = append(validationErrors, errors.Required("content_type"))
+	}
+
+	if a.Size <= 0 {
+		validationErrors = append(validationErrors, errors.FileEmpty("file"))
+	} else if a.Size > MaxAttachmentSize {
+		validationErrors = append(validationErrors, errors.FileTooLarge("file", MaxAttachmentSize>>20))
 	}
 
 	if len(validationErrors) > 0 {
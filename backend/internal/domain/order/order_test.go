@@ -372,6 +372,100 @@ func TestOrder_UpdateStatus(t *testing.T) {
 	}
 }
 
+func TestOrder_UpdateStatus_RecordsHistory(t *testing.T) {
+	order, err := NewOrder("order-123", "client-123", "lab-123", []ProsthesisItem{
+		{
+			Type:     "crown",
+			Material: "zirconia",
+			Quantity: 1,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewOrder() unexpected error = %v", err)
+	}
+
+	if len(order.History) != 1 || order.History[0].To != StatusReceived {
+		t.Fatalf("NewOrder() History = %v, want initial received entry", order.History)
+	}
+
+	_ = order.UpdateStatus(StatusInProduction)
+	_ = order.UpdateStatus(StatusReady) // Invalid transition must not be recorded
+
+	if len(order.History) != 2 {
+		t.Fatalf("History length = %d, want 2", len(order.History))
+	}
+	last := order.History[1]
+	if last.From != StatusReceived || last.To != StatusInProduction {
+		t.Errorf("History[1] = %v -> %v, want received -> in_production", last.From, last.To)
+	}
+	if last.ChangedAt.IsZero() {
+		t.Errorf("History[1] ChangedAt should be set")
+	}
+}
+
+func TestOrder_AddAttachment(t *testing.T) {
+	tests := []struct {
+		name        string
+		attachment  Attachment
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "valid attachment",
+			attachment: Attachment{ID: "att-1", FileName: "scan.stl", ContentType: "model/stl", Size: 1024},
+			wantErr:    false,
+		},
+		{
+			name:        "missing file name",
+			attachment:  Attachment{ID: "att-1", ContentType: "model/stl", Size: 1024},
+			wantErr:     true,
+			errContains: "file_name is required",
+		},
+		{
+			name:        "empty file",
+			attachment:  Attachment{ID: "att-1", FileName: "scan.stl", ContentType: "model/stl"},
+			wantErr:     true,
+			errContains: "file must not be empty",
+		},
+		{
+			name:        "file too large",
+			attachment:  Attachment{ID: "att-1", FileName: "scan.stl", ContentType: "model/stl", Size: MaxAttachmentSize + 1},
+			wantErr:     true,
+			errContains: "file must be at most 10 MB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &Order{ID: "order-123", Status: StatusReceived}
+
+			err := order.AddAttachment(tt.attachment)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("AddAttachment() expected error, got nil")
+					return
+				}
+				if tt.errContains != "" && !containsString(err.Error(), tt.errContains) {
+					t.Errorf("AddAttachment() error = %v, want error containing %v", err, tt.errContains)
+				}
+				if len(order.Attachments) != 0 {
+					t.Errorf("AddAttachment() should not attach invalid files")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("AddAttachment() unexpected error = %v", err)
+				return
+			}
+			if len(order.Attachments) != 1 {
+				t.Errorf("AddAttachment() Attachments length = %d, want 1", len(order.Attachments))
+			}
+		})
+	}
+}
+
 func TestOrder_Delete(t *testing.T) {
 	order := &Order{
 		ID:           "order-123",
@@ -574,6 +668,19 @@ func TestAllStatuses(t *testing.T) {
 	}
 }
 
+func TestOpenStatuses(t *testing.T) {
+	statuses := OpenStatuses()
+
+	if len(statuses) != len(AllStatuses())-1 {
+		t.Errorf("OpenStatuses() = %v, want every status but delivered", statuses)
+	}
+	for _, status := range statuses {
+		if status == StatusDelivered {
+			t.Error("OpenStatuses() contains the terminal status delivered")
+		}
+	}
+}
+
 func containsString(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsStringHelper(s, substr))
 }
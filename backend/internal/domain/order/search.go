@@ -0,0 +1,105 @@
+package order
+
+import (
+	"strings"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+// SearchCriteria represents the filters of an order search.
+// Empty fields don't restrict the result; all given criteria must match.
+type SearchCriteria struct {
+	Statuses       []Status // Matches any of the statuses
+	ClientID       string
+	TechnicianID   string
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	UpdatedFrom    *time.Time
+	UpdatedTo      *time.Time
+	ProsthesisType string // Matches if any item has this type
+	Material       string // Matches if any item has this material
+	Text           string // Case-insensitive substring of any item's notes
+}
+
+// Validate validates the search criteria
+func (c SearchCriteria) Validate() error {
+	var validationErrors errors.ValidationErrors
+
+	for _, s := range c.Statuses {
+		if !IsValidStatus(string(s)) {
+			validationErrors = append(validationErrors, errors.InvalidChoice("status", AllStatuses()))
+		}
+	}
+
+	if c.CreatedFrom != nil && c.CreatedTo != nil && c.CreatedFrom.After(*c.CreatedTo) {
+		validationErrors = append(validationErrors, errors.InvalidRange("created_from", "created_to"))
+	}
+
+	if c.UpdatedFrom != nil && c.UpdatedTo != nil && c.UpdatedFrom.After(*c.UpdatedTo) {
+		validationErrors = append(validationErrors, errors.InvalidRange("updated_from", "updated_to"))
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// Matches returns true if the order satisfies every criterion
+func (c SearchCriteria) Matches(o *Order) bool {
+	if len(c.Statuses) > 0 && !c.hasStatus(o.Status) {
+		return false
+	}
+	if c.ClientID != "" && o.ClientID != c.ClientID {
+		return false
+	}
+	if c.TechnicianID != "" && o.TechnicianID != c.TechnicianID {
+		return false
+	}
+	if !inRange(o.CreatedAt, c.CreatedFrom, c.CreatedTo) || !inRange(o.UpdatedAt, c.UpdatedFrom, c.UpdatedTo) {
+		return false
+	}
+	if c.ProsthesisType != "" && !o.anyItem(func(p ProsthesisItem) bool { return strings.EqualFold(p.Type, c.ProsthesisType) }) {
+		return false
+	}
+	if c.Material != "" && !o.anyItem(func(p ProsthesisItem) bool { return strings.EqualFold(p.Material, c.Material) }) {
+		return false
+	}
+	if c.Text != "" {
+		text := strings.ToLower(c.Text)
+		if !o.anyItem(func(p ProsthesisItem) bool { return strings.Contains(strings.ToLower(p.Notes), text) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c SearchCriteria) hasStatus(status Status) bool {
+	for _, s := range c.Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Order) anyItem(match func(ProsthesisItem) bool) bool {
+	for _, p := range o.Prosthesis {
+		if match(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func inRange(t time.Time, from, to *time.Time) bool {
+	if from != nil && t.Before(*from) {
+		return false
+	}
+	if to != nil && t.After(*to) {
+		return false
+	}
+	return true
+}
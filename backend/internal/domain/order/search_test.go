@@ -0,0 +1,81 @@
+package order
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSearchCriteria_Validate(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		criteria SearchCriteria
+		wantErr  bool
+	}{
+		{"empty criteria", SearchCriteria{}, false},
+		{"valid statuses", SearchCriteria{Statuses: []Status{StatusReceived, StatusReady}}, false},
+		{"invalid status", SearchCriteria{Statuses: []Status{StatusReceived, "shipped"}}, true},
+		{"ordered created range", SearchCriteria{CreatedFrom: &earlier, CreatedTo: &later}, false},
+		{"inverted created range", SearchCriteria{CreatedFrom: &later, CreatedTo: &earlier}, true},
+		{"inverted updated range", SearchCriteria{UpdatedFrom: &later, UpdatedTo: &earlier}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.criteria.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSearchCriteria_Matches(t *testing.T) {
+	created := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	o := &Order{
+		ID:           "order-1",
+		ClientID:     "client-1",
+		TechnicianID: "tech-1",
+		Status:       StatusInProduction,
+		Prosthesis: []ProsthesisItem{
+			{Type: "crown", Material: "zirconia", Quantity: 1},
+			{Type: "bridge", Material: "porcelain", Quantity: 1, Notes: "Check occlusion with patient"},
+		},
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+	before := created.Add(-time.Hour)
+	after := created.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		criteria SearchCriteria
+		want     bool
+	}{
+		{"empty criteria", SearchCriteria{}, true},
+		{"status in set", SearchCriteria{Statuses: []Status{StatusReceived, StatusInProduction}}, true},
+		{"status not in set", SearchCriteria{Statuses: []Status{StatusReady}}, false},
+		{"other client", SearchCriteria{ClientID: "client-2"}, false},
+		{"technician", SearchCriteria{TechnicianID: "tech-1"}, true},
+		{"other technician", SearchCriteria{TechnicianID: "tech-2"}, false},
+		{"within created range", SearchCriteria{CreatedFrom: &before, CreatedTo: &after}, true},
+		{"before created range", SearchCriteria{CreatedFrom: &after}, false},
+		{"after updated range", SearchCriteria{UpdatedTo: &before}, false},
+		{"type of second item", SearchCriteria{ProsthesisType: "Bridge"}, true},
+		{"missing type", SearchCriteria{ProsthesisType: "denture"}, false},
+		{"material of first item", SearchCriteria{Material: "ZIRCONIA"}, true},
+		{"type and material on different items", SearchCriteria{ProsthesisType: "crown", Material: "porcelain"}, true},
+		{"text in notes", SearchCriteria{Text: "OCCLUSION"}, true},
+		{"text not in notes", SearchCriteria{Text: "shade"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.criteria.Matches(o); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
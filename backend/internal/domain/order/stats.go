@@ -0,0 +1,63 @@
+package order
+
+import "time"
+
+// Stats summarizes the orders of a laboratory
+type Stats struct {
+	LaboratoryID string
+	ByStatus     map[Status]int // Orders currently in each status
+	Revised      int            // Orders sent to revision at least once
+}
+
+// NewStats creates empty stats of a laboratory
+func NewStats(laboratoryID string) *Stats {
+	return &Stats{LaboratoryID: laboratoryID, ByStatus: make(map[Status]int)}
+}
+
+// Add counts an order in the stats
+func (s *Stats) Add(o *Order) {
+	s.ByStatus[o.Status]++
+	if o.Revised() {
+		s.Revised++
+	}
+}
+
+// Total returns the number of orders counted
+func (s *Stats) Total() int {
+	total := 0
+	for _, n := range s.ByStatus {
+		total += n
+	}
+	return total
+}
+
+// RevisionRate returns the share of the orders sent to revision at least
+// once, zero when there are none
+func (s *Stats) RevisionRate() float64 {
+	total := s.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Revised) / float64(total)
+}
+
+// Revised reports whether the order was ever sent to revision
+func (o *Order) Revised() bool {
+	for _, change := range o.History {
+		if change.To == StatusRevision {
+			return true
+		}
+	}
+	return false
+}
+
+// LastStatusDuration returns the status the order left in its last
+// transition and how long it stayed in it, false when it never changed status
+func (o *Order) LastStatusDuration() (Status, time.Duration, bool) {
+	n := len(o.History)
+	if n < 2 {
+		return "", 0, false
+	}
+	last, previous := o.History[n-1], o.History[n-2]
+	return last.From, last.ChangedAt.Sub(previous.ChangedAt), true
+}
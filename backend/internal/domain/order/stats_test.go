@@ -0,0 +1,47 @@
+package order
+
+import (
+	"testing"
+	"time"
+)
+
+// orderThrough returns an order moved through the given statuses, an hour apart
+func orderThrough(statuses ...Status) *Order {
+	at := time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)
+	o := &Order{Status: StatusReceived, History: []StatusChange{{To: StatusReceived, ChangedAt: at}}}
+	for _, status := range statuses {
+		at = at.Add(time.Hour)
+		o.History = append(o.History, StatusChange{From: o.Status, To: status, ChangedAt: at})
+		o.Status = status
+	}
+	return o
+}
+
+func TestStats(t *testing.T) {
+	s := NewStats("lab-1")
+	s.Add(orderThrough())
+	s.Add(orderThrough(StatusInProduction, StatusQualityCheck, StatusReady, StatusDelivered))
+	s.Add(orderThrough(StatusInProduction, StatusQualityCheck, StatusRevision))
+	s.Add(orderThrough(StatusInProduction, StatusQualityCheck, StatusRevision, StatusInProduction))
+
+	if s.Total() != 4 || s.ByStatus[StatusInProduction] != 1 || s.ByStatus[StatusRevision] != 1 || s.ByStatus[StatusReady] != 0 {
+		t.Errorf("ByStatus = %v, want 4 orders counted in their current status", s.ByStatus)
+	}
+	if s.Revised != 2 || s.RevisionRate() != 0.5 {
+		t.Errorf("Revised = %d, RevisionRate() = %v, want 2 and 0.5", s.Revised, s.RevisionRate())
+	}
+	if rate := NewStats("lab-2").RevisionRate(); rate != 0 {
+		t.Errorf("RevisionRate() without orders = %v, want 0", rate)
+	}
+}
+
+func TestOrder_LastStatusDuration(t *testing.T) {
+	if _, _, ok := orderThrough().LastStatusDuration(); ok {
+		t.Error("LastStatusDuration() of a new order should report no transition")
+	}
+
+	status, d, ok := orderThrough(StatusInProduction, StatusQualityCheck).LastStatusDuration()
+	if !ok || status != StatusInProduction || d != time.Hour {
+		t.Errorf("LastStatusDuration() = %v, %v, %v, want an hour in production", status, d, ok)
+	}
+}
@@ -0,0 +1,91 @@
+// Package outbox defines the messages that carry domain events from the
+// persistence operation that raised them to their subscribers
+package outbox
+
+import (
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+// Message is an event stored with the change that raised it, waiting for delivery
+type Message struct {
+	ID            string
+	Event         event.Event
+	CreatedAt     time.Time
+	Attempts      int        // Failed deliveries so far
+	NextAttemptAt time.Time  // Not delivered before this time
+	DeliveredAt   *time.Time // Nil until delivered
+	DeadAt        *time.Time // Set when the attempts ran out
+	DeliveredTo   []string   // Subscribers that handled the event so far
+	LastError     string
+}
+
+// NewMessage creates a message due for delivery now
+func NewMessage(id string, e event.Event, now time.Time) *Message {
+	return &Message{ID: id, Event: e, CreatedAt: now, NextAttemptAt: now}
+}
+
+// IsDelivered reports whether the event reached its subscribers
+func (m *Message) IsDelivered() bool {
+	return m.DeliveredAt != nil
+}
+
+// IsDead reports whether the message was given up on
+func (m *Message) IsDead() bool {
+	return m.DeadAt != nil
+}
+
+// IsDue reports whether the message awaits a delivery attempt at the given time
+func (m *Message) IsDue(now time.Time) bool {
+	return !m.IsDelivered() && !m.IsDead() && !now.Before(m.NextAttemptAt)
+}
+
+// MarkHandled records the subscribers that handled the event, which later
+// attempts skip
+func (m *Message) MarkHandled(subscribers []string) {
+	m.DeliveredTo = append(m.DeliveredTo, subscribers...)
+}
+
+// MarkDelivered records a successful delivery
+func (m *Message) MarkDelivered(now time.Time) {
+	m.DeliveredAt = &now
+	m.LastError = ""
+}
+
+// MarkFailed records a failed delivery and schedules the next attempt, or
+// marks the message dead once the policy's attempts ran out
+func (m *Message) MarkFailed(err error, now time.Time, policy RetryPolicy) {
+	m.Attempts++
+	m.LastError = err.Error()
+	if policy.MaxAttempts > 0 && m.Attempts >= policy.MaxAttempts {
+		m.DeadAt = &now
+		return
+	}
+	m.NextAttemptAt = now.Add(policy.Backoff.Delay(m.Attempts))
+}
+
+// RetryPolicy decides how often and how long a failing message is retried
+type RetryPolicy struct {
+	MaxAttempts int     // Attempts before the message is dead; zero retries forever
+	Backoff     Backoff // Delay between the attempts
+}
+
+// Backoff spaces out the delivery attempts of a message, doubling the delay
+// after every failure up to Max
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+// Delay returns how long to wait after the given number of failed attempts
+func (b Backoff) Delay(attempts int) time.Duration {
+	delay := b.Initial
+	for i := 1; i < attempts && delay < b.Max; i++ {
+		delay *= 2
+	}
+	if delay > b.Max {
+		delay = b.Max
+	}
+	return delay
+}
@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	b := Backoff{Initial: time.Second, Max: 10 * time.Second}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 8 * time.Second},
+		{5, 10 * time.Second},
+		{50, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := b.Delay(tt.attempts); got != tt.want {
+			t.Errorf("Delay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestMessage_Delivery(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	m := NewMessage("msg-1", event.OrderCreated{}, now)
+
+	if !m.IsDue(now) {
+		t.Fatal("IsDue() = false for a new message, want true")
+	}
+
+	m.MarkFailed(errors.New("handler failed"), now, RetryPolicy{Backoff: Backoff{Initial: time.Minute, Max: time.Hour}})
+	if m.Attempts != 1 || m.LastError != "handler failed" || m.IsDue(now.Add(59*time.Second)) || !m.IsDue(now.Add(time.Minute)) {
+		t.Errorf("after MarkFailed() message = %+v, want a retry due in a minute", m)
+	}
+
+	m.MarkDelivered(now.Add(time.Minute))
+	if !m.IsDelivered() || m.LastError != "" || m.IsDue(now.Add(time.Hour)) {
+		t.Errorf("after MarkDelivered() message = %+v, want it delivered and not due", m)
+	}
+}
+
+func TestMessage_DeadAfterMaxAttempts(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	m := NewMessage("msg-1", event.OrderCreated{}, now)
+	policy := RetryPolicy{MaxAttempts: 2, Backoff: Backoff{Initial: time.Minute, Max: time.Hour}}
+
+	m.MarkFailed(errors.New("handler failed"), now, policy)
+	if m.IsDead() || !m.IsDue(now.Add(time.Minute)) {
+		t.Fatalf("after 1 of 2 attempts message = %+v, want a retry", m)
+	}
+
+	m.MarkFailed(errors.New("handler failed"), now.Add(time.Minute), policy)
+	if !m.IsDead() || m.IsDue(now.Add(24*time.Hour)) {
+		t.Errorf("after 2 of 2 attempts message = %+v, want it dead and never due", m)
+	}
+}
@@ -0,0 +1,10 @@
+package prosthesis
+
+import "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+
+// ListSpec describes the fields prostheses can be sorted and filtered by
+var ListSpec = listing.Spec{
+	SortFields:   []string{"type", "material", "created_at", "updated_at"},
+	DefaultSort:  "created_at",
+	FilterFields: []string{"type", "material", "shade"},
+}
@@ -35,6 +35,20 @@ func ValidProsthesisTypes() map[ProsthesisType]bool {
 	}
 }
 
+// AllProsthesisTypes returns all valid prosthesis types
+func AllProsthesisTypes() []ProsthesisType {
+	return []ProsthesisType{
+		ProsthesisTypeCrown,
+		ProsthesisTypeBridge,
+		ProsthesisTypeCompleteDenture,
+		ProsthesisTypePartialDenture,
+		ProsthesisTypeImplant,
+		ProsthesisTypeVeneer,
+		ProsthesisTypeInlay,
+		ProsthesisTypeOnlay,
+	}
+}
+
 // IsValid checks if the prosthesis type is valid
 func (pt ProsthesisType) IsValid() bool {
 	return ValidProsthesisTypes()[pt]
@@ -81,31 +95,19 @@ func (p *Prosthesis) Validate() error {
 
 	// Validate laboratory_id
 	if strings.TrimSpace(p.LaboratoryID) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "laboratory_id",
-			Message: "laboratory_id is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("laboratory_id"))
 	}
 
 	// Validate type
 	if strings.TrimSpace(string(p.Type)) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "type",
-			Message: "type is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("type"))
 	} else if !p.Type.IsValid() {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "type",
-			Message: "invalid prosthesis type",
-		})
+		validationErrors = append(validationErrors, errors.InvalidChoice("type", AllProsthesisTypes()))
 	}
 
 	// Validate material
 	if strings.TrimSpace(p.Material) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "material",
-			Message: "material is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("material"))
 	}
 
 	if len(validationErrors) > 0 {
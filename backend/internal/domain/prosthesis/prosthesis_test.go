@@ -72,7 +72,7 @@ func TestNewProsthesis(t *testing.T) {
 			prosthesisType: ProsthesisType("invalid_type"),
 			material:       "zirconia",
 			wantErr:        true,
-			errContains:    "invalid prosthesis type",
+			errContains:    "type must be one of",
 		},
 		{
 			name:           "empty material",
@@ -285,7 +285,7 @@ func TestProsthesis_Validate(t *testing.T) {
 				Material:     "zirconia",
 			},
 			wantErr:     true,
-			errContains: "invalid prosthesis type",
+			errContains: "type must be one of",
 		},
 		{
 			name: "empty material",
@@ -0,0 +1,10 @@
+package technician
+
+import "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
+
+// ListSpec describes the fields technicians can be sorted and filtered by
+var ListSpec = listing.Spec{
+	SortFields:   []string{"name", "email", "role", "created_at", "updated_at"},
+	DefaultSort:  "created_at",
+	FilterFields: []string{"role"},
+}
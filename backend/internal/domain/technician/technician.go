@@ -22,6 +22,11 @@ const (
 	RoleApprentice       Role = "apprentice"
 )
 
+// AllRoles returns all valid roles
+func AllRoles() []Role {
+	return []Role{RoleSeniorTechnician, RoleTechnician, RoleApprentice}
+}
+
 // IsValid checks if the role is valid
 func (r Role) IsValid() bool {
 	return r == RoleSeniorTechnician || r == RoleTechnician || r == RoleApprentice
@@ -73,65 +78,38 @@ func (t *Technician) Validate() error {
 
 	// Validate laboratory_id
 	if strings.TrimSpace(t.LaboratoryID) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "laboratory_id",
-			Message: "laboratory_id is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("laboratory_id"))
 	}
 
 	// Validate name
 	name := strings.TrimSpace(t.Name)
 	if name == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "name",
-			Message: "name is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("name"))
 	} else if len(name) > 200 {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "name",
-			Message: "name must be at most 200 characters",
-		})
+		validationErrors = append(validationErrors, errors.TooLong("name", 200))
 	}
 
 	// Validate email
 	email := strings.TrimSpace(t.Email)
 	if email == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "email",
-			Message: "email is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("email"))
 	} else if !emailRegex.MatchString(email) {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "email",
-			Message: "invalid email format",
-		})
+		validationErrors = append(validationErrors, errors.InvalidEmail("email"))
 	}
 
 	// Validate phone
 	phone := strings.TrimSpace(t.Phone)
 	if phone == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "phone",
-			Message: "phone is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("phone"))
 	} else if !phoneRegex.MatchString(strings.ReplaceAll(phone, " ", "")) {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "phone",
-			Message: "invalid phone format",
-		})
+		validationErrors = append(validationErrors, errors.InvalidPhone("phone"))
 	}
 
 	// Validate role
 	if strings.TrimSpace(string(t.Role)) == "" {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "role",
-			Message: "role is required",
-		})
+		validationErrors = append(validationErrors, errors.Required("role"))
 	} else if !t.Role.IsValid() {
-		validationErrors = append(validationErrors, errors.ValidationError{
-			Field:   "role",
-			Message: "invalid role. Must be one of: senior_technician, technician, apprentice",
-		})
+		validationErrors = append(validationErrors, errors.InvalidChoice("role", AllRoles()))
 	}
 
 	if len(validationErrors) > 0 {
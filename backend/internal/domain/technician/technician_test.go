@@ -136,7 +136,7 @@ func TestNewTechnician(t *testing.T) {
 			phone:        "+5511999999999",
 			role:         Role("invalid_role"),
 			wantErr:      true,
-			errContains:  "invalid role",
+			errContains:  "role must be one of",
 		},
 	}
 
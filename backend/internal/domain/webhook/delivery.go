@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+// DeliveryStatus represents the state of a delivery
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"   // Awaiting its first attempt or a retry
+	DeliveryDelivered DeliveryStatus = "delivered" // Accepted by the receiver
+	DeliveryDead      DeliveryStatus = "dead"      // Gave up after the last attempt failed
+)
+
+// AllDeliveryStatuses returns all delivery statuses
+func AllDeliveryStatuses() []DeliveryStatus {
+	return []DeliveryStatus{DeliveryPending, DeliveryDelivered, DeliveryDead}
+}
+
+// IsValid checks if the delivery status is valid
+func (s DeliveryStatus) IsValid() bool {
+	for _, v := range AllDeliveryStatuses() {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryPolicy decides when a failed delivery is attempted again
+type RetryPolicy struct {
+	MaxAttempts int            // Attempts before a delivery is dead-lettered
+	Backoff     outbox.Backoff // Delay between the attempts
+}
+
+// Attempt is the log entry of one delivery attempt
+type Attempt struct {
+	At         time.Time
+	StatusCode int    // Zero when no response was received
+	Error      string // Empty when the receiver accepted the payload
+	Duration   time.Duration
+}
+
+// Succeeded reports whether the receiver accepted the payload
+func (a Attempt) Succeeded() bool {
+	return a.Error == ""
+}
+
+// Delivery is an event on its way to a subscription, with the log of its attempts
+type Delivery struct {
+	ID             string
+	SubscriptionID string
+	LaboratoryID   string
+	EventID        string // Shared by the deliveries of the same event, for receivers to drop duplicates
+	EventType      EventType
+	Payload        []byte // Signed and posted as is on every attempt
+	Status         DeliveryStatus
+	Attempts       []Attempt
+	NextAttemptAt  time.Time
+	RedeliveryOf   string // ID of the delivery this one repeats on request, if any
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewDelivery creates a delivery of an event to a subscription, due now
+func NewDelivery(id string, s *Subscription, eventID string, eventType EventType, payload []byte, now time.Time) *Delivery {
+	return &Delivery{
+		ID:             id,
+		SubscriptionID: s.ID,
+		LaboratoryID:   s.LaboratoryID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// IsDue reports whether the delivery awaits an attempt at the given time
+func (d *Delivery) IsDue(now time.Time) bool {
+	return d.Status == DeliveryPending && !now.Before(d.NextAttemptAt)
+}
+
+// Record logs an attempt and moves the delivery on: delivered if it succeeded,
+// otherwise scheduled for a retry, or dead once the policy's attempts are used up
+func (d *Delivery) Record(a Attempt, policy RetryPolicy) {
+	d.Attempts = append(d.Attempts, a)
+	d.UpdatedAt = a.At
+
+	switch {
+	case a.Succeeded():
+		d.Status = DeliveryDelivered
+	case len(d.Attempts) >= policy.MaxAttempts:
+		d.Status = DeliveryDead
+	default:
+		d.NextAttemptAt = a.At.Add(policy.Backoff.Delay(len(d.Attempts)))
+	}
+}
+
+// Redeliver creates a new delivery of the same payload, due now
+func (d *Delivery) Redeliver(id string, now time.Time) *Delivery {
+	return &Delivery{
+		ID:             id,
+		SubscriptionID: d.SubscriptionID,
+		LaboratoryID:   d.LaboratoryID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+		RedeliveryOf:   d.ID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// DeliveryFilter holds the criteria for listing the deliveries of a subscription
+type DeliveryFilter struct {
+	SubscriptionID string
+	Status         DeliveryStatus // Empty matches every status
+	Limit          int            // Zero means no limit
+}
+
+// Matches reports whether a delivery satisfies the filter
+func (f DeliveryFilter) Matches(d *Delivery) bool {
+	if d.SubscriptionID != f.SubscriptionID {
+		return false
+	}
+	return f.Status == "" || d.Status == f.Status
+}
@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// signaturePrefix names the algorithm of a signature
+const signaturePrefix = "sha256="
+
+// Sign returns the signature of a payload sent at the given time: the
+// HMAC-SHA256, keyed with the subscription secret, of the Unix timestamp, a
+// dot and the payload, hex-encoded and prefixed with "sha256=". Signing the
+// timestamp lets receivers reject replayed payloads.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether a signature is the one of the payload sent at the
+// given time, comparing in constant time
+func Verify(secret, signature string, timestamp time.Time, payload []byte) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, payload)))
+}
@@ -0,0 +1,176 @@
+// Package webhook defines the subscriptions of laboratories to order lifecycle
+// events and the deliveries of those events to the subscribed URLs
+package webhook
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+// MinSecretLength is the minimum length of a subscription secret
+const MinSecretLength = 16
+
+// EventType identifies an event a subscription can be told about
+type EventType string
+
+const (
+	EventOrderCreated       EventType = "order.created"
+	EventOrderUpdated       EventType = "order.updated"
+	EventOrderStatusChanged EventType = "order.status_changed"
+	EventOrderDeleted       EventType = "order.deleted"
+	EventOrderInProduction  EventType = "order.in_production"
+	EventOrderQualityCheck  EventType = "order.quality_check"
+	EventOrderReady         EventType = "order.ready"
+	EventOrderDelivered     EventType = "order.delivered"
+	EventOrderRevision      EventType = "order.revision"
+)
+
+// AllEventTypes returns all event types
+func AllEventTypes() []EventType {
+	return []EventType{
+		EventOrderCreated, EventOrderUpdated, EventOrderStatusChanged, EventOrderDeleted,
+		EventOrderInProduction, EventOrderQualityCheck, EventOrderReady, EventOrderDelivered, EventOrderRevision,
+	}
+}
+
+// IsValid checks if the event type is valid
+func (t EventType) IsValid() bool {
+	for _, v := range AllEventTypes() {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// EventTypesOf returns the event types a domain event is delivered as. A status
+// change is delivered both as order.status_changed and as the event type of the
+// status it moved to, e.g. order.ready; events of other entities have none.
+func EventTypesOf(e event.Event) []EventType {
+	switch e := e.(type) {
+	case event.OrderCreated:
+		return []EventType{EventOrderCreated}
+	case event.OrderUpdated:
+		return []EventType{EventOrderUpdated}
+	case event.OrderDeleted:
+		return []EventType{EventOrderDeleted}
+	case event.OrderStatusChanged:
+		types := []EventType{EventOrderStatusChanged}
+		if t := EventType("order." + string(e.To)); t.IsValid() {
+			types = append(types, t)
+		}
+		return types
+	}
+	return nil
+}
+
+// Subscription is a URL of a laboratory told about the events of some types
+type Subscription struct {
+	ID           string
+	LaboratoryID string
+	URL          string
+	EventTypes   []EventType
+	Secret       string // Signs the payloads, see Sign
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+}
+
+// NewSubscription creates a new Subscription with validation
+func NewSubscription(id, laboratoryID, rawURL string, eventTypes []EventType, secret string) (*Subscription, error) {
+	now := time.Now().UTC()
+	s := &Subscription{
+		ID:           id,
+		LaboratoryID: laboratoryID,
+		URL:          strings.TrimSpace(rawURL),
+		EventTypes:   eventTypes,
+		Secret:       secret,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Validate validates the subscription fields
+func (s *Subscription) Validate() error {
+	var validationErrors errors.ValidationErrors
+
+	// Validate laboratory_id
+	if strings.TrimSpace(s.LaboratoryID) == "" {
+		validationErrors = append(validationErrors, errors.Required("laboratory_id"))
+	}
+
+	// Validate url: payloads are only sent over TLS
+	if s.URL == "" {
+		validationErrors = append(validationErrors, errors.Required("url"))
+	} else if u, err := url.Parse(s.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		validationErrors = append(validationErrors, errors.Invalid("url"))
+	}
+
+	// Validate event_types
+	if len(s.EventTypes) == 0 {
+		validationErrors = append(validationErrors, errors.AtLeastOne("event_types"))
+	}
+	for _, t := range s.EventTypes {
+		if !t.IsValid() {
+			validationErrors = append(validationErrors, errors.InvalidChoice("event_types", AllEventTypes()))
+			break
+		}
+	}
+
+	// Validate secret
+	if s.Secret == "" {
+		validationErrors = append(validationErrors, errors.Required("secret"))
+	} else if len(s.Secret) < MinSecretLength {
+		validationErrors = append(validationErrors, errors.TooShort("secret", MinSecretLength))
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// Update updates the subscription fields and sets UpdatedAt. An empty secret
+// keeps the current one.
+func (s *Subscription) Update(rawURL string, eventTypes []EventType, secret string) error {
+	s.URL = strings.TrimSpace(rawURL)
+	s.EventTypes = eventTypes
+	if secret != "" {
+		s.Secret = secret
+	}
+	s.UpdatedAt = time.Now().UTC()
+
+	return s.Validate()
+}
+
+// Wants reports whether the subscription is told about events of the given type
+func (s *Subscription) Wants(t EventType) bool {
+	for _, v := range s.EventTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Delete performs a soft delete by setting DeletedAt
+func (s *Subscription) Delete() {
+	now := time.Now().UTC()
+	s.DeletedAt = &now
+}
+
+// IsDeleted returns true if the subscription has been soft-deleted
+func (s *Subscription) IsDeleted() bool {
+	return s.DeletedAt != nil
+}
@@ -0,0 +1,206 @@
+package webhook
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+const testSecret = "0123456789abcdef"
+
+func TestNewSubscription(t *testing.T) {
+	tests := []struct {
+		name         string
+		laboratoryID string
+		url          string
+		eventTypes   []EventType
+		secret       string
+		errContains  string
+	}{
+		{
+			name:         "valid subscription",
+			laboratoryID: "lab-123",
+			url:          "https://clinic.example.com/hooks",
+			eventTypes:   []EventType{EventOrderReady, EventOrderDelivered},
+			secret:       testSecret,
+		},
+		{
+			name:        "empty laboratory_id",
+			url:         "https://clinic.example.com/hooks",
+			eventTypes:  []EventType{EventOrderReady},
+			secret:      testSecret,
+			errContains: "laboratory_id is required",
+		},
+		{
+			name:         "empty url",
+			laboratoryID: "lab-123",
+			eventTypes:   []EventType{EventOrderReady},
+			secret:       testSecret,
+			errContains:  "url is required",
+		},
+		{
+			name:         "plain http url",
+			laboratoryID: "lab-123",
+			url:          "http://clinic.example.com/hooks",
+			eventTypes:   []EventType{EventOrderReady},
+			secret:       testSecret,
+			errContains:  "url is invalid",
+		},
+		{
+			name:         "url without http scheme",
+			laboratoryID: "lab-123",
+			url:          "ftp://clinic.example.com/hooks",
+			eventTypes:   []EventType{EventOrderReady},
+			secret:       testSecret,
+			errContains:  "url is invalid",
+		},
+		{
+			name:         "no event types",
+			laboratoryID: "lab-123",
+			url:          "https://clinic.example.com/hooks",
+			secret:       testSecret,
+			errContains:  "at least one event_types item is required",
+		},
+		{
+			name:         "unknown event type",
+			laboratoryID: "lab-123",
+			url:          "https://clinic.example.com/hooks",
+			eventTypes:   []EventType{"client.created"},
+			secret:       testSecret,
+			errContains:  "event_types must be one of",
+		},
+		{
+			name:         "empty secret",
+			laboratoryID: "lab-123",
+			url:          "https://clinic.example.com/hooks",
+			eventTypes:   []EventType{EventOrderReady},
+			errContains:  "secret is required",
+		},
+		{
+			name:         "short secret",
+			laboratoryID: "lab-123",
+			url:          "https://clinic.example.com/hooks",
+			eventTypes:   []EventType{EventOrderReady},
+			secret:       "short",
+			errContains:  "secret must be at least 16 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSubscription("hook-1", tt.laboratoryID, tt.url, tt.eventTypes, tt.secret)
+			if tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("NewSubscription() error = %v, want it to contain %q", err, tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewSubscription() unexpected error = %v", err)
+			}
+			if s.CreatedAt.IsZero() || !s.Wants(EventOrderReady) || s.Wants(EventOrderCreated) {
+				t.Errorf("NewSubscription() = %+v, want a subscription to the given event types", s)
+			}
+		})
+	}
+}
+
+func TestSubscription_Update_KeepsSecret(t *testing.T) {
+	s, err := NewSubscription("hook-1", "lab-123", "https://clinic.example.com/hooks", []EventType{EventOrderReady}, testSecret)
+	if err != nil {
+		t.Fatalf("NewSubscription() error = %v", err)
+	}
+
+	if err := s.Update("https://clinic.example.com/v2", []EventType{EventOrderDelivered}, ""); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if s.Secret != testSecret || s.URL != "https://clinic.example.com/v2" || !s.Wants(EventOrderDelivered) {
+		t.Errorf("Update() = %+v, want the new URL and event types with the same secret", s)
+	}
+}
+
+func TestEventTypesOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		event event.Event
+		want  []EventType
+	}{
+		{"order created", event.OrderCreated{}, []EventType{EventOrderCreated}},
+		{"order ready", event.OrderStatusChanged{From: order.StatusQualityCheck, To: order.StatusReady}, []EventType{EventOrderStatusChanged, EventOrderReady}},
+		{"order delivered", event.OrderStatusChanged{From: order.StatusReady, To: order.StatusDelivered}, []EventType{EventOrderStatusChanged, EventOrderDelivered}},
+		{"order back to received", event.OrderStatusChanged{From: order.StatusInProduction, To: order.StatusReceived}, []EventType{EventOrderStatusChanged}},
+		{"client event", event.ClientCreated{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EventTypesOf(tt.event); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EventTypesOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelivery_Record(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	policy := RetryPolicy{MaxAttempts: 3, Backoff: outbox.Backoff{Initial: time.Minute, Max: time.Hour}}
+	sub := &Subscription{ID: "hook-1", LaboratoryID: "lab-123"}
+
+	d := NewDelivery("dlv-1", sub, "evt-1", EventOrderReady, []byte(`{}`), now)
+	if !d.IsDue(now) {
+		t.Fatal("IsDue() = false for a new delivery, want true")
+	}
+
+	d.Record(Attempt{At: now, StatusCode: 500, Error: "status 500"}, policy)
+	if d.Status != DeliveryPending || d.IsDue(now.Add(59*time.Second)) || !d.IsDue(now.Add(time.Minute)) {
+		t.Errorf("after a failed attempt delivery = %+v, want a retry due in a minute", d)
+	}
+
+	d.Record(Attempt{At: now.Add(time.Minute), Error: "connection refused"}, policy)
+	if !d.NextAttemptAt.Equal(now.Add(3 * time.Minute)) {
+		t.Errorf("after two failed attempts NextAttemptAt = %v, want the backoff doubled", d.NextAttemptAt)
+	}
+
+	d.Record(Attempt{At: now.Add(3 * time.Minute), StatusCode: 502, Error: "status 502"}, policy)
+	if d.Status != DeliveryDead || d.IsDue(now.Add(time.Hour)) || len(d.Attempts) != 3 {
+		t.Errorf("after the last failed attempt delivery = %+v, want it dead with 3 attempts", d)
+	}
+
+	redelivery := d.Redeliver("dlv-2", now.Add(time.Hour))
+	if redelivery.RedeliveryOf != "dlv-1" || redelivery.EventID != "evt-1" || !redelivery.IsDue(now.Add(time.Hour)) || len(redelivery.Attempts) != 0 {
+		t.Errorf("Redeliver() = %+v, want a due delivery of the same event", redelivery)
+	}
+
+	redelivery.Record(Attempt{At: now.Add(time.Hour), StatusCode: 204}, policy)
+	if redelivery.Status != DeliveryDelivered || redelivery.IsDue(now.Add(2*time.Hour)) {
+		t.Errorf("after a successful attempt delivery = %+v, want it delivered", redelivery)
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	ts := time.Unix(1705312800, 0)
+	payload := []byte(`{"type":"order.ready"}`)
+
+	signature := Sign(testSecret, ts, payload)
+	if !strings.HasPrefix(signature, "sha256=") || len(signature) != len("sha256=")+64 {
+		t.Errorf("Sign() = %q, want a hex HMAC-SHA256 prefixed with sha256=", signature)
+	}
+
+	if !Verify(testSecret, signature, ts, payload) {
+		t.Error("Verify() = false for the signature of the payload, want true")
+	}
+	if Verify("another-secret-value", signature, ts, payload) {
+		t.Error("Verify() = true with another secret, want false")
+	}
+	if Verify(testSecret, signature, ts.Add(time.Second), payload) {
+		t.Error("Verify() = true with another timestamp, want false")
+	}
+	if Verify(testSecret, signature, ts, []byte(`{"type":"order.delivered"}`)) {
+		t.Error("Verify() = true with another payload, want false")
+	}
+}
@@ -0,0 +1,336 @@
+package i18n
+
+import "strings"
+
+// KeyNoLinkedClient explains forbidden portal requests from users without a client
+const KeyNoLinkedClient = "portal.no_linked_client"
+
+// catalog maps message keys to their translations. Placeholders such as
+// {field} are filled from the params passed to Translate.
+var catalog = map[string]map[Language]string{
+	// Validation messages, keyed by the domain errors' message keys
+	"required": {
+		PtBR: "{field} é obrigatório",
+		En:   "{field} is required",
+		Es:   "{field} es obligatorio",
+	},
+	"too_long": {
+		PtBR: "{field} deve ter no máximo {max} caracteres",
+		En:   "{field} must be at most {max} characters",
+		Es:   "{field} debe tener como máximo {max} caracteres",
+	},
+	"too_short": {
+		PtBR: "{field} deve ter no mínimo {min} caracteres",
+		En:   "{field} must be at least {min} characters",
+		Es:   "{field} debe tener como mínimo {min} caracteres",
+	},
+	"invalid_email": {
+		PtBR: "{field} não é um e-mail válido",
+		En:   "{field} must be a valid email",
+		Es:   "{field} no es un correo electrónico válido",
+	},
+	"invalid_phone": {
+		PtBR: "{field} não é um telefone válido",
+		En:   "{field} must be a valid phone number",
+		Es:   "{field} no es un teléfono válido",
+	},
+	"invalid_choice": {
+		PtBR: "{field} deve ser um de: {allowed}",
+		En:   "{field} must be one of: {allowed}",
+		Es:   "{field} debe ser uno de: {allowed}",
+	},
+	"at_least_one": {
+		PtBR: "{field} deve ter pelo menos um item",
+		En:   "at least one {field} item is required",
+		Es:   "{field} debe tener al menos un elemento",
+	},
+	"greater_than": {
+		PtBR: "{field} deve ser maior que {min}",
+		En:   "{field} must be greater than {min}",
+		Es:   "{field} debe ser mayor que {min}",
+	},
+	"at_least": {
+		PtBR: "{field} deve ser no mínimo {min}",
+		En:   "{field} must be at least {min}",
+		Es:   "{field} debe ser como mínimo {min}",
+	},
+	"at_most": {
+		PtBR: "{field} deve ser no máximo {max}",
+		En:   "{field} must be at most {max}",
+		Es:   "{field} debe ser como máximo {max}",
+	},
+	"file_too_large": {
+		PtBR: "{field} deve ter no máximo {max} MB",
+		En:   "{field} must be at most {max} MB",
+		Es:   "{field} debe tener como máximo {max} MB",
+	},
+	"file_empty": {
+		PtBR: "{field} não pode estar vazio",
+		En:   "{field} must not be empty",
+		Es:   "{field} no puede estar vacío",
+	},
+	"invalid_file": {
+		PtBR: "{field} não é um arquivo válido",
+		En:   "{field} is not a valid file",
+		Es:   "{field} no es un archivo válido",
+	},
+	"invalid_range": {
+		PtBR: "{field} não pode ser posterior a {to}",
+		En:   "{field} must not be after {to}",
+		Es:   "{field} no puede ser posterior a {to}",
+	},
+	"out_of_range": {
+		PtBR: "{field} deve estar entre {min} e {max}",
+		En:   "{field} must be between {min} and {max}",
+		Es:   "{field} debe estar entre {min} y {max}",
+	},
+	"invalid_cursor": {
+		PtBR: "{field} é inválido ou foi emitido para outra ordenação",
+		En:   "{field} is invalid or was issued for a different sort",
+		Es:   "{field} no es válido o fue emitido para otro orden",
+	},
+	"unsupported_sort": {
+		PtBR: "não é possível ordenar por {value}",
+		En:   "cannot sort by {value}",
+		Es:   "no se puede ordenar por {value}",
+	},
+	"unsupported_filter": {
+		PtBR: "não é possível filtrar por {field}",
+		En:   "cannot filter by {field}",
+		Es:   "no se puede filtrar por {field}",
+	},
+	"positive_integer": {
+		PtBR: "{field} deve ser um número inteiro positivo",
+		En:   "{field} must be a positive integer",
+		Es:   "{field} debe ser un número entero positivo",
+	},
+	"invalid_timestamp": {
+		PtBR: "{field} deve ser uma data e hora RFC3339",
+		En:   "{field} must be an RFC3339 timestamp",
+		Es:   "{field} debe ser una fecha y hora RFC3339",
+	},
+	"reference_not_found": {
+		PtBR: "{field} referencia um recurso que não foi encontrado",
+		En:   "{field} references a resource that was not found",
+		Es:   "{field} hace referencia a un recurso que no se encontró",
+	},
+	"invalid_type": {
+		PtBR: "{field} deve ser do tipo {type}",
+		En:   "{field} must be of type {type}",
+		Es:   "{field} debe ser de tipo {type}",
+	},
+	"invalid": {
+		PtBR: "{field} é inválido",
+		En:   "{field} is invalid",
+		Es:   "{field} no es válido",
+	},
+	"duplicate_in_batch": {
+		PtBR: "{field} aparece em outro item do lote",
+		En:   "{field} appears in another item of the batch",
+		Es:   "{field} aparece en otro elemento del lote",
+	},
+
+	// Problem titles and details, keyed by problem code
+	"problem.validation_failed.title": {
+		PtBR: "Falha de validação",
+		En:   "Validation Failed",
+		Es:   "Error de validación",
+	},
+	"problem.validation_failed.detail": {
+		PtBR: "um ou mais campos são inválidos",
+		En:   "one or more fields are invalid",
+		Es:   "uno o más campos no son válidos",
+	},
+	"problem.malformed_request.title": {
+		PtBR: "Requisição malformada",
+		En:   "Malformed Request",
+		Es:   "Solicitud mal formada",
+	},
+	"problem.malformed_request.detail": {
+		PtBR: "o corpo da requisição não é um JSON válido",
+		En:   "request body is not valid JSON",
+		Es:   "el cuerpo de la solicitud no es un JSON válido",
+	},
+	"problem.payload_too_large.title": {
+		PtBR: "Conteúdo muito grande",
+		En:   "Payload Too Large",
+		Es:   "Contenido demasiado grande",
+	},
+	"problem.payload_too_large.detail": {
+		PtBR: "o arquivo deve ter no máximo 10 MB",
+		En:   "file must be at most 10 MB",
+		Es:   "el archivo debe tener como máximo 10 MB",
+	},
+	"problem.request_too_large.title": {
+		PtBR: "Requisição muito grande",
+		En:   "Request Too Large",
+		Es:   "Solicitud demasiado grande",
+	},
+	"problem.request_too_large.detail": {
+		PtBR: "o corpo da requisição é grande demais para ser processado",
+		En:   "request body is too large to be processed",
+		Es:   "el cuerpo de la solicitud es demasiado grande para ser procesado",
+	},
+	"problem.unsupported_media_type.title": {
+		PtBR: "Tipo de mídia não suportado",
+		En:   "Unsupported Media Type",
+		Es:   "Tipo de medio no admitido",
+	},
+	"problem.unsupported_media_type.detail": {
+		PtBR: "o tipo de conteúdo da requisição não é aceito por este recurso",
+		En:   "request content type is not accepted by this resource",
+		Es:   "el tipo de contenido de la solicitud no es aceptado por este recurso",
+	},
+	"problem.invalid_input.title": {
+		PtBR: "Entrada inválida",
+		En:   "Invalid Input",
+		Es:   "Entrada no válida",
+	},
+	"problem.invalid_input.detail": {
+		PtBR: "a requisição é inválida",
+		En:   "the request is invalid",
+		Es:   "la solicitud no es válida",
+	},
+	"problem.invalid_status_transition.title": {
+		PtBR: "Transição de status inválida",
+		En:   "Invalid Status Transition",
+		Es:   "Transición de estado no válida",
+	},
+	"problem.invalid_status_transition.detail": {
+		PtBR: "o pedido não pode passar para o status solicitado",
+		En:   "the order cannot move to the requested status",
+		Es:   "el pedido no puede pasar al estado solicitado",
+	},
+	"problem.unauthorized.title": {
+		PtBR: "Não autenticado",
+		En:   "Unauthorized",
+		Es:   "No autenticado",
+	},
+	"problem.unauthorized.detail": {
+		PtBR: "é necessário autenticar-se",
+		En:   "authentication is required",
+		Es:   "se requiere autenticación",
+	},
+	"problem.forbidden.title": {
+		PtBR: "Acesso negado",
+		En:   "Forbidden",
+		Es:   "Acceso denegado",
+	},
+	"problem.forbidden.detail": {
+		PtBR: "o acesso ao recurso foi negado",
+		En:   "access to the resource is denied",
+		Es:   "se denegó el acceso al recurso",
+	},
+	"problem.not_found.title": {
+		PtBR: "Não encontrado",
+		En:   "Not Found",
+		Es:   "No encontrado",
+	},
+	"problem.not_found.detail": {
+		PtBR: "o recurso não foi encontrado",
+		En:   "the resource was not found",
+		Es:   "no se encontró el recurso",
+	},
+	"problem.duplicate_email.title": {
+		PtBR: "E-mail duplicado",
+		En:   "Duplicate Email",
+		Es:   "Correo electrónico duplicado",
+	},
+	"problem.duplicate_email.detail": {
+		PtBR: "o e-mail já está em uso",
+		En:   "the email is already in use",
+		Es:   "el correo electrónico ya está en uso",
+	},
+	"problem.portal_user_already_linked.title": {
+		PtBR: "Usuário do portal já vinculado",
+		En:   "Portal User Already Linked",
+		Es:   "Usuario del portal ya vinculado",
+	},
+	"problem.portal_user_already_linked.detail": {
+		PtBR: "o usuário do portal já está vinculado a outro cliente",
+		En:   "the portal user is already linked to another client",
+		Es:   "el usuario del portal ya está vinculado a otro cliente",
+	},
+	"problem.idempotency_key_reused.title": {
+		PtBR: "Chave de idempotência reutilizada",
+		En:   "Idempotency Key Reused",
+		Es:   "Clave de idempotencia reutilizada",
+	},
+	"problem.idempotency_key_reused.detail": {
+		PtBR: "a Idempotency-Key já foi usada com uma requisição diferente",
+		En:   "the Idempotency-Key was already used with a different request",
+		Es:   "la Idempotency-Key ya se usó con una solicitud diferente",
+	},
+	"problem.idempotency_request_in_progress.title": {
+		PtBR: "Requisição em andamento",
+		En:   "Request In Progress",
+		Es:   "Solicitud en curso",
+	},
+	"problem.idempotency_request_in_progress.detail": {
+		PtBR: "uma requisição com esta Idempotency-Key ainda está sendo processada; tente novamente em instantes",
+		En:   "a request with this Idempotency-Key is still being processed; retry shortly",
+		Es:   "una solicitud con esta Idempotency-Key aún se está procesando; reintente en unos instantes",
+	},
+	"problem.rate_limited.title": {
+		PtBR: "Muitas requisições",
+		En:   "Too Many Requests",
+		Es:   "Demasiadas solicitudes",
+	},
+	"problem.rate_limited.detail": {
+		PtBR: "o limite de requisições foi excedido; tente novamente após o tempo indicado em Retry-After",
+		En:   "the rate limit was exceeded; retry after the time given in Retry-After",
+		Es:   "se superó el límite de solicitudes; reintente después del tiempo indicado en Retry-After",
+	},
+	"problem.internal_error.title": {
+		PtBR: "Erro interno do servidor",
+		En:   "Internal Server Error",
+		Es:   "Error interno del servidor",
+	},
+	"problem.internal_error.detail": {
+		PtBR: "ocorreu um erro inesperado",
+		En:   "an unexpected error occurred",
+		Es:   "ocurrió un error inesperado",
+	},
+
+	KeyNoLinkedClient: {
+		PtBR: "nenhum cliente está vinculado a este usuário",
+		En:   "no client is linked to this user",
+		Es:   "ningún cliente está vinculado a este usuario",
+	},
+}
+
+// Translate renders the message for key in lang, falling back to English and
+// then to the key itself when no translation exists
+func Translate(lang Language, key string, params map[string]string) string {
+	translations, ok := catalog[key]
+	if !ok {
+		return key
+	}
+	msg, ok := translations[lang]
+	if !ok {
+		msg = translations[En]
+	}
+
+	if len(params) == 0 {
+		return msg
+	}
+	pairs := make([]string, 0, 2*len(params))
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
+
+// Has reports whether key has a translation in every supported language
+func Has(key string) bool {
+	translations, ok := catalog[key]
+	if !ok {
+		return false
+	}
+	for _, lang := range Supported() {
+		if translations[lang] == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	domainerrors "github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/errors"
+)
+
+func TestCatalog_TranslatesEveryValidationKey(t *testing.T) {
+	for _, key := range domainerrors.Keys() {
+		if !Has(key) {
+			t.Errorf("key %q is missing a translation", key)
+		}
+	}
+}
+
+func TestCatalog_TranslatesEveryKey(t *testing.T) {
+	for key := range catalog {
+		if !Has(key) {
+			t.Errorf("key %q is missing a translation", key)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	params := map[string]string{"field": "name", "max": "200"}
+
+	tests := []struct {
+		name string
+		lang Language
+		key  string
+		want string
+	}{
+		{"portuguese", PtBR, domainerrors.KeyTooLong, "name deve ter no máximo 200 caracteres"},
+		{"english", En, domainerrors.KeyTooLong, "name must be at most 200 characters"},
+		{"spanish", Es, domainerrors.KeyTooLong, "name debe tener como máximo 200 caracteres"},
+		{"unsupported language falls back to english", Language("fr"), domainerrors.KeyTooLong, "name must be at most 200 characters"},
+		{"unknown key", PtBR, "no.such.key", "no.such.key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Translate(tt.lang, tt.key, params); got != tt.want {
+				t.Errorf("Translate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		tag    string
+		want   Language
+		wantOK bool
+	}{
+		{"pt-BR", PtBR, true},
+		{"pt", PtBR, true},
+		{"pt-PT", PtBR, true},
+		{"EN-us", En, true},
+		{"es-AR", Es, true},
+		{"es_MX", Es, true},
+		{"fr", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := Parse(tt.tag)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Parse(%q) = %q, %v, want %q, %v", tt.tag, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   Language
+		wantOK bool
+	}{
+		{"single", "es", Es, true},
+		{"first wins on equal weight", "en-US, pt-BR", En, true},
+		{"highest weight wins", "en;q=0.5, es;q=0.9, pt;q=0.7", Es, true},
+		{"skips unsupported", "fr-FR, de;q=0.9, pt;q=0.8", PtBR, true},
+		{"skips zero weight", "en;q=0, es;q=0.1", Es, true},
+		{"skips malformed weight", "en;q=abc, es;q=0.2", Es, true},
+		{"nothing supported", "fr, de", "", false},
+		{"wildcard only", "*", "", false},
+		{"empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Negotiate(tt.header)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Negotiate(%q) = %q, %v, want %q, %v", tt.header, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("FromContext() on empty context reported a language")
+	}
+
+	ctx := WithLanguage(context.Background(), Es)
+	if got, ok := FromContext(ctx); !ok || got != Es {
+		t.Errorf("FromContext() = %q, %v, want %q, true", got, ok, Es)
+	}
+}
@@ -0,0 +1,92 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language is a supported BCP 47 language tag
+type Language string
+
+const (
+	PtBR Language = "pt-BR"
+	En   Language = "en"
+	Es   Language = "es"
+)
+
+// Default is used when neither the request nor the laboratory picks a language
+const Default = PtBR
+
+// Supported returns all supported languages
+func Supported() []Language {
+	return []Language{PtBR, En, Es}
+}
+
+// Parse maps a language tag to a supported language, matching regional
+// variants by their primary subtag (pt-PT → pt-BR, en-US → en, es-AR → es)
+func Parse(tag string) (Language, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	primary := strings.SplitN(strings.ReplaceAll(tag, "_", "-"), "-", 2)[0]
+	switch primary {
+	case "pt":
+		return PtBR, true
+	case "en":
+		return En, true
+	case "es":
+		return Es, true
+	default:
+		return "", false
+	}
+}
+
+// Negotiate picks the supported language the client prefers most from an
+// Accept-Language header. It reports false when no listed language is supported.
+func Negotiate(acceptLanguage string) (Language, bool) {
+	type candidate struct {
+		lang Language
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, q := part, 1.0
+		if i := strings.Index(part, ";"); i >= 0 {
+			tag = part[:i]
+			param := strings.TrimSpace(part[i+1:])
+			if v, ok := strings.CutPrefix(param, "q="); ok {
+				parsed, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					continue
+				}
+				q = parsed
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		if lang, ok := Parse(tag); ok {
+			candidates = append(candidates, candidate{lang, q})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].lang, true
+}
+
+type contextKey struct{}
+
+// WithLanguage returns a copy of ctx carrying the given language
+func WithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext extracts the language from context
+func FromContext(ctx context.Context) (Language, bool) {
+	lang, ok := ctx.Value(contextKey{}).(Language)
+	return lang, ok
+}
@@ -0,0 +1,12 @@
+package outbound
+
+import "context"
+
+// AttachmentStorage defines the interface for storing order attachment contents
+type AttachmentStorage interface {
+	// Save stores the attachment contents under the given key
+	Save(ctx context.Context, key, contentType string, data []byte) error
+
+	// Get retrieves the attachment contents and content type by key
+	Get(ctx context.Context, key string) ([]byte, string, error)
+}
@@ -0,0 +1,17 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/audit"
+)
+
+// AuditRepository defines the interface for the append-only audit log.
+// Entries are immutable: there is intentionally no update or delete.
+type AuditRepository interface {
+	// Append stores a new audit entry
+	Append(ctx context.Context, e *audit.Entry) error
+
+	// List retrieves audit entries matching the filter, most recent first
+	List(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error)
+}
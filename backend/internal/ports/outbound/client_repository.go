@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/client"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 // ClientRepository defines the interface for client persistence operations
@@ -14,15 +15,22 @@ type ClientRepository interface {
 	// GetByID retrieves a client by ID (excludes soft-deleted)
 	GetByID(ctx context.Context, id string) (*client.Client, error)
 
+	// GetByIDs retrieves the clients with the given IDs in one lookup (excludes
+	// soft-deleted); IDs without a match are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*client.Client, error)
+
 	// GetByEmail retrieves a client by email within a laboratory (excludes soft-deleted)
 	GetByEmail(ctx context.Context, laboratoryID, email string) (*client.Client, error)
 
+	// GetByPortalUserID retrieves the client bound to a portal identity (excludes soft-deleted)
+	GetByPortalUserID(ctx context.Context, userID string) (*client.Client, error)
+
 	// Update updates an existing client
 	Update(ctx context.Context, c *client.Client) error
 
 	// Delete performs a soft delete on a client
 	Delete(ctx context.Context, id string) error
 
-	// List retrieves all active (non-deleted) clients for a laboratory
-	List(ctx context.Context, laboratoryID string) ([]*client.Client, error)
+	// List retrieves a page of active (non-deleted) clients for a laboratory
+	List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*client.Client], error)
 }
@@ -0,0 +1,22 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/comment"
+)
+
+// CommentRepository defines the interface for order comment persistence operations
+type CommentRepository interface {
+	// Create stores a new comment
+	Create(ctx context.Context, c *comment.Comment) error
+
+	// GetByID retrieves a comment by ID (excludes soft-deleted)
+	GetByID(ctx context.Context, id string) (*comment.Comment, error)
+
+	// Delete performs a soft delete on a comment
+	Delete(ctx context.Context, id string) error
+
+	// ListByOrder retrieves the active comments of an order, oldest first
+	ListByOrder(ctx context.Context, orderID string) ([]*comment.Comment, error)
+}
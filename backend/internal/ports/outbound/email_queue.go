@@ -0,0 +1,25 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+)
+
+// EmailQueue defines the interface for the queue of notification emails
+type EmailQueue interface {
+	// Enqueue stores new emails; an email whose ID is already queued is
+	// skipped, so handling an event again queues none twice
+	Enqueue(ctx context.Context, emails ...*notification.Email) error
+
+	// Claim returns up to limit emails due at now, oldest first, and holds
+	// them back from other claims for the lease duration
+	Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*notification.Email, error)
+
+	// Update stores the outcome of a send attempt
+	Update(ctx context.Context, e *notification.Email) error
+
+	// Purge deletes the emails sent or dead before the given time and returns how many
+	Purge(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,15 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/event"
+)
+
+// EventPublisher defines the interface for publishing domain events.
+// Failures of their subscribers are handled by the publisher, not returned:
+// an error means the events could not be accepted for delivery.
+type EventPublisher interface {
+	// Publish delivers events, in order, to their subscribers
+	Publish(ctx context.Context, events ...event.Event) error
+}
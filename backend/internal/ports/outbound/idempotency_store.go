@@ -0,0 +1,21 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/idempotency"
+)
+
+// IdempotencyStore defines the interface for storing requests made with an
+// Idempotency-Key. Keys are unique per scope; expired records are ignored.
+type IdempotencyStore interface {
+	// Reserve stores rec as in progress. When an unexpired record already
+	// exists for its scope and key, nothing is stored and that record is returned.
+	Reserve(ctx context.Context, rec *idempotency.Record) (*idempotency.Record, error)
+
+	// Complete stores the response of a reserved record
+	Complete(ctx context.Context, rec *idempotency.Record) error
+
+	// Release deletes a reserved record so the request can be retried
+	Release(ctx context.Context, scope, key string) error
+}
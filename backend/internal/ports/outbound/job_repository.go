@@ -0,0 +1,39 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/job"
+)
+
+// JobRunRepository defines the interface for the run history of scheduled
+// jobs. Only the last job.HistoryLimit runs of each job need to be kept.
+type JobRunRepository interface {
+	// Create stores a new run
+	Create(ctx context.Context, r *job.Run) error
+
+	// Update stores the outcome of a run
+	Update(ctx context.Context, r *job.Run) error
+
+	// ListByJob retrieves up to limit runs of a job, most recent first
+	ListByJob(ctx context.Context, name string, limit int) ([]*job.Run, error)
+}
+
+// JobLocker grants the exclusive right to run a job, so that a job runs on a
+// single scheduler instance at a time. Locks expire after their ttl in case
+// their owner stops without releasing them.
+type JobLocker interface {
+	// TryLock acquires the lock of a job for owner, reporting false when
+	// another owner holds it
+	TryLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error)
+
+	// Unlock releases the lock of a job held by owner
+	Unlock(ctx context.Context, name, owner string) error
+}
+
+// DeletedPurger permanently removes soft-deleted records
+type DeletedPurger interface {
+	// PurgeDeleted removes the records deleted before the given time and returns how many
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/laboratory"
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 )
 
 // LaboratoryRepository defines the interface for laboratory persistence operations
@@ -23,7 +24,7 @@ type LaboratoryRepository interface {
 	// Delete performs a soft delete on a laboratory
 	Delete(ctx context.Context, id string) error
 
-	// List retrieves all active (non-deleted) laboratories
-	List(ctx context.Context) ([]*laboratory.Laboratory, error)
+	// List retrieves a page of active (non-deleted) laboratories
+	List(ctx context.Context, q listing.Query) (listing.Page[*laboratory.Laboratory], error)
 }
 
@@ -0,0 +1,22 @@
+package outbound
+
+import (
+	"context"
+	"net/mail"
+)
+
+// Email is a message with a plain text and an HTML alternative
+type Email struct {
+	From    mail.Address
+	ReplyTo *mail.Address // Nil to receive replies at From
+	To      mail.Address
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer defines the interface for sending emails
+type Mailer interface {
+	// Send sends the email, returning an error unless the server accepted it
+	Send(ctx context.Context, email Email) error
+}
@@ -0,0 +1,16 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/notification"
+)
+
+// NotificationSettingsRepository defines the interface for notification settings persistence operations
+type NotificationSettingsRepository interface {
+	// Get retrieves the settings of a laboratory, ErrNotFound when never saved
+	Get(ctx context.Context, laboratoryID string) (*notification.Settings, error)
+
+	// Save creates or replaces the settings of a laboratory
+	Save(ctx context.Context, s *notification.Settings) error
+}
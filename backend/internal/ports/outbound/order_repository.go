@@ -3,6 +3,7 @@ package outbound
 import (
 	"context"
 
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/order"
 )
 
@@ -20,12 +21,21 @@ type OrderRepository interface {
 	// UpdateStatus updates only the order status
 	UpdateStatus(ctx context.Context, id string, status order.Status) error
 
+	// AddAttachment appends an attachment to an order, leaving its other fields as stored
+	AddAttachment(ctx context.Context, id string, a order.Attachment) error
+
 	// Delete performs a soft delete on an order
 	Delete(ctx context.Context, id string) error
 
-	// List retrieves all active (non-deleted) orders for a laboratory
-	List(ctx context.Context, laboratoryID string) ([]*order.Order, error)
+	// Search retrieves a page of active (non-deleted) orders of a laboratory matching the criteria
+	Search(ctx context.Context, laboratoryID string, criteria order.SearchCriteria, q listing.Query) (listing.Page[*order.Order], error)
 
 	// ListByClientID retrieves all active orders for a specific client
 	ListByClientID(ctx context.Context, clientID string) ([]*order.Order, error)
 }
+
+// OrderStatsReader summarizes the orders of every laboratory, for monitoring
+type OrderStatsReader interface {
+	// Stats returns the stats of the active orders of each laboratory having some
+	Stats(ctx context.Context) ([]*order.Stats, error)
+}
@@ -0,0 +1,34 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/outbox"
+)
+
+// OutboxStore defines the interface for the outbox of domain events. Messages
+// appended within a transaction (see Transactor) are stored only if it commits.
+type OutboxStore interface {
+	// Append stores new messages
+	Append(ctx context.Context, msgs ...*outbox.Message) error
+
+	// Claim returns up to limit messages due at now, oldest first, and holds
+	// them back from other claims for the lease duration
+	Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*outbox.Message, error)
+
+	// Update stores the outcome of a delivery attempt
+	Update(ctx context.Context, m *outbox.Message) error
+
+	// Purge deletes the messages delivered or dead before the given time and returns how many
+	Purge(ctx context.Context, before time.Time) (int, error)
+}
+
+// Transactor runs a unit of work in a single transaction, so that the entity
+// changes and the outbox messages it writes are stored together or not at all.
+// Repositories join the transaction through the context passed to fn.
+type Transactor interface {
+	// WithinTransaction runs fn in a transaction, committed if fn returns nil.
+	// Within an existing transaction, fn joins it.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
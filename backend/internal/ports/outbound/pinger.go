@@ -0,0 +1,9 @@
+package outbound
+
+import "context"
+
+// Pinger is implemented by the stores able to report whether they answer,
+// for the readiness probe
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
@@ -3,6 +3,7 @@ package outbound
 import (
 	"context"
 
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/prosthesis"
 )
 
@@ -14,14 +15,18 @@ type ProsthesisRepository interface {
 	// GetByID retrieves a prosthesis by ID (excludes soft-deleted)
 	GetByID(ctx context.Context, id string) (*prosthesis.Prosthesis, error)
 
+	// GetByIDs retrieves the prostheses with the given IDs in one lookup (excludes
+	// soft-deleted); IDs without a match are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*prosthesis.Prosthesis, error)
+
 	// Update updates an existing prosthesis
 	Update(ctx context.Context, p *prosthesis.Prosthesis) error
 
 	// Delete performs a soft delete on a prosthesis
 	Delete(ctx context.Context, id string) error
 
-	// List retrieves all active (non-deleted) prostheses for a laboratory
-	List(ctx context.Context, laboratoryID string) ([]*prosthesis.Prosthesis, error)
+	// List retrieves a page of active (non-deleted) prostheses for a laboratory
+	List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*prosthesis.Prosthesis], error)
 
 	// FindByType retrieves prostheses filtered by type for a laboratory
 	FindByType(ctx context.Context, laboratoryID string, prosthesisType prosthesis.ProsthesisType) ([]*prosthesis.Prosthesis, error)
@@ -3,6 +3,7 @@ package outbound
 import (
 	"context"
 
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/listing"
 	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/technician"
 )
 
@@ -14,6 +15,10 @@ type TechnicianRepository interface {
 	// GetByID retrieves a technician by ID (excludes soft-deleted)
 	GetByID(ctx context.Context, id string) (*technician.Technician, error)
 
+	// GetByIDs retrieves the technicians with the given IDs in one lookup (excludes
+	// soft-deleted); IDs without a match are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*technician.Technician, error)
+
 	// GetByEmail retrieves a technician by email within a laboratory (excludes soft-deleted)
 	GetByEmail(ctx context.Context, laboratoryID, email string) (*technician.Technician, error)
 
@@ -23,8 +28,11 @@ type TechnicianRepository interface {
 	// Delete performs a soft delete on a technician
 	Delete(ctx context.Context, id string) error
 
-	// List retrieves all active (non-deleted) technicians for a laboratory
-	List(ctx context.Context, laboratoryID string) ([]*technician.Technician, error)
+	// Restore reverts the soft delete of a technician
+	Restore(ctx context.Context, id string) error
+
+	// List retrieves a page of active (non-deleted) technicians for a laboratory
+	List(ctx context.Context, laboratoryID string, q listing.Query) (listing.Page[*technician.Technician], error)
 
 	// ListByRole retrieves all active technicians for a laboratory filtered by role
 	ListByRole(ctx context.Context, laboratoryID string, role technician.Role) ([]*technician.Technician, error)
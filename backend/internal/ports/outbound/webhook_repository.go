@@ -0,0 +1,45 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+// WebhookSubscriptionRepository defines the interface for webhook subscription persistence operations
+type WebhookSubscriptionRepository interface {
+	// Create stores a new subscription
+	Create(ctx context.Context, s *webhook.Subscription) error
+
+	// GetByID retrieves a subscription by ID (excludes soft-deleted)
+	GetByID(ctx context.Context, id string) (*webhook.Subscription, error)
+
+	// Update updates an existing subscription
+	Update(ctx context.Context, s *webhook.Subscription) error
+
+	// Delete performs a soft delete on a subscription
+	Delete(ctx context.Context, id string) error
+
+	// ListByLaboratory retrieves the active subscriptions of a laboratory, oldest first
+	ListByLaboratory(ctx context.Context, laboratoryID string) ([]*webhook.Subscription, error)
+}
+
+// WebhookDeliveryRepository defines the interface for webhook delivery persistence operations
+type WebhookDeliveryRepository interface {
+	// Create stores new deliveries
+	Create(ctx context.Context, deliveries ...*webhook.Delivery) error
+
+	// GetByID retrieves a delivery by ID
+	GetByID(ctx context.Context, id string) (*webhook.Delivery, error)
+
+	// Update stores the outcome of a delivery attempt
+	Update(ctx context.Context, d *webhook.Delivery) error
+
+	// List retrieves the deliveries matching the filter, most recent first
+	List(ctx context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, error)
+
+	// Claim returns up to limit deliveries due at now, oldest first, and holds
+	// them back from other claims for the lease duration
+	Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*webhook.Delivery, error)
+}
@@ -0,0 +1,25 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/internal/domain/webhook"
+)
+
+// WebhookRequest is a signed payload to post to the URL of a subscription
+type WebhookRequest struct {
+	URL        string
+	DeliveryID string
+	EventType  webhook.EventType
+	Timestamp  time.Time // Signed with the payload
+	Signature  string
+	Payload    []byte
+}
+
+// WebhookSender defines the interface for posting webhook payloads
+type WebhookSender interface {
+	// Send posts the request and returns the status code of the response, zero
+	// if none was received. An error is returned unless the status is 2xx.
+	Send(ctx context.Context, req WebhookRequest) (int, error)
+}
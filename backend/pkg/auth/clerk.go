@@ -2,7 +2,9 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 	"sync"
@@ -13,12 +15,21 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// ErrUnauthorized is added to the Gin context of a request that isn't
+// authenticated, for the HTTP adapter to render
+var ErrUnauthorized = errors.New("unauthorized")
+
 // ContextKey is the type for context keys
 type ContextKey string
 
 const (
 	// UserIDKey is the context key for user ID
 	UserIDKey ContextKey = "user_id"
+
+	// WebSocketBearerProtocol is the WebSocket subprotocol announcing a token.
+	// Browsers can't set headers on WebSocket handshakes, so they send the
+	// "bearer" and "<token>" subprotocols instead of the Authorization header.
+	WebSocketBearerProtocol = "bearer"
 )
 
 // ClerkConfig holds Clerk configuration
@@ -68,37 +79,57 @@ func (m *ClerkMiddleware) Authenticate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "missing authorization header",
-			})
+			authHeader = webSocketAuthorization(c.Request)
+		}
+		if authHeader == "" {
+			unauthorized(c, "missing authorization header")
 			return
 		}
 
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "invalid authorization header format",
-			})
+			unauthorized(c, "invalid authorization header format")
 			return
 		}
 
 		token := parts[1]
 		claims, err := m.validateToken(c.Request.Context(), token)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": fmt.Sprintf("invalid token: %v", err),
-			})
+			unauthorized(c, fmt.Sprintf("invalid token: %v", err))
 			return
 		}
 
 		// Set user ID in context
-		ctx := context.WithValue(c.Request.Context(), UserIDKey, claims.Sub)
-		c.Request = c.Request.WithContext(ctx)
+		c.Request = c.Request.WithContext(WithUserID(c.Request.Context(), claims.Sub))
 
 		c.Next()
 	}
 }
 
+// unauthorized aborts the request with an unauthorized problem, rendered by
+// the Problems middleware. The reason is only logged, for tokens not to be
+// probed through the responses.
+func unauthorized(c *gin.Context, reason string) {
+	slog.DebugContext(c.Request.Context(), "auth: request not authenticated", "reason", reason)
+	_ = c.Error(ErrUnauthorized)
+	c.Abort()
+}
+
+// VerifyToken validates a JWT token and returns its claims, for transports
+// other than HTTP
+func (m *ClerkMiddleware) VerifyToken(ctx context.Context, token string) (*Claims, error) {
+	return m.validateToken(ctx, token)
+}
+
+// CheckJWKS fetches the JSON Web Key Set from Clerk, reporting an error when
+// it can't be reached. Keys of tokens not seen yet are fetched from there.
+func (m *ClerkMiddleware) CheckJWKS(ctx context.Context) error {
+	if _, err := m.jwksClient.Get(ctx, &jwks.GetParams{}); err != nil {
+		return fmt.Errorf("failed to fetch the JWKS: %w", err)
+	}
+	return nil
+}
+
 // validateToken validates a JWT token using Clerk SDK
 func (m *ClerkMiddleware) validateToken(ctx context.Context, tokenString string) (*Claims, error) {
 	// Try to get cached JWK
@@ -167,6 +198,19 @@ func (m *ClerkMiddleware) setJWK(jwk *clerk.JSONWebKey) {
 	m.jwkStore.jwk = jwk
 }
 
+// webSocketAuthorization returns the token of the bearer subprotocols of a
+// WebSocket handshake as an Authorization header value, or an empty string
+func webSocketAuthorization(r *http.Request) string {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return ""
+	}
+	protocols := strings.Split(r.Header.Get("Sec-WebSocket-Protocol"), ",")
+	if len(protocols) != 2 || strings.TrimSpace(protocols[0]) != WebSocketBearerProtocol {
+		return ""
+	}
+	return "Bearer " + strings.TrimSpace(protocols[1])
+}
+
 // GetUserID extracts user ID from context
 func GetUserID(ctx context.Context) string {
 	if v := ctx.Value(UserIDKey); v != nil {
@@ -175,6 +219,11 @@ func GetUserID(ctx context.Context) string {
 	return ""
 }
 
+// WithUserID returns a copy of ctx carrying the given user ID
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, UserIDKey, userID)
+}
+
 // GetLaboratoryID returns empty string (laboratory_id is no longer extracted from JWT)
 // Handlers should extract laboratory_id from query parameters instead
 func GetLaboratoryID(ctx context.Context) string {
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestClerkMiddleware_Authenticate_Unauthorized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	m := NewClerkMiddleware(ClerkConfig{SecretKey: "sk_test_123"})
+	r := gin.New()
+	// Stands in for the problem details middleware of the HTTP adapter
+	r.Use(func(c *gin.Context) {
+		c.Next()
+		if err := c.Errors.Last(); err != nil && errors.Is(err.Err, ErrUnauthorized) {
+			c.Status(http.StatusUnauthorized)
+		}
+	})
+	r.GET("/orders", m.Authenticate(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name          string
+		authorization string
+	}{
+		{"missing header", ""},
+		{"not a bearer token", "Basic dXNlcjpwYXNz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != http.StatusUnauthorized || w.Body.Len() != 0 {
+				t.Errorf("Authenticate() = %v %q, want %v left to the problem details", w.Code, w.Body.String(), http.StatusUnauthorized)
+			}
+		})
+	}
+}
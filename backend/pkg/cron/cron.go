@@ -0,0 +1,226 @@
+// Package cron parses cron expressions and computes their next activation
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// descriptors maps the predefined schedules to their expression
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// field describes the values allowed in one position of an expression
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Sunday is both 0 and 7
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// set is a bit set of the values of a field
+type set uint64
+
+func (s set) has(v int) bool {
+	return s&(1<<uint(v)) != 0
+}
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	spec   string
+	minute set
+	hour   set
+	dom    set
+	month  set
+	dow    set
+	// A day matches either day field when both are restricted, as in cron
+	domAny bool
+	dowAny bool
+}
+
+// Parse parses a standard five field cron expression (minute, hour, day of
+// month, month, day of week) or one of the descriptors @yearly, @annually,
+// @monthly, @weekly, @daily, @midnight and @hourly. Fields accept *, values,
+// ranges (1-5), steps (*/15, 0-30/10), lists (1,15) and, for months and days
+// of the week, three letter names (jan, mon).
+func Parse(spec string) (*Schedule, error) {
+	expr := strings.TrimSpace(spec)
+	if strings.HasPrefix(expr, "@") {
+		d, ok := descriptors[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("cron: unknown descriptor %q", expr)
+		}
+		expr = d
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("cron: expected 5 fields in %q, got %d", spec, len(parts))
+	}
+
+	s := &Schedule{spec: strings.TrimSpace(spec)}
+	var err error
+	if s.minute, err = parseField(parts[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(parts[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseField(parts[2], domField); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(parts[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseField(parts[4], dowField); err != nil {
+		return nil, err
+	}
+	if s.dow.has(7) {
+		s.dow |= 1 // Sunday
+	}
+	s.domAny = strings.HasPrefix(parts[2], "*")
+	s.dowAny = strings.HasPrefix(parts[4], "*")
+	return s, nil
+}
+
+// MustParse is like Parse but panics on invalid expressions
+func MustParse(spec string) *Schedule {
+	s, err := Parse(spec)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// String returns the expression the schedule was parsed from
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the first activation strictly after t, in t's location, or
+// the zero time when the schedule never activates (e.g. 30 February)
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+
+	// Every schedule that activates at all does so within a leap cycle
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !s.month.has(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !s.hour.has(t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !s.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of t matches the day fields
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom.has(t.Day())
+	dow := s.dow.has(int(t.Weekday()))
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dow
+	case s.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// parseField parses a comma-separated list of ranges of a field
+func parseField(expr string, f field) (set, error) {
+	var s set
+	for _, part := range strings.Split(expr, ",") {
+		r, err := parseRange(part, f)
+		if err != nil {
+			return 0, err
+		}
+		s |= r
+	}
+	return s, nil
+}
+
+// parseRange parses *, a value or a range of a field, with an optional step
+func parseRange(expr string, f field) (set, error) {
+	rng, stepExpr, hasStep := strings.Cut(expr, "/")
+
+	lo, hi := f.min, f.max
+	if rng != "*" {
+		first, last, isRange := strings.Cut(rng, "-")
+		var err error
+		if lo, err = parseValue(first, f); err != nil {
+			return 0, err
+		}
+		hi = lo
+		if isRange {
+			if hi, err = parseValue(last, f); err != nil {
+				return 0, err
+			}
+		} else if hasStep {
+			hi = f.max // 5/15 runs from 5 to the end of the field
+		}
+	}
+	if lo > hi {
+		return 0, fmt.Errorf("cron: invalid %s range %q", f.name, expr)
+	}
+
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepExpr)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("cron: invalid %s step %q", f.name, expr)
+		}
+		step = n
+	}
+
+	var s set
+	for v := lo; v <= hi; v += step {
+		s |= 1 << uint(v)
+	}
+	return s, nil
+}
+
+// parseValue parses a number or name of a field
+func parseValue(expr string, f field) (int, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("cron: invalid %s %q", f.name, expr)
+	}
+	return v, nil
+}
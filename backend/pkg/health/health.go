@@ -0,0 +1,148 @@
+// Package health reports the liveness and readiness of the server. Readiness
+// aggregates checks of the dependencies the server needs to serve requests,
+// such as its stores, the identity provider and the background workers.
+package health
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Statuses of the server and of its checks
+const (
+	StatusUp       = "up"
+	StatusDown     = "down"
+	StatusDraining = "draining"
+)
+
+// Check reports an error when a dependency isn't ready
+type Check func(ctx context.Context) error
+
+// Result is the outcome of a check
+type Result struct {
+	Name   string
+	Status string
+	Error  string
+}
+
+// Report is the readiness of the server and the results of its checks, sorted
+// by name
+type Report struct {
+	Status string
+	Checks []Result
+}
+
+// Ready reports whether the server can serve requests
+func (r Report) Ready() bool {
+	return r.Status == StatusUp
+}
+
+// Registry holds the readiness checks of the server
+type Registry struct {
+	timeout  time.Duration
+	draining atomic.Bool
+
+	mu     sync.RWMutex
+	checks map[string]Check
+}
+
+// NewRegistry creates a registry failing the checks that take longer than
+// timeout
+func NewRegistry(timeout time.Duration) *Registry {
+	return &Registry{timeout: timeout, checks: make(map[string]Check)}
+}
+
+// Register adds a readiness check, replacing the one of the same name
+func (r *Registry) Register(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = check
+}
+
+// Drain makes the server unready for good, so that load balancers stop
+// sending it requests while it shuts down
+func (r *Registry) Drain() {
+	r.draining.Store(true)
+}
+
+// Check runs every check concurrently. The server is ready when all of them
+// pass and it isn't draining.
+func (r *Registry) Check(ctx context.Context) Report {
+	if r.draining.Load() {
+		return Report{Status: StatusDraining}
+	}
+
+	r.mu.RLock()
+	names := make([]string, 0, len(r.checks))
+	for name := range r.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	checks := make([]Check, len(names))
+	for i, name := range names {
+		checks[i] = r.checks[name]
+	}
+	r.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	report := Report{Status: StatusUp, Checks: make([]Result, len(names))}
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			report.Checks[i] = run(ctx, names[i], checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+// run runs a check until ctx is done
+func run(ctx context.Context, name string, check Check) Result {
+	done := make(chan error, 1)
+	go func() { done <- check(ctx) }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = errors.New("timed out")
+	}
+	if err != nil {
+		return Result{Name: name, Status: StatusDown, Error: err.Error()}
+	}
+	return Result{Name: name, Status: StatusUp}
+}
+
+// Cached returns a check running check at most once per ttl and answering
+// with its last outcome in between, for dependencies that must not be called
+// on every probe
+func Cached(check Check, ttl time.Duration) Check {
+	var (
+		mu      sync.Mutex
+		checked time.Time
+		last    error
+	)
+	return func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if checked.IsZero() || time.Since(checked) >= ttl {
+			last = check(ctx)
+			checked = time.Now()
+		}
+		return last
+	}
+}
@@ -0,0 +1,97 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRegistry_Check(t *testing.T) {
+	registry := NewRegistry(50 * time.Millisecond)
+	registry.Register("store", func(ctx context.Context) error { return nil })
+	registry.Register("jwks", func(ctx context.Context) error { return errors.New("connection refused") })
+	hang := make(chan struct{})
+	defer close(hang)
+	registry.Register("slow", func(ctx context.Context) error {
+		<-hang
+		return nil
+	})
+
+	report := registry.Check(context.Background())
+	if report.Ready() || report.Status != StatusDown {
+		t.Fatalf("Check() status = %s, want %s", report.Status, StatusDown)
+	}
+	want := []Result{
+		{Name: "jwks", Status: StatusDown, Error: "connection refused"},
+		{Name: "slow", Status: StatusDown, Error: "timed out"},
+		{Name: "store", Status: StatusUp},
+	}
+	if len(report.Checks) != len(want) {
+		t.Fatalf("Check() results = %+v, want %+v", report.Checks, want)
+	}
+	for i, result := range want {
+		if got := report.Checks[i]; got != result {
+			t.Errorf("result %d = %+v, want %+v", i, got, result)
+		}
+	}
+
+	registry.Register("jwks", func(ctx context.Context) error { return nil })
+	registry.Register("slow", func(ctx context.Context) error { return nil })
+	if report := registry.Check(context.Background()); !report.Ready() {
+		t.Errorf("Check() = %+v, want ready once every check passes", report)
+	}
+
+	registry.Drain()
+	if report := registry.Check(context.Background()); report.Ready() || report.Status != StatusDraining || len(report.Checks) != 0 {
+		t.Errorf("Check() while draining = %+v, want %s without running the checks", report, StatusDraining)
+	}
+}
+
+func TestCached(t *testing.T) {
+	calls := 0
+	check := Cached(func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return errors.New("unreachable")
+		}
+		return nil
+	}, 20*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		if err := check(context.Background()); err == nil {
+			t.Fatalf("call %d error = nil, want the cached error", i)
+		}
+	}
+	time.Sleep(30 * time.Millisecond)
+	if err := check(context.Background()); err != nil {
+		t.Errorf("error after the ttl = %v, want nil", err)
+	}
+	if calls != 2 {
+		t.Errorf("check ran %d times, want 2", calls)
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	var h Heartbeat
+	if err := h.Err(0); err == nil {
+		t.Error("Err() before the first beat = nil, want an error")
+	}
+
+	h.Beat()
+	if err := h.Err(time.Minute); err != nil {
+		t.Errorf("Err() after a beat = %v, want nil", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if err := h.Err(time.Millisecond); err == nil {
+		t.Error("Err() of a stale beat = nil, want an error")
+	}
+	if err := h.Err(0); err != nil {
+		t.Errorf("Err() without max age = %v, want nil", err)
+	}
+
+	h.Stop()
+	if err := h.Err(0); err == nil {
+		t.Error("Err() after Stop() = nil, want an error")
+	}
+}
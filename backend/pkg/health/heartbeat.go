@@ -0,0 +1,41 @@
+package health
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Heartbeat tracks a background loop, which beats while it runs. The zero
+// value is a loop that hasn't started.
+type Heartbeat struct {
+	last    atomic.Int64 // Unix nanoseconds of the last beat
+	stopped atomic.Bool
+}
+
+// Beat records that the loop is running
+func (h *Heartbeat) Beat() {
+	h.last.Store(time.Now().UnixNano())
+}
+
+// Stop records that the loop has returned
+func (h *Heartbeat) Stop() {
+	h.stopped.Store(true)
+}
+
+// Err reports why the loop isn't healthy: it hasn't started, it has stopped
+// or, with a positive maxAge, it last beat longer than maxAge ago
+func (h *Heartbeat) Err(maxAge time.Duration) error {
+	last := h.last.Load()
+	switch {
+	case h.stopped.Load():
+		return errors.New("stopped")
+	case last == 0:
+		return errors.New("not started")
+	}
+	if age := time.Since(time.Unix(0, last)); maxAge > 0 && age > maxAge {
+		return fmt.Errorf("last ran %s ago", age.Round(time.Second))
+	}
+	return nil
+}
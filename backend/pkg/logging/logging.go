@@ -0,0 +1,125 @@
+// Package logging sets up structured logging with log/slog. Records logged
+// with a context carry the correlation attributes of the request it belongs
+// to: the request ID, the authenticated user, the laboratory and the trace.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+)
+
+// Formats of the log output
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Attribute keys of the correlation attributes
+const (
+	RequestIDKey    = "request_id"
+	UserIDKey       = "user_id"
+	LaboratoryIDKey = "laboratory_id"
+	TraceIDKey      = "trace_id"
+	SpanIDKey       = "span_id"
+)
+
+// Config holds the logging settings
+type Config struct {
+	Level  string // debug, info, warn or error
+	Format string // json or text
+}
+
+// New creates a logger writing records of at least the configured level to w
+// in the configured format, with the correlation attributes of their context
+func New(cfg Config, w io.Writer) (*slog.Logger, error) {
+	level, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	opts := &slog.HandlerOptions{Level: level}
+
+	var h slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case FormatJSON, "":
+		h = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("logging: unknown format %q", cfg.Format)
+	}
+	return slog.New(NewContextHandler(h)), nil
+}
+
+// ParseLevel parses a level name, info when empty
+func ParseLevel(name string) (slog.Level, error) {
+	if name == "" {
+		return slog.LevelInfo, nil
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("logging: unknown level %q", name)
+	}
+	return level, nil
+}
+
+// ContextHandler adds the correlation attributes of the context to the
+// records of the handler it wraps
+type ContextHandler struct {
+	slog.Handler
+}
+
+// NewContextHandler wraps h to add the correlation attributes
+func NewContextHandler(h slog.Handler) *ContextHandler {
+	return &ContextHandler{Handler: h}
+}
+
+// Handle adds the request, user, laboratory and trace IDs found in ctx to r
+func (h *ContextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		r.AddAttrs(slog.String(RequestIDKey, id))
+	}
+	if id := auth.GetUserID(ctx); id != "" {
+		r.AddAttrs(slog.String(UserIDKey, id))
+	}
+	if id := LaboratoryID(ctx); id != "" {
+		r.AddAttrs(slog.String(LaboratoryIDKey, id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String(TraceIDKey, sc.TraceID().String()), slog.String(SpanIDKey, sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler adding attrs, keeping the correlation attributes
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup returns a handler nesting the attributes in a group, keeping the
+// correlation attributes
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+type laboratoryIDKey struct{}
+
+// WithLaboratoryID returns a copy of ctx carrying the laboratory a request is for
+func WithLaboratoryID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, laboratoryIDKey{}, id)
+}
+
+// LaboratoryID extracts the laboratory ID from context
+func LaboratoryID(ctx context.Context) string {
+	if v, ok := ctx.Value(laboratoryIDKey{}).(string); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/requestid"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"defaults", Config{}, false},
+		{"json", Config{Level: "debug", Format: "json"}, false},
+		{"text", Config{Level: "WARN", Format: "text"}, false},
+		{"unknown level", Config{Level: "verbose"}, true},
+		{"unknown format", Config{Format: "xml"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg, &bytes.Buffer{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// decode returns the JSON records of buf
+func decode(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid record %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestContextHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger, _ := New(Config{Level: "info", Format: "json"}, &buf)
+
+	ctx := requestid.WithRequestID(context.Background(), "req-123")
+	ctx = auth.WithUserID(ctx, "user-123")
+	ctx = WithLaboratoryID(ctx, "lab-123")
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:  trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	}))
+
+	logger.With("component", "orders").InfoContext(ctx, "order created", "order_id", "order-123")
+	logger.InfoContext(context.Background(), "started")
+	logger.DebugContext(ctx, "below the level")
+
+	records := decode(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("logged %d records, want 2", len(records))
+	}
+	want := map[string]any{
+		"msg":           "order created",
+		"component":     "orders",
+		"order_id":      "order-123",
+		RequestIDKey:    "req-123",
+		UserIDKey:       "user-123",
+		LaboratoryIDKey: "lab-123",
+		TraceIDKey:      "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanIDKey:       "00f067aa0ba902b7",
+	}
+	for key, value := range want {
+		if records[0][key] != value {
+			t.Errorf("record[%s] = %v, want %v", key, records[0][key], value)
+		}
+	}
+	for _, key := range []string{RequestIDKey, UserIDKey, LaboratoryIDKey, TraceIDKey} {
+		if _, ok := records[1][key]; ok {
+			t.Errorf("record without context has %s", key)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var buf bytes.Buffer
+	logger, _ := New(Config{Level: "info", Format: "json"}, &buf)
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(logger)
+
+	r := gin.New()
+	r.Use(requestid.Middleware())
+	r.Use(Middleware())
+	authenticated := r.Group("", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithUserID(c.Request.Context(), "user-123"))
+		c.Next()
+	})
+	authenticated.GET("/orders/:id", func(c *gin.Context) {
+		slog.InfoContext(c.Request.Context(), "loading order")
+		c.Status(http.StatusNotFound)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/orders/order-123?laboratory_id=lab-123", nil)
+	req.Header.Set(requestid.Header, "req-123")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	records := decode(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("logged %d records, want the handler's and the request's", len(records))
+	}
+	for _, record := range records {
+		if record[RequestIDKey] != "req-123" || record[UserIDKey] != "user-123" || record[LaboratoryIDKey] != "lab-123" {
+			t.Errorf("record = %v, want the correlation attributes", record)
+		}
+	}
+
+	request := records[1]
+	if request["msg"] != "request served" || request["level"] != "WARN" || request["route"] != "/orders/:id" || request["status"] != float64(http.StatusNotFound) {
+		t.Errorf("request record = %v, want a warning for the not found route", request)
+	}
+}
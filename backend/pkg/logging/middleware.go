@@ -0,0 +1,45 @@
+package logging
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware stores the laboratory of the laboratory_id query parameter in the
+// request context and logs every request once served, with the correlation
+// attributes set by the inner middlewares, such as the authenticated user.
+// Server errors are logged at error level and client errors at warn level.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if id := c.Query("laboratory_id"); id != "" {
+			c.Request = c.Request.WithContext(WithLaboratoryID(c.Request.Context(), id))
+		}
+
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		slog.Log(c.Request.Context(), level, "request served",
+			"method", c.Request.Method,
+			"route", route,
+			"path", c.Request.URL.Path,
+			"status", status,
+			"duration_ms", time.Since(start).Milliseconds(),
+			"client_ip", c.ClientIP(),
+		)
+	}
+}
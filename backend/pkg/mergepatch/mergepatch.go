@@ -0,0 +1,47 @@
+// Package mergepatch implements JSON Merge Patch (RFC 7396)
+package mergepatch
+
+import (
+	"encoding/json"
+)
+
+// ContentType is the media type of merge patch documents
+const ContentType = "application/merge-patch+json"
+
+// Apply applies patch to the JSON document target and returns the result.
+// Members of a patch object replace those of the target, recursively for
+// nested objects; null members remove them. A patch that is not an object
+// replaces the target entirely.
+func Apply(target, patch []byte) ([]byte, error) {
+	var t, p any
+	if len(target) > 0 {
+		if err := json.Unmarshal(target, &t); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, err
+	}
+	return json.Marshal(merge(t, p))
+}
+
+// merge is the MergePatch function of RFC 7396, section 2
+func merge(target, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]any)
+	if !ok {
+		targetObj = make(map[string]any, len(patchObj))
+	}
+	for name, value := range patchObj {
+		if value == nil {
+			delete(targetObj, name)
+			continue
+		}
+		targetObj[name] = merge(targetObj[name], value)
+	}
+	return targetObj
+}
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often idle buckets are evicted from the memory store
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens   float64
+	last     time.Time
+	capacity float64
+	rate     float64
+}
+
+// full reports whether the bucket has refilled completely at the given time
+func (b *bucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.capacity
+}
+
+// MemoryStore is an in-memory, per-process token bucket store
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore creates a new in-memory token bucket store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Take refills the buckets of keys and takes a token from each of them if
+// every one has a token available
+func (s *MemoryStore) Take(ctx context.Context, keys []string, rule Rule) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	capacity := rule.capacity()
+	rate := rule.ratePerSecond()
+
+	s.sweep(now)
+
+	buckets := make([]*bucket, len(keys))
+	allowed := true
+	for i, key := range keys {
+		b, ok := s.buckets[key]
+		if !ok {
+			b = &bucket{tokens: capacity, last: now}
+			s.buckets[key] = b
+		}
+		b.capacity, b.rate = capacity, rate
+
+		// Refill for the time elapsed since the last request
+		b.tokens = math.Min(capacity, b.tokens+now.Sub(b.last).Seconds()*rate)
+		b.last = now
+
+		buckets[i] = b
+		allowed = allowed && b.tokens >= 1
+	}
+
+	var result *Result
+	for _, b := range buckets {
+		r := Result{Limit: int(capacity), Allowed: allowed}
+		if allowed {
+			b.tokens--
+		} else if b.tokens < 1 {
+			r.RetryAfter = duration((1 - b.tokens) / rate)
+		}
+		r.Remaining = int(b.tokens)
+		r.Reset = duration((capacity - b.tokens) / rate)
+		if result == nil || moreRestrictive(r, *result) {
+			result = &r
+		}
+	}
+	if result == nil {
+		return Result{Limit: int(capacity), Remaining: int(capacity), Allowed: true}, nil
+	}
+	return *result, nil
+}
+
+// sweep evicts buckets that have refilled completely, as they are
+// indistinguishable from new ones
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if b.full(now) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func duration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
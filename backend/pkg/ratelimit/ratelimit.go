@@ -0,0 +1,197 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+// ErrRateLimited is added to the Gin context of a throttled request, for the
+// HTTP adapter to render
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// Rule defines a token bucket: it refills at RequestsPerMinute and holds at most Burst tokens
+type Rule struct {
+	RequestsPerMinute int
+	Burst             int
+}
+
+// capacity returns the bucket size, defaulting to one minute worth of requests
+func (r Rule) capacity() float64 {
+	if r.Burst > 0 {
+		return float64(r.Burst)
+	}
+	return float64(r.RequestsPerMinute)
+}
+
+// ratePerSecond returns the refill rate of the bucket
+func (r Rule) ratePerSecond() float64 {
+	return float64(r.RequestsPerMinute) / 60
+}
+
+// enabled returns false for rules that don't limit anything
+func (r Rule) enabled() bool {
+	return r.RequestsPerMinute > 0
+}
+
+// Result is the outcome of taking a token from a bucket
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // Time until a token is available, zero when allowed
+	Reset      time.Duration // Time until the bucket is full again
+}
+
+// RetryAfterSeconds returns RetryAfter rounded up to whole seconds
+func (r Result) RetryAfterSeconds() int {
+	return seconds(r.RetryAfter)
+}
+
+// Store keeps token buckets. The in-memory store is per process; a shared
+// store (e.g. Redis) is needed to enforce limits across several instances.
+type Store interface {
+	// Take takes a token from the bucket of every key, or from none of them
+	// when one is empty, and returns the result of the most restrictive bucket
+	Take(ctx context.Context, keys []string, rule Rule) (Result, error)
+}
+
+// Membership tells whether a user belongs to a laboratory
+type Membership interface {
+	IsMember(ctx context.Context, userID, laboratoryID string) (bool, error)
+}
+
+// Rules holds the default rule and per route group overrides
+type Rules struct {
+	Default Rule
+	Groups  map[string]Rule
+}
+
+// forGroup returns the rule of a route group, falling back to the default
+func (r Rules) forGroup(group string) Rule {
+	if rule, ok := r.Groups[group]; ok {
+		return rule
+	}
+	return r.Default
+}
+
+// Limiter throttles requests per API identity and per laboratory
+type Limiter struct {
+	store   Store
+	rules   Rules
+	members Membership
+}
+
+// NewLimiter creates a new rate limiter. Laboratory buckets are only charged
+// for members of the laboratory, so they are not used when members is nil.
+func NewLimiter(store Store, rules Rules, members Membership) *Limiter {
+	return &Limiter{
+		store:   store,
+		rules:   rules,
+		members: members,
+	}
+}
+
+// Middleware returns a Gin middleware enforcing the rule of a route group.
+// It must run after authentication so the caller's identity is known.
+// Each request takes a token from the identity bucket and, when the caller
+// belongs to the laboratory_id it asks for, from the laboratory bucket. No
+// token is taken unless both buckets have one, so that rejected requests
+// don't drain the laboratory of other callers.
+func (l *Limiter) Middleware(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		result := l.Take(ctx, group, auth.GetUserID(ctx), c.ClientIP(), c.Query("laboratory_id"))
+		if result == nil {
+			c.Next()
+			return
+		}
+
+		setHeaders(c, *result)
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(result.RetryAfterSeconds()))
+			_ = c.Error(ErrRateLimited)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Take takes a token for a request of a route group, as Middleware does, for
+// the other APIs. The identity is userID, or clientIP for anonymous requests.
+// It returns nil when the group isn't limited or the store is unavailable,
+// and the request is then allowed.
+func (l *Limiter) Take(ctx context.Context, group, userID, clientIP, laboratoryID string) *Result {
+	rule := l.rules.forGroup(group)
+	if !rule.enabled() {
+		return nil
+	}
+
+	keys := []string{group + ":identity:" + identity(userID, clientIP)}
+	if l.isMember(ctx, userID, laboratoryID) {
+		keys = append(keys, group+":laboratory:"+laboratoryID)
+	}
+
+	result, err := l.store.Take(ctx, keys, rule)
+	if err != nil {
+		// Fail open: an unavailable store must not take the API down
+		slog.ErrorContext(ctx, "ratelimit: failed to take a token", "keys", keys, "error", err)
+		return nil
+	}
+	return &result
+}
+
+// isMember reports whether the authenticated caller belongs to the laboratory
+func (l *Limiter) isMember(ctx context.Context, userID, laboratoryID string) bool {
+	if l.members == nil || laboratoryID == "" || userID == "" {
+		return false
+	}
+
+	ok, err := l.members.IsMember(ctx, userID, laboratoryID)
+	if err != nil {
+		slog.ErrorContext(ctx, "ratelimit: failed to check the laboratory membership", "error", err)
+		return false
+	}
+	return ok
+}
+
+// identity returns the authenticated user, or the client IP for anonymous requests
+func identity(userID, clientIP string) string {
+	if userID != "" {
+		return "user:" + userID
+	}
+	return "ip:" + clientIP
+}
+
+// moreRestrictive reports whether a is more restrictive than b
+func moreRestrictive(a, b Result) bool {
+	switch {
+	case a.Allowed != b.Allowed:
+		return !a.Allowed
+	case !a.Allowed:
+		return a.RetryAfter > b.RetryAfter
+	default:
+		return a.Remaining < b.Remaining
+	}
+}
+
+// setHeaders writes the RateLimit-* headers of the IETF draft
+func setHeaders(c *gin.Context, r Result) {
+	c.Header("RateLimit-Limit", strconv.Itoa(r.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(r.Remaining))
+	c.Header("RateLimit-Reset", strconv.Itoa(seconds(r.Reset)))
+}
+
+// seconds rounds a duration up to whole seconds
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
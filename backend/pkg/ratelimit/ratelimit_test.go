@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+)
+
+func newTestStore(now *time.Time) *MemoryStore {
+	s := NewMemoryStore()
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newTestStore(&now)
+	rule := Rule{RequestsPerMinute: 60, Burst: 2}
+	ctx := context.Background()
+
+	for i, wantRemaining := range []int{1, 0} {
+		r, _ := store.Take(ctx, []string{"key"}, rule)
+		if !r.Allowed || r.Remaining != wantRemaining {
+			t.Fatalf("Take() #%d = allowed %v remaining %d, want allowed remaining %d", i+1, r.Allowed, r.Remaining, wantRemaining)
+		}
+	}
+
+	r, _ := store.Take(ctx, []string{"key"}, rule)
+	if r.Allowed {
+		t.Fatal("Take() over burst should be rejected")
+	}
+	if r.RetryAfter != time.Second {
+		t.Errorf("Take() RetryAfter = %v, want 1s", r.RetryAfter)
+	}
+
+	// Other keys have their own bucket
+	if r, _ := store.Take(ctx, []string{"other"}, rule); !r.Allowed {
+		t.Error("Take() on another key should be allowed")
+	}
+
+	// No token is taken from the other buckets when one of them is empty
+	if r, _ := store.Take(ctx, []string{"other", "key"}, rule); r.Allowed {
+		t.Fatal("Take() with an empty bucket should be rejected")
+	}
+	if r, _ := store.Take(ctx, []string{"other"}, rule); !r.Allowed || r.Remaining != 0 {
+		t.Errorf("Take() after a rejection = allowed %v remaining %d, want the token left", r.Allowed, r.Remaining)
+	}
+
+	// One token refills per second at 60 requests per minute
+	now = now.Add(time.Second)
+	if r, _ := store.Take(ctx, []string{"key"}, rule); !r.Allowed {
+		t.Error("Take() after refill should be allowed")
+	}
+}
+
+// failingStore is a store that is always unavailable
+type failingStore struct{}
+
+func (failingStore) Take(ctx context.Context, keys []string, rule Rule) (Result, error) {
+	return Result{}, errors.New("store unavailable")
+}
+
+// testMembership maps users to the laboratories they belong to
+type testMembership map[string]string
+
+func (m testMembership) IsMember(ctx context.Context, userID, laboratoryID string) (bool, error) {
+	return m[userID] == laboratoryID, nil
+}
+
+func setupTestRouter(store Store, rules Rules, members Membership) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	limiter := NewLimiter(store, rules, members)
+
+	r := gin.New()
+	// Stands in for the problem details middleware of the HTTP adapter
+	r.Use(func(c *gin.Context) {
+		c.Next()
+		if err := c.Errors.Last(); err != nil && errors.Is(err.Err, ErrRateLimited) {
+			c.Status(http.StatusTooManyRequests)
+		}
+	})
+	r.Use(func(c *gin.Context) {
+		if userID := c.GetHeader("X-Test-User"); userID != "" {
+			c.Request = c.Request.WithContext(auth.WithUserID(c.Request.Context(), userID))
+		}
+		c.Next()
+	})
+	r.GET("/orders", limiter.Middleware("orders"), func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.GET("/portal", limiter.Middleware("portal"), func(c *gin.Context) { c.Status(http.StatusOK) })
+	return r
+}
+
+func doRequest(r *gin.Engine, path, userID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if userID != "" {
+		req.Header.Set("X-Test-User", userID)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestLimiter_Middleware(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	router := setupTestRouter(newTestStore(&now), Rules{
+		Default: Rule{RequestsPerMinute: 60, Burst: 2},
+		Groups:  map[string]Rule{"portal": {RequestsPerMinute: 0}},
+	}, testMembership{"user_a": "lab-1", "user_b": "lab-1", "user_c": "lab-3", "user_d": "lab-4", "user_e": "lab-4"})
+
+	tests := []struct {
+		name       string
+		path       string
+		userID     string
+		wantStatus int
+	}{
+		{"first request", "/orders?laboratory_id=lab-1", "user_a", http.StatusOK},
+		{"second request", "/orders?laboratory_id=lab-1", "user_a", http.StatusOK},
+		{"identity exhausted", "/orders?laboratory_id=lab-2", "user_a", http.StatusTooManyRequests},
+		{"laboratory exhausted for another member", "/orders?laboratory_id=lab-1", "user_b", http.StatusTooManyRequests},
+		{"laboratory not charged for non-members", "/orders?laboratory_id=lab-4", "user_c", http.StatusOK},
+		{"laboratory not charged for non-members again", "/orders?laboratory_id=lab-4", "user_c", http.StatusOK},
+		{"throttled identity", "/orders?laboratory_id=lab-4", "user_c", http.StatusTooManyRequests},
+		{"laboratory left untouched by rejections", "/orders?laboratory_id=lab-4", "user_d", http.StatusOK},
+		{"laboratory shared by members", "/orders?laboratory_id=lab-4", "user_e", http.StatusOK},
+		{"laboratory exhausted", "/orders?laboratory_id=lab-4", "user_d", http.StatusTooManyRequests},
+		{"unlimited group", "/portal", "user_a", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, tt.path, tt.userID)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %v, want %v", w.Code, tt.wantStatus)
+			}
+			if tt.path == "/portal" {
+				return
+			}
+			if w.Header().Get("RateLimit-Limit") != "2" {
+				t.Errorf("RateLimit-Limit = %q, want %q", w.Header().Get("RateLimit-Limit"), "2")
+			}
+			if tt.wantStatus == http.StatusTooManyRequests && w.Header().Get("Retry-After") != "1" {
+				t.Errorf("Retry-After = %q, want %q", w.Header().Get("Retry-After"), "1")
+			}
+		})
+	}
+}
+
+func TestLimiter_Middleware_FailsOpen(t *testing.T) {
+	router := setupTestRouter(failingStore{}, Rules{Default: Rule{RequestsPerMinute: 1}}, nil)
+
+	if w := doRequest(router, "/orders", ""); w.Code != http.StatusOK {
+		t.Errorf("status = %v, want %v when the store is unavailable", w.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,47 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/uuid"
+)
+
+// Header is the HTTP header carrying the request correlation ID
+const Header = "X-Request-ID"
+
+// maxLength bounds client-supplied IDs so they can't bloat logs and audit entries
+const maxLength = 128
+
+type contextKey struct{}
+
+// Middleware propagates the X-Request-ID header, generating one when absent,
+// and stores it in the request context and the response headers
+func Middleware() gin.HandlerFunc {
+	gen := uuid.NewGenerator()
+	return func(c *gin.Context) {
+		id := c.GetHeader(Header)
+		if id == "" || len(id) > maxLength {
+			id = gen.Generate()
+		}
+
+		c.Request = c.Request.WithContext(WithRequestID(c.Request.Context(), id))
+		c.Header(Header, id)
+
+		c.Next()
+	}
+}
+
+// WithRequestID returns a copy of ctx carrying the given request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext extracts the request ID from context
+func FromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(contextKey{}).(string); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+package requestid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		wantSame bool
+	}{
+		{"missing header", "", false},
+		{"valid header", "req-123", true},
+		{"header at max length", strings.Repeat("a", maxLength), true},
+		{"header above max length", strings.Repeat("a", maxLength+1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			var stored string
+			r.GET("/orders", Middleware(), func(c *gin.Context) {
+				stored = FromContext(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+			if tt.header != "" {
+				req.Header.Set(Header, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(Header)
+			if got == "" || stored != got {
+				t.Fatalf("request ID in context = %q and response = %q, want the same non-empty ID", stored, got)
+			}
+			if (got == tt.header) != tt.wantSame {
+				t.Errorf("request ID = %q for header %q, want kept = %v", got, tt.header, tt.wantSame)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Package tracing sets up OpenTelemetry tracing. Spans are exported over OTLP,
+// or written to stdout in development, and the trace context is propagated
+// with the W3C traceparent header.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Name is the instrumentation scope of the spans of the server
+const Name = "github.com/JonatasP2A/dental-prosthesis/backend"
+
+// Exporters of the spans
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Attribute keys of the correlation attributes
+const (
+	LaboratoryIDKey = attribute.Key("laboratory_id")
+	UserIDKey       = attribute.Key("user_id")
+	RequestIDKey    = attribute.Key("request_id")
+)
+
+// Config holds the tracing settings
+type Config struct {
+	ServiceName string
+	Exporter    string  // otlp or stdout
+	Endpoint    string  // host:port of the OTLP gRPC collector
+	Insecure    bool    // Connect to the collector without TLS
+	SampleRatio float64 // Share of the new traces sampled, from 0 to 1
+}
+
+// NewProvider creates a tracer provider exporting the sampled spans in
+// batches. The stdout exporter writes them to w. Traces started by a caller
+// are sampled when the caller sampled them.
+func NewProvider(ctx context.Context, cfg Config, w io.Writer) (*sdktrace.TracerProvider, error) {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch strings.ToLower(cfg.Exporter) {
+	case ExporterOTLP, "":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(w))
+	default:
+		return nil, fmt.Errorf("tracing: unknown exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing: failed to create the %s exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("tracing: failed to describe the service: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	), nil
+}
+
+// Setup makes tp the provider of the spans of the server and propagates the
+// trace context with the W3C headers
+func Setup(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Start starts a span as a child of the span of ctx, if any
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(Name).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End ends span, recording *err as its error status when set. It is meant to
+// be deferred with the address of a named error result.
+func End(span trace.Span, err *error) {
+	if err != nil && *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
+
+// LaboratoryID is the attribute of the laboratory a span is for
+func LaboratoryID(id string) attribute.KeyValue {
+	return LaboratoryIDKey.String(id)
+}
+
+// EntityID is the attribute of the ID of an entity a span reads or writes,
+// keyed by its kind, e.g. order_id
+func EntityID(kind, id string) attribute.KeyValue {
+	return attribute.String(kind+"_id", id)
+}
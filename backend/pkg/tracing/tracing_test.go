@@ -0,0 +1,176 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/auth"
+	"github.com/JonatasP2A/dental-prosthesis/backend/pkg/logging"
+)
+
+// record makes a span recorder the provider of the spans for the test
+func record(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	spans := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { Setup(previous) })
+	Setup(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	return spans
+}
+
+// attributes returns the string attributes of a span by key
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]string {
+	attrs := map[attribute.Key]string{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value.Emit()
+	}
+	return attrs
+}
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"otlp", Config{ServiceName: "api", Exporter: ExporterOTLP, Endpoint: "localhost:4317", Insecure: true, SampleRatio: 1}, false},
+		{"stdout", Config{ServiceName: "api", Exporter: ExporterStdout, SampleRatio: 1}, false},
+		{"unknown exporter", Config{Exporter: "zipkin"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp, err := NewProvider(context.Background(), tt.cfg, &bytes.Buffer{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tp != nil {
+				_ = tp.Shutdown(context.Background())
+			}
+		})
+	}
+}
+
+func TestNewProvider_Stdout(t *testing.T) {
+	var buf bytes.Buffer
+	tp, err := NewProvider(context.Background(), Config{ServiceName: "dental-api", Exporter: ExporterStdout, SampleRatio: 1}, &buf)
+	if err != nil {
+		t.Fatalf("NewProvider() unexpected error = %v", err)
+	}
+
+	_, span := tp.Tracer(Name).Start(context.Background(), "order.GetOrder")
+	span.End()
+	if err := tp.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() unexpected error = %v", err)
+	}
+
+	for _, want := range []string{`"Name":"order.GetOrder"`, `"Value":"dental-api"`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("exported spans don't contain %s: %s", want, buf.String())
+		}
+	}
+}
+
+func TestEnd(t *testing.T) {
+	spans := record(t)
+
+	succeed := func(ctx context.Context) (err error) {
+		_, span := Start(ctx, "succeed", LaboratoryID("lab-1"), EntityID("order", "order-1"))
+		defer End(span, &err)
+		return nil
+	}
+	fail := func(ctx context.Context) (err error) {
+		_, span := Start(ctx, "fail")
+		defer End(span, &err)
+		return stderrors.New("store unavailable")
+	}
+	_ = succeed(context.Background())
+	_ = fail(context.Background())
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("ended %d spans, want 2", len(ended))
+	}
+	if attrs := attributes(ended[0]); attrs[LaboratoryIDKey] != "lab-1" || attrs["order_id"] != "order-1" {
+		t.Errorf("succeed attributes = %v, want the laboratory and order IDs", attrs)
+	}
+	if ended[0].Status().Code != codes.Unset {
+		t.Errorf("succeed status = %v, want unset", ended[0].Status().Code)
+	}
+	if ended[1].Status().Code != codes.Error || ended[1].Status().Description != "store unavailable" {
+		t.Errorf("fail status = %+v, want the error", ended[1].Status())
+	}
+	if len(ended[1].Events()) != 1 || ended[1].Events()[0].Name != "exception" {
+		t.Errorf("fail events = %+v, want the recorded error", ended[1].Events())
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	spans := record(t)
+
+	r := gin.New()
+	r.Use(logging.Middleware())
+	r.Use(Middleware())
+	authenticated := r.Group("", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(auth.WithUserID(c.Request.Context(), "user-123"))
+		c.Next()
+	})
+	authenticated.GET("/orders/:id", func(c *gin.Context) {
+		_, span := Start(c.Request.Context(), "order.GetOrder")
+		span.End()
+		c.Status(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/orders/order-123?laboratory_id=lab-123", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+	ended := spans.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("ended %d spans, want 3", len(ended))
+	}
+	child, server, unmatched := ended[0], ended[1], ended[2]
+
+	if server.Name() != "GET /orders/:id" {
+		t.Errorf("server span name = %q, want GET /orders/:id", server.Name())
+	}
+	if got := server.SpanContext().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("server span trace = %s, want the trace of the traceparent header", got)
+	}
+	if child.Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Error("handler span isn't a child of the server span")
+	}
+	want := map[attribute.Key]string{
+		"http.route":                "/orders/:id",
+		"http.response.status_code": "500",
+		LaboratoryIDKey:             "lab-123",
+		UserIDKey:                   "user-123",
+	}
+	attrs := attributes(server)
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("server span attribute %s = %q, want %q", key, attrs[key], value)
+		}
+	}
+	if server.Status().Code != codes.Error {
+		t.Errorf("server span status = %v, want error", server.Status().Code)
+	}
+
+	if unmatched.Name() != "GET unmatched" || unmatched.Status().Code != codes.Unset {
+		t.Errorf("unknown path span = %q (%v), want GET unmatched without error", unmatched.Name(), unmatched.Status().Code)
+	}
+}
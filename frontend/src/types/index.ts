@@ -14,15 +14,19 @@ export interface Laboratory {
   email: string
   phone: string
   address: Address
+  language: Language
   created_at: string
   updated_at: string
 }
 
+export type Language = 'pt-BR' | 'en' | 'es'
+
 export interface CreateLaboratoryRequest {
   name: string
   email: string
   phone: string
   address: Address
+  language?: Language
 }
 
 export interface UpdateLaboratoryRequest {
@@ -30,6 +34,7 @@ export interface UpdateLaboratoryRequest {
   email: string
   phone: string
   address: Address
+  language?: Language
 }
 
 // Client types
@@ -159,8 +164,21 @@ export interface UpdateTechnicianRequest {
   specializations?: string[]
 }
 
-// API Error types
+// API Error types (RFC 7807 problem details)
+export interface ApiFieldError {
+  field: string
+  message: string
+  code: string
+  params?: Record<string, string>
+}
+
 export interface ApiError {
-  error: string
-  details?: Record<string, string>
+  type: string
+  title: string
+  status: number
+  detail?: string
+  instance?: string
+  code: string
+  errors?: ApiFieldError[]
+  request_id?: string
 }